@@ -3,6 +3,7 @@ package gamestate
 import (
 	"context"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -14,7 +15,11 @@ type Client struct {
 }
 
 func New(addr string) (*Client, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -31,6 +36,13 @@ func (c *Client) UpdateGameState(ctx context.Context, state *gamestatev1.GameSta
 	return err
 }
 
+func (c *Client) UpdateGameStates(ctx context.Context, states []*gamestatev1.GameState) error {
+	_, err := c.client.UpdateGameStates(ctx, &gamestatev1.UpdateGameStatesRequest{
+		States: states,
+	})
+	return err
+}
+
 func (c *Client) WatchGameState(ctx context.Context, gameID string) (<-chan *gamestatev1.GameState, error) {
 	stream, err := c.client.WatchGameState(ctx, &gamestatev1.WatchGameStateRequest{
 		GameId: gameID,
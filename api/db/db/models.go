@@ -7,12 +7,191 @@ package db
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type CompetitionGender string
+
+const (
+	CompetitionGenderMen   CompetitionGender = "men"
+	CompetitionGenderWomen CompetitionGender = "women"
+	CompetitionGenderMixed CompetitionGender = "mixed"
+)
+
+func (e *CompetitionGender) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CompetitionGender(s)
+	case string:
+		*e = CompetitionGender(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CompetitionGender: %T", src)
+	}
+	return nil
+}
+
+type NullCompetitionGender struct {
+	CompetitionGender CompetitionGender
+	Valid             bool // Valid is true if CompetitionGender is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCompetitionGender) Scan(value interface{}) error {
+	if value == nil {
+		ns.CompetitionGender, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CompetitionGender.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCompetitionGender) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CompetitionGender), nil
+}
+
+type CompetitionLevel string
+
+const (
+	CompetitionLevelInternational CompetitionLevel = "international"
+	CompetitionLevelProfessional  CompetitionLevel = "professional"
+	CompetitionLevelProvincial    CompetitionLevel = "provincial"
+	CompetitionLevelClub          CompetitionLevel = "club"
+	CompetitionLevelSchool        CompetitionLevel = "school"
+)
+
+func (e *CompetitionLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CompetitionLevel(s)
+	case string:
+		*e = CompetitionLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CompetitionLevel: %T", src)
+	}
+	return nil
+}
+
+type NullCompetitionLevel struct {
+	CompetitionLevel CompetitionLevel
+	Valid            bool // Valid is true if CompetitionLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCompetitionLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.CompetitionLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CompetitionLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCompetitionLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CompetitionLevel), nil
+}
+
+type FinalsFormat string
+
+const (
+	FinalsFormatTop4 FinalsFormat = "top_4"
+	FinalsFormatTop6 FinalsFormat = "top_6"
+	FinalsFormatTop8 FinalsFormat = "top_8"
+)
+
+func (e *FinalsFormat) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FinalsFormat(s)
+	case string:
+		*e = FinalsFormat(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FinalsFormat: %T", src)
+	}
+	return nil
+}
+
+type NullFinalsFormat struct {
+	FinalsFormat FinalsFormat
+	Valid        bool // Valid is true if FinalsFormat is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFinalsFormat) Scan(value interface{}) error {
+	if value == nil {
+		ns.FinalsFormat, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FinalsFormat.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFinalsFormat) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FinalsFormat), nil
+}
+
+type GameEventType string
+
+const (
+	GameEventTypeTry         GameEventType = "try"
+	GameEventTypeConversion  GameEventType = "conversion"
+	GameEventTypePenaltyGoal GameEventType = "penalty_goal"
+	GameEventTypeDropGoal    GameEventType = "drop_goal"
+	GameEventTypePenaltyTry  GameEventType = "penalty_try"
+	GameEventTypeYellowCard  GameEventType = "yellow_card"
+	GameEventTypeRedCard     GameEventType = "red_card"
+)
+
+func (e *GameEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = GameEventType(s)
+	case string:
+		*e = GameEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for GameEventType: %T", src)
+	}
+	return nil
+}
+
+type NullGameEventType struct {
+	GameEventType GameEventType
+	Valid         bool // Valid is true if GameEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullGameEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.GameEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.GameEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullGameEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.GameEventType), nil
+}
+
 type GameStatus string
 
 const (
@@ -56,6 +235,142 @@ func (ns NullGameStatus) Value() (driver.Value, error) {
 	return string(ns.GameStatus), nil
 }
 
+type OfficialRole string
+
+const (
+	OfficialRoleReferee          OfficialRole = "referee"
+	OfficialRoleAssistantReferee OfficialRole = "assistant_referee"
+	OfficialRoleTmo              OfficialRole = "tmo"
+)
+
+func (e *OfficialRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OfficialRole(s)
+	case string:
+		*e = OfficialRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OfficialRole: %T", src)
+	}
+	return nil
+}
+
+type NullOfficialRole struct {
+	OfficialRole OfficialRole
+	Valid        bool // Valid is true if OfficialRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOfficialRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.OfficialRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OfficialRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOfficialRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OfficialRole), nil
+}
+
+type PlayerPosition string
+
+const (
+	PlayerPositionProp        PlayerPosition = "prop"
+	PlayerPositionHooker      PlayerPosition = "hooker"
+	PlayerPositionLock        PlayerPosition = "lock"
+	PlayerPositionFlanker     PlayerPosition = "flanker"
+	PlayerPositionNumberEight PlayerPosition = "number_eight"
+	PlayerPositionScrumHalf   PlayerPosition = "scrum_half"
+	PlayerPositionFlyHalf     PlayerPosition = "fly_half"
+	PlayerPositionCentre      PlayerPosition = "centre"
+	PlayerPositionWing        PlayerPosition = "wing"
+	PlayerPositionFullback    PlayerPosition = "fullback"
+)
+
+func (e *PlayerPosition) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PlayerPosition(s)
+	case string:
+		*e = PlayerPosition(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PlayerPosition: %T", src)
+	}
+	return nil
+}
+
+type NullPlayerPosition struct {
+	PlayerPosition PlayerPosition
+	Valid          bool // Valid is true if PlayerPosition is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPlayerPosition) Scan(value interface{}) error {
+	if value == nil {
+		ns.PlayerPosition, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PlayerPosition.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPlayerPosition) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PlayerPosition), nil
+}
+
+type SportCode string
+
+const (
+	SportCodeRugbyUnion  SportCode = "rugby_union"
+	SportCodeRugbySevens SportCode = "rugby_sevens"
+	SportCodeRugbyLeague SportCode = "rugby_league"
+)
+
+func (e *SportCode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SportCode(s)
+	case string:
+		*e = SportCode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SportCode: %T", src)
+	}
+	return nil
+}
+
+type NullSportCode struct {
+	SportCode SportCode
+	Valid     bool // Valid is true if SportCode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSportCode) Scan(value interface{}) error {
+	if value == nil {
+		ns.SportCode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SportCode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSportCode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SportCode), nil
+}
+
 type StageType string
 
 const (
@@ -99,28 +414,123 @@ func (ns NullStageType) Value() (driver.Value, error) {
 }
 
 type Competition struct {
+	ID              uuid.UUID
+	Name            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+	Sport           SportCode
+	Gender          NullCompetitionGender
+	Level           NullCompetitionLevel
+	Country         sql.NullString
+	Region          sql.NullString
+	GoverningBody   sql.NullString
+	LogoUrl         sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	Website         sql.NullString
+}
+
+type Game struct {
+	ID           uuid.UUID
+	SeasonID     uuid.UUID
+	StageID      uuid.UUID
+	Date         time.Time
+	HomeTeamID   uuid.UUID
+	AwayTeamID   uuid.UUID
+	HomeScore    sql.NullInt32
+	AwayScore    sql.NullInt32
+	Status       GameStatus
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
+	VenueID      uuid.NullUUID
+	NeutralVenue bool
+}
+
+type GameEvent struct {
 	ID        uuid.UUID
-	Name      string
+	GameID    uuid.UUID
+	TeamID    uuid.UUID
+	PlayerID  uuid.NullUUID
+	EventType GameEventType
+	Minute    int32
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt sql.NullTime
 }
 
-type Game struct {
+type GameLineupPlayer struct {
+	ID          uuid.UUID
+	GameID      uuid.UUID
+	TeamID      uuid.UUID
+	PlayerID    uuid.UUID
+	ShirtNumber int32
+	Captain     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
+}
+
+type GameOfficial struct {
 	ID         uuid.UUID
-	SeasonID   uuid.UUID
-	StageID    uuid.UUID
-	Date       time.Time
-	HomeTeamID uuid.UUID
-	AwayTeamID uuid.UUID
-	HomeScore  sql.NullInt32
-	AwayScore  sql.NullInt32
-	Status     GameStatus
+	GameID     uuid.UUID
+	OfficialID uuid.UUID
+	Role       OfficialRole
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  sql.NullTime
 }
 
+type GameReplacement struct {
+	ID          uuid.UUID
+	GameID      uuid.UUID
+	TeamID      uuid.UUID
+	PlayerOffID uuid.UUID
+	PlayerOnID  uuid.UUID
+	Minute      int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
+}
+
+type Official struct {
+	ID          uuid.UUID
+	Name        string
+	Nationality sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
+}
+
+type Player struct {
+	ID          uuid.UUID
+	TeamID      uuid.UUID
+	Name        string
+	DateOfBirth sql.NullTime
+	Position    PlayerPosition
+	Nationality sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
+}
+
+type PlayerGameStat struct {
+	GameID       uuid.UUID
+	SeasonID     uuid.UUID
+	TeamID       uuid.UUID
+	PlayerID     uuid.UUID
+	Appeared     bool
+	Minutes      int32
+	Tries        int32
+	Conversions  int32
+	PenaltyGoals int32
+	DropGoals    int32
+	YellowCards  int32
+	RedCards     int32
+	Points       int32
+}
+
 type Season struct {
 	ID            uuid.UUID
 	CompetitionID uuid.UUID
@@ -129,15 +539,38 @@ type Season struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	DeletedAt     sql.NullTime
+	Name          string
+	Year          int32
+}
+
+type SeasonFinal struct {
+	SeasonID          uuid.UUID
+	Format            FinalsFormat
+	RoundIntervalDays int32
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 type SeasonTeam struct {
-	ID        uuid.UUID
-	SeasonID  uuid.UUID
-	TeamID    uuid.UUID
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
+	ID          uuid.UUID
+	SeasonID    uuid.UUID
+	TeamID      uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
+	HomeVenueID uuid.NullUUID
+}
+
+type SeasonTeamPlayer struct {
+	ID           uuid.UUID
+	SeasonTeamID uuid.UUID
+	PlayerID     uuid.UUID
+	JerseyNumber int32
+	ActiveFrom   sql.NullTime
+	ActiveTo     sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
 }
 
 type Stage struct {
@@ -152,10 +585,32 @@ type Stage struct {
 }
 
 type Team struct {
+	ID              uuid.UUID
+	Name            string
+	Abbreviation    string
+	Location        string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+	HomeVenueID     uuid.NullUUID
+	ShortName       sql.NullString
+	LogoUrl         sql.NullString
+	CrestUrl        sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	FoundedYear     sql.NullInt32
+	SocialHandles   json.RawMessage
+	ExternalRefs    json.RawMessage
+}
+
+type TeamAlias struct {
 	ID           uuid.UUID
+	TeamID       uuid.UUID
 	Name         string
 	Abbreviation string
-	Location     string
+	LogoUrl      sql.NullString
+	ValidFrom    time.Time
+	ValidTo      sql.NullTime
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    sql.NullTime
@@ -166,3 +621,17 @@ type User struct {
 	Name  string
 	Email string
 }
+
+type Venue struct {
+	ID        uuid.UUID
+	Name      string
+	City      string
+	Country   string
+	Timezone  string
+	Capacity  sql.NullInt32
+	Latitude  sql.NullFloat64
+	Longitude sql.NullFloat64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
+}
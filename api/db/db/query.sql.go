@@ -8,30 +8,262 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const clearSeasonTeamHomeVenues = `-- name: ClearSeasonTeamHomeVenues :exec
+UPDATE season_teams
+SET
+	home_venue_id = NULL,
+	updated_at = $1
+WHERE
+	home_venue_id = $2
+`
+
+type ClearSeasonTeamHomeVenuesParams struct {
+	UpdatedAt time.Time
+	VenueID   uuid.NullUUID
+}
+
+// Clear every season home venue override using the given venue
+func (q *Queries) ClearSeasonTeamHomeVenues(ctx context.Context, arg ClearSeasonTeamHomeVenuesParams) error {
+	_, err := q.db.ExecContext(ctx, clearSeasonTeamHomeVenues, arg.UpdatedAt, arg.VenueID)
+	return err
+}
+
+const clearTeamHomeVenues = `-- name: ClearTeamHomeVenues :exec
+UPDATE teams
+SET
+	home_venue_id = NULL,
+	updated_at = $1
+WHERE
+	home_venue_id = $2
+`
+
+type ClearTeamHomeVenuesParams struct {
+	UpdatedAt time.Time
+	VenueID   uuid.NullUUID
+}
+
+// Clear the default home venue of every team using the given venue
+func (q *Queries) ClearTeamHomeVenues(ctx context.Context, arg ClearTeamHomeVenuesParams) error {
+	_, err := q.db.ExecContext(ctx, clearTeamHomeVenues, arg.UpdatedAt, arg.VenueID)
+	return err
+}
+
 const countCompetitions = `-- name: CountCompetitions :one
-SELECT COUNT(*) FROM competitions WHERE deleted_at IS NULL
+SELECT
+    COUNT(*)
+FROM
+    competitions
+WHERE
+    deleted_at IS NULL
+AND
+    ($1::sport_code IS NULL OR sport = $1)
+AND
+    ($2::competition_gender IS NULL OR gender = $2)
+AND
+    ($3::competition_level IS NULL OR level = $3)
+AND
+    ($4::text IS NULL OR country = $4)
+`
+
+type CountCompetitionsParams struct {
+	Sport   NullSportCode
+	Gender  NullCompetitionGender
+	Level   NullCompetitionLevel
+	Country sql.NullString
+}
+
+// Get total competitions matching the optional filters (excluding soft-deleted)
+func (q *Queries) CountCompetitions(ctx context.Context, arg CountCompetitionsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCompetitions,
+		arg.Sport,
+		arg.Gender,
+		arg.Level,
+		arg.Country,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countGameFeed = `-- name: CountGameFeed :one
+SELECT
+    COUNT(*)
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+JOIN
+    stages st ON st.id = g.stage_id
+JOIN
+    teams ht ON ht.id = g.home_team_id
+JOIN
+    teams awt ON awt.id = g.away_team_id
+WHERE
+    g.deleted_at IS NULL
+AND
+    ($1::uuid IS NULL OR s.competition_id = $1)
+AND
+    ($2::uuid IS NULL OR g.stage_id = $2)
+AND
+    ($3::uuid IS NULL OR g.home_team_id = $3 OR g.away_team_id = $3)
+AND
+    ($4::game_status IS NULL OR g.status = $4)
+AND
+    ($5::timestamptz IS NULL OR g.date >= $5)
+AND
+    ($6::timestamptz IS NULL OR g.date <= $6)
 `
 
-// Get total competitions (excluding soft-deleted)
-func (q *Queries) CountCompetitions(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countCompetitions)
+type CountGameFeedParams struct {
+	CompetitionID uuid.NullUUID
+	StageID       uuid.NullUUID
+	TeamID        uuid.NullUUID
+	Status        NullGameStatus
+	DateFrom      sql.NullTime
+	DateTo        sql.NullTime
+}
+
+// Get total games across competitions matching the optional filters (excluding soft-deleted)
+func (q *Queries) CountGameFeed(ctx context.Context, arg CountGameFeedParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countGameFeed,
+		arg.CompetitionID,
+		arg.StageID,
+		arg.TeamID,
+		arg.Status,
+		arg.DateFrom,
+		arg.DateTo,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const countGames = `-- name: CountGames :one
-SELECT COUNT(*) FROM games WHERE season_id = $1 AND deleted_at IS NULL
+SELECT
+    COUNT(*)
+FROM
+    games
+WHERE
+    season_id = $1
+AND
+    ($2::uuid IS NULL OR stage_id = $2)
+AND
+    ($3::uuid IS NULL OR home_team_id = $3 OR away_team_id = $3)
+AND
+    ($4::game_status IS NULL OR status = $4)
+AND
+    ($5::timestamptz IS NULL OR date >= $5)
+AND
+    ($6::timestamptz IS NULL OR date <= $6)
+AND
+    deleted_at IS NULL
+`
+
+type CountGamesParams struct {
+	SeasonID uuid.UUID
+	StageID  uuid.NullUUID
+	TeamID   uuid.NullUUID
+	Status   NullGameStatus
+	DateFrom sql.NullTime
+	DateTo   sql.NullTime
+}
+
+// Get total games for a season matching the optional filters (excluding soft-deleted)
+func (q *Queries) CountGames(ctx context.Context, arg CountGamesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countGames,
+		arg.SeasonID,
+		arg.StageID,
+		arg.TeamID,
+		arg.Status,
+		arg.DateFrom,
+		arg.DateTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countGamesByTeamID = `-- name: CountGamesByTeamID :one
+SELECT
+    COUNT(*)
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (g.home_team_id = $1 OR g.away_team_id = $1)
+AND
+    ($2::uuid IS NULL OR g.season_id = $2)
+AND
+    ($3::game_status IS NULL OR g.status = $3)
+AND
+    g.deleted_at IS NULL
+`
+
+type CountGamesByTeamIDParams struct {
+	TeamID   uuid.UUID
+	SeasonID uuid.NullUUID
+	Status   NullGameStatus
+}
+
+// Get total games a team played in across all seasons matching the optional filters (excluding soft-deleted)
+func (q *Queries) CountGamesByTeamID(ctx context.Context, arg CountGamesByTeamIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countGamesByTeamID, arg.TeamID, arg.SeasonID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countGamesByVenueID = `-- name: CountGamesByVenueID :one
+SELECT COUNT(*)
+FROM games
+WHERE venue_id = $1
+AND deleted_at IS NULL
+`
+
+// Get total games played at a venue (excluding soft-deleted)
+func (q *Queries) CountGamesByVenueID(ctx context.Context, venueID uuid.NullUUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countGamesByVenueID, venueID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOfficials = `-- name: CountOfficials :one
+SELECT COUNT(*)
+FROM officials
+WHERE deleted_at IS NULL
+`
+
+// Get total officials (excluding soft-deleted)
+func (q *Queries) CountOfficials(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOfficials)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPlayersByTeamID = `-- name: CountPlayersByTeamID :one
+SELECT COUNT(*)
+FROM players
+WHERE team_id = $1
+AND deleted_at IS NULL
 `
 
-// Get total games for a season (excluding soft-deleted)
-func (q *Queries) CountGames(ctx context.Context, seasonID uuid.UUID) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countGames, seasonID)
+// Get total players in a team (excluding soft-deleted)
+func (q *Queries) CountPlayersByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPlayersByTeamID, teamID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -52,12 +284,40 @@ func (q *Queries) CountSeasons(ctx context.Context, competitionID uuid.UUID) (in
 const countTeams = `-- name: CountTeams :one
 SELECT COUNT(*)
 FROM teams
+WHERE
+	deleted_at IS NULL
+AND
+	(
+		$1::text IS NULL
+		OR name ILIKE $1
+		OR abbreviation ILIKE $1
+		OR EXISTS (
+			SELECT 1
+			FROM team_aliases a
+			WHERE a.team_id = teams.id
+			AND a.deleted_at IS NULL
+			AND (a.name ILIKE $1 OR a.abbreviation ILIKE $1)
+		)
+	)
+`
+
+// Get total teams matching the optional pattern (excluding soft-deleted)
+func (q *Queries) CountTeams(ctx context.Context, namePattern sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTeams, namePattern)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countVenues = `-- name: CountVenues :one
+SELECT COUNT(*)
+FROM venues
 WHERE deleted_at IS NULL
 `
 
-// Get total teams (excluding soft-deleted)
-func (q *Queries) CountTeams(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countTeams)
+// Get total venues (excluding soft-deleted)
+func (q *Queries) CountVenues(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countVenues)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -69,23 +329,53 @@ INSERT INTO competitions (
     name,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    sport,
+    gender,
+    level,
+    country,
+    region,
+    governing_body,
+    logo_url,
+    primary_colour,
+    secondary_colour,
+    website
 )
 VALUES (
     $1,
     $2,
     $3,
     $4,
-    $5
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15
 )
 `
 
 type CreateCompetitionParams struct {
-	ID        uuid.UUID
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
+	ID              uuid.UUID
+	Name            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+	Sport           SportCode
+	Gender          NullCompetitionGender
+	Level           NullCompetitionLevel
+	Country         sql.NullString
+	Region          sql.NullString
+	GoverningBody   sql.NullString
+	LogoUrl         sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	Website         sql.NullString
 }
 
 // Insert a new competition into the database
@@ -96,6 +386,16 @@ func (q *Queries) CreateCompetition(ctx context.Context, arg CreateCompetitionPa
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.DeletedAt,
+		arg.Sport,
+		arg.Gender,
+		arg.Level,
+		arg.Country,
+		arg.Region,
+		arg.GoverningBody,
+		arg.LogoUrl,
+		arg.PrimaryColour,
+		arg.SecondaryColour,
+		arg.Website,
 	)
 	return err
 }
@@ -113,7 +413,9 @@ INSERT INTO games (
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    venue_id,
+    neutral_venue
 )
 VALUES (
     $1,
@@ -127,23 +429,27 @@ VALUES (
     $9,
     $10,
     $11,
-    $12
+    $12,
+    $13,
+    $14
 )
 `
 
 type CreateGameParams struct {
-	ID         uuid.UUID
-	SeasonID   uuid.UUID
-	StageID    uuid.UUID
-	Date       time.Time
-	HomeTeamID uuid.UUID
-	AwayTeamID uuid.UUID
-	HomeScore  sql.NullInt32
-	AwayScore  sql.NullInt32
-	Status     GameStatus
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  sql.NullTime
+	ID           uuid.UUID
+	SeasonID     uuid.UUID
+	StageID      uuid.UUID
+	Date         time.Time
+	HomeTeamID   uuid.UUID
+	AwayTeamID   uuid.UUID
+	HomeScore    sql.NullInt32
+	AwayScore    sql.NullInt32
+	Status       GameStatus
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
+	VenueID      uuid.NullUUID
+	NeutralVenue bool
 }
 
 // Insert a new game into the database
@@ -161,16 +467,20 @@ func (q *Queries) CreateGame(ctx context.Context, arg CreateGameParams) error {
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.DeletedAt,
+		arg.VenueID,
+		arg.NeutralVenue,
 	)
 	return err
 }
 
-const createSeason = `-- name: CreateSeason :exec
-INSERT INTO seasons (
+const createGameEvent = `-- name: CreateGameEvent :exec
+INSERT INTO game_events (
 	id,
-	competition_id,
-	start_date,
-	end_date,
+	game_id,
+	team_id,
+	player_id,
+	event_type,
+	minute,
 	created_at,
 	updated_at,
 	deleted_at
@@ -182,27 +492,33 @@ VALUES (
 	$4,
 	$5,
 	$6,
-	$7
+	$7,
+	$8,
+	$9
 )
 `
 
-type CreateSeasonParams struct {
-	ID            uuid.UUID
-	CompetitionID uuid.UUID
-	StartDate     time.Time
-	EndDate       time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	DeletedAt     sql.NullTime
+type CreateGameEventParams struct {
+	ID        uuid.UUID
+	GameID    uuid.UUID
+	TeamID    uuid.UUID
+	PlayerID  uuid.NullUUID
+	EventType GameEventType
+	Minute    int32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
 }
 
-// Insert a new season into the database
-func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) error {
-	_, err := q.db.ExecContext(ctx, createSeason,
+// Record a scoring or disciplinary event in a game
+func (q *Queries) CreateGameEvent(ctx context.Context, arg CreateGameEventParams) error {
+	_, err := q.db.ExecContext(ctx, createGameEvent,
 		arg.ID,
-		arg.CompetitionID,
-		arg.StartDate,
-		arg.EndDate,
+		arg.GameID,
+		arg.TeamID,
+		arg.PlayerID,
+		arg.EventType,
+		arg.Minute,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.DeletedAt,
@@ -210,40 +526,52 @@ func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) erro
 	return err
 }
 
-const createSeasonTeams = `-- name: CreateSeasonTeams :exec
-INSERT INTO season_teams (
-  id,
-  team_id,
-  season_id,
-  created_at,
-  updated_at,
-  deleted_at
+const createGameLineupPlayer = `-- name: CreateGameLineupPlayer :exec
+INSERT INTO game_lineup_players (
+	id,
+	game_id,
+	team_id,
+	player_id,
+	shirt_number,
+	captain,
+	created_at,
+	updated_at,
+	deleted_at
 )
 VALUES (
-  $1,
-  $2,
-  $3,
-  $4,
-  $5,
-  $6
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9
 )
 `
 
-type CreateSeasonTeamsParams struct {
-	ID        uuid.UUID
-	TeamID    uuid.UUID
-	SeasonID  uuid.UUID
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
+type CreateGameLineupPlayerParams struct {
+	ID          uuid.UUID
+	GameID      uuid.UUID
+	TeamID      uuid.UUID
+	PlayerID    uuid.UUID
+	ShirtNumber int32
+	Captain     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
-// Insert a new season_teams relationship
-func (q *Queries) CreateSeasonTeams(ctx context.Context, arg CreateSeasonTeamsParams) error {
-	_, err := q.db.ExecContext(ctx, createSeasonTeams,
+// Name a player on a side's team sheet for a game
+func (q *Queries) CreateGameLineupPlayer(ctx context.Context, arg CreateGameLineupPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, createGameLineupPlayer,
 		arg.ID,
+		arg.GameID,
 		arg.TeamID,
-		arg.SeasonID,
+		arg.PlayerID,
+		arg.ShirtNumber,
+		arg.Captain,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.DeletedAt,
@@ -251,48 +579,44 @@ func (q *Queries) CreateSeasonTeams(ctx context.Context, arg CreateSeasonTeamsPa
 	return err
 }
 
-const createStage = `-- name: CreateStage :exec
-INSERT INTO stages (
+const createGameOfficial = `-- name: CreateGameOfficial :exec
+INSERT INTO game_officials (
 	id,
-	season_id,
-	name,
-	stage_type,
-	order_index,
+	game_id,
+	official_id,
+	role,
 	created_at,
 	updated_at,
 	deleted_at
 )
 VALUES (
 	$1,
-  $2,
+	$2,
 	$3,
 	$4,
 	$5,
 	$6,
-	$7,
-	$8
+	$7
 )
 `
 
-type CreateStageParams struct {
+type CreateGameOfficialParams struct {
 	ID         uuid.UUID
-	SeasonID   uuid.UUID
-	Name       string
-	StageType  StageType
-	OrderIndex int32
+	GameID     uuid.UUID
+	OfficialID uuid.UUID
+	Role       OfficialRole
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	DeletedAt  sql.NullTime
 }
 
-// Insert a new stage into the database
-func (q *Queries) CreateStage(ctx context.Context, arg CreateStageParams) error {
-	_, err := q.db.ExecContext(ctx, createStage,
+// Appoint an official to a game
+func (q *Queries) CreateGameOfficial(ctx context.Context, arg CreateGameOfficialParams) error {
+	_, err := q.db.ExecContext(ctx, createGameOfficial,
 		arg.ID,
-		arg.SeasonID,
-		arg.Name,
-		arg.StageType,
-		arg.OrderIndex,
+		arg.GameID,
+		arg.OfficialID,
+		arg.Role,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.DeletedAt,
@@ -300,12 +624,14 @@ func (q *Queries) CreateStage(ctx context.Context, arg CreateStageParams) error
 	return err
 }
 
-const createTeam = `-- name: CreateTeam :exec
-INSERT INTO teams (
+const createGameReplacement = `-- name: CreateGameReplacement :exec
+INSERT INTO game_replacements (
 	id,
-	name,
-	abbreviation,
-	location,
+	game_id,
+	team_id,
+	player_off_id,
+	player_on_id,
+	minute,
 	created_at,
 	updated_at,
 	deleted_at
@@ -317,27 +643,33 @@ VALUES (
 	$4,
 	$5,
 	$6,
-	$7
+	$7,
+	$8,
+	$9
 )
 `
 
-type CreateTeamParams struct {
-	ID           uuid.UUID
-	Name         string
-	Abbreviation string
-	Location     string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    sql.NullTime
+type CreateGameReplacementParams struct {
+	ID          uuid.UUID
+	GameID      uuid.UUID
+	TeamID      uuid.UUID
+	PlayerOffID uuid.UUID
+	PlayerOnID  uuid.UUID
+	Minute      int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
-// Insert a new team into the database
-func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) error {
-	_, err := q.db.ExecContext(ctx, createTeam,
+// Record a replacement made during a game
+func (q *Queries) CreateGameReplacement(ctx context.Context, arg CreateGameReplacementParams) error {
+	_, err := q.db.ExecContext(ctx, createGameReplacement,
 		arg.ID,
-		arg.Name,
-		arg.Abbreviation,
-		arg.Location,
+		arg.GameID,
+		arg.TeamID,
+		arg.PlayerOffID,
+		arg.PlayerOnID,
+		arg.Minute,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.DeletedAt,
@@ -345,358 +677,2942 @@ func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) error {
 	return err
 }
 
-const deleteCompetition = `-- name: DeleteCompetition :exec
-UPDATE competitions
-SET
-	deleted_at = $1
-WHERE
-	id = $2
-AND
-	deleted_at IS NULL
+const createOfficial = `-- name: CreateOfficial :exec
+INSERT INTO officials (
+	id,
+	name,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6
+)
 `
 
-type DeleteCompetitionParams struct {
-	DeletedAt sql.NullTime
-	ID        uuid.UUID
+type CreateOfficialParams struct {
+	ID          uuid.UUID
+	Name        string
+	Nationality sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
-// Soft delete a competition
-func (q *Queries) DeleteCompetition(ctx context.Context, arg DeleteCompetitionParams) error {
-	_, err := q.db.ExecContext(ctx, deleteCompetition, arg.DeletedAt, arg.ID)
+// Insert a new match official into the database
+func (q *Queries) CreateOfficial(ctx context.Context, arg CreateOfficialParams) error {
+	_, err := q.db.ExecContext(ctx, createOfficial,
+		arg.ID,
+		arg.Name,
+		arg.Nationality,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
 	return err
 }
 
-const deleteGame = `-- name: DeleteGame :exec
-UPDATE games
-SET
-    deleted_at = $1
-WHERE
-    id = $2
-AND
-    deleted_at IS NULL
+const createPlayer = `-- name: CreatePlayer :exec
+INSERT INTO players (
+	id,
+	team_id,
+	name,
+	date_of_birth,
+	position,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9
+)
 `
 
-type DeleteGameParams struct {
-	DeletedAt sql.NullTime
-	ID        uuid.UUID
+type CreatePlayerParams struct {
+	ID          uuid.UUID
+	TeamID      uuid.UUID
+	Name        string
+	DateOfBirth sql.NullTime
+	Position    PlayerPosition
+	Nationality sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
-// Soft delete a game
-func (q *Queries) DeleteGame(ctx context.Context, arg DeleteGameParams) error {
-	_, err := q.db.ExecContext(ctx, deleteGame, arg.DeletedAt, arg.ID)
+// Insert a new player into the database
+func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) error {
+	_, err := q.db.ExecContext(ctx, createPlayer,
+		arg.ID,
+		arg.TeamID,
+		arg.Name,
+		arg.DateOfBirth,
+		arg.Position,
+		arg.Nationality,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
 	return err
 }
 
-const deleteGamesByCompetitionID = `-- name: DeleteGamesByCompetitionID :exec
-UPDATE games
-SET
-    deleted_at = $1
-WHERE
-    season_id IN (
-        SELECT id
-        FROM seasons
-        WHERE competition_id = $2
-          AND deleted_at IS NULL
-    )
-AND
-    deleted_at IS NULL
+const createSeason = `-- name: CreateSeason :exec
+INSERT INTO seasons (
+	id,
+	competition_id,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	deleted_at,
+	name,
+	year
+)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9
+)
 `
 
-type DeleteGamesByCompetitionIDParams struct {
-	DeletedAt     sql.NullTime
+type CreateSeasonParams struct {
+	ID            uuid.UUID
 	CompetitionID uuid.UUID
+	StartDate     time.Time
+	EndDate       time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     sql.NullTime
+	Name          string
+	Year          int32
 }
 
-// Soft delete all games belonging to a competition via seasons
-func (q *Queries) DeleteGamesByCompetitionID(ctx context.Context, arg DeleteGamesByCompetitionIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteGamesByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+// Insert a new season into the database
+func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) error {
+	_, err := q.db.ExecContext(ctx, createSeason,
+		arg.ID,
+		arg.CompetitionID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+		arg.Name,
+		arg.Year,
+	)
 	return err
 }
 
-const deleteGamesBySeasonID = `-- name: DeleteGamesBySeasonID :exec
-UPDATE games
-SET
-  deleted_at = $1
-WHERE
-  season_id = $2
-AND
-  deleted_at IS NULL
+const createSeasonTeamPlayer = `-- name: CreateSeasonTeamPlayer :exec
+INSERT INTO season_team_players (
+	id,
+	season_team_id,
+	player_id,
+	jersey_number,
+	active_from,
+	active_to,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9
+)
 `
 
-type DeleteGamesBySeasonIDParams struct {
-	DeletedAt sql.NullTime
-	SeasonID  uuid.UUID
+type CreateSeasonTeamPlayerParams struct {
+	ID           uuid.UUID
+	SeasonTeamID uuid.UUID
+	PlayerID     uuid.UUID
+	JerseyNumber int32
+	ActiveFrom   sql.NullTime
+	ActiveTo     sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
 }
 
-// Soft delete all games for a given season
-func (q *Queries) DeleteGamesBySeasonID(ctx context.Context, arg DeleteGamesBySeasonIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteGamesBySeasonID, arg.DeletedAt, arg.SeasonID)
+// Name a player in a team's squad for a season
+func (q *Queries) CreateSeasonTeamPlayer(ctx context.Context, arg CreateSeasonTeamPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, createSeasonTeamPlayer,
+		arg.ID,
+		arg.SeasonTeamID,
+		arg.PlayerID,
+		arg.JerseyNumber,
+		arg.ActiveFrom,
+		arg.ActiveTo,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
 	return err
 }
 
-const deleteSeason = `-- name: DeleteSeason :exec
-UPDATE seasons
-SET
-	deleted_at = $1
-WHERE
-	id = $2
-AND
-	deleted_at IS NULL
+const createSeasonTeams = `-- name: CreateSeasonTeams :exec
+INSERT INTO season_teams (
+  id,
+  team_id,
+  season_id,
+  created_at,
+  updated_at,
+  deleted_at
+)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
 `
 
-type DeleteSeasonParams struct {
-	DeletedAt sql.NullTime
+type CreateSeasonTeamsParams struct {
 	ID        uuid.UUID
+	TeamID    uuid.UUID
+	SeasonID  uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
 }
 
-// Soft delete a season
-func (q *Queries) DeleteSeason(ctx context.Context, arg DeleteSeasonParams) error {
-	_, err := q.db.ExecContext(ctx, deleteSeason, arg.DeletedAt, arg.ID)
+// Insert a new season_teams relationship
+func (q *Queries) CreateSeasonTeams(ctx context.Context, arg CreateSeasonTeamsParams) error {
+	_, err := q.db.ExecContext(ctx, createSeasonTeams,
+		arg.ID,
+		arg.TeamID,
+		arg.SeasonID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
 	return err
 }
 
-const deleteSeasonTeam = `-- name: DeleteSeasonTeam :exec
-UPDATE season_teams
-SET
-  deleted_at = $1
-WHERE
-  id = $2
-AND
-  deleted_at IS NULL
+const createStage = `-- name: CreateStage :exec
+INSERT INTO stages (
+	id,
+	season_id,
+	name,
+	stage_type,
+	order_index,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	$1,
+  $2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8
+)
 `
 
-type DeleteSeasonTeamParams struct {
-	DeletedAt sql.NullTime
-	ID        uuid.UUID
-}
-
-// Soft delete a team_season record
-func (q *Queries) DeleteSeasonTeam(ctx context.Context, arg DeleteSeasonTeamParams) error {
-	_, err := q.db.ExecContext(ctx, deleteSeasonTeam, arg.DeletedAt, arg.ID)
-	return err
+type CreateStageParams struct {
+	ID         uuid.UUID
+	SeasonID   uuid.UUID
+	Name       string
+	StageType  StageType
+	OrderIndex int32
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  sql.NullTime
 }
 
-const deleteSeasonTeamsByCompetitionID = `-- name: DeleteSeasonTeamsByCompetitionID :exec
-UPDATE season_teams
+// Insert a new stage into the database
+func (q *Queries) CreateStage(ctx context.Context, arg CreateStageParams) error {
+	_, err := q.db.ExecContext(ctx, createStage,
+		arg.ID,
+		arg.SeasonID,
+		arg.Name,
+		arg.StageType,
+		arg.OrderIndex,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
+	return err
+}
+
+const createTeam = `-- name: CreateTeam :exec
+INSERT INTO teams (
+	id,
+	name,
+	abbreviation,
+	location,
+	created_at,
+	updated_at,
+	deleted_at,
+	home_venue_id,
+	short_name,
+	logo_url,
+	crest_url,
+	primary_colour,
+	secondary_colour,
+	founded_year,
+	social_handles,
+	external_refs
+)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9,
+	$10,
+	$11,
+	$12,
+	$13,
+	$14,
+	$15,
+	$16
+)
+`
+
+type CreateTeamParams struct {
+	ID              uuid.UUID
+	Name            string
+	Abbreviation    string
+	Location        string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+	HomeVenueID     uuid.NullUUID
+	ShortName       sql.NullString
+	LogoUrl         sql.NullString
+	CrestUrl        sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	FoundedYear     sql.NullInt32
+	SocialHandles   json.RawMessage
+	ExternalRefs    json.RawMessage
+}
+
+// Insert a new team into the database
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) error {
+	_, err := q.db.ExecContext(ctx, createTeam,
+		arg.ID,
+		arg.Name,
+		arg.Abbreviation,
+		arg.Location,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+		arg.HomeVenueID,
+		arg.ShortName,
+		arg.LogoUrl,
+		arg.CrestUrl,
+		arg.PrimaryColour,
+		arg.SecondaryColour,
+		arg.FoundedYear,
+		arg.SocialHandles,
+		arg.ExternalRefs,
+	)
+	return err
+}
+
+const createTeamAlias = `-- name: CreateTeamAlias :exec
+INSERT INTO team_aliases (
+    id,
+    team_id,
+    name,
+    abbreviation,
+    logo_url,
+    valid_from,
+    valid_to,
+    created_at,
+    updated_at,
+    deleted_at
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+)
+`
+
+type CreateTeamAliasParams struct {
+	ID           uuid.UUID
+	TeamID       uuid.UUID
+	Name         string
+	Abbreviation string
+	LogoUrl      sql.NullString
+	ValidFrom    time.Time
+	ValidTo      sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    sql.NullTime
+}
+
+// Insert a new team alias into the database
+func (q *Queries) CreateTeamAlias(ctx context.Context, arg CreateTeamAliasParams) error {
+	_, err := q.db.ExecContext(ctx, createTeamAlias,
+		arg.ID,
+		arg.TeamID,
+		arg.Name,
+		arg.Abbreviation,
+		arg.LogoUrl,
+		arg.ValidFrom,
+		arg.ValidTo,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
+	return err
+}
+
+const createVenue = `-- name: CreateVenue :exec
+INSERT INTO venues (
+	id,
+	name,
+	city,
+	country,
+	timezone,
+	capacity,
+	latitude,
+	longitude,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9,
+	$10,
+	$11
+)
+`
+
+type CreateVenueParams struct {
+	ID        uuid.UUID
+	Name      string
+	City      string
+	Country   string
+	Timezone  string
+	Capacity  sql.NullInt32
+	Latitude  sql.NullFloat64
+	Longitude sql.NullFloat64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt sql.NullTime
+}
+
+// Insert a new venue into the database
+func (q *Queries) CreateVenue(ctx context.Context, arg CreateVenueParams) error {
+	_, err := q.db.ExecContext(ctx, createVenue,
+		arg.ID,
+		arg.Name,
+		arg.City,
+		arg.Country,
+		arg.Timezone,
+		arg.Capacity,
+		arg.Latitude,
+		arg.Longitude,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DeletedAt,
+	)
+	return err
+}
+
+const deleteCompetition = `-- name: DeleteCompetition :exec
+UPDATE competitions
 SET
-  deleted_at = $1
+	deleted_at = $1
 WHERE
-    season_id IN (
-        SELECT id
-        FROM seasons
-        WHERE competition_id = $2
-          AND deleted_at IS NULL
-    )
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteCompetitionParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a competition
+func (q *Queries) DeleteCompetition(ctx context.Context, arg DeleteCompetitionParams) error {
+	_, err := q.db.ExecContext(ctx, deleteCompetition, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteGame = `-- name: DeleteGame :exec
+UPDATE games
+SET
+    deleted_at = $1
+WHERE
+    id = $2
 AND
     deleted_at IS NULL
 `
 
-type DeleteSeasonTeamsByCompetitionIDParams struct {
-	DeletedAt     sql.NullTime
-	CompetitionID uuid.UUID
+type DeleteGameParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
 }
 
-// Soft delete all season teams for a competition
-func (q *Queries) DeleteSeasonTeamsByCompetitionID(ctx context.Context, arg DeleteSeasonTeamsByCompetitionIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteSeasonTeamsByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+// Soft delete a game
+func (q *Queries) DeleteGame(ctx context.Context, arg DeleteGameParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGame, arg.DeletedAt, arg.ID)
 	return err
 }
 
-const deleteSeasonTeamsBySeasonID = `-- name: DeleteSeasonTeamsBySeasonID :exec
-UPDATE season_teams
+const deleteGameEvent = `-- name: DeleteGameEvent :exec
+UPDATE game_events
 SET
-  deleted_at = $1
+	deleted_at = $1
 WHERE
-  season_id = $2
+	id = $2
 AND
-  deleted_at IS NULL
+	deleted_at IS NULL
 `
 
-type DeleteSeasonTeamsBySeasonIDParams struct {
+type DeleteGameEventParams struct {
 	DeletedAt sql.NullTime
-	SeasonID  uuid.UUID
+	ID        uuid.UUID
 }
 
-// Soft delete all team_season records for a given season
-func (q *Queries) DeleteSeasonTeamsBySeasonID(ctx context.Context, arg DeleteSeasonTeamsBySeasonIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteSeasonTeamsBySeasonID, arg.DeletedAt, arg.SeasonID)
+// Soft delete a game event
+func (q *Queries) DeleteGameEvent(ctx context.Context, arg DeleteGameEventParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGameEvent, arg.DeletedAt, arg.ID)
 	return err
 }
 
-const deleteSeasonsByCompetitionID = `-- name: DeleteSeasonsByCompetitionID :exec
-UPDATE seasons
+const deleteGameLineupPlayers = `-- name: DeleteGameLineupPlayers :exec
+UPDATE game_lineup_players
 SET
-    deleted_at = $1
+	deleted_at = $1
 WHERE
-    competition_id = $2
+	game_id = $2
 AND
-    deleted_at IS NULL
+	team_id = $3
+AND
+	deleted_at IS NULL
 `
 
-type DeleteSeasonsByCompetitionIDParams struct {
-	DeletedAt     sql.NullTime
-	CompetitionID uuid.UUID
+type DeleteGameLineupPlayersParams struct {
+	DeletedAt sql.NullTime
+	GameID    uuid.UUID
+	TeamID    uuid.UUID
 }
 
-// Soft delete all seasons for a competition
-func (q *Queries) DeleteSeasonsByCompetitionID(ctx context.Context, arg DeleteSeasonsByCompetitionIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteSeasonsByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+// Soft delete a side's team sheet for a game
+func (q *Queries) DeleteGameLineupPlayers(ctx context.Context, arg DeleteGameLineupPlayersParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGameLineupPlayers, arg.DeletedAt, arg.GameID, arg.TeamID)
 	return err
 }
 
-const deleteStage = `-- name: DeleteStage :exec
-UPDATE stages
+const deleteGameOfficial = `-- name: DeleteGameOfficial :exec
+UPDATE game_officials
 SET
-  deleted_at = $1
+	deleted_at = $1
 WHERE
-  id = $2
+	id = $2
 AND
-  deleted_at IS NULL
+	deleted_at IS NULL
 `
 
-type DeleteStageParams struct {
+type DeleteGameOfficialParams struct {
 	DeletedAt sql.NullTime
 	ID        uuid.UUID
 }
 
-// Soft delete a stage
-func (q *Queries) DeleteStage(ctx context.Context, arg DeleteStageParams) error {
-	_, err := q.db.ExecContext(ctx, deleteStage, arg.DeletedAt, arg.ID)
+// Soft delete an official's appointment to a game
+func (q *Queries) DeleteGameOfficial(ctx context.Context, arg DeleteGameOfficialParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGameOfficial, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteGameOfficialsByOfficialID = `-- name: DeleteGameOfficialsByOfficialID :exec
+UPDATE game_officials
+SET
+	deleted_at = $1
+WHERE
+	official_id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteGameOfficialsByOfficialIDParams struct {
+	DeletedAt  sql.NullTime
+	OfficialID uuid.UUID
+}
+
+// Soft delete every appointment for an official
+func (q *Queries) DeleteGameOfficialsByOfficialID(ctx context.Context, arg DeleteGameOfficialsByOfficialIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGameOfficialsByOfficialID, arg.DeletedAt, arg.OfficialID)
 	return err
 }
 
-const deleteStagesByCompetitionID = `-- name: DeleteStagesByCompetitionID :exec
-UPDATE stages
-SET
-  deleted_at = $1
+const deleteGameReplacement = `-- name: DeleteGameReplacement :exec
+UPDATE game_replacements
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteGameReplacementParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a replacement
+func (q *Queries) DeleteGameReplacement(ctx context.Context, arg DeleteGameReplacementParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGameReplacement, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteGamesByCompetitionID = `-- name: DeleteGamesByCompetitionID :exec
+UPDATE games
+SET
+    deleted_at = $1
+WHERE
+    season_id IN (
+        SELECT id
+        FROM seasons
+        WHERE competition_id = $2
+          AND deleted_at IS NULL
+    )
+AND
+    deleted_at IS NULL
+`
+
+type DeleteGamesByCompetitionIDParams struct {
+	DeletedAt     sql.NullTime
+	CompetitionID uuid.UUID
+}
+
+// Soft delete all games belonging to a competition via seasons
+func (q *Queries) DeleteGamesByCompetitionID(ctx context.Context, arg DeleteGamesByCompetitionIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGamesByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+	return err
+}
+
+const deleteGamesBySeasonID = `-- name: DeleteGamesBySeasonID :exec
+UPDATE games
+SET
+  deleted_at = $1
+WHERE
+  season_id = $2
+AND
+  deleted_at IS NULL
+`
+
+type DeleteGamesBySeasonIDParams struct {
+	DeletedAt sql.NullTime
+	SeasonID  uuid.UUID
+}
+
+// Soft delete all games for a given season
+func (q *Queries) DeleteGamesBySeasonID(ctx context.Context, arg DeleteGamesBySeasonIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteGamesBySeasonID, arg.DeletedAt, arg.SeasonID)
+	return err
+}
+
+const deleteOfficial = `-- name: DeleteOfficial :exec
+UPDATE officials
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteOfficialParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete an official
+func (q *Queries) DeleteOfficial(ctx context.Context, arg DeleteOfficialParams) error {
+	_, err := q.db.ExecContext(ctx, deleteOfficial, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deletePlayer = `-- name: DeletePlayer :exec
+UPDATE players
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeletePlayerParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a player
+func (q *Queries) DeletePlayer(ctx context.Context, arg DeletePlayerParams) error {
+	_, err := q.db.ExecContext(ctx, deletePlayer, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteSeason = `-- name: DeleteSeason :exec
+UPDATE seasons
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteSeasonParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a season
+func (q *Queries) DeleteSeason(ctx context.Context, arg DeleteSeasonParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeason, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteSeasonFinals = `-- name: DeleteSeasonFinals :exec
+DELETE FROM season_finals
+WHERE
+  season_id = $1
+`
+
+// Remove the finals format for a season
+func (q *Queries) DeleteSeasonFinals(ctx context.Context, seasonID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonFinals, seasonID)
+	return err
+}
+
+const deleteSeasonTeam = `-- name: DeleteSeasonTeam :exec
+UPDATE season_teams
+SET
+  deleted_at = $1
+WHERE
+  id = $2
+AND
+  deleted_at IS NULL
+`
+
+type DeleteSeasonTeamParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a team_season record
+func (q *Queries) DeleteSeasonTeam(ctx context.Context, arg DeleteSeasonTeamParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonTeam, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteSeasonTeamPlayer = `-- name: DeleteSeasonTeamPlayer :exec
+UPDATE season_team_players
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteSeasonTeamPlayerParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a squad member
+func (q *Queries) DeleteSeasonTeamPlayer(ctx context.Context, arg DeleteSeasonTeamPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonTeamPlayer, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteSeasonTeamPlayersByPlayerID = `-- name: DeleteSeasonTeamPlayersByPlayerID :exec
+UPDATE season_team_players
+SET
+	deleted_at = $1
+WHERE
+	player_id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteSeasonTeamPlayersByPlayerIDParams struct {
+	DeletedAt sql.NullTime
+	PlayerID  uuid.UUID
+}
+
+// Soft delete every squad entry for a player
+func (q *Queries) DeleteSeasonTeamPlayersByPlayerID(ctx context.Context, arg DeleteSeasonTeamPlayersByPlayerIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonTeamPlayersByPlayerID, arg.DeletedAt, arg.PlayerID)
+	return err
+}
+
+const deleteSeasonTeamsByCompetitionID = `-- name: DeleteSeasonTeamsByCompetitionID :exec
+UPDATE season_teams
+SET
+  deleted_at = $1
+WHERE
+    season_id IN (
+        SELECT id
+        FROM seasons
+        WHERE competition_id = $2
+          AND deleted_at IS NULL
+    )
+AND
+    deleted_at IS NULL
+`
+
+type DeleteSeasonTeamsByCompetitionIDParams struct {
+	DeletedAt     sql.NullTime
+	CompetitionID uuid.UUID
+}
+
+// Soft delete all season teams for a competition
+func (q *Queries) DeleteSeasonTeamsByCompetitionID(ctx context.Context, arg DeleteSeasonTeamsByCompetitionIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonTeamsByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+	return err
+}
+
+const deleteSeasonTeamsBySeasonID = `-- name: DeleteSeasonTeamsBySeasonID :exec
+UPDATE season_teams
+SET
+  deleted_at = $1
+WHERE
+  season_id = $2
+AND
+  deleted_at IS NULL
+`
+
+type DeleteSeasonTeamsBySeasonIDParams struct {
+	DeletedAt sql.NullTime
+	SeasonID  uuid.UUID
+}
+
+// Soft delete all team_season records for a given season
+func (q *Queries) DeleteSeasonTeamsBySeasonID(ctx context.Context, arg DeleteSeasonTeamsBySeasonIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonTeamsBySeasonID, arg.DeletedAt, arg.SeasonID)
+	return err
+}
+
+const deleteSeasonsByCompetitionID = `-- name: DeleteSeasonsByCompetitionID :exec
+UPDATE seasons
+SET
+    deleted_at = $1
+WHERE
+    competition_id = $2
+AND
+    deleted_at IS NULL
+`
+
+type DeleteSeasonsByCompetitionIDParams struct {
+	DeletedAt     sql.NullTime
+	CompetitionID uuid.UUID
+}
+
+// Soft delete all seasons for a competition
+func (q *Queries) DeleteSeasonsByCompetitionID(ctx context.Context, arg DeleteSeasonsByCompetitionIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSeasonsByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+	return err
+}
+
+const deleteStage = `-- name: DeleteStage :exec
+UPDATE stages
+SET
+  deleted_at = $1
+WHERE
+  id = $2
+AND
+  deleted_at IS NULL
+`
+
+type DeleteStageParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a stage
+func (q *Queries) DeleteStage(ctx context.Context, arg DeleteStageParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStage, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteStagesByCompetitionID = `-- name: DeleteStagesByCompetitionID :exec
+UPDATE stages
+SET
+  deleted_at = $1
+WHERE
+    season_id IN (
+        SELECT id
+        FROM seasons
+        WHERE competition_id = $2
+          AND deleted_at IS NULL
+    )
+AND
+    deleted_at IS NULL
+`
+
+type DeleteStagesByCompetitionIDParams struct {
+	DeletedAt     sql.NullTime
+	CompetitionID uuid.UUID
+}
+
+// Soft delete all stages for a competition
+func (q *Queries) DeleteStagesByCompetitionID(ctx context.Context, arg DeleteStagesByCompetitionIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStagesByCompetitionID, arg.DeletedAt, arg.CompetitionID)
+	return err
+}
+
+const deleteStagesBySeasonID = `-- name: DeleteStagesBySeasonID :exec
+UPDATE stages
+SET
+  deleted_at = $1
+WHERE
+  season_id = $2
+AND
+  deleted_at IS NULL
+`
+
+type DeleteStagesBySeasonIDParams struct {
+	DeletedAt sql.NullTime
+	SeasonID  uuid.UUID
+}
+
+// Soft delete all stages for a season
+func (q *Queries) DeleteStagesBySeasonID(ctx context.Context, arg DeleteStagesBySeasonIDParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStagesBySeasonID, arg.DeletedAt, arg.SeasonID)
+	return err
+}
+
+const deleteTeam = `-- name: DeleteTeam :exec
+UPDATE teams
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteTeamParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a team
+func (q *Queries) DeleteTeam(ctx context.Context, arg DeleteTeamParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTeam, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteTeamAlias = `-- name: DeleteTeamAlias :exec
+UPDATE team_aliases
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteTeamAliasParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a team alias
+func (q *Queries) DeleteTeamAlias(ctx context.Context, arg DeleteTeamAliasParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTeamAlias, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const deleteVenue = `-- name: DeleteVenue :exec
+UPDATE venues
+SET
+	deleted_at = $1
+WHERE
+	id = $2
+AND
+	deleted_at IS NULL
+`
+
+type DeleteVenueParams struct {
+	DeletedAt sql.NullTime
+	ID        uuid.UUID
+}
+
+// Soft delete a venue
+func (q *Queries) DeleteVenue(ctx context.Context, arg DeleteVenueParams) error {
+	_, err := q.db.ExecContext(ctx, deleteVenue, arg.DeletedAt, arg.ID)
+	return err
+}
+
+const getAllGamesBySeasonID = `-- name: GetAllGamesBySeasonID :many
+SELECT
+    id,
+    season_id,
+    stage_id,
+    date,
+    home_team_id,
+    away_team_id,
+    home_score,
+    away_score,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    venue_id,
+    neutral_venue
+FROM
+    games
+WHERE
+    season_id = $1
+AND
+    deleted_at IS NULL
+ORDER BY date ASC, id ASC
+`
+
+// Fetch every game in a season for fixture checks, excluding soft-deleted games
+func (q *Queries) GetAllGamesBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getAllGamesBySeasonID, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VenueID,
+			&i.NeutralVenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetition = `-- name: GetCompetition :one
+SELECT
+	id,
+	name,
+	created_at,
+	updated_at,
+	deleted_at,
+	sport,
+	gender,
+	level,
+	country,
+	region,
+	governing_body,
+	logo_url,
+	primary_colour,
+	secondary_colour,
+	website
+FROM
+	competitions
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+`
+
+// Fetch a competition by id, excluding soft-deleted competitions
+func (q *Queries) GetCompetition(ctx context.Context, id uuid.UUID) (Competition, error) {
+	row := q.db.QueryRowContext(ctx, getCompetition, id)
+	var i Competition
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Sport,
+		&i.Gender,
+		&i.Level,
+		&i.Country,
+		&i.Region,
+		&i.GoverningBody,
+		&i.LogoUrl,
+		&i.PrimaryColour,
+		&i.SecondaryColour,
+		&i.Website,
+	)
+	return i, err
+}
+
+const getCompetitionLeaderboard = `-- name: GetCompetitionLeaderboard :many
+SELECT
+	pgs.player_id,
+	p.name AS player_name,
+	COUNT(*) FILTER (WHERE pgs.appeared)::INT AS appearances,
+	SUM(pgs.tries)::INT AS tries,
+	SUM(pgs.points)::INT AS points
+FROM
+	player_game_stats pgs
+JOIN
+	seasons s ON s.id = pgs.season_id
+JOIN
+	players p ON p.id = pgs.player_id
+WHERE
+	s.competition_id = $1
+AND
+	s.deleted_at IS NULL
+GROUP BY
+	pgs.player_id,
+	p.name
+HAVING
+	CASE WHEN $2::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END > 0
+ORDER BY
+	CASE WHEN $2::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END DESC,
+	p.name ASC
+LIMIT $3
+`
+
+type GetCompetitionLeaderboardParams struct {
+	CompetitionID uuid.UUID
+	Stat          string
+	PageLimit     int32
+}
+
+type GetCompetitionLeaderboardRow struct {
+	PlayerID    uuid.UUID
+	PlayerName  string
+	Appearances int32
+	Tries       int32
+	Points      int32
+}
+
+// Rank a competition's players by tries or points across all of its seasons
+func (q *Queries) GetCompetitionLeaderboard(ctx context.Context, arg GetCompetitionLeaderboardParams) ([]GetCompetitionLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCompetitionLeaderboard, arg.CompetitionID, arg.Stat, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompetitionLeaderboardRow
+	for rows.Next() {
+		var i GetCompetitionLeaderboardRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Appearances,
+			&i.Tries,
+			&i.Points,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompetitions = `-- name: GetCompetitions :many
+SELECT
+    id,
+    name,
+    created_at,
+    updated_at,
+    deleted_at,
+    sport,
+    gender,
+    level,
+    country,
+    region,
+    governing_body,
+    logo_url,
+    primary_colour,
+    secondary_colour,
+    website
+FROM
+    competitions
+WHERE
+    deleted_at IS NULL
+AND
+    ($1::sport_code IS NULL OR sport = $1)
+AND
+    ($2::competition_gender IS NULL OR gender = $2)
+AND
+    ($3::competition_level IS NULL OR level = $3)
+AND
+    ($4::text IS NULL OR country = $4)
+ORDER BY created_at DESC, id DESC
+LIMIT $5
+OFFSET $6
+`
+
+type GetCompetitionsParams struct {
+	Sport      NullSportCode
+	Gender     NullCompetitionGender
+	Level      NullCompetitionLevel
+	Country    sql.NullString
+	PageLimit  int32
+	PageOffset int32
+}
+
+// Fetch competitions matching the optional filters with limit/offset, excluding soft-deleted
+func (q *Queries) GetCompetitions(ctx context.Context, arg GetCompetitionsParams) ([]Competition, error) {
+	rows, err := q.db.QueryContext(ctx, getCompetitions,
+		arg.Sport,
+		arg.Gender,
+		arg.Level,
+		arg.Country,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Competition
+	for rows.Next() {
+		var i Competition
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Sport,
+			&i.Gender,
+			&i.Level,
+			&i.Country,
+			&i.Region,
+			&i.GoverningBody,
+			&i.LogoUrl,
+			&i.PrimaryColour,
+			&i.SecondaryColour,
+			&i.Website,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrentSeason = `-- name: GetCurrentSeason :one
+SELECT
+	id,
+	competition_id,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	deleted_at,
+	name,
+	year
+FROM
+	seasons
+WHERE
+	competition_id = $1
+AND
+	deleted_at IS NULL
+ORDER BY
+	CASE
+		WHEN start_date <= $2 AND end_date >= $2 THEN 0
+		WHEN start_date > $2 THEN 1
+		ELSE 2
+	END,
+	CASE WHEN start_date > $2 THEN start_date END ASC,
+	end_date DESC
+LIMIT 1
+`
+
+type GetCurrentSeasonParams struct {
+	CompetitionID uuid.UUID
+	At            time.Time
+}
+
+// Fetch the season in progress at a time, falling back to the next season to start and then the last to finish
+func (q *Queries) GetCurrentSeason(ctx context.Context, arg GetCurrentSeasonParams) (Season, error) {
+	row := q.db.QueryRowContext(ctx, getCurrentSeason, arg.CompetitionID, arg.At)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.CompetitionID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Year,
+	)
+	return i, err
+}
+
+const getGame = `-- name: GetGame :one
+SELECT
+    id,
+    season_id,
+    stage_id,
+    date,
+    home_team_id,
+    away_team_id,
+    home_score,
+    away_score,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    venue_id,
+    neutral_venue
+FROM
+    games
+WHERE
+    id = $1
+AND
+    deleted_at IS NULL
+`
+
+// Fetch a game by id, excluding soft-deleted games
+func (q *Queries) GetGame(ctx context.Context, id uuid.UUID) (Game, error) {
+	row := q.db.QueryRowContext(ctx, getGame, id)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.SeasonID,
+		&i.StageID,
+		&i.Date,
+		&i.HomeTeamID,
+		&i.AwayTeamID,
+		&i.HomeScore,
+		&i.AwayScore,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.VenueID,
+		&i.NeutralVenue,
+	)
+	return i, err
+}
+
+const getGameDetails = `-- name: GetGameDetails :many
+SELECT
+    g.id,
+    ht.id AS home_team_id,
+    COALESCE(ha.name, ht.name)::text AS home_team_name,
+    COALESCE(ha.abbreviation, ht.abbreviation)::text AS home_team_abbreviation,
+    COALESCE(ha.logo_url, ht.logo_url) AS home_team_logo_url,
+    ht.short_name AS home_team_short_name,
+    ht.primary_colour AS home_team_primary_colour,
+    ht.secondary_colour AS home_team_secondary_colour,
+    awt.id AS away_team_id,
+    COALESCE(aa.name, awt.name)::text AS away_team_name,
+    COALESCE(aa.abbreviation, awt.abbreviation)::text AS away_team_abbreviation,
+    COALESCE(aa.logo_url, awt.logo_url) AS away_team_logo_url,
+    awt.short_name AS away_team_short_name,
+    awt.primary_colour AS away_team_primary_colour,
+    awt.secondary_colour AS away_team_secondary_colour,
+    st.id AS stage_id,
+    st.name AS stage_name,
+    st.stage_type,
+    st.order_index AS stage_order_index,
+    v.timezone AS venue_timezone
+FROM
+    games g
+JOIN
+    teams ht ON ht.id = g.home_team_id
+JOIN
+    teams awt ON awt.id = g.away_team_id
+JOIN
+    stages st ON st.id = g.stage_id
+LEFT JOIN
+    team_aliases ha ON ha.team_id = g.home_team_id
+    AND ha.deleted_at IS NULL
+    AND ha.valid_from <= g.date
+    AND (ha.valid_to IS NULL OR g.date < ha.valid_to + 1)
+LEFT JOIN
+    team_aliases aa ON aa.team_id = g.away_team_id
+    AND aa.deleted_at IS NULL
+    AND aa.valid_from <= g.date
+    AND (aa.valid_to IS NULL OR g.date < aa.valid_to + 1)
+LEFT JOIN
+    venues v ON v.id = g.venue_id
+WHERE
+    g.id = ANY($1::uuid[])
+`
+
+type GetGameDetailsRow struct {
+	ID                      uuid.UUID
+	HomeTeamID              uuid.UUID
+	HomeTeamName            string
+	HomeTeamAbbreviation    string
+	HomeTeamLogoUrl         sql.NullString
+	HomeTeamShortName       sql.NullString
+	HomeTeamPrimaryColour   sql.NullString
+	HomeTeamSecondaryColour sql.NullString
+	AwayTeamID              uuid.UUID
+	AwayTeamName            string
+	AwayTeamAbbreviation    string
+	AwayTeamLogoUrl         sql.NullString
+	AwayTeamShortName       sql.NullString
+	AwayTeamPrimaryColour   sql.NullString
+	AwayTeamSecondaryColour sql.NullString
+	StageID                 uuid.UUID
+	StageName               string
+	StageType               StageType
+	StageOrderIndex         int32
+	VenueTimezone           sql.NullString
+}
+
+// Fetch the teams as named on the game date, stage and venue timezone for each of the given games, used to expand and localize game responses
+func (q *Queries) GetGameDetails(ctx context.Context, gameIds []uuid.UUID) ([]GetGameDetailsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGameDetails, pq.Array(gameIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGameDetailsRow
+	for rows.Next() {
+		var i GetGameDetailsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.HomeTeamID,
+			&i.HomeTeamName,
+			&i.HomeTeamAbbreviation,
+			&i.HomeTeamLogoUrl,
+			&i.HomeTeamShortName,
+			&i.HomeTeamPrimaryColour,
+			&i.HomeTeamSecondaryColour,
+			&i.AwayTeamID,
+			&i.AwayTeamName,
+			&i.AwayTeamAbbreviation,
+			&i.AwayTeamLogoUrl,
+			&i.AwayTeamShortName,
+			&i.AwayTeamPrimaryColour,
+			&i.AwayTeamSecondaryColour,
+			&i.StageID,
+			&i.StageName,
+			&i.StageType,
+			&i.StageOrderIndex,
+			&i.VenueTimezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGameEvents = `-- name: GetGameEvents :many
+SELECT
+	id,
+	game_id,
+	team_id,
+	player_id,
+	event_type,
+	minute,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	game_events
+WHERE
+	game_id = $1
+AND
+	deleted_at IS NULL
+ORDER BY
+	minute ASC,
+	created_at ASC
+`
+
+// Fetch the events in a game, in the order they happened
+func (q *Queries) GetGameEvents(ctx context.Context, gameID uuid.UUID) ([]GameEvent, error) {
+	rows, err := q.db.QueryContext(ctx, getGameEvents, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameEvent
+	for rows.Next() {
+		var i GameEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.TeamID,
+			&i.PlayerID,
+			&i.EventType,
+			&i.Minute,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGameFeed = `-- name: GetGameFeed :many
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.venue_id,
+    g.neutral_venue,
+    v.timezone AS venue_timezone,
+    s.competition_id,
+    c.name AS competition_name,
+    st.name AS stage_name,
+    COALESCE(ha.name, ht.name)::text AS home_team_name,
+    COALESCE(ha.abbreviation, ht.abbreviation)::text AS home_team_abbreviation,
+    COALESCE(ha.logo_url, ht.logo_url) AS home_team_logo_url,
+    ht.short_name AS home_team_short_name,
+    ht.primary_colour AS home_team_primary_colour,
+    ht.secondary_colour AS home_team_secondary_colour,
+    COALESCE(aa.name, awt.name)::text AS away_team_name,
+    COALESCE(aa.abbreviation, awt.abbreviation)::text AS away_team_abbreviation,
+    COALESCE(aa.logo_url, awt.logo_url) AS away_team_logo_url,
+    awt.short_name AS away_team_short_name,
+    awt.primary_colour AS away_team_primary_colour,
+    awt.secondary_colour AS away_team_secondary_colour
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+JOIN
+    stages st ON st.id = g.stage_id
+JOIN
+    teams ht ON ht.id = g.home_team_id
+JOIN
+    teams awt ON awt.id = g.away_team_id
+LEFT JOIN
+    team_aliases ha ON ha.team_id = g.home_team_id
+    AND ha.deleted_at IS NULL
+    AND ha.valid_from <= g.date
+    AND (ha.valid_to IS NULL OR g.date < ha.valid_to + 1)
+LEFT JOIN
+    team_aliases aa ON aa.team_id = g.away_team_id
+    AND aa.deleted_at IS NULL
+    AND aa.valid_from <= g.date
+    AND (aa.valid_to IS NULL OR g.date < aa.valid_to + 1)
+LEFT JOIN
+    venues v ON v.id = g.venue_id
+WHERE
+    g.deleted_at IS NULL
+AND
+    ($1::uuid IS NULL OR s.competition_id = $1)
+AND
+    ($2::uuid IS NULL OR g.stage_id = $2)
+AND
+    ($3::uuid IS NULL OR g.home_team_id = $3 OR g.away_team_id = $3)
+AND
+    ($4::game_status IS NULL OR g.status = $4)
+AND
+    ($5::timestamptz IS NULL OR g.date >= $5)
+AND
+    ($6::timestamptz IS NULL OR g.date <= $6)
+ORDER BY
+    CASE WHEN $7::boolean THEN g.date END DESC,
+    g.date ASC,
+    g.id ASC
+LIMIT $8
+OFFSET $9
+`
+
+type GetGameFeedParams struct {
+	CompetitionID uuid.NullUUID
+	StageID       uuid.NullUUID
+	TeamID        uuid.NullUUID
+	Status        NullGameStatus
+	DateFrom      sql.NullTime
+	DateTo        sql.NullTime
+	SortDesc      bool
+	PageLimit     int32
+	PageOffset    int32
+}
+
+type GetGameFeedRow struct {
+	ID                      uuid.UUID
+	SeasonID                uuid.UUID
+	StageID                 uuid.UUID
+	Date                    time.Time
+	HomeTeamID              uuid.UUID
+	AwayTeamID              uuid.UUID
+	HomeScore               sql.NullInt32
+	AwayScore               sql.NullInt32
+	Status                  GameStatus
+	VenueID                 uuid.NullUUID
+	NeutralVenue            bool
+	VenueTimezone           sql.NullString
+	CompetitionID           uuid.UUID
+	CompetitionName         string
+	StageName               string
+	HomeTeamName            string
+	HomeTeamAbbreviation    string
+	HomeTeamLogoUrl         sql.NullString
+	HomeTeamShortName       sql.NullString
+	HomeTeamPrimaryColour   sql.NullString
+	HomeTeamSecondaryColour sql.NullString
+	AwayTeamName            string
+	AwayTeamAbbreviation    string
+	AwayTeamLogoUrl         sql.NullString
+	AwayTeamShortName       sql.NullString
+	AwayTeamPrimaryColour   sql.NullString
+	AwayTeamSecondaryColour sql.NullString
+}
+
+// Fetch a page of games across competitions with competition, stage and team details as named on the game date, excluding soft-deleted games
+func (q *Queries) GetGameFeed(ctx context.Context, arg GetGameFeedParams) ([]GetGameFeedRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGameFeed,
+		arg.CompetitionID,
+		arg.StageID,
+		arg.TeamID,
+		arg.Status,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.SortDesc,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGameFeedRow
+	for rows.Next() {
+		var i GetGameFeedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
+			&i.VenueID,
+			&i.NeutralVenue,
+			&i.VenueTimezone,
+			&i.CompetitionID,
+			&i.CompetitionName,
+			&i.StageName,
+			&i.HomeTeamName,
+			&i.HomeTeamAbbreviation,
+			&i.HomeTeamLogoUrl,
+			&i.HomeTeamShortName,
+			&i.HomeTeamPrimaryColour,
+			&i.HomeTeamSecondaryColour,
+			&i.AwayTeamName,
+			&i.AwayTeamAbbreviation,
+			&i.AwayTeamLogoUrl,
+			&i.AwayTeamShortName,
+			&i.AwayTeamPrimaryColour,
+			&i.AwayTeamSecondaryColour,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGameLineupPlayers = `-- name: GetGameLineupPlayers :many
+SELECT
+	glp.id,
+	glp.game_id,
+	glp.team_id,
+	glp.player_id,
+	glp.shirt_number,
+	glp.captain,
+	glp.created_at,
+	glp.updated_at,
+	glp.deleted_at,
+	p.team_id AS player_team_id,
+	p.name AS player_name,
+	p.date_of_birth AS player_date_of_birth,
+	p.position AS player_position,
+	p.nationality AS player_nationality,
+	p.created_at AS player_created_at,
+	p.updated_at AS player_updated_at,
+	p.deleted_at AS player_deleted_at
+FROM
+	game_lineup_players glp
+JOIN
+	players p ON p.id = glp.player_id
+WHERE
+	glp.game_id = $1
+AND
+	glp.deleted_at IS NULL
+ORDER BY
+	glp.team_id ASC,
+	glp.shirt_number ASC
+`
+
+type GetGameLineupPlayersRow struct {
+	ID                uuid.UUID
+	GameID            uuid.UUID
+	TeamID            uuid.UUID
+	PlayerID          uuid.UUID
+	ShirtNumber       int32
+	Captain           bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         sql.NullTime
+	PlayerTeamID      uuid.UUID
+	PlayerName        string
+	PlayerDateOfBirth sql.NullTime
+	PlayerPosition    PlayerPosition
+	PlayerNationality sql.NullString
+	PlayerCreatedAt   time.Time
+	PlayerUpdatedAt   time.Time
+	PlayerDeletedAt   sql.NullTime
+}
+
+// Fetch both team sheets for a game with their players, ordered by shirt number
+func (q *Queries) GetGameLineupPlayers(ctx context.Context, gameID uuid.UUID) ([]GetGameLineupPlayersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGameLineupPlayers, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGameLineupPlayersRow
+	for rows.Next() {
+		var i GetGameLineupPlayersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.TeamID,
+			&i.PlayerID,
+			&i.ShirtNumber,
+			&i.Captain,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.PlayerTeamID,
+			&i.PlayerName,
+			&i.PlayerDateOfBirth,
+			&i.PlayerPosition,
+			&i.PlayerNationality,
+			&i.PlayerCreatedAt,
+			&i.PlayerUpdatedAt,
+			&i.PlayerDeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGameOfficials = `-- name: GetGameOfficials :many
+SELECT
+	id,
+	game_id,
+	official_id,
+	role,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	game_officials
+WHERE
+	game_id = $1
+AND
+	deleted_at IS NULL
+ORDER BY
+	role ASC,
+	created_at ASC
+`
+
+// Fetch the officials appointed to a game, referee first
+func (q *Queries) GetGameOfficials(ctx context.Context, gameID uuid.UUID) ([]GameOfficial, error) {
+	rows, err := q.db.QueryContext(ctx, getGameOfficials, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameOfficial
+	for rows.Next() {
+		var i GameOfficial
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.OfficialID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGamePlayerStats = `-- name: GetGamePlayerStats :many
+SELECT
+	game_id,
+	season_id,
+	team_id,
+	player_id,
+	appeared,
+	minutes,
+	tries,
+	conversions,
+	penalty_goals,
+	drop_goals,
+	yellow_cards,
+	red_cards,
+	points
+FROM
+	player_game_stats
+WHERE
+	game_id = $1
+ORDER BY
+	team_id ASC,
+	points DESC,
+	tries DESC
+`
+
+// Fetch per-player stats for a finished game, top scorers first
+func (q *Queries) GetGamePlayerStats(ctx context.Context, gameID uuid.UUID) ([]PlayerGameStat, error) {
+	rows, err := q.db.QueryContext(ctx, getGamePlayerStats, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerGameStat
+	for rows.Next() {
+		var i PlayerGameStat
+		if err := rows.Scan(
+			&i.GameID,
+			&i.SeasonID,
+			&i.TeamID,
+			&i.PlayerID,
+			&i.Appeared,
+			&i.Minutes,
+			&i.Tries,
+			&i.Conversions,
+			&i.PenaltyGoals,
+			&i.DropGoals,
+			&i.YellowCards,
+			&i.RedCards,
+			&i.Points,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGameReplacements = `-- name: GetGameReplacements :many
+SELECT
+	id,
+	game_id,
+	team_id,
+	player_off_id,
+	player_on_id,
+	minute,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	game_replacements
+WHERE
+	game_id = $1
+AND
+	deleted_at IS NULL
+ORDER BY
+	minute ASC,
+	created_at ASC
+`
+
+// Fetch the replacements made in a game, in the order they were made
+func (q *Queries) GetGameReplacements(ctx context.Context, gameID uuid.UUID) ([]GameReplacement, error) {
+	rows, err := q.db.QueryContext(ctx, getGameReplacements, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameReplacement
+	for rows.Next() {
+		var i GameReplacement
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.TeamID,
+			&i.PlayerOffID,
+			&i.PlayerOnID,
+			&i.Minute,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGamesBySeasonID = `-- name: GetGamesBySeasonID :many
+SELECT
+    id,
+    season_id,
+    stage_id,
+    date,
+    home_team_id,
+    away_team_id,
+    home_score,
+    away_score,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    venue_id,
+    neutral_venue
+FROM
+    games
+WHERE
+    season_id = $1
+AND
+    ($2::uuid IS NULL OR stage_id = $2)
+AND
+    ($3::uuid IS NULL OR home_team_id = $3 OR away_team_id = $3)
+AND
+    ($4::game_status IS NULL OR status = $4)
+AND
+    ($5::timestamptz IS NULL OR date >= $5)
+AND
+    ($6::timestamptz IS NULL OR date <= $6)
+AND
+    deleted_at IS NULL
+ORDER BY
+    CASE WHEN $7::boolean THEN date END DESC,
+    date ASC,
+    id ASC
+LIMIT $9
+OFFSET $8
+`
+
+type GetGamesBySeasonIDParams struct {
+	SeasonID   uuid.UUID
+	StageID    uuid.NullUUID
+	TeamID     uuid.NullUUID
+	Status     NullGameStatus
+	DateFrom   sql.NullTime
+	DateTo     sql.NullTime
+	SortDesc   bool
+	PageOffset int32
+	PageLimit  int32
+}
+
+// Fetch a page of games for a season matching the optional filters, excluding soft-deleted games
+func (q *Queries) GetGamesBySeasonID(ctx context.Context, arg GetGamesBySeasonIDParams) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getGamesBySeasonID,
+		arg.SeasonID,
+		arg.StageID,
+		arg.TeamID,
+		arg.Status,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.SortDesc,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VenueID,
+			&i.NeutralVenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGamesByStageID = `-- name: GetGamesByStageID :many
+SELECT
+    id,
+    season_id,
+    stage_id,
+    date,
+    home_team_id,
+    away_team_id,
+    home_score,
+    away_score,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    venue_id,
+    neutral_venue
+FROM
+    games
+WHERE
+    stage_id = $1
+AND
+    season_id = $2
+AND
+    deleted_at IS NULL
+ORDER BY date ASC, id ASC
+`
+
+type GetGamesByStageIDParams struct {
+	StageID  uuid.UUID
+	SeasonID uuid.UUID
+}
+
+// Fetch all games for a stage, excluding soft-deleted games
+func (q *Queries) GetGamesByStageID(ctx context.Context, arg GetGamesByStageIDParams) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getGamesByStageID, arg.StageID, arg.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VenueID,
+			&i.NeutralVenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGamesByTeamID = `-- name: GetGamesByTeamID :many
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.created_at,
+    g.updated_at,
+    g.deleted_at,
+    g.venue_id,
+    g.neutral_venue
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (g.home_team_id = $1 OR g.away_team_id = $1)
+AND
+    ($2::uuid IS NULL OR g.season_id = $2)
+AND
+    ($3::game_status IS NULL OR g.status = $3)
+AND
+    g.deleted_at IS NULL
+ORDER BY
+    CASE WHEN $4::boolean THEN g.date END DESC,
+    g.date ASC,
+    g.id ASC
+LIMIT $6
+OFFSET $5
+`
+
+type GetGamesByTeamIDParams struct {
+	TeamID     uuid.UUID
+	SeasonID   uuid.NullUUID
+	Status     NullGameStatus
+	SortDesc   bool
+	PageOffset int32
+	PageLimit  int32
+}
+
+// Fetch a page of games a team played in across all seasons matching the optional filters, excluding soft-deleted games
+func (q *Queries) GetGamesByTeamID(ctx context.Context, arg GetGamesByTeamIDParams) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getGamesByTeamID,
+		arg.TeamID,
+		arg.SeasonID,
+		arg.Status,
+		arg.SortDesc,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VenueID,
+			&i.NeutralVenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHeadToHeadGames = `-- name: GetHeadToHeadGames :many
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.created_at,
+    g.updated_at,
+    g.deleted_at,
+    g.venue_id,
+    g.neutral_venue
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (
+        (g.home_team_id = $1 AND g.away_team_id = $2)
+        OR
+        (g.home_team_id = $2 AND g.away_team_id = $1)
+    )
+AND
+    g.status = 'finished'
+AND
+    g.home_score IS NOT NULL
+AND
+    g.away_score IS NOT NULL
+AND
+    g.deleted_at IS NULL
+ORDER BY
+    g.date DESC,
+    g.id DESC
+`
+
+type GetHeadToHeadGamesParams struct {
+	TeamID     uuid.UUID
+	OpponentID uuid.UUID
+}
+
+// Fetch every finished game with a score between two teams, newest first
+func (q *Queries) GetHeadToHeadGames(ctx context.Context, arg GetHeadToHeadGamesParams) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getHeadToHeadGames, arg.TeamID, arg.OpponentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.VenueID,
+			&i.NeutralVenue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOfficial = `-- name: GetOfficial :one
+SELECT
+	id,
+	name,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	officials
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+`
+
+// Fetch an official by id, excluding soft-deleted officials
+func (q *Queries) GetOfficial(ctx context.Context, id uuid.UUID) (Official, error) {
+	row := q.db.QueryRowContext(ctx, getOfficial, id)
+	var i Official
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Nationality,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getOfficialAppointments = `-- name: GetOfficialAppointments :many
+SELECT
+	gof.id,
+	gof.role,
+	g.id AS game_id,
+	s.competition_id,
+	g.season_id,
+	g.date,
+	g.home_team_id,
+	g.away_team_id,
+	g.status
+FROM
+	game_officials gof
+JOIN
+	games g ON g.id = gof.game_id
+JOIN
+	seasons s ON s.id = g.season_id
+WHERE
+	gof.official_id = $1
+AND
+	gof.deleted_at IS NULL
+AND
+	g.deleted_at IS NULL
+ORDER BY
+	g.date ASC
+`
+
+type GetOfficialAppointmentsRow struct {
+	ID            uuid.UUID
+	Role          OfficialRole
+	GameID        uuid.UUID
+	CompetitionID uuid.UUID
+	SeasonID      uuid.UUID
+	Date          time.Time
+	HomeTeamID    uuid.UUID
+	AwayTeamID    uuid.UUID
+	Status        GameStatus
+}
+
+// Fetch every game an official is appointed to, in kickoff order
+func (q *Queries) GetOfficialAppointments(ctx context.Context, officialID uuid.UUID) ([]GetOfficialAppointmentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOfficialAppointments, officialID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOfficialAppointmentsRow
+	for rows.Next() {
+		var i GetOfficialAppointmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Role,
+			&i.GameID,
+			&i.CompetitionID,
+			&i.SeasonID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOfficials = `-- name: GetOfficials :many
+SELECT
+	id,
+	name,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	officials
+WHERE
+	deleted_at IS NULL
+ORDER BY
+	name ASC
+LIMIT $1
+OFFSET $2
+`
+
+type GetOfficialsParams struct {
+	PageLimit  int32
+	PageOffset int32
+}
+
+// Fetch officials with pagination, excluding soft-deleted officials
+func (q *Queries) GetOfficials(ctx context.Context, arg GetOfficialsParams) ([]Official, error) {
+	rows, err := q.db.QueryContext(ctx, getOfficials, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Official
+	for rows.Next() {
+		var i Official
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Nationality,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayer = `-- name: GetPlayer :one
+SELECT
+	id,
+	team_id,
+	name,
+	date_of_birth,
+	position,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	players
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+`
+
+// Fetch a player by id, excluding soft-deleted players
+func (q *Queries) GetPlayer(ctx context.Context, id uuid.UUID) (Player, error) {
+	row := q.db.QueryRowContext(ctx, getPlayer, id)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Name,
+		&i.DateOfBirth,
+		&i.Position,
+		&i.Nationality,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getPlayersByTeamID = `-- name: GetPlayersByTeamID :many
+SELECT
+	id,
+	team_id,
+	name,
+	date_of_birth,
+	position,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	players
+WHERE
+	team_id = $1
+AND
+	deleted_at IS NULL
+ORDER BY
+	name ASC
+LIMIT $2
+OFFSET $3
+`
+
+type GetPlayersByTeamIDParams struct {
+	TeamID     uuid.UUID
+	PageLimit  int32
+	PageOffset int32
+}
+
+// Fetch a team's players with pagination, excluding soft-deleted players
+func (q *Queries) GetPlayersByTeamID(ctx context.Context, arg GetPlayersByTeamIDParams) ([]Player, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayersByTeamID, arg.TeamID, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Player
+	for rows.Next() {
+		var i Player
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.DateOfBirth,
+			&i.Position,
+			&i.Nationality,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerSeasonStats = `-- name: GetPlayerSeasonStats :many
+SELECT
+	pgs.season_id,
+	s.competition_id,
+	pgs.team_id,
+	COUNT(*) FILTER (WHERE pgs.appeared)::INT AS appearances,
+	SUM(pgs.minutes)::INT AS minutes,
+	SUM(pgs.tries)::INT AS tries,
+	SUM(pgs.conversions)::INT AS conversions,
+	SUM(pgs.penalty_goals)::INT AS penalty_goals,
+	SUM(pgs.drop_goals)::INT AS drop_goals,
+	SUM(pgs.yellow_cards)::INT AS yellow_cards,
+	SUM(pgs.red_cards)::INT AS red_cards,
+	SUM(pgs.points)::INT AS points
+FROM
+	player_game_stats pgs
+JOIN
+	seasons s ON s.id = pgs.season_id
+WHERE
+	pgs.player_id = $1
+GROUP BY
+	pgs.season_id,
+	s.competition_id,
+	s.start_date,
+	pgs.team_id
+ORDER BY
+	s.start_date ASC
+`
+
+type GetPlayerSeasonStatsRow struct {
+	SeasonID      uuid.UUID
+	CompetitionID uuid.UUID
+	TeamID        uuid.UUID
+	Appearances   int32
+	Minutes       int32
+	Tries         int32
+	Conversions   int32
+	PenaltyGoals  int32
+	DropGoals     int32
+	YellowCards   int32
+	RedCards      int32
+	Points        int32
+}
+
+// Sum a player's stats per season and team across every competition, oldest season first
+func (q *Queries) GetPlayerSeasonStats(ctx context.Context, playerID uuid.UUID) ([]GetPlayerSeasonStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerSeasonStats, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerSeasonStatsRow
+	for rows.Next() {
+		var i GetPlayerSeasonStatsRow
+		if err := rows.Scan(
+			&i.SeasonID,
+			&i.CompetitionID,
+			&i.TeamID,
+			&i.Appearances,
+			&i.Minutes,
+			&i.Tries,
+			&i.Conversions,
+			&i.PenaltyGoals,
+			&i.DropGoals,
+			&i.YellowCards,
+			&i.RedCards,
+			&i.Points,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeason = `-- name: GetSeason :one
+SELECT
+	id,
+	competition_id,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	deleted_at,
+	name,
+	year
+FROM
+	seasons
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+`
+
+// Fetch a season by id, excluding soft-deleted seasons
+func (q *Queries) GetSeason(ctx context.Context, id uuid.UUID) (Season, error) {
+	row := q.db.QueryRowContext(ctx, getSeason, id)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.CompetitionID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Name,
+		&i.Year,
+	)
+	return i, err
+}
+
+const getSeasonFinals = `-- name: GetSeasonFinals :one
+SELECT
+  season_id,
+  format,
+  round_interval_days,
+  created_at,
+  updated_at
+FROM
+  season_finals
 WHERE
-    season_id IN (
-        SELECT id
-        FROM seasons
-        WHERE competition_id = $2
-          AND deleted_at IS NULL
-    )
-AND
-    deleted_at IS NULL
+  season_id = $1
 `
 
-type DeleteStagesByCompetitionIDParams struct {
-	DeletedAt     sql.NullTime
-	CompetitionID uuid.UUID
-}
-
-// Soft delete all stages for a competition
-func (q *Queries) DeleteStagesByCompetitionID(ctx context.Context, arg DeleteStagesByCompetitionIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteStagesByCompetitionID, arg.DeletedAt, arg.CompetitionID)
-	return err
+// Fetch the finals format for a season
+func (q *Queries) GetSeasonFinals(ctx context.Context, seasonID uuid.UUID) (SeasonFinal, error) {
+	row := q.db.QueryRowContext(ctx, getSeasonFinals, seasonID)
+	var i SeasonFinal
+	err := row.Scan(
+		&i.SeasonID,
+		&i.Format,
+		&i.RoundIntervalDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-const deleteStagesBySeasonID = `-- name: DeleteStagesBySeasonID :exec
-UPDATE stages
-SET
-  deleted_at = $1
+const getSeasonLeaderboard = `-- name: GetSeasonLeaderboard :many
+SELECT
+	pgs.player_id,
+	p.name AS player_name,
+	pgs.team_id,
+	COUNT(*) FILTER (WHERE pgs.appeared)::INT AS appearances,
+	SUM(pgs.tries)::INT AS tries,
+	SUM(pgs.points)::INT AS points
+FROM
+	player_game_stats pgs
+JOIN
+	players p ON p.id = pgs.player_id
 WHERE
-  season_id = $2
-AND
-  deleted_at IS NULL
+	pgs.season_id = $1
+GROUP BY
+	pgs.player_id,
+	p.name,
+	pgs.team_id
+HAVING
+	CASE WHEN $2::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END > 0
+ORDER BY
+	CASE WHEN $2::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END DESC,
+	p.name ASC
+LIMIT $3
 `
 
-type DeleteStagesBySeasonIDParams struct {
-	DeletedAt sql.NullTime
+type GetSeasonLeaderboardParams struct {
 	SeasonID  uuid.UUID
+	Stat      string
+	PageLimit int32
 }
 
-// Soft delete all stages for a season
-func (q *Queries) DeleteStagesBySeasonID(ctx context.Context, arg DeleteStagesBySeasonIDParams) error {
-	_, err := q.db.ExecContext(ctx, deleteStagesBySeasonID, arg.DeletedAt, arg.SeasonID)
-	return err
-}
-
-const deleteTeam = `-- name: DeleteTeam :exec
-UPDATE teams
-SET
-	deleted_at = $1
-WHERE
-	id = $2
-AND
-	deleted_at IS NULL
-`
-
-type DeleteTeamParams struct {
-	DeletedAt sql.NullTime
-	ID        uuid.UUID
+type GetSeasonLeaderboardRow struct {
+	PlayerID    uuid.UUID
+	PlayerName  string
+	TeamID      uuid.UUID
+	Appearances int32
+	Tries       int32
+	Points      int32
 }
 
-// Soft delete a team
-func (q *Queries) DeleteTeam(ctx context.Context, arg DeleteTeamParams) error {
-	_, err := q.db.ExecContext(ctx, deleteTeam, arg.DeletedAt, arg.ID)
-	return err
+// Rank a season's players by tries or points
+func (q *Queries) GetSeasonLeaderboard(ctx context.Context, arg GetSeasonLeaderboardParams) ([]GetSeasonLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSeasonLeaderboard, arg.SeasonID, arg.Stat, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeasonLeaderboardRow
+	for rows.Next() {
+		var i GetSeasonLeaderboardRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.TeamID,
+			&i.Appearances,
+			&i.Tries,
+			&i.Points,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getCompetition = `-- name: GetCompetition :one
-SELECT 
+const getSeasonTeamPlayers = `-- name: GetSeasonTeamPlayers :many
+SELECT
 	id,
-	name,
+	season_team_id,
+	player_id,
+	jersey_number,
+	active_from,
+	active_to,
 	created_at,
 	updated_at,
-	deleted_at 
-FROM 
-	competitions
-WHERE 
-	id = $1
+	deleted_at
+FROM
+	season_team_players
+WHERE
+	season_team_id = $1
 AND
 	deleted_at IS NULL
+ORDER BY
+	jersey_number ASC,
+	active_from ASC NULLS FIRST
 `
 
-// Fetch a competition by id, excluding soft-deleted competitions
-func (q *Queries) GetCompetition(ctx context.Context, id uuid.UUID) (Competition, error) {
-	row := q.db.QueryRowContext(ctx, getCompetition, id)
-	var i Competition
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.DeletedAt,
-	)
-	return i, err
+// Fetch a team's squad for a season, ordered by jersey number
+func (q *Queries) GetSeasonTeamPlayers(ctx context.Context, seasonTeamID uuid.UUID) ([]SeasonTeamPlayer, error) {
+	rows, err := q.db.QueryContext(ctx, getSeasonTeamPlayers, seasonTeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SeasonTeamPlayer
+	for rows.Next() {
+		var i SeasonTeamPlayer
+		if err := rows.Scan(
+			&i.ID,
+			&i.SeasonTeamID,
+			&i.PlayerID,
+			&i.JerseyNumber,
+			&i.ActiveFrom,
+			&i.ActiveTo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getCompetitions = `-- name: GetCompetitions :many
+const getSeasonTeams = `-- name: GetSeasonTeams :many
 SELECT
-    id,
-    name,
-    created_at,
-    updated_at,
-    deleted_at
-FROM competitions
-WHERE deleted_at IS NULL
-ORDER BY created_at DESC, id DESC
-LIMIT $2
-OFFSET $1
+  id,
+  team_id,
+  season_id,
+  created_at,
+  updated_at,
+  deleted_at,
+  home_venue_id
+FROM
+  season_teams
+WHERE
+  season_id = $1
+AND
+  deleted_at IS NULL
 `
 
-type GetCompetitionsParams struct {
-	PageOffset int32
-	PageLimit  int32
+type GetSeasonTeamsRow struct {
+	ID          uuid.UUID
+	TeamID      uuid.UUID
+	SeasonID    uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
+	HomeVenueID uuid.NullUUID
 }
 
-// Fetch competitions with limit/offset, excluding soft-deleted
-func (q *Queries) GetCompetitions(ctx context.Context, arg GetCompetitionsParams) ([]Competition, error) {
-	rows, err := q.db.QueryContext(ctx, getCompetitions, arg.PageOffset, arg.PageLimit)
+// Fetch all season_teams
+func (q *Queries) GetSeasonTeams(ctx context.Context, seasonID uuid.UUID) ([]GetSeasonTeamsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSeasonTeams, seasonID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Competition
+	var items []GetSeasonTeamsRow
 	for rows.Next() {
-		var i Competition
+		var i GetSeasonTeamsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
+			&i.TeamID,
+			&i.SeasonID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.HomeVenueID,
 		); err != nil {
 			return nil, err
 		}
@@ -711,99 +3627,102 @@ func (q *Queries) GetCompetitions(ctx context.Context, arg GetCompetitionsParams
 	return items, nil
 }
 
-const getGame = `-- name: GetGame :one
+const getSeasons = `-- name: GetSeasons :many
 SELECT
-    id,
-    season_id,
-    stage_id,
-    date,
-    home_team_id,
-    away_team_id,
-    home_score,
-    away_score,
-    status,
-    created_at,
-    updated_at,
-    deleted_at
+	id,
+	competition_id,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	deleted_at,
+	name,
+	year
 FROM
-    games
+	seasons
 WHERE
-    id = $1
+	competition_id = $1
 AND
-    deleted_at IS NULL
+	deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+OFFSET $3
 `
 
-// Fetch a game by id, excluding soft-deleted games
-func (q *Queries) GetGame(ctx context.Context, id uuid.UUID) (Game, error) {
-	row := q.db.QueryRowContext(ctx, getGame, id)
-	var i Game
-	err := row.Scan(
-		&i.ID,
-		&i.SeasonID,
-		&i.StageID,
-		&i.Date,
-		&i.HomeTeamID,
-		&i.AwayTeamID,
-		&i.HomeScore,
-		&i.AwayScore,
-		&i.Status,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.DeletedAt,
-	)
-	return i, err
+type GetSeasonsParams struct {
+	CompetitionID uuid.UUID
+	PageLimit     int32
+	PageOffset    int32
+}
+
+// Fetch all seasons for a competition, excluding soft-deleted seasons
+func (q *Queries) GetSeasons(ctx context.Context, arg GetSeasonsParams) ([]Season, error) {
+	rows, err := q.db.QueryContext(ctx, getSeasons, arg.CompetitionID, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Season
+	for rows.Next() {
+		var i Season
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompetitionID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Name,
+			&i.Year,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getGamesByStageID = `-- name: GetGamesByStageID :many
+const getStagesBySeasonID = `-- name: GetStagesBySeasonID :many
 SELECT
-    id,
-    season_id,
-    stage_id,
-    date,
-    home_team_id,
-    away_team_id,
-    home_score,
-    away_score,
-    status,
-    created_at,
-    updated_at,
-    deleted_at
+  id,
+  season_id,
+  name,
+  stage_type,
+  order_index,
+  created_at,
+  updated_at,
+  deleted_at
 FROM
-    games
+  stages
 WHERE
-    stage_id = $1
-AND
-    season_id = $2
+  season_id = $1
 AND
-    deleted_at IS NULL
-ORDER BY date ASC, id ASC
+  deleted_at IS NULL
 `
 
-type GetGamesByStageIDParams struct {
-	StageID  uuid.UUID
-	SeasonID uuid.UUID
-}
-
-// Fetch all games for a stage, excluding soft-deleted games
-func (q *Queries) GetGamesByStageID(ctx context.Context, arg GetGamesByStageIDParams) ([]Game, error) {
-	rows, err := q.db.QueryContext(ctx, getGamesByStageID, arg.StageID, arg.SeasonID)
+// Fetch all stages for a season, excluding soft-deleted stages
+func (q *Queries) GetStagesBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]Stage, error) {
+	rows, err := q.db.QueryContext(ctx, getStagesBySeasonID, seasonID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Game
+	var items []Stage
 	for rows.Next() {
-		var i Game
+		var i Stage
 		if err := rows.Scan(
 			&i.ID,
 			&i.SeasonID,
-			&i.StageID,
-			&i.Date,
-			&i.HomeTeamID,
-			&i.AwayTeamID,
-			&i.HomeScore,
-			&i.AwayScore,
-			&i.Status,
+			&i.Name,
+			&i.StageType,
+			&i.OrderIndex,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -821,32 +3740,101 @@ func (q *Queries) GetGamesByStageID(ctx context.Context, arg GetGamesByStageIDPa
 	return items, nil
 }
 
-const getSeason = `-- name: GetSeason :one
+const getTeam = `-- name: GetTeam :one
 SELECT
 	id,
-	competition_id,
-	start_date,
-	end_date,
+	name,
+	abbreviation,
+	location,
+	created_at,
+	updated_at,
+	deleted_at,
+	home_venue_id,
+	short_name,
+	logo_url,
+	crest_url,
+	primary_colour,
+	secondary_colour,
+	founded_year,
+	social_handles,
+	external_refs
+FROM
+	teams
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL;	
+
+-- name: LockTeam :one
+-- Lock a team row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	teams
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Fetch a team by id, excluding soft-deleted teams
+func (q *Queries) GetTeam(ctx context.Context, id uuid.UUID) (Team, error) {
+	row := q.db.QueryRowContext(ctx, getTeam, id)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Abbreviation,
+		&i.Location,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.HomeVenueID,
+		&i.ShortName,
+		&i.LogoUrl,
+		&i.CrestUrl,
+		&i.PrimaryColour,
+		&i.SecondaryColour,
+		&i.FoundedYear,
+		&i.SocialHandles,
+		&i.ExternalRefs,
+	)
+	return i, err
+}
+
+const getTeamAlias = `-- name: GetTeamAlias :one
+SELECT
+	id,
+	team_id,
+	name,
+	abbreviation,
+	logo_url,
+	valid_from,
+	valid_to,
 	created_at,
 	updated_at,
 	deleted_at
 FROM
-	seasons
+	team_aliases
 WHERE
 	id = $1
 AND
 	deleted_at IS NULL
 `
 
-// Fetch a season by id, excluding soft-deleted seasons
-func (q *Queries) GetSeason(ctx context.Context, id uuid.UUID) (Season, error) {
-	row := q.db.QueryRowContext(ctx, getSeason, id)
-	var i Season
+// Fetch a team alias by id, excluding soft-deleted aliases
+func (q *Queries) GetTeamAlias(ctx context.Context, id uuid.UUID) (TeamAlias, error) {
+	row := q.db.QueryRowContext(ctx, getTeamAlias, id)
+	var i TeamAlias
 	err := row.Scan(
 		&i.ID,
-		&i.CompetitionID,
-		&i.StartDate,
-		&i.EndDate,
+		&i.TeamID,
+		&i.Name,
+		&i.Abbreviation,
+		&i.LogoUrl,
+		&i.ValidFrom,
+		&i.ValidTo,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
@@ -854,45 +3842,53 @@ func (q *Queries) GetSeason(ctx context.Context, id uuid.UUID) (Season, error) {
 	return i, err
 }
 
-const getSeasonTeams = `-- name: GetSeasonTeams :many
+const getTeamAliasesAt = `-- name: GetTeamAliasesAt :many
 SELECT
-  id,
-  team_id,
-  season_id,
-  created_at,
-  updated_at,
-  deleted_at
+	id,
+	team_id,
+	name,
+	abbreviation,
+	logo_url,
+	valid_from,
+	valid_to,
+	created_at,
+	updated_at,
+	deleted_at
 FROM
-  season_teams
+	team_aliases
 WHERE
-  season_id = $1
+	team_id = ANY($1::uuid[])
 AND
-  deleted_at IS NULL
+	valid_from <= $2::date
+AND
+	(valid_to IS NULL OR valid_to >= $2::date)
+AND
+	deleted_at IS NULL
 `
 
-type GetSeasonTeamsRow struct {
-	ID        uuid.UUID
-	TeamID    uuid.UUID
-	SeasonID  uuid.UUID
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
+type GetTeamAliasesAtParams struct {
+	TeamIds []uuid.UUID
+	At      time.Time
 }
 
-// Fetch all season_teams
-func (q *Queries) GetSeasonTeams(ctx context.Context, seasonID uuid.UUID) ([]GetSeasonTeamsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getSeasonTeams, seasonID)
+// Fetch the aliases of the given teams that are valid on a date, excluding soft-deleted aliases
+func (q *Queries) GetTeamAliasesAt(ctx context.Context, arg GetTeamAliasesAtParams) ([]TeamAlias, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamAliasesAt, pq.Array(arg.TeamIds), arg.At)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetSeasonTeamsRow
+	var items []TeamAlias
 	for rows.Next() {
-		var i GetSeasonTeamsRow
+		var i TeamAlias
 		if err := rows.Scan(
 			&i.ID,
 			&i.TeamID,
-			&i.SeasonID,
+			&i.Name,
+			&i.Abbreviation,
+			&i.LogoUrl,
+			&i.ValidFrom,
+			&i.ValidTo,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -910,50 +3906,133 @@ func (q *Queries) GetSeasonTeams(ctx context.Context, seasonID uuid.UUID) ([]Get
 	return items, nil
 }
 
-const getSeasons = `-- name: GetSeasons :many
+const getTeamAliasesByTeamID = `-- name: GetTeamAliasesByTeamID :many
 SELECT
 	id,
-	competition_id,
-	start_date,
-	end_date,
+	team_id,
+	name,
+	abbreviation,
+	logo_url,
+	valid_from,
+	valid_to,
 	created_at,
 	updated_at,
 	deleted_at
 FROM
-	seasons
+	team_aliases
 WHERE
-	competition_id = $1
+	team_id = $1
 AND
 	deleted_at IS NULL
-ORDER BY created_at DESC, id DESC
-LIMIT $3
-OFFSET $2
+ORDER BY
+	valid_from ASC,
+	id ASC
 `
 
-type GetSeasonsParams struct {
-	CompetitionID uuid.UUID
-	PageOffset    int32
-	PageLimit     int32
+// Fetch every alias of a team oldest first, excluding soft-deleted aliases
+func (q *Queries) GetTeamAliasesByTeamID(ctx context.Context, teamID uuid.UUID) ([]TeamAlias, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamAliasesByTeamID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TeamAlias
+	for rows.Next() {
+		var i TeamAlias
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.Abbreviation,
+			&i.LogoUrl,
+			&i.ValidFrom,
+			&i.ValidTo,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-// Fetch all seasons for a competition, excluding soft-deleted seasons
-func (q *Queries) GetSeasons(ctx context.Context, arg GetSeasonsParams) ([]Season, error) {
-	rows, err := q.db.QueryContext(ctx, getSeasons, arg.CompetitionID, arg.PageOffset, arg.PageLimit)
+const getTeamResults = `-- name: GetTeamResults :many
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.created_at,
+    g.updated_at,
+    g.deleted_at,
+    g.venue_id,
+    g.neutral_venue
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (g.home_team_id = $1 OR g.away_team_id = $1)
+AND
+    ($2::uuid IS NULL OR g.season_id = $2)
+AND
+    g.status = 'finished'
+AND
+    g.home_score IS NOT NULL
+AND
+    g.away_score IS NOT NULL
+AND
+    g.deleted_at IS NULL
+ORDER BY
+    g.date DESC,
+    g.id DESC
+`
+
+type GetTeamResultsParams struct {
+	TeamID   uuid.UUID
+	SeasonID uuid.NullUUID
+}
+
+// Fetch every finished game with a score for a team, newest first, optionally limited to a season
+func (q *Queries) GetTeamResults(ctx context.Context, arg GetTeamResultsParams) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamResults, arg.TeamID, arg.SeasonID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Season
+	var items []Game
 	for rows.Next() {
-		var i Season
+		var i Game
 		if err := rows.Scan(
 			&i.ID,
-			&i.CompetitionID,
-			&i.StartDate,
-			&i.EndDate,
+			&i.SeasonID,
+			&i.StageID,
+			&i.Date,
+			&i.HomeTeamID,
+			&i.AwayTeamID,
+			&i.HomeScore,
+			&i.AwayScore,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.VenueID,
+			&i.NeutralVenue,
 		); err != nil {
 			return nil, err
 		}
@@ -968,43 +4047,80 @@ func (q *Queries) GetSeasons(ctx context.Context, arg GetSeasonsParams) ([]Seaso
 	return items, nil
 }
 
-const getStagesBySeasonID = `-- name: GetStagesBySeasonID :many
+const getTeams = `-- name: GetTeams :many
 SELECT
-  id,
-  season_id,
-  name,
-  stage_type,
-  order_index,
-  created_at,
-  updated_at,
-  deleted_at
+	id,
+	name,
+	abbreviation,
+	location,
+	created_at,
+	updated_at,
+	deleted_at,
+	home_venue_id,
+	short_name,
+	logo_url,
+	crest_url,
+	primary_colour,
+	secondary_colour,
+	founded_year,
+	social_handles,
+	external_refs
 FROM
-  stages
+	teams
 WHERE
-  season_id = $1
+	deleted_at IS NULL
 AND
-  deleted_at IS NULL
+	(
+		$1::text IS NULL
+		OR name ILIKE $1
+		OR abbreviation ILIKE $1
+		OR EXISTS (
+			SELECT 1
+			FROM team_aliases a
+			WHERE a.team_id = teams.id
+			AND a.deleted_at IS NULL
+			AND (a.name ILIKE $1 OR a.abbreviation ILIKE $1)
+		)
+	)
+ORDER BY
+	name ASC
+LIMIT $2
+OFFSET $3
 `
 
-// Fetch all stages for a season, excluding soft-deleted stages
-func (q *Queries) GetStagesBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]Stage, error) {
-	rows, err := q.db.QueryContext(ctx, getStagesBySeasonID, seasonID)
+type GetTeamsParams struct {
+	NamePattern sql.NullString
+	PageLimit   int32
+	PageOffset  int32
+}
+
+// Fetch teams with pagination whose name, abbreviation or any alias matches the optional pattern, excluding soft-deleted teams
+func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]Team, error) {
+	rows, err := q.db.QueryContext(ctx, getTeams, arg.NamePattern, arg.PageLimit, arg.PageOffset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Stage
+	var items []Team
 	for rows.Next() {
-		var i Stage
+		var i Team
 		if err := rows.Scan(
 			&i.ID,
-			&i.SeasonID,
 			&i.Name,
-			&i.StageType,
-			&i.OrderIndex,
+			&i.Abbreviation,
+			&i.Location,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.HomeVenueID,
+			&i.ShortName,
+			&i.LogoUrl,
+			&i.CrestUrl,
+			&i.PrimaryColour,
+			&i.SecondaryColour,
+			&i.FoundedYear,
+			&i.SocialHandles,
+			&i.ExternalRefs,
 		); err != nil {
 			return nil, err
 		}
@@ -1019,32 +4135,40 @@ func (q *Queries) GetStagesBySeasonID(ctx context.Context, seasonID uuid.UUID) (
 	return items, nil
 }
 
-const getTeam = `-- name: GetTeam :one
+const getVenue = `-- name: GetVenue :one
 SELECT
 	id,
 	name,
-	abbreviation,
-	location,
+	city,
+	country,
+	timezone,
+	capacity,
+	latitude,
+	longitude,
 	created_at,
 	updated_at,
 	deleted_at
 FROM
-	teams
+	venues
 WHERE
 	id = $1
 AND
 	deleted_at IS NULL
 `
 
-// Fetch a team by id, excluding soft-deleted teams
-func (q *Queries) GetTeam(ctx context.Context, id uuid.UUID) (Team, error) {
-	row := q.db.QueryRowContext(ctx, getTeam, id)
-	var i Team
+// Fetch a venue by id, excluding soft-deleted venues
+func (q *Queries) GetVenue(ctx context.Context, id uuid.UUID) (Venue, error) {
+	row := q.db.QueryRowContext(ctx, getVenue, id)
+	var i Venue
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
-		&i.Abbreviation,
-		&i.Location,
+		&i.City,
+		&i.Country,
+		&i.Timezone,
+		&i.Capacity,
+		&i.Latitude,
+		&i.Longitude,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
@@ -1052,45 +4176,54 @@ func (q *Queries) GetTeam(ctx context.Context, id uuid.UUID) (Team, error) {
 	return i, err
 }
 
-const getTeams = `-- name: GetTeams :many
+const getVenues = `-- name: GetVenues :many
 SELECT
 	id,
 	name,
-	abbreviation,
-	location,
+	city,
+	country,
+	timezone,
+	capacity,
+	latitude,
+	longitude,
 	created_at,
 	updated_at,
 	deleted_at
 FROM
-	teams
+	venues
 WHERE
 	deleted_at IS NULL
 ORDER BY
-	name ASC
+	name ASC,
+	city ASC
 LIMIT $2
 OFFSET $1
 `
 
-type GetTeamsParams struct {
+type GetVenuesParams struct {
 	PageOffset int32
 	PageLimit  int32
 }
 
-// Fetch teams with pagination, excluding soft-deleted teams
-func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]Team, error) {
-	rows, err := q.db.QueryContext(ctx, getTeams, arg.PageOffset, arg.PageLimit)
+// Fetch venues with pagination, excluding soft-deleted venues
+func (q *Queries) GetVenues(ctx context.Context, arg GetVenuesParams) ([]Venue, error) {
+	rows, err := q.db.QueryContext(ctx, getVenues, arg.PageOffset, arg.PageLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Team
+	var items []Venue
 	for rows.Next() {
-		var i Team
+		var i Venue
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
-			&i.Abbreviation,
-			&i.Location,
+			&i.City,
+			&i.Country,
+			&i.Timezone,
+			&i.Capacity,
+			&i.Latitude,
+			&i.Longitude,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -1108,24 +4241,197 @@ func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]Team, err
 	return items, nil
 }
 
+const lockCompetition = `-- name: LockCompetition :one
+SELECT
+	updated_at
+FROM
+	competitions
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock a competition row for the rest of the transaction and return its updated_at
+func (q *Queries) LockCompetition(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockCompetition, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const lockOfficial = `-- name: LockOfficial :one
+SELECT
+	updated_at
+FROM
+	officials
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock an official row for the rest of the transaction and return its updated_at
+func (q *Queries) LockOfficial(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockOfficial, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const lockPlayer = `-- name: LockPlayer :one
+SELECT
+	updated_at
+FROM
+	players
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock a player row for the rest of the transaction and return its updated_at
+func (q *Queries) LockPlayer(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockPlayer, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const lockSeason = `-- name: LockSeason :one
+SELECT
+	updated_at
+FROM
+	seasons
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock a season row for the rest of the transaction and return its updated_at
+func (q *Queries) LockSeason(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockSeason, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const lockTeam = `-- name: LockTeam :one
+SELECT
+	updated_at
+FROM
+	teams
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock a team row for the rest of the transaction and return its updated_at
+func (q *Queries) LockTeam(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockTeam, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const lockGame = `-- name: LockGame :one
+SELECT
+	updated_at
+FROM
+	games
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock a game row for the rest of the transaction and return its updated_at
+func (q *Queries) LockGame(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockGame, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const lockVenue = `-- name: LockVenue :one
+SELECT
+	updated_at
+FROM
+	venues
+WHERE
+	id = $1
+AND
+	deleted_at IS NULL
+FOR UPDATE
+`
+
+// Lock a venue row for the rest of the transaction and return its updated_at
+func (q *Queries) LockVenue(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, lockVenue, id)
+	var updated_at time.Time
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
 const updateCompetition = `-- name: UpdateCompetition :exec
 UPDATE competitions
 SET
-	name = $1
+	name = $1,
+	sport = $2,
+	gender = $3,
+	level = $4,
+	country = $5,
+	region = $6,
+	governing_body = $7,
+	logo_url = $8,
+	primary_colour = $9,
+	secondary_colour = $10,
+	website = $11
 WHERE
-	id = $2
+	id = $12
 AND
 	deleted_at IS NULL
 `
 
 type UpdateCompetitionParams struct {
-	Name string
-	ID   uuid.UUID
+	Name            string
+	Sport           SportCode
+	Gender          NullCompetitionGender
+	Level           NullCompetitionLevel
+	Country         sql.NullString
+	Region          sql.NullString
+	GoverningBody   sql.NullString
+	LogoUrl         sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	Website         sql.NullString
+	ID              uuid.UUID
 }
 
 // Update an existing competition by id
 func (q *Queries) UpdateCompetition(ctx context.Context, arg UpdateCompetitionParams) error {
-	_, err := q.db.ExecContext(ctx, updateCompetition, arg.Name, arg.ID)
+	_, err := q.db.ExecContext(ctx, updateCompetition,
+		arg.Name,
+		arg.Sport,
+		arg.Gender,
+		arg.Level,
+		arg.Country,
+		arg.Region,
+		arg.GoverningBody,
+		arg.LogoUrl,
+		arg.PrimaryColour,
+		arg.SecondaryColour,
+		arg.Website,
+		arg.ID,
+	)
 	return err
 }
 
@@ -1139,23 +4445,27 @@ SET
     home_score = $5,
     away_score = $6,
     status = $7,
-    updated_at = $8
+    venue_id = $8,
+    neutral_venue = $9,
+    updated_at = $10
 WHERE
-    id = $9
+    id = $11
 AND
     deleted_at IS NULL
 `
 
 type UpdateGameParams struct {
-	StageID    uuid.UUID
-	Date       time.Time
-	HomeTeamID uuid.UUID
-	AwayTeamID uuid.UUID
-	HomeScore  sql.NullInt32
-	AwayScore  sql.NullInt32
-	Status     GameStatus
-	UpdatedAt  time.Time
-	ID         uuid.UUID
+	StageID      uuid.UUID
+	Date         time.Time
+	HomeTeamID   uuid.UUID
+	AwayTeamID   uuid.UUID
+	HomeScore    sql.NullInt32
+	AwayScore    sql.NullInt32
+	Status       GameStatus
+	VenueID      uuid.NullUUID
+	NeutralVenue bool
+	UpdatedAt    time.Time
+	ID           uuid.UUID
 }
 
 // Update an existing game by id
@@ -1168,6 +4478,97 @@ func (q *Queries) UpdateGame(ctx context.Context, arg UpdateGameParams) error {
 		arg.HomeScore,
 		arg.AwayScore,
 		arg.Status,
+		arg.VenueID,
+		arg.NeutralVenue,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateGameOfficialRole = `-- name: UpdateGameOfficialRole :exec
+UPDATE game_officials
+SET
+	role = $1,
+	updated_at = $2
+WHERE
+	id = $3
+AND
+	deleted_at IS NULL
+`
+
+type UpdateGameOfficialRoleParams struct {
+	Role      OfficialRole
+	UpdatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Change the role an official has in a game
+func (q *Queries) UpdateGameOfficialRole(ctx context.Context, arg UpdateGameOfficialRoleParams) error {
+	_, err := q.db.ExecContext(ctx, updateGameOfficialRole, arg.Role, arg.UpdatedAt, arg.ID)
+	return err
+}
+
+const updateOfficial = `-- name: UpdateOfficial :exec
+UPDATE officials
+SET
+	name = $1,
+	nationality = $2,
+	updated_at = $3
+WHERE
+	id = $4
+AND
+	deleted_at IS NULL
+`
+
+type UpdateOfficialParams struct {
+	Name        string
+	Nationality sql.NullString
+	UpdatedAt   time.Time
+	ID          uuid.UUID
+}
+
+// Update an existing official by id
+func (q *Queries) UpdateOfficial(ctx context.Context, arg UpdateOfficialParams) error {
+	_, err := q.db.ExecContext(ctx, updateOfficial,
+		arg.Name,
+		arg.Nationality,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updatePlayer = `-- name: UpdatePlayer :exec
+UPDATE players
+SET
+	name = $1,
+	date_of_birth = $2,
+	position = $3,
+	nationality = $4,
+	updated_at = $5
+WHERE
+	id = $6
+AND
+	deleted_at IS NULL
+`
+
+type UpdatePlayerParams struct {
+	Name        string
+	DateOfBirth sql.NullTime
+	Position    PlayerPosition
+	Nationality sql.NullString
+	UpdatedAt   time.Time
+	ID          uuid.UUID
+}
+
+// Update an existing player by id
+func (q *Queries) UpdatePlayer(ctx context.Context, arg UpdatePlayerParams) error {
+	_, err := q.db.ExecContext(ctx, updatePlayer,
+		arg.Name,
+		arg.DateOfBirth,
+		arg.Position,
+		arg.Nationality,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -1178,17 +4579,21 @@ const updateSeason = `-- name: UpdateSeason :exec
 UPDATE seasons
 SET
 	competition_id = $1,
-	start_date = $2,
-	end_date = $3,
-	updated_at = $4
+	name = $2,
+	year = $3,
+	start_date = $4,
+	end_date = $5,
+	updated_at = $6
 WHERE
-	id = $5
-AND	
+	id = $7
+AND
 	deleted_at IS NULL
 `
 
 type UpdateSeasonParams struct {
 	CompetitionID uuid.UUID
+	Name          string
+	Year          int32
 	StartDate     time.Time
 	EndDate       time.Time
 	UpdatedAt     time.Time
@@ -1199,6 +4604,8 @@ type UpdateSeasonParams struct {
 func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) error {
 	_, err := q.db.ExecContext(ctx, updateSeason,
 		arg.CompetitionID,
+		arg.Name,
+		arg.Year,
 		arg.StartDate,
 		arg.EndDate,
 		arg.UpdatedAt,
@@ -1207,6 +4614,70 @@ func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) erro
 	return err
 }
 
+const updateSeasonTeamHomeVenue = `-- name: UpdateSeasonTeamHomeVenue :exec
+UPDATE season_teams
+SET
+  home_venue_id = $1,
+  updated_at = $2
+WHERE
+  season_id = $3
+AND
+  team_id = $4
+AND
+  deleted_at IS NULL
+`
+
+type UpdateSeasonTeamHomeVenueParams struct {
+	HomeVenueID uuid.NullUUID
+	UpdatedAt   time.Time
+	SeasonID    uuid.UUID
+	TeamID      uuid.UUID
+}
+
+// Set or clear the home venue a team uses for one season
+func (q *Queries) UpdateSeasonTeamHomeVenue(ctx context.Context, arg UpdateSeasonTeamHomeVenueParams) error {
+	_, err := q.db.ExecContext(ctx, updateSeasonTeamHomeVenue,
+		arg.HomeVenueID,
+		arg.UpdatedAt,
+		arg.SeasonID,
+		arg.TeamID,
+	)
+	return err
+}
+
+const updateSeasonTeamPlayer = `-- name: UpdateSeasonTeamPlayer :exec
+UPDATE season_team_players
+SET
+	jersey_number = $1,
+	active_from = $2,
+	active_to = $3,
+	updated_at = $4
+WHERE
+	id = $5
+AND
+	deleted_at IS NULL
+`
+
+type UpdateSeasonTeamPlayerParams struct {
+	JerseyNumber int32
+	ActiveFrom   sql.NullTime
+	ActiveTo     sql.NullTime
+	UpdatedAt    time.Time
+	ID           uuid.UUID
+}
+
+// Update a squad member's jersey number and active dates
+func (q *Queries) UpdateSeasonTeamPlayer(ctx context.Context, arg UpdateSeasonTeamPlayerParams) error {
+	_, err := q.db.ExecContext(ctx, updateSeasonTeamPlayer,
+		arg.JerseyNumber,
+		arg.ActiveFrom,
+		arg.ActiveTo,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
 const updateStage = `-- name: UpdateStage :exec
 UPDATE stages
 SET
@@ -1246,19 +4717,37 @@ SET
 	name = $1,
 	abbreviation = $2,
 	location = $3,
-	updated_at = $4
+	home_venue_id = $4,
+	short_name = $5,
+	logo_url = $6,
+	crest_url = $7,
+	primary_colour = $8,
+	secondary_colour = $9,
+	founded_year = $10,
+	social_handles = $11,
+	external_refs = $12,
+	updated_at = $13
 WHERE
-	id = $5
+	id = $14
 AND
 	deleted_at IS NULL
 `
 
 type UpdateTeamParams struct {
-	Name         string
-	Abbreviation string
-	Location     string
-	UpdatedAt    time.Time
-	ID           uuid.UUID
+	Name            string
+	Abbreviation    string
+	Location        string
+	HomeVenueID     uuid.NullUUID
+	ShortName       sql.NullString
+	LogoUrl         sql.NullString
+	CrestUrl        sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	FoundedYear     sql.NullInt32
+	SocialHandles   json.RawMessage
+	ExternalRefs    json.RawMessage
+	UpdatedAt       time.Time
+	ID              uuid.UUID
 }
 
 // Update an existing team by id
@@ -1267,8 +4756,143 @@ func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) error {
 		arg.Name,
 		arg.Abbreviation,
 		arg.Location,
+		arg.HomeVenueID,
+		arg.ShortName,
+		arg.LogoUrl,
+		arg.CrestUrl,
+		arg.PrimaryColour,
+		arg.SecondaryColour,
+		arg.FoundedYear,
+		arg.SocialHandles,
+		arg.ExternalRefs,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTeamAlias = `-- name: UpdateTeamAlias :exec
+UPDATE team_aliases
+SET
+	name = $1,
+	abbreviation = $2,
+	logo_url = $3,
+	valid_from = $4,
+	valid_to = $5,
+	updated_at = $6
+WHERE
+	id = $7
+AND
+	deleted_at IS NULL
+`
+
+type UpdateTeamAliasParams struct {
+	Name         string
+	Abbreviation string
+	LogoUrl      sql.NullString
+	ValidFrom    time.Time
+	ValidTo      sql.NullTime
+	UpdatedAt    time.Time
+	ID           uuid.UUID
+}
+
+// Update an existing team alias by id
+func (q *Queries) UpdateTeamAlias(ctx context.Context, arg UpdateTeamAliasParams) error {
+	_, err := q.db.ExecContext(ctx, updateTeamAlias,
+		arg.Name,
+		arg.Abbreviation,
+		arg.LogoUrl,
+		arg.ValidFrom,
+		arg.ValidTo,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateVenue = `-- name: UpdateVenue :exec
+UPDATE venues
+SET
+	name = $1,
+	city = $2,
+	country = $3,
+	timezone = $4,
+	capacity = $5,
+	latitude = $6,
+	longitude = $7,
+	updated_at = $8
+WHERE
+	id = $9
+AND
+	deleted_at IS NULL
+`
+
+type UpdateVenueParams struct {
+	Name      string
+	City      string
+	Country   string
+	Timezone  string
+	Capacity  sql.NullInt32
+	Latitude  sql.NullFloat64
+	Longitude sql.NullFloat64
+	UpdatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Update an existing venue by id
+func (q *Queries) UpdateVenue(ctx context.Context, arg UpdateVenueParams) error {
+	_, err := q.db.ExecContext(ctx, updateVenue,
+		arg.Name,
+		arg.City,
+		arg.Country,
+		arg.Timezone,
+		arg.Capacity,
+		arg.Latitude,
+		arg.Longitude,
 		arg.UpdatedAt,
 		arg.ID,
 	)
 	return err
 }
+
+const upsertSeasonFinals = `-- name: UpsertSeasonFinals :exec
+INSERT INTO season_finals (
+  season_id,
+  format,
+  round_interval_days,
+  created_at,
+  updated_at
+)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT (season_id) DO UPDATE
+SET
+  format = EXCLUDED.format,
+  round_interval_days = EXCLUDED.round_interval_days,
+  updated_at = EXCLUDED.updated_at
+`
+
+type UpsertSeasonFinalsParams struct {
+	SeasonID          uuid.UUID
+	Format            FinalsFormat
+	RoundIntervalDays int32
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Set the finals format for a season, replacing any existing one
+func (q *Queries) UpsertSeasonFinals(ctx context.Context, arg UpsertSeasonFinalsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSeasonFinals,
+		arg.SeasonID,
+		arg.Format,
+		arg.RoundIntervalDays,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
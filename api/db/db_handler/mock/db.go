@@ -13,6 +13,7 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/bradley-adams/gainline/db/db"
 	db_handler "github.com/bradley-adams/gainline/db/db_handler"
@@ -213,34 +214,137 @@ func (m *MockQueries) EXPECT() *MockQueriesMockRecorder {
 	return m.recorder
 }
 
+// ClearSeasonTeamHomeVenues mocks base method.
+func (m *MockQueries) ClearSeasonTeamHomeVenues(ctx context.Context, arg db.ClearSeasonTeamHomeVenuesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSeasonTeamHomeVenues", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearSeasonTeamHomeVenues indicates an expected call of ClearSeasonTeamHomeVenues.
+func (mr *MockQueriesMockRecorder) ClearSeasonTeamHomeVenues(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSeasonTeamHomeVenues", reflect.TypeOf((*MockQueries)(nil).ClearSeasonTeamHomeVenues), ctx, arg)
+}
+
+// ClearTeamHomeVenues mocks base method.
+func (m *MockQueries) ClearTeamHomeVenues(ctx context.Context, arg db.ClearTeamHomeVenuesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearTeamHomeVenues", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearTeamHomeVenues indicates an expected call of ClearTeamHomeVenues.
+func (mr *MockQueriesMockRecorder) ClearTeamHomeVenues(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearTeamHomeVenues", reflect.TypeOf((*MockQueries)(nil).ClearTeamHomeVenues), ctx, arg)
+}
+
 // CountCompetitions mocks base method.
-func (m *MockQueries) CountCompetitions(ctx context.Context) (int64, error) {
+func (m *MockQueries) CountCompetitions(ctx context.Context, arg db.CountCompetitionsParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountCompetitions", ctx)
+	ret := m.ctrl.Call(m, "CountCompetitions", ctx, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountCompetitions indicates an expected call of CountCompetitions.
-func (mr *MockQueriesMockRecorder) CountCompetitions(ctx any) *gomock.Call {
+func (mr *MockQueriesMockRecorder) CountCompetitions(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompetitions", reflect.TypeOf((*MockQueries)(nil).CountCompetitions), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompetitions", reflect.TypeOf((*MockQueries)(nil).CountCompetitions), ctx, arg)
+}
+
+// CountGameFeed mocks base method.
+func (m *MockQueries) CountGameFeed(ctx context.Context, arg db.CountGameFeedParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountGameFeed", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountGameFeed indicates an expected call of CountGameFeed.
+func (mr *MockQueriesMockRecorder) CountGameFeed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGameFeed", reflect.TypeOf((*MockQueries)(nil).CountGameFeed), ctx, arg)
 }
 
 // CountGames mocks base method.
-func (m *MockQueries) CountGames(ctx context.Context, seasonID uuid.UUID) (int64, error) {
+func (m *MockQueries) CountGames(ctx context.Context, arg db.CountGamesParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountGames", ctx, seasonID)
+	ret := m.ctrl.Call(m, "CountGames", ctx, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountGames indicates an expected call of CountGames.
-func (mr *MockQueriesMockRecorder) CountGames(ctx, seasonID any) *gomock.Call {
+func (mr *MockQueriesMockRecorder) CountGames(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGames", reflect.TypeOf((*MockQueries)(nil).CountGames), ctx, arg)
+}
+
+// CountGamesByTeamID mocks base method.
+func (m *MockQueries) CountGamesByTeamID(ctx context.Context, arg db.CountGamesByTeamIDParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountGamesByTeamID", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountGamesByTeamID indicates an expected call of CountGamesByTeamID.
+func (mr *MockQueriesMockRecorder) CountGamesByTeamID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGamesByTeamID", reflect.TypeOf((*MockQueries)(nil).CountGamesByTeamID), ctx, arg)
+}
+
+// CountGamesByVenueID mocks base method.
+func (m *MockQueries) CountGamesByVenueID(ctx context.Context, venueID uuid.NullUUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountGamesByVenueID", ctx, venueID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountGamesByVenueID indicates an expected call of CountGamesByVenueID.
+func (mr *MockQueriesMockRecorder) CountGamesByVenueID(ctx, venueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGamesByVenueID", reflect.TypeOf((*MockQueries)(nil).CountGamesByVenueID), ctx, venueID)
+}
+
+// CountOfficials mocks base method.
+func (m *MockQueries) CountOfficials(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOfficials", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOfficials indicates an expected call of CountOfficials.
+func (mr *MockQueriesMockRecorder) CountOfficials(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOfficials", reflect.TypeOf((*MockQueries)(nil).CountOfficials), ctx)
+}
+
+// CountPlayersByTeamID mocks base method.
+func (m *MockQueries) CountPlayersByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPlayersByTeamID", ctx, teamID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPlayersByTeamID indicates an expected call of CountPlayersByTeamID.
+func (mr *MockQueriesMockRecorder) CountPlayersByTeamID(ctx, teamID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGames", reflect.TypeOf((*MockQueries)(nil).CountGames), ctx, seasonID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPlayersByTeamID", reflect.TypeOf((*MockQueries)(nil).CountPlayersByTeamID), ctx, teamID)
 }
 
 // CountSeasons mocks base method.
@@ -259,18 +363,33 @@ func (mr *MockQueriesMockRecorder) CountSeasons(ctx, competitionID any) *gomock.
 }
 
 // CountTeams mocks base method.
-func (m *MockQueries) CountTeams(ctx context.Context) (int64, error) {
+func (m *MockQueries) CountTeams(ctx context.Context, namePattern sql.NullString) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountTeams", ctx)
+	ret := m.ctrl.Call(m, "CountTeams", ctx, namePattern)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountTeams indicates an expected call of CountTeams.
-func (mr *MockQueriesMockRecorder) CountTeams(ctx any) *gomock.Call {
+func (mr *MockQueriesMockRecorder) CountTeams(ctx, namePattern any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTeams", reflect.TypeOf((*MockQueries)(nil).CountTeams), ctx, namePattern)
+}
+
+// CountVenues mocks base method.
+func (m *MockQueries) CountVenues(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVenues", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountVenues indicates an expected call of CountVenues.
+func (mr *MockQueriesMockRecorder) CountVenues(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTeams", reflect.TypeOf((*MockQueries)(nil).CountTeams), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVenues", reflect.TypeOf((*MockQueries)(nil).CountVenues), ctx)
 }
 
 // CreateCompetition mocks base method.
@@ -301,6 +420,90 @@ func (mr *MockQueriesMockRecorder) CreateGame(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGame", reflect.TypeOf((*MockQueries)(nil).CreateGame), ctx, arg)
 }
 
+// CreateGameEvent mocks base method.
+func (m *MockQueries) CreateGameEvent(ctx context.Context, arg db.CreateGameEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGameEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGameEvent indicates an expected call of CreateGameEvent.
+func (mr *MockQueriesMockRecorder) CreateGameEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGameEvent", reflect.TypeOf((*MockQueries)(nil).CreateGameEvent), ctx, arg)
+}
+
+// CreateGameLineupPlayer mocks base method.
+func (m *MockQueries) CreateGameLineupPlayer(ctx context.Context, arg db.CreateGameLineupPlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGameLineupPlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGameLineupPlayer indicates an expected call of CreateGameLineupPlayer.
+func (mr *MockQueriesMockRecorder) CreateGameLineupPlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGameLineupPlayer", reflect.TypeOf((*MockQueries)(nil).CreateGameLineupPlayer), ctx, arg)
+}
+
+// CreateGameOfficial mocks base method.
+func (m *MockQueries) CreateGameOfficial(ctx context.Context, arg db.CreateGameOfficialParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGameOfficial", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGameOfficial indicates an expected call of CreateGameOfficial.
+func (mr *MockQueriesMockRecorder) CreateGameOfficial(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGameOfficial", reflect.TypeOf((*MockQueries)(nil).CreateGameOfficial), ctx, arg)
+}
+
+// CreateGameReplacement mocks base method.
+func (m *MockQueries) CreateGameReplacement(ctx context.Context, arg db.CreateGameReplacementParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGameReplacement", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGameReplacement indicates an expected call of CreateGameReplacement.
+func (mr *MockQueriesMockRecorder) CreateGameReplacement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGameReplacement", reflect.TypeOf((*MockQueries)(nil).CreateGameReplacement), ctx, arg)
+}
+
+// CreateOfficial mocks base method.
+func (m *MockQueries) CreateOfficial(ctx context.Context, arg db.CreateOfficialParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOfficial", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOfficial indicates an expected call of CreateOfficial.
+func (mr *MockQueriesMockRecorder) CreateOfficial(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOfficial", reflect.TypeOf((*MockQueries)(nil).CreateOfficial), ctx, arg)
+}
+
+// CreatePlayer mocks base method.
+func (m *MockQueries) CreatePlayer(ctx context.Context, arg db.CreatePlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePlayer indicates an expected call of CreatePlayer.
+func (mr *MockQueriesMockRecorder) CreatePlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlayer", reflect.TypeOf((*MockQueries)(nil).CreatePlayer), ctx, arg)
+}
+
 // CreateSeason mocks base method.
 func (m *MockQueries) CreateSeason(ctx context.Context, arg db.CreateSeasonParams) error {
 	m.ctrl.T.Helper()
@@ -315,6 +518,20 @@ func (mr *MockQueriesMockRecorder) CreateSeason(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeason", reflect.TypeOf((*MockQueries)(nil).CreateSeason), ctx, arg)
 }
 
+// CreateSeasonTeamPlayer mocks base method.
+func (m *MockQueries) CreateSeasonTeamPlayer(ctx context.Context, arg db.CreateSeasonTeamPlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeasonTeamPlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSeasonTeamPlayer indicates an expected call of CreateSeasonTeamPlayer.
+func (mr *MockQueriesMockRecorder) CreateSeasonTeamPlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeasonTeamPlayer", reflect.TypeOf((*MockQueries)(nil).CreateSeasonTeamPlayer), ctx, arg)
+}
+
 // CreateSeasonTeams mocks base method.
 func (m *MockQueries) CreateSeasonTeams(ctx context.Context, arg db.CreateSeasonTeamsParams) error {
 	m.ctrl.T.Helper()
@@ -357,6 +574,34 @@ func (mr *MockQueriesMockRecorder) CreateTeam(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockQueries)(nil).CreateTeam), ctx, arg)
 }
 
+// CreateTeamAlias mocks base method.
+func (m *MockQueries) CreateTeamAlias(ctx context.Context, arg db.CreateTeamAliasParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeamAlias", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTeamAlias indicates an expected call of CreateTeamAlias.
+func (mr *MockQueriesMockRecorder) CreateTeamAlias(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeamAlias", reflect.TypeOf((*MockQueries)(nil).CreateTeamAlias), ctx, arg)
+}
+
+// CreateVenue mocks base method.
+func (m *MockQueries) CreateVenue(ctx context.Context, arg db.CreateVenueParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVenue", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVenue indicates an expected call of CreateVenue.
+func (mr *MockQueriesMockRecorder) CreateVenue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVenue", reflect.TypeOf((*MockQueries)(nil).CreateVenue), ctx, arg)
+}
+
 // DeleteCompetition mocks base method.
 func (m *MockQueries) DeleteCompetition(ctx context.Context, arg db.DeleteCompetitionParams) error {
 	m.ctrl.T.Helper()
@@ -385,6 +630,76 @@ func (mr *MockQueriesMockRecorder) DeleteGame(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGame", reflect.TypeOf((*MockQueries)(nil).DeleteGame), ctx, arg)
 }
 
+// DeleteGameEvent mocks base method.
+func (m *MockQueries) DeleteGameEvent(ctx context.Context, arg db.DeleteGameEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGameEvent indicates an expected call of DeleteGameEvent.
+func (mr *MockQueriesMockRecorder) DeleteGameEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameEvent", reflect.TypeOf((*MockQueries)(nil).DeleteGameEvent), ctx, arg)
+}
+
+// DeleteGameLineupPlayers mocks base method.
+func (m *MockQueries) DeleteGameLineupPlayers(ctx context.Context, arg db.DeleteGameLineupPlayersParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameLineupPlayers", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGameLineupPlayers indicates an expected call of DeleteGameLineupPlayers.
+func (mr *MockQueriesMockRecorder) DeleteGameLineupPlayers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameLineupPlayers", reflect.TypeOf((*MockQueries)(nil).DeleteGameLineupPlayers), ctx, arg)
+}
+
+// DeleteGameOfficial mocks base method.
+func (m *MockQueries) DeleteGameOfficial(ctx context.Context, arg db.DeleteGameOfficialParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameOfficial", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGameOfficial indicates an expected call of DeleteGameOfficial.
+func (mr *MockQueriesMockRecorder) DeleteGameOfficial(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameOfficial", reflect.TypeOf((*MockQueries)(nil).DeleteGameOfficial), ctx, arg)
+}
+
+// DeleteGameOfficialsByOfficialID mocks base method.
+func (m *MockQueries) DeleteGameOfficialsByOfficialID(ctx context.Context, arg db.DeleteGameOfficialsByOfficialIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameOfficialsByOfficialID", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGameOfficialsByOfficialID indicates an expected call of DeleteGameOfficialsByOfficialID.
+func (mr *MockQueriesMockRecorder) DeleteGameOfficialsByOfficialID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameOfficialsByOfficialID", reflect.TypeOf((*MockQueries)(nil).DeleteGameOfficialsByOfficialID), ctx, arg)
+}
+
+// DeleteGameReplacement mocks base method.
+func (m *MockQueries) DeleteGameReplacement(ctx context.Context, arg db.DeleteGameReplacementParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGameReplacement", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGameReplacement indicates an expected call of DeleteGameReplacement.
+func (mr *MockQueriesMockRecorder) DeleteGameReplacement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGameReplacement", reflect.TypeOf((*MockQueries)(nil).DeleteGameReplacement), ctx, arg)
+}
+
 // DeleteGamesByCompetitionID mocks base method.
 func (m *MockQueries) DeleteGamesByCompetitionID(ctx context.Context, arg db.DeleteGamesByCompetitionIDParams) error {
 	m.ctrl.T.Helper()
@@ -413,6 +728,34 @@ func (mr *MockQueriesMockRecorder) DeleteGamesBySeasonID(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGamesBySeasonID", reflect.TypeOf((*MockQueries)(nil).DeleteGamesBySeasonID), ctx, arg)
 }
 
+// DeleteOfficial mocks base method.
+func (m *MockQueries) DeleteOfficial(ctx context.Context, arg db.DeleteOfficialParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOfficial", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOfficial indicates an expected call of DeleteOfficial.
+func (mr *MockQueriesMockRecorder) DeleteOfficial(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOfficial", reflect.TypeOf((*MockQueries)(nil).DeleteOfficial), ctx, arg)
+}
+
+// DeletePlayer mocks base method.
+func (m *MockQueries) DeletePlayer(ctx context.Context, arg db.DeletePlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlayer indicates an expected call of DeletePlayer.
+func (mr *MockQueriesMockRecorder) DeletePlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlayer", reflect.TypeOf((*MockQueries)(nil).DeletePlayer), ctx, arg)
+}
+
 // DeleteSeason mocks base method.
 func (m *MockQueries) DeleteSeason(ctx context.Context, arg db.DeleteSeasonParams) error {
 	m.ctrl.T.Helper()
@@ -427,6 +770,20 @@ func (mr *MockQueriesMockRecorder) DeleteSeason(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeason", reflect.TypeOf((*MockQueries)(nil).DeleteSeason), ctx, arg)
 }
 
+// DeleteSeasonFinals mocks base method.
+func (m *MockQueries) DeleteSeasonFinals(ctx context.Context, seasonID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeasonFinals", ctx, seasonID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeasonFinals indicates an expected call of DeleteSeasonFinals.
+func (mr *MockQueriesMockRecorder) DeleteSeasonFinals(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeasonFinals", reflect.TypeOf((*MockQueries)(nil).DeleteSeasonFinals), ctx, seasonID)
+}
+
 // DeleteSeasonTeam mocks base method.
 func (m *MockQueries) DeleteSeasonTeam(ctx context.Context, arg db.DeleteSeasonTeamParams) error {
 	m.ctrl.T.Helper()
@@ -441,6 +798,34 @@ func (mr *MockQueriesMockRecorder) DeleteSeasonTeam(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeasonTeam", reflect.TypeOf((*MockQueries)(nil).DeleteSeasonTeam), ctx, arg)
 }
 
+// DeleteSeasonTeamPlayer mocks base method.
+func (m *MockQueries) DeleteSeasonTeamPlayer(ctx context.Context, arg db.DeleteSeasonTeamPlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeasonTeamPlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeasonTeamPlayer indicates an expected call of DeleteSeasonTeamPlayer.
+func (mr *MockQueriesMockRecorder) DeleteSeasonTeamPlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeasonTeamPlayer", reflect.TypeOf((*MockQueries)(nil).DeleteSeasonTeamPlayer), ctx, arg)
+}
+
+// DeleteSeasonTeamPlayersByPlayerID mocks base method.
+func (m *MockQueries) DeleteSeasonTeamPlayersByPlayerID(ctx context.Context, arg db.DeleteSeasonTeamPlayersByPlayerIDParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeasonTeamPlayersByPlayerID", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeasonTeamPlayersByPlayerID indicates an expected call of DeleteSeasonTeamPlayersByPlayerID.
+func (mr *MockQueriesMockRecorder) DeleteSeasonTeamPlayersByPlayerID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeasonTeamPlayersByPlayerID", reflect.TypeOf((*MockQueries)(nil).DeleteSeasonTeamPlayersByPlayerID), ctx, arg)
+}
+
 // DeleteSeasonTeamsByCompetitionID mocks base method.
 func (m *MockQueries) DeleteSeasonTeamsByCompetitionID(ctx context.Context, arg db.DeleteSeasonTeamsByCompetitionIDParams) error {
 	m.ctrl.T.Helper()
@@ -539,6 +924,49 @@ func (mr *MockQueriesMockRecorder) DeleteTeam(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockQueries)(nil).DeleteTeam), ctx, arg)
 }
 
+// DeleteTeamAlias mocks base method.
+func (m *MockQueries) DeleteTeamAlias(ctx context.Context, arg db.DeleteTeamAliasParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamAlias", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamAlias indicates an expected call of DeleteTeamAlias.
+func (mr *MockQueriesMockRecorder) DeleteTeamAlias(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamAlias", reflect.TypeOf((*MockQueries)(nil).DeleteTeamAlias), ctx, arg)
+}
+
+// DeleteVenue mocks base method.
+func (m *MockQueries) DeleteVenue(ctx context.Context, arg db.DeleteVenueParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVenue", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVenue indicates an expected call of DeleteVenue.
+func (mr *MockQueriesMockRecorder) DeleteVenue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVenue", reflect.TypeOf((*MockQueries)(nil).DeleteVenue), ctx, arg)
+}
+
+// GetAllGamesBySeasonID mocks base method.
+func (m *MockQueries) GetAllGamesBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]db.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGamesBySeasonID", ctx, seasonID)
+	ret0, _ := ret[0].([]db.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllGamesBySeasonID indicates an expected call of GetAllGamesBySeasonID.
+func (mr *MockQueriesMockRecorder) GetAllGamesBySeasonID(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGamesBySeasonID", reflect.TypeOf((*MockQueries)(nil).GetAllGamesBySeasonID), ctx, seasonID)
+}
+
 // GetCompetition mocks base method.
 func (m *MockQueries) GetCompetition(ctx context.Context, id uuid.UUID) (db.Competition, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +982,21 @@ func (mr *MockQueriesMockRecorder) GetCompetition(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetition", reflect.TypeOf((*MockQueries)(nil).GetCompetition), ctx, id)
 }
 
+// GetCompetitionLeaderboard mocks base method.
+func (m *MockQueries) GetCompetitionLeaderboard(ctx context.Context, arg db.GetCompetitionLeaderboardParams) ([]db.GetCompetitionLeaderboardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetitionLeaderboard", ctx, arg)
+	ret0, _ := ret[0].([]db.GetCompetitionLeaderboardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetitionLeaderboard indicates an expected call of GetCompetitionLeaderboard.
+func (mr *MockQueriesMockRecorder) GetCompetitionLeaderboard(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetitionLeaderboard", reflect.TypeOf((*MockQueries)(nil).GetCompetitionLeaderboard), ctx, arg)
+}
+
 // GetCompetitions mocks base method.
 func (m *MockQueries) GetCompetitions(ctx context.Context, arg db.GetCompetitionsParams) ([]db.Competition, error) {
 	m.ctrl.T.Helper()
@@ -569,7 +1012,22 @@ func (mr *MockQueriesMockRecorder) GetCompetitions(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetitions", reflect.TypeOf((*MockQueries)(nil).GetCompetitions), ctx, arg)
 }
 
-// GetGame mocks base method.
+// GetCurrentSeason mocks base method.
+func (m *MockQueries) GetCurrentSeason(ctx context.Context, arg db.GetCurrentSeasonParams) (db.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentSeason", ctx, arg)
+	ret0, _ := ret[0].(db.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentSeason indicates an expected call of GetCurrentSeason.
+func (mr *MockQueriesMockRecorder) GetCurrentSeason(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentSeason", reflect.TypeOf((*MockQueries)(nil).GetCurrentSeason), ctx, arg)
+}
+
+// GetGame mocks base method.
 func (m *MockQueries) GetGame(ctx context.Context, id uuid.UUID) (db.Game, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetGame", ctx, id)
@@ -584,6 +1042,126 @@ func (mr *MockQueriesMockRecorder) GetGame(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGame", reflect.TypeOf((*MockQueries)(nil).GetGame), ctx, id)
 }
 
+// GetGameDetails mocks base method.
+func (m *MockQueries) GetGameDetails(ctx context.Context, gameIds []uuid.UUID) ([]db.GetGameDetailsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameDetails", ctx, gameIds)
+	ret0, _ := ret[0].([]db.GetGameDetailsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameDetails indicates an expected call of GetGameDetails.
+func (mr *MockQueriesMockRecorder) GetGameDetails(ctx, gameIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameDetails", reflect.TypeOf((*MockQueries)(nil).GetGameDetails), ctx, gameIds)
+}
+
+// GetGameEvents mocks base method.
+func (m *MockQueries) GetGameEvents(ctx context.Context, gameID uuid.UUID) ([]db.GameEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameEvents", ctx, gameID)
+	ret0, _ := ret[0].([]db.GameEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameEvents indicates an expected call of GetGameEvents.
+func (mr *MockQueriesMockRecorder) GetGameEvents(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameEvents", reflect.TypeOf((*MockQueries)(nil).GetGameEvents), ctx, gameID)
+}
+
+// GetGameFeed mocks base method.
+func (m *MockQueries) GetGameFeed(ctx context.Context, arg db.GetGameFeedParams) ([]db.GetGameFeedRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameFeed", ctx, arg)
+	ret0, _ := ret[0].([]db.GetGameFeedRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameFeed indicates an expected call of GetGameFeed.
+func (mr *MockQueriesMockRecorder) GetGameFeed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameFeed", reflect.TypeOf((*MockQueries)(nil).GetGameFeed), ctx, arg)
+}
+
+// GetGameLineupPlayers mocks base method.
+func (m *MockQueries) GetGameLineupPlayers(ctx context.Context, gameID uuid.UUID) ([]db.GetGameLineupPlayersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameLineupPlayers", ctx, gameID)
+	ret0, _ := ret[0].([]db.GetGameLineupPlayersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameLineupPlayers indicates an expected call of GetGameLineupPlayers.
+func (mr *MockQueriesMockRecorder) GetGameLineupPlayers(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameLineupPlayers", reflect.TypeOf((*MockQueries)(nil).GetGameLineupPlayers), ctx, gameID)
+}
+
+// GetGameOfficials mocks base method.
+func (m *MockQueries) GetGameOfficials(ctx context.Context, gameID uuid.UUID) ([]db.GameOfficial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameOfficials", ctx, gameID)
+	ret0, _ := ret[0].([]db.GameOfficial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameOfficials indicates an expected call of GetGameOfficials.
+func (mr *MockQueriesMockRecorder) GetGameOfficials(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameOfficials", reflect.TypeOf((*MockQueries)(nil).GetGameOfficials), ctx, gameID)
+}
+
+// GetGamePlayerStats mocks base method.
+func (m *MockQueries) GetGamePlayerStats(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamePlayerStats", ctx, gameID)
+	ret0, _ := ret[0].([]db.PlayerGameStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamePlayerStats indicates an expected call of GetGamePlayerStats.
+func (mr *MockQueriesMockRecorder) GetGamePlayerStats(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamePlayerStats", reflect.TypeOf((*MockQueries)(nil).GetGamePlayerStats), ctx, gameID)
+}
+
+// GetGameReplacements mocks base method.
+func (m *MockQueries) GetGameReplacements(ctx context.Context, gameID uuid.UUID) ([]db.GameReplacement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameReplacements", ctx, gameID)
+	ret0, _ := ret[0].([]db.GameReplacement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameReplacements indicates an expected call of GetGameReplacements.
+func (mr *MockQueriesMockRecorder) GetGameReplacements(ctx, gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameReplacements", reflect.TypeOf((*MockQueries)(nil).GetGameReplacements), ctx, gameID)
+}
+
+// GetGamesBySeasonID mocks base method.
+func (m *MockQueries) GetGamesBySeasonID(ctx context.Context, arg db.GetGamesBySeasonIDParams) ([]db.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesBySeasonID", ctx, arg)
+	ret0, _ := ret[0].([]db.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesBySeasonID indicates an expected call of GetGamesBySeasonID.
+func (mr *MockQueriesMockRecorder) GetGamesBySeasonID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesBySeasonID", reflect.TypeOf((*MockQueries)(nil).GetGamesBySeasonID), ctx, arg)
+}
+
 // GetGamesByStageID mocks base method.
 func (m *MockQueries) GetGamesByStageID(ctx context.Context, arg db.GetGamesByStageIDParams) ([]db.Game, error) {
 	m.ctrl.T.Helper()
@@ -599,6 +1177,126 @@ func (mr *MockQueriesMockRecorder) GetGamesByStageID(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesByStageID", reflect.TypeOf((*MockQueries)(nil).GetGamesByStageID), ctx, arg)
 }
 
+// GetGamesByTeamID mocks base method.
+func (m *MockQueries) GetGamesByTeamID(ctx context.Context, arg db.GetGamesByTeamIDParams) ([]db.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGamesByTeamID", ctx, arg)
+	ret0, _ := ret[0].([]db.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGamesByTeamID indicates an expected call of GetGamesByTeamID.
+func (mr *MockQueriesMockRecorder) GetGamesByTeamID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesByTeamID", reflect.TypeOf((*MockQueries)(nil).GetGamesByTeamID), ctx, arg)
+}
+
+// GetHeadToHeadGames mocks base method.
+func (m *MockQueries) GetHeadToHeadGames(ctx context.Context, arg db.GetHeadToHeadGamesParams) ([]db.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeadToHeadGames", ctx, arg)
+	ret0, _ := ret[0].([]db.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeadToHeadGames indicates an expected call of GetHeadToHeadGames.
+func (mr *MockQueriesMockRecorder) GetHeadToHeadGames(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeadToHeadGames", reflect.TypeOf((*MockQueries)(nil).GetHeadToHeadGames), ctx, arg)
+}
+
+// GetOfficial mocks base method.
+func (m *MockQueries) GetOfficial(ctx context.Context, id uuid.UUID) (db.Official, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfficial", ctx, id)
+	ret0, _ := ret[0].(db.Official)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfficial indicates an expected call of GetOfficial.
+func (mr *MockQueriesMockRecorder) GetOfficial(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfficial", reflect.TypeOf((*MockQueries)(nil).GetOfficial), ctx, id)
+}
+
+// GetOfficialAppointments mocks base method.
+func (m *MockQueries) GetOfficialAppointments(ctx context.Context, officialID uuid.UUID) ([]db.GetOfficialAppointmentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfficialAppointments", ctx, officialID)
+	ret0, _ := ret[0].([]db.GetOfficialAppointmentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfficialAppointments indicates an expected call of GetOfficialAppointments.
+func (mr *MockQueriesMockRecorder) GetOfficialAppointments(ctx, officialID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfficialAppointments", reflect.TypeOf((*MockQueries)(nil).GetOfficialAppointments), ctx, officialID)
+}
+
+// GetOfficials mocks base method.
+func (m *MockQueries) GetOfficials(ctx context.Context, arg db.GetOfficialsParams) ([]db.Official, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfficials", ctx, arg)
+	ret0, _ := ret[0].([]db.Official)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfficials indicates an expected call of GetOfficials.
+func (mr *MockQueriesMockRecorder) GetOfficials(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfficials", reflect.TypeOf((*MockQueries)(nil).GetOfficials), ctx, arg)
+}
+
+// GetPlayer mocks base method.
+func (m *MockQueries) GetPlayer(ctx context.Context, id uuid.UUID) (db.Player, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayer", ctx, id)
+	ret0, _ := ret[0].(db.Player)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayer indicates an expected call of GetPlayer.
+func (mr *MockQueriesMockRecorder) GetPlayer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayer", reflect.TypeOf((*MockQueries)(nil).GetPlayer), ctx, id)
+}
+
+// GetPlayerSeasonStats mocks base method.
+func (m *MockQueries) GetPlayerSeasonStats(ctx context.Context, playerID uuid.UUID) ([]db.GetPlayerSeasonStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerSeasonStats", ctx, playerID)
+	ret0, _ := ret[0].([]db.GetPlayerSeasonStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerSeasonStats indicates an expected call of GetPlayerSeasonStats.
+func (mr *MockQueriesMockRecorder) GetPlayerSeasonStats(ctx, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerSeasonStats", reflect.TypeOf((*MockQueries)(nil).GetPlayerSeasonStats), ctx, playerID)
+}
+
+// GetPlayersByTeamID mocks base method.
+func (m *MockQueries) GetPlayersByTeamID(ctx context.Context, arg db.GetPlayersByTeamIDParams) ([]db.Player, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayersByTeamID", ctx, arg)
+	ret0, _ := ret[0].([]db.Player)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayersByTeamID indicates an expected call of GetPlayersByTeamID.
+func (mr *MockQueriesMockRecorder) GetPlayersByTeamID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayersByTeamID", reflect.TypeOf((*MockQueries)(nil).GetPlayersByTeamID), ctx, arg)
+}
+
 // GetSeason mocks base method.
 func (m *MockQueries) GetSeason(ctx context.Context, id uuid.UUID) (db.Season, error) {
 	m.ctrl.T.Helper()
@@ -614,6 +1312,51 @@ func (mr *MockQueriesMockRecorder) GetSeason(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeason", reflect.TypeOf((*MockQueries)(nil).GetSeason), ctx, id)
 }
 
+// GetSeasonFinals mocks base method.
+func (m *MockQueries) GetSeasonFinals(ctx context.Context, seasonID uuid.UUID) (db.SeasonFinal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeasonFinals", ctx, seasonID)
+	ret0, _ := ret[0].(db.SeasonFinal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeasonFinals indicates an expected call of GetSeasonFinals.
+func (mr *MockQueriesMockRecorder) GetSeasonFinals(ctx, seasonID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeasonFinals", reflect.TypeOf((*MockQueries)(nil).GetSeasonFinals), ctx, seasonID)
+}
+
+// GetSeasonLeaderboard mocks base method.
+func (m *MockQueries) GetSeasonLeaderboard(ctx context.Context, arg db.GetSeasonLeaderboardParams) ([]db.GetSeasonLeaderboardRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeasonLeaderboard", ctx, arg)
+	ret0, _ := ret[0].([]db.GetSeasonLeaderboardRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeasonLeaderboard indicates an expected call of GetSeasonLeaderboard.
+func (mr *MockQueriesMockRecorder) GetSeasonLeaderboard(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeasonLeaderboard", reflect.TypeOf((*MockQueries)(nil).GetSeasonLeaderboard), ctx, arg)
+}
+
+// GetSeasonTeamPlayers mocks base method.
+func (m *MockQueries) GetSeasonTeamPlayers(ctx context.Context, seasonTeamID uuid.UUID) ([]db.SeasonTeamPlayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeasonTeamPlayers", ctx, seasonTeamID)
+	ret0, _ := ret[0].([]db.SeasonTeamPlayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeasonTeamPlayers indicates an expected call of GetSeasonTeamPlayers.
+func (mr *MockQueriesMockRecorder) GetSeasonTeamPlayers(ctx, seasonTeamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeasonTeamPlayers", reflect.TypeOf((*MockQueries)(nil).GetSeasonTeamPlayers), ctx, seasonTeamID)
+}
+
 // GetSeasonTeams mocks base method.
 func (m *MockQueries) GetSeasonTeams(ctx context.Context, seasonID uuid.UUID) ([]db.GetSeasonTeamsRow, error) {
 	m.ctrl.T.Helper()
@@ -674,6 +1417,66 @@ func (mr *MockQueriesMockRecorder) GetTeam(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockQueries)(nil).GetTeam), ctx, id)
 }
 
+// GetTeamAlias mocks base method.
+func (m *MockQueries) GetTeamAlias(ctx context.Context, id uuid.UUID) (db.TeamAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamAlias", ctx, id)
+	ret0, _ := ret[0].(db.TeamAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamAlias indicates an expected call of GetTeamAlias.
+func (mr *MockQueriesMockRecorder) GetTeamAlias(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamAlias", reflect.TypeOf((*MockQueries)(nil).GetTeamAlias), ctx, id)
+}
+
+// GetTeamAliasesAt mocks base method.
+func (m *MockQueries) GetTeamAliasesAt(ctx context.Context, arg db.GetTeamAliasesAtParams) ([]db.TeamAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamAliasesAt", ctx, arg)
+	ret0, _ := ret[0].([]db.TeamAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamAliasesAt indicates an expected call of GetTeamAliasesAt.
+func (mr *MockQueriesMockRecorder) GetTeamAliasesAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamAliasesAt", reflect.TypeOf((*MockQueries)(nil).GetTeamAliasesAt), ctx, arg)
+}
+
+// GetTeamAliasesByTeamID mocks base method.
+func (m *MockQueries) GetTeamAliasesByTeamID(ctx context.Context, teamID uuid.UUID) ([]db.TeamAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamAliasesByTeamID", ctx, teamID)
+	ret0, _ := ret[0].([]db.TeamAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamAliasesByTeamID indicates an expected call of GetTeamAliasesByTeamID.
+func (mr *MockQueriesMockRecorder) GetTeamAliasesByTeamID(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamAliasesByTeamID", reflect.TypeOf((*MockQueries)(nil).GetTeamAliasesByTeamID), ctx, teamID)
+}
+
+// GetTeamResults mocks base method.
+func (m *MockQueries) GetTeamResults(ctx context.Context, arg db.GetTeamResultsParams) ([]db.Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamResults", ctx, arg)
+	ret0, _ := ret[0].([]db.Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamResults indicates an expected call of GetTeamResults.
+func (mr *MockQueriesMockRecorder) GetTeamResults(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamResults", reflect.TypeOf((*MockQueries)(nil).GetTeamResults), ctx, arg)
+}
+
 // GetTeams mocks base method.
 func (m *MockQueries) GetTeams(ctx context.Context, arg db.GetTeamsParams) ([]db.Team, error) {
 	m.ctrl.T.Helper()
@@ -689,6 +1492,141 @@ func (mr *MockQueriesMockRecorder) GetTeams(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeams", reflect.TypeOf((*MockQueries)(nil).GetTeams), ctx, arg)
 }
 
+// GetVenue mocks base method.
+func (m *MockQueries) GetVenue(ctx context.Context, id uuid.UUID) (db.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVenue", ctx, id)
+	ret0, _ := ret[0].(db.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVenue indicates an expected call of GetVenue.
+func (mr *MockQueriesMockRecorder) GetVenue(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVenue", reflect.TypeOf((*MockQueries)(nil).GetVenue), ctx, id)
+}
+
+// GetVenues mocks base method.
+func (m *MockQueries) GetVenues(ctx context.Context, arg db.GetVenuesParams) ([]db.Venue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVenues", ctx, arg)
+	ret0, _ := ret[0].([]db.Venue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVenues indicates an expected call of GetVenues.
+func (mr *MockQueriesMockRecorder) GetVenues(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVenues", reflect.TypeOf((*MockQueries)(nil).GetVenues), ctx, arg)
+}
+
+// LockCompetition mocks base method.
+func (m *MockQueries) LockCompetition(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCompetition", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCompetition indicates an expected call of LockCompetition.
+func (mr *MockQueriesMockRecorder) LockCompetition(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCompetition", reflect.TypeOf((*MockQueries)(nil).LockCompetition), ctx, id)
+}
+
+// LockGame mocks base method.
+func (m *MockQueries) LockGame(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockGame", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockGame indicates an expected call of LockGame.
+func (mr *MockQueriesMockRecorder) LockGame(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockGame", reflect.TypeOf((*MockQueries)(nil).LockGame), ctx, id)
+}
+
+// LockOfficial mocks base method.
+func (m *MockQueries) LockOfficial(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOfficial", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOfficial indicates an expected call of LockOfficial.
+func (mr *MockQueriesMockRecorder) LockOfficial(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOfficial", reflect.TypeOf((*MockQueries)(nil).LockOfficial), ctx, id)
+}
+
+// LockPlayer mocks base method.
+func (m *MockQueries) LockPlayer(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPlayer", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPlayer indicates an expected call of LockPlayer.
+func (mr *MockQueriesMockRecorder) LockPlayer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPlayer", reflect.TypeOf((*MockQueries)(nil).LockPlayer), ctx, id)
+}
+
+// LockSeason mocks base method.
+func (m *MockQueries) LockSeason(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockSeason", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockSeason indicates an expected call of LockSeason.
+func (mr *MockQueriesMockRecorder) LockSeason(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockSeason", reflect.TypeOf((*MockQueries)(nil).LockSeason), ctx, id)
+}
+
+// LockTeam mocks base method.
+func (m *MockQueries) LockTeam(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTeam", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockTeam indicates an expected call of LockTeam.
+func (mr *MockQueriesMockRecorder) LockTeam(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTeam", reflect.TypeOf((*MockQueries)(nil).LockTeam), ctx, id)
+}
+
+// LockVenue mocks base method.
+func (m *MockQueries) LockVenue(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockVenue", ctx, id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockVenue indicates an expected call of LockVenue.
+func (mr *MockQueriesMockRecorder) LockVenue(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockVenue", reflect.TypeOf((*MockQueries)(nil).LockVenue), ctx, id)
+}
+
 // UpdateCompetition mocks base method.
 func (m *MockQueries) UpdateCompetition(ctx context.Context, arg db.UpdateCompetitionParams) error {
 	m.ctrl.T.Helper()
@@ -717,6 +1655,48 @@ func (mr *MockQueriesMockRecorder) UpdateGame(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGame", reflect.TypeOf((*MockQueries)(nil).UpdateGame), ctx, arg)
 }
 
+// UpdateGameOfficialRole mocks base method.
+func (m *MockQueries) UpdateGameOfficialRole(ctx context.Context, arg db.UpdateGameOfficialRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameOfficialRole", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameOfficialRole indicates an expected call of UpdateGameOfficialRole.
+func (mr *MockQueriesMockRecorder) UpdateGameOfficialRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameOfficialRole", reflect.TypeOf((*MockQueries)(nil).UpdateGameOfficialRole), ctx, arg)
+}
+
+// UpdateOfficial mocks base method.
+func (m *MockQueries) UpdateOfficial(ctx context.Context, arg db.UpdateOfficialParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOfficial", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOfficial indicates an expected call of UpdateOfficial.
+func (mr *MockQueriesMockRecorder) UpdateOfficial(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOfficial", reflect.TypeOf((*MockQueries)(nil).UpdateOfficial), ctx, arg)
+}
+
+// UpdatePlayer mocks base method.
+func (m *MockQueries) UpdatePlayer(ctx context.Context, arg db.UpdatePlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlayer indicates an expected call of UpdatePlayer.
+func (mr *MockQueriesMockRecorder) UpdatePlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlayer", reflect.TypeOf((*MockQueries)(nil).UpdatePlayer), ctx, arg)
+}
+
 // UpdateSeason mocks base method.
 func (m *MockQueries) UpdateSeason(ctx context.Context, arg db.UpdateSeasonParams) error {
 	m.ctrl.T.Helper()
@@ -731,6 +1711,34 @@ func (mr *MockQueriesMockRecorder) UpdateSeason(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeason", reflect.TypeOf((*MockQueries)(nil).UpdateSeason), ctx, arg)
 }
 
+// UpdateSeasonTeamHomeVenue mocks base method.
+func (m *MockQueries) UpdateSeasonTeamHomeVenue(ctx context.Context, arg db.UpdateSeasonTeamHomeVenueParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeasonTeamHomeVenue", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSeasonTeamHomeVenue indicates an expected call of UpdateSeasonTeamHomeVenue.
+func (mr *MockQueriesMockRecorder) UpdateSeasonTeamHomeVenue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeasonTeamHomeVenue", reflect.TypeOf((*MockQueries)(nil).UpdateSeasonTeamHomeVenue), ctx, arg)
+}
+
+// UpdateSeasonTeamPlayer mocks base method.
+func (m *MockQueries) UpdateSeasonTeamPlayer(ctx context.Context, arg db.UpdateSeasonTeamPlayerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeasonTeamPlayer", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSeasonTeamPlayer indicates an expected call of UpdateSeasonTeamPlayer.
+func (mr *MockQueriesMockRecorder) UpdateSeasonTeamPlayer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeasonTeamPlayer", reflect.TypeOf((*MockQueries)(nil).UpdateSeasonTeamPlayer), ctx, arg)
+}
+
 // UpdateStage mocks base method.
 func (m *MockQueries) UpdateStage(ctx context.Context, arg db.UpdateStageParams) error {
 	m.ctrl.T.Helper()
@@ -758,3 +1766,45 @@ func (mr *MockQueriesMockRecorder) UpdateTeam(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockQueries)(nil).UpdateTeam), ctx, arg)
 }
+
+// UpdateTeamAlias mocks base method.
+func (m *MockQueries) UpdateTeamAlias(ctx context.Context, arg db.UpdateTeamAliasParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamAlias", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamAlias indicates an expected call of UpdateTeamAlias.
+func (mr *MockQueriesMockRecorder) UpdateTeamAlias(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamAlias", reflect.TypeOf((*MockQueries)(nil).UpdateTeamAlias), ctx, arg)
+}
+
+// UpdateVenue mocks base method.
+func (m *MockQueries) UpdateVenue(ctx context.Context, arg db.UpdateVenueParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVenue", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVenue indicates an expected call of UpdateVenue.
+func (mr *MockQueriesMockRecorder) UpdateVenue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVenue", reflect.TypeOf((*MockQueries)(nil).UpdateVenue), ctx, arg)
+}
+
+// UpsertSeasonFinals mocks base method.
+func (m *MockQueries) UpsertSeasonFinals(ctx context.Context, arg db.UpsertSeasonFinalsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSeasonFinals", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSeasonFinals indicates an expected call of UpsertSeasonFinals.
+func (mr *MockQueriesMockRecorder) UpsertSeasonFinals(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSeasonFinals", reflect.TypeOf((*MockQueries)(nil).UpsertSeasonFinals), ctx, arg)
+}
@@ -3,10 +3,21 @@ package db_handler
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	tracer   = otel.Tracer("github.com/bradley-adams/gainline/db/db_handler")
+	dbSystem = semconv.DBSystemNamePostgreSQL
 )
 
 type DB interface {
@@ -25,15 +36,18 @@ type Queries interface {
 	//Competition
 	CreateCompetition(ctx context.Context, arg db.CreateCompetitionParams) error
 	GetCompetition(ctx context.Context, id uuid.UUID) (db.Competition, error)
+	LockCompetition(ctx context.Context, id uuid.UUID) (time.Time, error)
 	GetCompetitions(ctx context.Context, arg db.GetCompetitionsParams) ([]db.Competition, error)
-	CountCompetitions(ctx context.Context) (int64, error)
+	CountCompetitions(ctx context.Context, arg db.CountCompetitionsParams) (int64, error)
 	UpdateCompetition(ctx context.Context, arg db.UpdateCompetitionParams) error
 	DeleteCompetition(ctx context.Context, arg db.DeleteCompetitionParams) error
 
 	//Season
 	CreateSeason(ctx context.Context, arg db.CreateSeasonParams) error
 	GetSeason(ctx context.Context, id uuid.UUID) (db.Season, error)
+	LockSeason(ctx context.Context, id uuid.UUID) (time.Time, error)
 	GetSeasons(ctx context.Context, arg db.GetSeasonsParams) ([]db.Season, error)
+	GetCurrentSeason(ctx context.Context, arg db.GetCurrentSeasonParams) (db.Season, error)
 	CountSeasons(ctx context.Context, competitionID uuid.UUID) (int64, error)
 	UpdateSeason(ctx context.Context, arg db.UpdateSeasonParams) error
 	DeleteSeason(ctx context.Context, arg db.DeleteSeasonParams) error
@@ -50,10 +64,29 @@ type Queries interface {
 	//Team
 	CreateTeam(ctx context.Context, arg db.CreateTeamParams) error
 	GetTeam(ctx context.Context, id uuid.UUID) (db.Team, error)
+	LockTeam(ctx context.Context, id uuid.UUID) (time.Time, error)
 	GetTeams(ctx context.Context, arg db.GetTeamsParams) ([]db.Team, error)
-	CountTeams(ctx context.Context) (int64, error)
+	CountTeams(ctx context.Context, namePattern sql.NullString) (int64, error)
 	UpdateTeam(ctx context.Context, arg db.UpdateTeamParams) error
 	DeleteTeam(ctx context.Context, arg db.DeleteTeamParams) error
+	ClearTeamHomeVenues(ctx context.Context, arg db.ClearTeamHomeVenuesParams) error
+
+	//Team alias
+	CreateTeamAlias(ctx context.Context, arg db.CreateTeamAliasParams) error
+	GetTeamAlias(ctx context.Context, id uuid.UUID) (db.TeamAlias, error)
+	GetTeamAliasesByTeamID(ctx context.Context, teamID uuid.UUID) ([]db.TeamAlias, error)
+	GetTeamAliasesAt(ctx context.Context, arg db.GetTeamAliasesAtParams) ([]db.TeamAlias, error)
+	UpdateTeamAlias(ctx context.Context, arg db.UpdateTeamAliasParams) error
+	DeleteTeamAlias(ctx context.Context, arg db.DeleteTeamAliasParams) error
+
+	//Player
+	CreatePlayer(ctx context.Context, arg db.CreatePlayerParams) error
+	GetPlayer(ctx context.Context, id uuid.UUID) (db.Player, error)
+	LockPlayer(ctx context.Context, id uuid.UUID) (time.Time, error)
+	GetPlayersByTeamID(ctx context.Context, arg db.GetPlayersByTeamIDParams) ([]db.Player, error)
+	CountPlayersByTeamID(ctx context.Context, teamID uuid.UUID) (int64, error)
+	UpdatePlayer(ctx context.Context, arg db.UpdatePlayerParams) error
+	DeletePlayer(ctx context.Context, arg db.DeletePlayerParams) error
 
 	//SeasonTeams
 	CreateSeasonTeams(ctx context.Context, arg db.CreateSeasonTeamsParams) error
@@ -61,16 +94,86 @@ type Queries interface {
 	DeleteSeasonTeam(ctx context.Context, arg db.DeleteSeasonTeamParams) error
 	DeleteSeasonTeamsBySeasonID(ctx context.Context, arg db.DeleteSeasonTeamsBySeasonIDParams) error
 	DeleteSeasonTeamsByCompetitionID(ctx context.Context, arg db.DeleteSeasonTeamsByCompetitionIDParams) error
+	UpdateSeasonTeamHomeVenue(ctx context.Context, arg db.UpdateSeasonTeamHomeVenueParams) error
+	ClearSeasonTeamHomeVenues(ctx context.Context, arg db.ClearSeasonTeamHomeVenuesParams) error
+
+	//SeasonTeamPlayers
+	CreateSeasonTeamPlayer(ctx context.Context, arg db.CreateSeasonTeamPlayerParams) error
+	GetSeasonTeamPlayers(ctx context.Context, seasonTeamID uuid.UUID) ([]db.SeasonTeamPlayer, error)
+	UpdateSeasonTeamPlayer(ctx context.Context, arg db.UpdateSeasonTeamPlayerParams) error
+	DeleteSeasonTeamPlayer(ctx context.Context, arg db.DeleteSeasonTeamPlayerParams) error
+	DeleteSeasonTeamPlayersByPlayerID(ctx context.Context, arg db.DeleteSeasonTeamPlayersByPlayerIDParams) error
+
+	//Season finals
+	UpsertSeasonFinals(ctx context.Context, arg db.UpsertSeasonFinalsParams) error
+	GetSeasonFinals(ctx context.Context, seasonID uuid.UUID) (db.SeasonFinal, error)
+	DeleteSeasonFinals(ctx context.Context, seasonID uuid.UUID) error
 
 	//Game
 	CreateGame(ctx context.Context, arg db.CreateGameParams) error
 	GetGame(ctx context.Context, id uuid.UUID) (db.Game, error)
+	LockGame(ctx context.Context, id uuid.UUID) (time.Time, error)
+	GetGameDetails(ctx context.Context, gameIds []uuid.UUID) ([]db.GetGameDetailsRow, error)
 	GetGamesByStageID(ctx context.Context, arg db.GetGamesByStageIDParams) ([]db.Game, error)
-	CountGames(ctx context.Context, seasonID uuid.UUID) (int64, error)
+	GetGamesBySeasonID(ctx context.Context, arg db.GetGamesBySeasonIDParams) ([]db.Game, error)
+	GetAllGamesBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]db.Game, error)
+	CountGames(ctx context.Context, arg db.CountGamesParams) (int64, error)
+	GetGameFeed(ctx context.Context, arg db.GetGameFeedParams) ([]db.GetGameFeedRow, error)
+	CountGameFeed(ctx context.Context, arg db.CountGameFeedParams) (int64, error)
+	GetGamesByTeamID(ctx context.Context, arg db.GetGamesByTeamIDParams) ([]db.Game, error)
+	CountGamesByTeamID(ctx context.Context, arg db.CountGamesByTeamIDParams) (int64, error)
+	GetTeamResults(ctx context.Context, arg db.GetTeamResultsParams) ([]db.Game, error)
+	GetHeadToHeadGames(ctx context.Context, arg db.GetHeadToHeadGamesParams) ([]db.Game, error)
 	UpdateGame(ctx context.Context, arg db.UpdateGameParams) error
 	DeleteGame(ctx context.Context, arg db.DeleteGameParams) error
 	DeleteGamesByCompetitionID(ctx context.Context, arg db.DeleteGamesByCompetitionIDParams) error
 	DeleteGamesBySeasonID(ctx context.Context, arg db.DeleteGamesBySeasonIDParams) error
+	CountGamesByVenueID(ctx context.Context, venueID uuid.NullUUID) (int64, error)
+
+	//Game lineups
+	CreateGameLineupPlayer(ctx context.Context, arg db.CreateGameLineupPlayerParams) error
+	GetGameLineupPlayers(ctx context.Context, gameID uuid.UUID) ([]db.GetGameLineupPlayersRow, error)
+	DeleteGameLineupPlayers(ctx context.Context, arg db.DeleteGameLineupPlayersParams) error
+	CreateGameReplacement(ctx context.Context, arg db.CreateGameReplacementParams) error
+	GetGameReplacements(ctx context.Context, gameID uuid.UUID) ([]db.GameReplacement, error)
+	DeleteGameReplacement(ctx context.Context, arg db.DeleteGameReplacementParams) error
+
+	//Game events
+	CreateGameEvent(ctx context.Context, arg db.CreateGameEventParams) error
+	GetGameEvents(ctx context.Context, gameID uuid.UUID) ([]db.GameEvent, error)
+	DeleteGameEvent(ctx context.Context, arg db.DeleteGameEventParams) error
+
+	//Player stats
+	GetGamePlayerStats(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error)
+	GetPlayerSeasonStats(ctx context.Context, playerID uuid.UUID) ([]db.GetPlayerSeasonStatsRow, error)
+	GetSeasonLeaderboard(ctx context.Context, arg db.GetSeasonLeaderboardParams) ([]db.GetSeasonLeaderboardRow, error)
+	GetCompetitionLeaderboard(ctx context.Context, arg db.GetCompetitionLeaderboardParams) ([]db.GetCompetitionLeaderboardRow, error)
+
+	//Officials
+	CreateOfficial(ctx context.Context, arg db.CreateOfficialParams) error
+	GetOfficial(ctx context.Context, id uuid.UUID) (db.Official, error)
+	LockOfficial(ctx context.Context, id uuid.UUID) (time.Time, error)
+	GetOfficials(ctx context.Context, arg db.GetOfficialsParams) ([]db.Official, error)
+	CountOfficials(ctx context.Context) (int64, error)
+	UpdateOfficial(ctx context.Context, arg db.UpdateOfficialParams) error
+	DeleteOfficial(ctx context.Context, arg db.DeleteOfficialParams) error
+
+	//Game officials
+	CreateGameOfficial(ctx context.Context, arg db.CreateGameOfficialParams) error
+	GetGameOfficials(ctx context.Context, gameID uuid.UUID) ([]db.GameOfficial, error)
+	UpdateGameOfficialRole(ctx context.Context, arg db.UpdateGameOfficialRoleParams) error
+	DeleteGameOfficial(ctx context.Context, arg db.DeleteGameOfficialParams) error
+	DeleteGameOfficialsByOfficialID(ctx context.Context, arg db.DeleteGameOfficialsByOfficialIDParams) error
+	GetOfficialAppointments(ctx context.Context, officialID uuid.UUID) ([]db.GetOfficialAppointmentsRow, error)
+
+	//Venue
+	CreateVenue(ctx context.Context, arg db.CreateVenueParams) error
+	GetVenue(ctx context.Context, id uuid.UUID) (db.Venue, error)
+	LockVenue(ctx context.Context, id uuid.UUID) (time.Time, error)
+	GetVenues(ctx context.Context, arg db.GetVenuesParams) ([]db.Venue, error)
+	CountVenues(ctx context.Context) (int64, error)
+	UpdateVenue(ctx context.Context, arg db.UpdateVenueParams) error
+	DeleteVenue(ctx context.Context, arg db.DeleteVenueParams) error
 }
 
 type DBWrapper struct {
@@ -96,16 +199,19 @@ func (d DBWrapper) Rollback(tx *sql.Tx) error {
 func RunInTransaction(
 	ctx context.Context,
 	db DB,
-	f func(q Queries) error,
-) error {
+	f func(ctx context.Context, q Queries) error,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "db.RunInTransaction", trace.WithAttributes(dbSystem))
+	defer func() { endSpan(span, err) }()
+
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	err = f(db.New(tx))
+	err = f(ctx, db.New(tx))
 	if err != nil {
-		return handleTxError(err, db, tx)
+		return handleTxError(ctx, err, db, tx)
 	}
 
 	err = db.Commit(tx)
@@ -119,15 +225,28 @@ func RunInTransaction(
 func Run(
 	ctx context.Context,
 	db DB,
-	f func(q Queries) error,
-) error {
-	return f(db.New(db))
+	f func(ctx context.Context, q Queries) error,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "db.Run", trace.WithAttributes(dbSystem))
+	defer func() { endSpan(span, err) }()
+
+	return f(ctx, db.New(db))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
 }
 
-func handleTxError(err error, db DB, tx *sql.Tx) error {
+func handleTxError(ctx context.Context, err error, db DB, tx *sql.Tx) error {
 	rollbackErr := db.Rollback(tx)
 	if rollbackErr != nil {
+		zerolog.Ctx(ctx).Error().Err(rollbackErr).Msg("unable to roll back transaction")
 		return errors.Wrap(err, rollbackErr.Error())
 	}
+	zerolog.Ctx(ctx).Debug().Err(err).Msg("transaction rolled back")
 	return err
 }
@@ -39,6 +39,47 @@ const docTemplate = `{
                         "description": "Items per page",
                         "name": "page_size",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "rugby_union",
+                            "rugby_sevens",
+                            "rugby_league"
+                        ],
+                        "type": "string",
+                        "description": "Only competitions of this code",
+                        "name": "sport",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "men",
+                            "women",
+                            "mixed"
+                        ],
+                        "type": "string",
+                        "description": "Only competitions for this gender",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "international",
+                            "professional",
+                            "provincial",
+                            "club",
+                            "school"
+                        ],
+                        "type": "string",
+                        "description": "Only competitions at this level",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only competitions in this ISO 3166 country",
+                        "name": "country",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -48,10 +89,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.PaginatedResponse-api_CompetitionResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -89,19 +136,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "404": {
                         "description": "Not found",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -132,18 +185,30 @@ const docTemplate = `{
                         "description": "Competition found",
                         "schema": {
                             "$ref": "#/definitions/api.CompetitionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the competition, for If-Match"
+                            }
                         }
                     },
                     "400": {
                         "description": "Invalid competition ID",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -177,6 +242,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.CompetitionRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the competition being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -184,18 +255,42 @@ const docTemplate = `{
                         "description": "Competition updated",
                         "schema": {
                             "$ref": "#/definitions/api.CompetitionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated competition"
+                            }
                         }
                     },
                     "400": {
                         "description": "Invalid request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Competition changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -229,13 +324,176 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid competition ID",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch. The write fails with 412 if the competition changes after it is read, or if If-Match names an older version.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competitions"
+                ],
+                "summary": "Partially update a competition",
+                "operationId": "patch-competition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "UUID of the competition",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "competition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CompetitionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the competition being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Competition updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.CompetitionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated competition"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Competition changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/leaderboard": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get a competition's all-time leaderboard",
+                "operationId": "get-competition-leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "tries",
+                            "points"
+                        ],
+                        "type": "string",
+                        "default": "points",
+                        "description": "Stat to rank by",
+                        "name": "stat",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of players to list",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leaderboard",
+                        "schema": {
+                            "$ref": "#/definitions/api.LeaderboardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query params",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -286,13 +544,19 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid competition ID",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -338,13 +602,68 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/current": {
+            "get": {
+                "description": "Returns the season in progress. When none is, returns the next season to start, or failing that the last season to finish. The resolution says which.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Get the current season for a competition",
+                "operationId": "get-current-season",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Season found",
+                        "schema": {
+                            "$ref": "#/definitions/api.CurrentSeasonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid competition ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Competition not found or has no seasons",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -383,18 +702,36 @@ const docTemplate = `{
                         "description": "Season found",
                         "schema": {
                             "$ref": "#/definitions/api.SeasonResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the season, for If-Match"
+                            }
                         }
                     },
                     "400": {
                         "description": "Invalid season ID",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -436,6 +773,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.SeasonRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the season being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -443,18 +786,42 @@ const docTemplate = `{
                         "description": "Season updated",
                         "schema": {
                             "$ref": "#/definitions/api.SeasonResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated season"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Season changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -493,31 +860,42 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid season ID",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/competitions/{competitionID}/seasons/{seasonID}/games": {
-            "post": {
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch. Arrays such as stages and teams are replaced whole, so send every stage (with its id) or team to keep. The write fails with 412 if the season changes after it is read, or if If-Match names an older version.",
                 "consumes": [
-                    "application/json"
+                    "application/merge-patch+json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Games"
+                    "Seasons"
                 ],
-                "summary": "Create a new game",
-                "operationId": "create-game",
+                "summary": "Partially update a season",
+                "operationId": "patch-season",
                 "parameters": [
                     {
                         "type": "string",
@@ -536,47 +914,83 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Game details to create",
-                        "name": "game",
+                        "description": "Fields to change",
+                        "name": "season",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.GameRequest"
+                            "$ref": "#/definitions/api.SeasonRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the season being patched",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successful operation",
+                    "200": {
+                        "description": "Season updated",
                         "schema": {
-                            "$ref": "#/definitions/api.GameResponse"
+                            "$ref": "#/definitions/api.SeasonResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated season"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Season changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
             }
         },
-        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}": {
+        "/competitions/{competitionID}/seasons/{seasonID}/finals": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Games"
+                    "Finals"
                 ],
-                "summary": "Get a single game by ID",
-                "operationId": "get-game",
+                "summary": "Get a season's finals bracket",
+                "operationId": "get-finals",
                 "parameters": [
                     {
                         "type": "string",
@@ -593,33 +1007,31 @@ const docTemplate = `{
                         "name": "seasonID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
-                        "description": "Game ID",
-                        "name": "gameID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Game found",
+                        "description": "Finals bracket",
                         "schema": {
-                            "$ref": "#/definitions/api.GameResponse"
+                            "$ref": "#/definitions/api.FinalsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid ID",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -632,10 +1044,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Games"
+                    "Finals"
                 ],
-                "summary": "Update a game",
-                "operationId": "update-game",
+                "summary": "Set a season's finals format",
+                "operationId": "set-finals",
                 "parameters": [
                     {
                         "type": "string",
@@ -654,40 +1066,44 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
-                        "description": "Game ID",
-                        "name": "gameID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Game details to update",
-                        "name": "game",
+                        "description": "Finals format",
+                        "name": "finals",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.GameRequest"
+                            "$ref": "#/definitions/api.FinalsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Game updated",
+                        "description": "Finals bracket",
                         "schema": {
-                            "$ref": "#/definitions/api.GameResponse"
+                            "$ref": "#/definitions/api.FinalsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -697,14 +1113,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Games"
+                    "Finals"
                 ],
-                "summary": "Delete a game by ID",
-                "operationId": "delete-game",
+                "summary": "Remove a season's finals format",
+                "operationId": "delete-finals",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "a973dd2c-ecd3-4578-b5c3-9022a3f0ecbd",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
                         "description": "Competition ID",
                         "name": "competitionID",
                         "in": "path",
@@ -712,53 +1128,55 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "default": "fe04fe69-834f-42be-9821-04e53e8de26d",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
                         "description": "Season ID",
                         "name": "seasonID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "30f8181f-0a44-4ad7-a163-3ef2d29e504e",
-                        "description": "Game ID",
-                        "name": "gameID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No Content\"\t\"Game deleted successfully"
+                        "description": "No Content\"\t\"Finals format removed"
                     },
-                    "400": {
-                        "description": "Invalid game ID",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
             }
         },
-        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/live": {
-            "get": {
+        "/competitions/{competitionID}/seasons/{seasonID}/fixtures/generate": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "text/event-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "Games"
+                    "Fixtures"
                 ],
-                "summary": "Watch live game state",
-                "operationId": "watch-game",
+                "summary": "Generate a round-robin draw",
+                "operationId": "generate-fixtures",
                 "parameters": [
                     {
                         "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
                         "description": "Competition ID",
                         "name": "competitionID",
                         "in": "path",
@@ -766,48 +1184,84 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
                         "description": "Season ID",
                         "name": "seasonID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Game ID",
-                        "name": "gameID",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Preview the draw without saving it",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Draw options",
+                        "name": "fixtures",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.FixtureGenerateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Draw preview",
+                        "schema": {
+                            "$ref": "#/definitions/api.FixtureGenerateResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Draw saved",
+                        "schema": {
+                            "$ref": "#/definitions/api.FixtureGenerateResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid game ID",
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Stages already have games",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
-                        "description": "Unable to watch game",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
             }
         },
-        "/competitions/{competitionID}/seasons/{seasonID}/stages/{stageID}/games": {
+        "/competitions/{competitionID}/seasons/{seasonID}/fixtures/validate": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Games"
+                    "Fixtures"
                 ],
-                "summary": "Get games",
-                "operationId": "get-games",
+                "summary": "Check a season's fixtures",
+                "operationId": "validate-fixtures",
                 "parameters": [
                     {
                         "type": "string",
@@ -826,50 +1280,73 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "eab15533-dea6-4a3d-8a95-d38e4fba2d5a",
-                        "description": "Stage ID",
-                        "name": "stageID",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Override the minimum rest days between a team's games",
+                        "name": "min_rest_days",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Games for stage",
+                        "description": "Fixture report",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api.GameResponse"
-                            }
+                            "$ref": "#/definitions/api.FixtureValidateResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
             }
         },
-        "/teams": {
+        "/competitions/{competitionID}/seasons/{seasonID}/games": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Games"
                 ],
-                "summary": "Retrieve teams with pagination",
-                "operationId": "get-teams",
+                "summary": "Get games for a season",
+                "operationId": "get-season-games",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -880,22 +1357,93 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 20,
-                        "description": "Items per page",
+                        "description": "Page size",
                         "name": "page_size",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games in this stage",
+                        "name": "stage_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games involving this team",
+                        "name": "team_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "scheduled",
+                            "playing",
+                            "finished",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Only games with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games on or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games on or before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "date",
+                            "-date"
+                        ],
+                        "type": "string",
+                        "default": "date",
+                        "description": "Sort order by date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated resources to embed: teams, stage",
+                        "name": "expand",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Paginated games",
                         "schema": {
-                            "$ref": "#/definitions/api.PaginatedResponse-api_TeamResponse"
+                            "$ref": "#/definitions/api.PaginatedResponse-api_GameResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query params",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -908,80 +1456,148 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Games"
                 ],
-                "summary": "Create a new team",
-                "operationId": "create-team",
+                "summary": "Create a new game",
+                "operationId": "create-game",
                 "parameters": [
                     {
-                        "description": "Team details to create",
-                        "name": "team",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.TeamRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Successful operation",
-                        "schema": {
-                            "$ref": "#/definitions/api.TeamResponse"
-                        }
-                    },
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Game details to create",
+                        "name": "game",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameResponse"
+                        }
+                    },
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
             }
         },
-        "/teams/{teamID}": {
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Games"
                 ],
-                "summary": "Get a single team by ID",
-                "operationId": "get-team",
+                "summary": "Get a single game by ID",
+                "operationId": "get-game",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
-                        "description": "Team ID",
-                        "name": "teamID",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated resources to embed: teams, stage",
+                        "name": "expand",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Team found",
+                        "description": "Game found",
                         "schema": {
-                            "$ref": "#/definitions/api.TeamResponse"
+                            "$ref": "#/definitions/api.GameResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the game, for If-Match"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid team ID",
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -994,46 +1610,92 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Games"
                 ],
-                "summary": "Update an existing team",
-                "operationId": "update-team",
+                "summary": "Update a game",
+                "operationId": "update-game",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
-                        "description": "Team ID",
-                        "name": "teamID",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Team details to update",
-                        "name": "team",
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Game details to update",
+                        "name": "game",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.TeamRequest"
+                            "$ref": "#/definitions/api.GameRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the game being replaced",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Team updated",
+                        "description": "Game updated",
                         "schema": {
-                            "$ref": "#/definitions/api.TeamResponse"
+                            "$ref": "#/definitions/api.GameResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated game"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Game changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
@@ -1043,288 +1705,5870 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Games"
                 ],
-                "summary": "Delete a team by ID",
-                "operationId": "delete-team",
+                "summary": "Delete a game by ID",
+                "operationId": "delete-game",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "2c6f1e7b-1d3e-4e0a-9c4b-3e5e0b9f0001",
-                        "description": "Team ID",
-                        "name": "teamID",
+                        "default": "a973dd2c-ecd3-4578-b5c3-9022a3f0ecbd",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "fe04fe69-834f-42be-9821-04e53e8de26d",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "30f8181f-0a44-4ad7-a163-3ef2d29e504e",
+                        "description": "Game ID",
+                        "name": "gameID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No Content\"\t\"Team deleted successfully"
+                        "description": "No Content\"\t\"Game deleted successfully"
                     },
                     "400": {
-                        "description": "Invalid team ID",
+                        "description": "Invalid game ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.Problem"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "api.CompetitionRequest": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
-                }
-            }
-        },
-        "api.CompetitionResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                }
-            }
-        },
-        "api.GameRequest": {
-            "type": "object",
-            "required": [
-                "away_team_id",
-                "date",
-                "home_team_id",
-                "stage_id"
-            ],
-            "properties": {
-                "away_score": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "away_team_id": {
-                    "type": "string",
-                    "example": "7b6cdb33-3bc6-4b0c-bac2-82d2a6bc6a97"
-                },
-                "date": {
-                    "type": "string",
-                    "example": "2025-08-02T00:00:00Z"
-                },
-                "home_score": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "home_team_id": {
-                    "type": "string",
-                    "example": "013952a5-87e1-4d26-a312-09b2aff54241"
-                },
-                "stage_id": {
-                    "type": "string",
-                    "example": "eab15533-dea6-4a3d-8a95-d38e4fba2d5a"
-                },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/api.GameStatus"
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch, so a scorer can send just {\"home_score\": 7}. The write fails with 412 if the game changes after it is read, or if If-Match names an older version.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Partially update a game",
+                "operationId": "patch-game",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "game",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GameRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the game being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Game updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated game"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Game changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/events": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Get the events in a game",
+                "operationId": "get-game-events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Events found",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.GameEventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid game ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Events can be recorded once a game is playing, and corrected after it has finished. A penalty try names no player; every other event names a player in the team's squad on the game date. Events feed player statistics and do not change the score.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Record a game event",
+                "operationId": "create-game-event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GameEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Event recorded",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Team is not playing in the game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Game has not kicked off",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/events/{eventID}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Delete a game event",
+                "operationId": "delete-game-event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "3a7d9c2e-5b1f-4e8a-b6d4-0c2f8e1a9b57",
+                        "description": "Event ID",
+                        "name": "eventID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Event deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lineups"
+                ],
+                "summary": "Get the lineups for a game",
+                "operationId": "get-lineups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lineups found",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameLineupsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid game ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID}": {
+            "put": {
+                "description": "Every player must be in the team's season squad on the game date. The lineup is locked once the kickoff time passes or the game is no longer scheduled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lineups"
+                ],
+                "summary": "Set a side's lineup for a game",
+                "operationId": "set-lineup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team sheet",
+                        "name": "lineup",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.LineupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lineup saved",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamLineupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Team is not playing in the game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Game has kicked off",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lineups"
+                ],
+                "summary": "Clear a side's lineup for a game",
+                "operationId": "clear-lineup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Lineup cleared"
+                    },
+                    "400": {
+                        "description": "Invalid game or team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Team is not playing in the game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Game has kicked off",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID}/replacements": {
+            "post": {
+                "description": "A bench player comes on for a player on the field. Replacements can only be recorded while the game is playing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lineups"
+                ],
+                "summary": "Record a replacement",
+                "operationId": "add-replacement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Players and minute",
+                        "name": "replacement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.ReplacementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Replacement recorded",
+                        "schema": {
+                            "$ref": "#/definitions/api.ReplacementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Team is not playing in the game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Game is not playing",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID}/replacements/{replacementID}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lineups"
+                ],
+                "summary": "Remove a replacement",
+                "operationId": "remove-replacement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "2e6f8a1b-4c3d-4b5e-9a7f-6d1c0b2e3f40",
+                        "description": "Replacement ID",
+                        "name": "replacementID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Replacement removed"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Team is not playing in the game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Game is not playing",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/live": {
+            "get": {
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Watch live game state",
+                "operationId": "watch-game",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Invalid game ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Unable to watch game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/officials": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Get the officials for a game",
+                "operationId": "get-game-officials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Officials found",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.GameOfficialResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid game ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/officials/{officialID}": {
+            "put": {
+                "description": "A game has one referee, two assistant referees and one TMO. An official cannot be appointed to games kicking off less than three hours apart.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Appoint an official to a game",
+                "operationId": "set-game-official",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
+                        "name": "appointment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GameOfficialRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role changed",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameOfficialResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Official appointed",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameOfficialResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Role already filled or official double-booked",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Remove an official from a game",
+                "operationId": "remove-game-official",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Official removed"
+                    },
+                    "400": {
+                        "description": "Invalid game or official ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Official not appointed to the game",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/stats": {
+            "get": {
+                "description": "Lists everyone who took the field or scored, by team and top scorer first. Games that have not finished have no stats.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get player stats for a game",
+                "operationId": "get-game-stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01",
+                        "description": "Game ID",
+                        "name": "gameID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Game stats",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.PlayerGameStatsResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid game ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/games:batch": {
+            "post": {
+                "description": "Games with an \"id\" update that game and games without one are created. Nothing is saved unless every game is valid, and errors are reported per game as games[i].field.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Create and update games in bulk",
+                "operationId": "batch-games",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Games to create or update",
+                        "name": "games",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.GameBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Games saved",
+                        "schema": {
+                            "$ref": "#/definitions/api.GameBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/leaderboard": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get a season's leaderboard",
+                "operationId": "get-season-leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "tries",
+                            "points"
+                        ],
+                        "type": "string",
+                        "default": "points",
+                        "description": "Stat to rank by",
+                        "name": "stat",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of players to list",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leaderboard",
+                        "schema": {
+                            "$ref": "#/definitions/api.LeaderboardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query params",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/stages/{stageID}/games": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Get games",
+                "operationId": "get-games",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "eab15533-dea6-4a3d-8a95-d38e4fba2d5a",
+                        "description": "Stage ID",
+                        "name": "stageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated resources to embed: teams, stage",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Games for stage",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.GameResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/home-venue": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Set a team's home venue for a season",
+                "operationId": "set-season-team-home-venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Home venue for the season",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.SeasonTeamHomeVenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Home venue set",
+                        "schema": {
+                            "$ref": "#/definitions/api.SeasonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not in season",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Seasons"
+                ],
+                "summary": "Remove a team's home venue for a season",
+                "operationId": "delete-season-team-home-venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Home venue removed"
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not in season",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/squad": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Squads"
+                ],
+                "summary": "Get a team's squad for a season",
+                "operationId": "get-squad",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Squad found",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.SquadPlayerResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not in season",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/squad/{playerID}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Squads"
+                ],
+                "summary": "Add or update a squad player",
+                "operationId": "set-squad-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Jersey number and active dates",
+                        "name": "squadPlayer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.SquadPlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Squad player updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.SquadPlayerResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Squad player added",
+                        "schema": {
+                            "$ref": "#/definitions/api.SquadPlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not in season or player not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Jersey number already taken",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Squads"
+                ],
+                "summary": "Remove a squad player",
+                "operationId": "remove-squad-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "44dd315c-1abc-43aa-9843-642f920190d1",
+                        "description": "Competition ID",
+                        "name": "competitionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "9300778f-cce0-4efe-af6c-e399d8170315",
+                        "description": "Season ID",
+                        "name": "seasonID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Squad player removed"
+                    },
+                    "400": {
+                        "description": "Invalid team or player ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not in squad",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/games": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Games"
+                ],
+                "summary": "Get fixtures and results across competitions",
+                "operationId": "get-game-feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games on or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games on or before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "scheduled",
+                            "playing",
+                            "finished",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Only games with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games involving this team",
+                        "name": "team_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games in this competition",
+                        "name": "competition_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "date",
+                            "-date"
+                        ],
+                        "type": "string",
+                        "default": "date",
+                        "description": "Sort order by date",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated games",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaginatedResponse-api_GameFeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query params",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/officials": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Retrieve officials with pagination",
+                "operationId": "get-officials",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaginatedResponse-api_OfficialResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Create a new official",
+                "operationId": "create-official",
+                "parameters": [
+                    {
+                        "description": "Official details to create",
+                        "name": "official",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/officials/{officialID}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Get a single official by ID",
+                "operationId": "get-official",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Official found",
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the official, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid official ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Update an existing official",
+                "operationId": "update-official",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Official details to update",
+                        "name": "official",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the official being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Official updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated official"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Official changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Delete an official by ID",
+                "operationId": "delete-official",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Official deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid official ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch. The write fails with 412 if the official changes after it is read, or if If-Match names an older version.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Partially update an official",
+                "operationId": "patch-official",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "official",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the official being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Official updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated official"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Official changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/officials/{officialID}/appointments": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Officials"
+                ],
+                "summary": "Get an official's appointments",
+                "operationId": "get-official-appointments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090",
+                        "description": "Official ID",
+                        "name": "officialID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upcoming and past appointments",
+                        "schema": {
+                            "$ref": "#/definitions/api.OfficialAppointmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid official ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Retrieve teams with pagination",
+                "operationId": "get-teams",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only teams whose name, abbreviation or any alias contains this text",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaginatedResponse-api_TeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Create a new team",
+                "operationId": "create-team",
+                "parameters": [
+                    {
+                        "description": "Team details to create",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get a single team by ID",
+                "operationId": "get-team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team found",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the team, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Update an existing team",
+                "operationId": "update-team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Team details to update",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the team being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated team"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Team changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Delete a team by ID",
+                "operationId": "delete-team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "2c6f1e7b-1d3e-4e0a-9c4b-3e5e0b9f0001",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Team deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch. The write fails with 412 if the team changes after it is read, or if If-Match names an older version.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Partially update a team",
+                "operationId": "patch-team",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "team",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the team being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated team"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Team changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/aliases": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get a team's aliases",
+                "operationId": "get-team-aliases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "b5c6e9d7-8f11-4ef2-acc6-2e5a97839532",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Aliases oldest first",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.TeamAliasResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Add an alias to a team",
+                "operationId": "create-team-alias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "b5c6e9d7-8f11-4ef2-acc6-2e5a97839532",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alias details to create",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Dates overlap another alias",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/aliases/{aliasID}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Update a team alias",
+                "operationId": "update-team-alias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "b5c6e9d7-8f11-4ef2-acc6-2e5a97839532",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias ID",
+                        "name": "aliasID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alias details to update",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Dates overlap another alias",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Delete a team alias",
+                "operationId": "delete-team-alias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "b5c6e9d7-8f11-4ef2-acc6-2e5a97839532",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias ID",
+                        "name": "aliasID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Alias deleted"
+                    },
+                    "400": {
+                        "description": "Invalid team or alias ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/form": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get a team's form",
+                "operationId": "get-team-form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games in this season",
+                        "name": "season_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 20,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of recent results to list",
+                        "name": "last",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team form",
+                        "schema": {
+                            "$ref": "#/definitions/api.TeamFormResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/games": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get games for a team",
+                "operationId": "get-team-games",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only games in this season",
+                        "name": "season_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "scheduled",
+                            "playing",
+                            "finished",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Only games with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "date",
+                            "-date"
+                        ],
+                        "type": "string",
+                        "default": "date",
+                        "description": "Sort order by date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated resources to embed: teams, stage",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Paginated games",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaginatedResponse-api_GameResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/head-to-head/{opponentID}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get the head-to-head record between two teams",
+                "operationId": "get-team-head-to-head",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7b6cdb33-3bc6-4b0c-bac2-82d2a6bc6a97",
+                        "description": "Opponent ID",
+                        "name": "opponentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Head-to-head record",
+                        "schema": {
+                            "$ref": "#/definitions/api.HeadToHeadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/players": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "Retrieve a team's players with pagination",
+                "operationId": "get-players",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaginatedResponse-api_PlayerResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "Add a player to a team",
+                "operationId": "create-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player details to create",
+                        "name": "player",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/players/{playerID}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "Get a single player by ID",
+                "operationId": "get-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Player found",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the player, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team or player ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "Update an existing player",
+                "operationId": "update-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Player details to update",
+                        "name": "player",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the player being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Player updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated player"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Player changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "Delete a player by ID",
+                "operationId": "delete-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Player deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid team or player ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch. The write fails with 412 if the player changes after it is read, or if If-Match names an older version.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Players"
+                ],
+                "summary": "Partially update a player",
+                "operationId": "patch-player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "player",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the player being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Player updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated player"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Player changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{teamID}/players/{playerID}/stats": {
+            "get": {
+                "description": "One row per season and team the player has played for, across every competition, with career totals. A player can be named in squads outside the team they are registered with.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get a player's career stats",
+                "operationId": "get-player-career",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "description": "Team ID",
+                        "name": "teamID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10",
+                        "description": "Player ID",
+                        "name": "playerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Career stats",
+                        "schema": {
+                            "$ref": "#/definitions/api.PlayerCareerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team or player ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venues"
+                ],
+                "summary": "Retrieve venues with pagination",
+                "operationId": "get-venues",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.PaginatedResponse-api_VenueResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venues"
+                ],
+                "summary": "Create a new venue",
+                "operationId": "create-venue",
+                "parameters": [
+                    {
+                        "description": "Venue details to create",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Venue already exists in that city",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/venues/{venueID}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venues"
+                ],
+                "summary": "Get a single venue by ID",
+                "operationId": "get-venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01",
+                        "description": "Venue ID",
+                        "name": "venueID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Venue found",
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the venue, for If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid venue ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venues"
+                ],
+                "summary": "Update an existing venue",
+                "operationId": "update-venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01",
+                        "description": "Venue ID",
+                        "name": "venueID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Venue details to update",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the venue being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Venue updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated venue"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Venue changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venues"
+                ],
+                "summary": "Delete a venue by ID",
+                "operationId": "delete-venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01",
+                        "description": "Venue ID",
+                        "name": "venueID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content\"\t\"Venue deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid venue ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Venue still has games",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies an RFC 7386 JSON merge patch. The write fails with 412 if the venue changes after it is read, or if If-Match names an older version.",
+                "consumes": [
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Venues"
+                ],
+                "summary": "Partially update a venue",
+                "operationId": "patch-venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01",
+                        "description": "Venue ID",
+                        "name": "venueID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "venue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the venue being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Venue updated",
+                        "schema": {
+                            "$ref": "#/definitions/api.VenueResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated venue"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Venue changed since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.AppointmentResponse": {
+            "type": "object",
+            "properties": {
+                "away_team_id": {
+                    "type": "string"
+                },
+                "competition_id": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "game_id": {
+                    "type": "string"
+                },
+                "home_team_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/api.OfficialRole"
+                },
+                "season_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/api.GameStatus"
+                }
+            }
+        },
+        "api.CompetitionGender": {
+            "type": "string",
+            "enum": [
+                "men",
+                "women",
+                "mixed"
+            ],
+            "x-enum-varnames": [
+                "CompetitionGenderMen",
+                "CompetitionGenderWomen",
+                "CompetitionGenderMixed"
+            ]
+        },
+        "api.CompetitionLevel": {
+            "type": "string",
+            "enum": [
+                "international",
+                "professional",
+                "provincial",
+                "club",
+                "school"
+            ],
+            "x-enum-varnames": [
+                "CompetitionLevelInternational",
+                "CompetitionLevelProfessional",
+                "CompetitionLevelProvincial",
+                "CompetitionLevelClub",
+                "CompetitionLevelSchool"
+            ]
+        },
+        "api.CompetitionRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "NZ"
+                },
+                "gender": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.CompetitionGender"
+                        }
+                    ],
+                    "example": "men"
+                },
+                "governing_body": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "New Zealand Rugby"
+                },
+                "level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.CompetitionLevel"
+                        }
+                    ],
+                    "example": "provincial"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
+                "primary_colour": {
+                    "type": "string",
+                    "example": "#000000"
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Pacific"
+                },
+                "secondary_colour": {
+                    "type": "string",
+                    "example": "#FFFFFF"
+                },
+                "sport": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.SportCode"
+                        }
+                    ],
+                    "example": "rugby_union"
+                },
+                "website": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com"
+                }
+            }
+        },
+        "api.CompetitionResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "gender": {
+                    "$ref": "#/definitions/api.CompetitionGender"
+                },
+                "governing_body": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "level": {
+                    "$ref": "#/definitions/api.CompetitionLevel"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primary_colour": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "secondary_colour": {
+                    "type": "string"
+                },
+                "sport": {
+                    "$ref": "#/definitions/api.SportCode"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.CurrentSeasonResponse": {
+            "type": "object",
+            "properties": {
+                "resolution": {
+                    "enum": [
+                        "current",
+                        "next",
+                        "previous"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.SeasonResolution"
+                        }
+                    ],
+                    "example": "current"
+                },
+                "season": {
+                    "$ref": "#/definitions/api.SeasonResponse"
+                }
+            }
+        },
+        "api.FinalsFormat": {
+            "type": "string",
+            "enum": [
+                "top_4",
+                "top_6",
+                "top_8"
+            ],
+            "x-enum-varnames": [
+                "FinalsFormatTop4",
+                "FinalsFormatTop6",
+                "FinalsFormatTop8"
+            ]
+        },
+        "api.FinalsRequest": {
+            "type": "object",
+            "required": [
+                "format"
+            ],
+            "properties": {
+                "format": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.FinalsFormat"
+                        }
+                    ],
+                    "example": "top_6"
+                },
+                "round_interval_days": {
+                    "type": "integer",
+                    "maximum": 28,
+                    "minimum": 1,
+                    "example": 7
+                }
+            }
+        },
+        "api.FinalsResponse": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "$ref": "#/definitions/api.FinalsFormat"
+                },
+                "round_interval_days": {
+                    "type": "integer"
+                },
+                "rounds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.FinalsRoundResponse"
+                    }
+                },
+                "seeds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.FinalsSeedResponse"
+                    }
+                }
+            }
+        },
+        "api.FinalsRoundResponse": {
+            "type": "object",
+            "properties": {
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.GameResponse"
+                    }
+                },
+                "stage": {
+                    "$ref": "#/definitions/api.StageSummary"
+                }
+            }
+        },
+        "api.FinalsSeedResponse": {
+            "type": "object",
+            "properties": {
+                "points": {
+                    "type": "integer",
+                    "example": 52
+                },
+                "record": {
+                    "$ref": "#/definitions/api.TeamRecordResponse"
+                },
+                "seed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                }
+            }
+        },
+        "api.FixtureGameResponse": {
+            "type": "object",
+            "properties": {
+                "away_team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                },
+                "id": {
+                    "description": "Only set once the game has been saved.",
+                    "type": "string"
+                }
+            }
+        },
+        "api.FixtureGenerateRequest": {
+            "type": "object",
+            "required": [
+                "first_round_date"
+            ],
+            "properties": {
+                "double_round_robin": {
+                    "type": "boolean"
+                },
+                "first_round_date": {
+                    "type": "string",
+                    "example": "2025-08-01T00:00:00Z"
+                },
+                "kickoff_slots": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/api.KickoffSlot"
+                    }
+                },
+                "round_interval_days": {
+                    "type": "integer",
+                    "maximum": 28,
+                    "minimum": 1,
+                    "example": 7
+                }
+            }
+        },
+        "api.FixtureGenerateResponse": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "game_count": {
+                    "type": "integer"
+                },
+                "rounds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.FixtureRoundResponse"
+                    }
+                }
+            }
+        },
+        "api.FixtureIssueResponse": {
+            "type": "object",
+            "properties": {
+                "game_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Auckland plays twice in Round 1"
+                },
+                "rule": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.FixtureRule"
+                        }
+                    ],
+                    "example": "team_double_booked"
+                },
+                "team_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.FixtureRoundResponse": {
+            "type": "object",
+            "properties": {
+                "byes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TeamSummary"
+                    }
+                },
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.FixtureGameResponse"
+                    }
+                },
+                "stage": {
+                    "$ref": "#/definitions/api.StageSummary"
+                }
+            }
+        },
+        "api.FixtureRule": {
+            "type": "string",
+            "enum": [
+                "self_match",
+                "team_double_booked",
+                "min_rest_days"
+            ],
+            "x-enum-varnames": [
+                "FixtureRuleSelfMatch",
+                "FixtureRuleDoubleBooked",
+                "FixtureRuleMinRestDays"
+            ]
+        },
+        "api.FixtureValidateResponse": {
+            "type": "object",
+            "properties": {
+                "game_count": {
+                    "type": "integer"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.FixtureIssueResponse"
+                    }
+                },
+                "min_rest_days": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.GameBatchRequest": {
+            "type": "object",
+            "required": [
+                "games"
+            ],
+            "properties": {
+                "games": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/api.GameRequest"
+                    }
+                }
+            }
+        },
+        "api.GameBatchResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "games": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.GameResponse"
+                    }
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "api.GameEventRequest": {
+            "type": "object",
+            "required": [
+                "minute",
+                "team_id",
+                "type"
+            ],
+            "properties": {
+                "minute": {
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 1,
+                    "example": 23
+                },
+                "player_id": {
+                    "type": "string",
+                    "example": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"
+                },
+                "team_id": {
+                    "type": "string",
+                    "example": "013952a5-87e1-4d26-a312-09b2aff54241"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.GameEventType"
+                        }
+                    ],
+                    "example": "try"
+                }
+            }
+        },
+        "api.GameEventResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "minute": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/api.GameEventType"
+                }
+            }
+        },
+        "api.GameEventType": {
+            "type": "string",
+            "enum": [
+                "try",
+                "conversion",
+                "penalty_goal",
+                "drop_goal",
+                "penalty_try",
+                "yellow_card",
+                "red_card"
+            ],
+            "x-enum-varnames": [
+                "GameEventTypeTry",
+                "GameEventTypeConversion",
+                "GameEventTypePenaltyGoal",
+                "GameEventTypeDropGoal",
+                "GameEventTypePenaltyTry",
+                "GameEventTypeYellowCard",
+                "GameEventTypeRedCard"
+            ]
+        },
+        "api.GameFeedResponse": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "type": "integer"
+                },
+                "away_team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                },
+                "competition_id": {
+                    "type": "string"
+                },
+                "competition_name": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "home_score": {
+                    "type": "integer"
+                },
+                "home_team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "local_kickoff": {
+                    "type": "string",
+                    "example": "2025-08-02T19:05:00+12:00"
+                },
+                "neutral_venue": {
+                    "type": "boolean"
+                },
+                "season_id": {
+                    "type": "string"
+                },
+                "stage_id": {
+                    "type": "string"
+                },
+                "stage_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/api.GameStatus"
+                },
+                "venue_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.GameLineupsResponse": {
+            "type": "object",
+            "properties": {
+                "away": {
+                    "$ref": "#/definitions/api.TeamLineupResponse"
+                },
+                "game_id": {
+                    "type": "string"
+                },
+                "home": {
+                    "$ref": "#/definitions/api.TeamLineupResponse"
+                }
+            }
+        },
+        "api.GameOfficialRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.OfficialRole"
+                        }
+                    ],
+                    "example": "referee"
+                }
+            }
+        },
+        "api.GameOfficialResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "official": {
+                    "$ref": "#/definitions/api.OfficialSummary"
+                },
+                "role": {
+                    "$ref": "#/definitions/api.OfficialRole"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.GameRequest": {
+            "type": "object",
+            "required": [
+                "away_team_id",
+                "date",
+                "home_team_id",
+                "stage_id"
+            ],
+            "properties": {
+                "away_score": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "away_team_id": {
+                    "type": "string",
+                    "example": "7b6cdb33-3bc6-4b0c-bac2-82d2a6bc6a97"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-08-02T00:00:00Z"
+                },
+                "home_score": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "home_team_id": {
+                    "type": "string",
+                    "example": "013952a5-87e1-4d26-a312-09b2aff54241"
+                },
+                "neutral_venue": {
+                    "type": "boolean"
+                },
+                "stage_id": {
+                    "type": "string",
+                    "example": "eab15533-dea6-4a3d-8a95-d38e4fba2d5a"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.GameStatus"
+                        }
+                    ],
+                    "example": "playing"
+                },
+                "venue_id": {
+                    "type": "string",
+                    "example": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01"
+                }
+            }
+        },
+        "api.GameResponse": {
+            "type": "object",
+            "properties": {
+                "away_score": {
+                    "type": "integer"
+                },
+                "away_team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                },
+                "away_team_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "home_score": {
+                    "type": "integer"
+                },
+                "home_team": {
+                    "description": "Only set when requested with expand.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.TeamSummary"
+                        }
+                    ]
+                },
+                "home_team_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "local_kickoff": {
+                    "description": "LocalKickoff is Date in the venue's timezone, set when the game has a venue.",
+                    "type": "string",
+                    "example": "2025-08-02T19:05:00+12:00"
+                },
+                "neutral_venue": {
+                    "type": "boolean"
+                },
+                "season_id": {
+                    "type": "string"
+                },
+                "stage": {
+                    "$ref": "#/definitions/api.StageSummary"
+                },
+                "stage_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/api.GameStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "venue_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.GameResult": {
+            "type": "string",
+            "enum": [
+                "W",
+                "D",
+                "L"
+            ],
+            "x-enum-varnames": [
+                "GameResultWin",
+                "GameResultDraw",
+                "GameResultLoss"
+            ]
+        },
+        "api.GameStatus": {
+            "type": "string",
+            "enum": [
+                "scheduled",
+                "playing",
+                "finished",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "GameStatusScheduled",
+                "GameStatusPlaying",
+                "GameStatusFinished",
+                "GameStatusCancelled"
+            ]
+        },
+        "api.HeadToHeadResponse": {
+            "type": "object",
+            "properties": {
+                "biggest_loss": {
+                    "$ref": "#/definitions/api.TeamResultResponse"
+                },
+                "biggest_win": {
+                    "$ref": "#/definitions/api.TeamResultResponse"
+                },
+                "last_meeting": {
+                    "$ref": "#/definitions/api.TeamResultResponse"
+                },
+                "meetings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TeamResultResponse"
+                    }
+                },
+                "opponent": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                },
+                "record": {
+                    "$ref": "#/definitions/api.TeamRecordResponse"
+                },
+                "team": {
+                    "$ref": "#/definitions/api.TeamSummary"
+                }
+            }
+        },
+        "api.KickoffSlot": {
+            "type": "object",
+            "required": [
+                "time"
+            ],
+            "properties": {
+                "day_offset": {
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0,
+                    "example": 1
+                },
+                "time": {
+                    "type": "string",
+                    "example": "19:05"
+                }
+            }
+        },
+        "api.LeaderboardEntryResponse": {
+            "type": "object",
+            "properties": {
+                "appearances": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "player_name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "tries": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.LeaderboardResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.LeaderboardEntryResponse"
+                    }
+                },
+                "stat": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.LineupPlayerRequest": {
+            "type": "object",
+            "required": [
+                "player_id",
+                "shirt_number"
+            ],
+            "properties": {
+                "player_id": {
+                    "type": "string",
+                    "example": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"
+                },
+                "shirt_number": {
+                    "type": "integer",
+                    "maximum": 23,
+                    "minimum": 1,
+                    "example": 8
+                }
+            }
+        },
+        "api.LineupPlayerResponse": {
+            "type": "object",
+            "properties": {
+                "captain": {
+                    "type": "boolean"
+                },
+                "player": {
+                    "$ref": "#/definitions/api.PlayerSummary"
+                },
+                "shirt_number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.LineupRequest": {
+            "type": "object",
+            "required": [
+                "captain_id",
+                "players"
+            ],
+            "properties": {
+                "captain_id": {
+                    "type": "string",
+                    "example": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"
+                },
+                "players": {
+                    "type": "array",
+                    "maxItems": 23,
+                    "minItems": 15,
+                    "items": {
+                        "$ref": "#/definitions/api.LineupPlayerRequest"
+                    }
+                }
+            }
+        },
+        "api.OfficialAppointmentsResponse": {
+            "type": "object",
+            "properties": {
+                "official_id": {
+                    "type": "string"
+                },
+                "past": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.AppointmentResponse"
+                    }
+                },
+                "upcoming": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.AppointmentResponse"
+                    }
+                }
+            }
+        },
+        "api.OfficialRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Ben O'Keeffe"
+                },
+                "nationality": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "New Zealand"
+                }
+            }
+        },
+        "api.OfficialResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nationality": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.OfficialRole": {
+            "type": "string",
+            "enum": [
+                "referee",
+                "assistant_referee",
+                "tmo"
+            ],
+            "x-enum-varnames": [
+                "OfficialRoleReferee",
+                "OfficialRoleAssistantReferee",
+                "OfficialRoleTMO"
+            ]
+        },
+        "api.OfficialSummary": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_CompetitionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CompetitionResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_GameFeedResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.GameFeedResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_GameResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.GameResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_OfficialResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.OfficialResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_PlayerResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PlayerResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_TeamResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TeamResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginatedResponse-api_VenueResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.VenueResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/api.PaginationMeta"
+                }
+            }
+        },
+        "api.PaginationMeta": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PlayerCareerResponse": {
+            "type": "object",
+            "properties": {
+                "player": {
+                    "$ref": "#/definitions/api.PlayerSummary"
+                },
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.PlayerSeasonStatsResponse"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/api.PlayerStatsResponse"
+                }
+            }
+        },
+        "api.PlayerGameStatsResponse": {
+            "type": "object",
+            "properties": {
+                "appearances": {
+                    "type": "integer"
+                },
+                "conversions": {
+                    "type": "integer"
+                },
+                "drop_goals": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "penalty_goals": {
+                    "type": "integer"
+                },
+                "player_id": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "tries": {
+                    "type": "integer"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PlayerPosition": {
+            "type": "string",
+            "enum": [
+                "prop",
+                "hooker",
+                "lock",
+                "flanker",
+                "number_eight",
+                "scrum_half",
+                "fly_half",
+                "centre",
+                "wing",
+                "fullback"
+            ],
+            "x-enum-varnames": [
+                "PlayerPositionProp",
+                "PlayerPositionHooker",
+                "PlayerPositionLock",
+                "PlayerPositionFlanker",
+                "PlayerPositionNumberEight",
+                "PlayerPositionScrumHalf",
+                "PlayerPositionFlyHalf",
+                "PlayerPositionCentre",
+                "PlayerPositionWing",
+                "PlayerPositionFullback"
+            ]
+        },
+        "api.PlayerRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "position"
+            ],
+            "properties": {
+                "date_of_birth": {
+                    "type": "string",
+                    "example": "1993-10-14T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Ardie Savea"
+                },
+                "nationality": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "New Zealand"
+                },
+                "position": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.PlayerPosition"
                         }
                     ],
-                    "example": "playing"
+                    "example": "number_eight"
+                }
+            }
+        },
+        "api.PlayerResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "date_of_birth": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nationality": {
+                    "type": "string"
+                },
+                "position": {
+                    "$ref": "#/definitions/api.PlayerPosition"
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.PlayerSeasonStatsResponse": {
+            "type": "object",
+            "properties": {
+                "appearances": {
+                    "type": "integer"
+                },
+                "competition_id": {
+                    "type": "string"
+                },
+                "conversions": {
+                    "type": "integer"
+                },
+                "drop_goals": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "penalty_goals": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "tries": {
+                    "type": "integer"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PlayerStatsResponse": {
+            "type": "object",
+            "properties": {
+                "appearances": {
+                    "type": "integer"
+                },
+                "conversions": {
+                    "type": "integer"
+                },
+                "drop_goals": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "penalty_goals": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "red_cards": {
+                    "type": "integer"
+                },
+                "tries": {
+                    "type": "integer"
+                },
+                "yellow_cards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.PlayerSummary": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "$ref": "#/definitions/api.PlayerPosition"
+                }
+            }
+        },
+        "api.ReplacementRequest": {
+            "type": "object",
+            "required": [
+                "minute",
+                "player_off_id",
+                "player_on_id"
+            ],
+            "properties": {
+                "minute": {
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 1,
+                    "example": 55
+                },
+                "player_off_id": {
+                    "type": "string",
+                    "example": "7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"
+                },
+                "player_on_id": {
+                    "type": "string",
+                    "example": "8d2a4b63-7f1c-4e9b-a3c5-2b0f6e4d8c21"
+                }
+            }
+        },
+        "api.ReplacementResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "minute": {
+                    "type": "integer"
+                },
+                "player_off_id": {
+                    "type": "string"
+                },
+                "player_on_id": {
+                    "type": "string"
                 }
             }
         },
-        "api.GameResponse": {
+        "api.SeasonRequest": {
             "type": "object",
+            "required": [
+                "end_date",
+                "stages",
+                "start_date",
+                "teams"
+            ],
             "properties": {
-                "away_score": {
-                    "type": "integer"
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
                 },
-                "away_team_id": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "2025"
+                },
+                "stages": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/api.StageRequest"
+                    }
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "teams": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 2,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "013952a5-87e1-4d26-a312-09b2aff54241",
+                        "7b6cdb33-3bc6-4b0c-bac2-82d2a6bc6a97"
+                    ]
+                },
+                "year": {
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1871,
+                    "example": 2025
+                }
+            }
+        },
+        "api.SeasonResolution": {
+            "type": "string",
+            "enum": [
+                "current",
+                "next",
+                "previous"
+            ],
+            "x-enum-varnames": [
+                "SeasonResolutionCurrent",
+                "SeasonResolutionNext",
+                "SeasonResolutionPrevious"
+            ]
+        },
+        "api.SeasonResponse": {
+            "type": "object",
+            "properties": {
+                "competition_id": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "date": {
+                "deleted_at": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "end_date": {
                     "type": "string"
                 },
-                "home_score": {
+                "home_venues": {
+                    "description": "HomeVenues maps a team ID to the venue replacing its home venue this season.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.StageResponse"
+                    }
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TeamResponse"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "year": {
                     "type": "integer"
+                }
+            }
+        },
+        "api.SeasonTeamHomeVenueRequest": {
+            "type": "object",
+            "required": [
+                "venue_id"
+            ],
+            "properties": {
+                "venue_id": {
+                    "type": "string",
+                    "example": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01"
+                }
+            }
+        },
+        "api.SportCode": {
+            "type": "string",
+            "enum": [
+                "rugby_union",
+                "rugby_sevens",
+                "rugby_league"
+            ],
+            "x-enum-varnames": [
+                "SportCodeRugbyUnion",
+                "SportCodeRugbySevens",
+                "SportCodeRugbyLeague"
+            ]
+        },
+        "api.SquadPlayerRequest": {
+            "type": "object",
+            "required": [
+                "jersey_number"
+            ],
+            "properties": {
+                "active_from": {
+                    "type": "string",
+                    "example": "2025-02-14T00:00:00Z"
                 },
-                "home_team_id": {
+                "active_to": {
+                    "type": "string",
+                    "example": "2025-06-21T00:00:00Z"
+                },
+                "jersey_number": {
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1,
+                    "example": 8
+                }
+            }
+        },
+        "api.SquadPlayerResponse": {
+            "type": "object",
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_to": {
+                    "type": "string"
+                },
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "season_id": {
+                "jersey_number": {
+                    "type": "integer"
+                },
+                "player": {
+                    "$ref": "#/definitions/api.PlayerSummary"
+                },
+                "updated_at": {
                     "type": "string"
+                }
+            }
+        },
+        "api.StageRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "order_index",
+                "stage_type"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
                 },
-                "stage_id": {
+                "order_index": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "stage_type": {
+                    "$ref": "#/definitions/api.StageType"
+                }
+            }
+        },
+        "api.StageResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/api.GameStatus"
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "order_index": {
+                    "type": "integer"
+                },
+                "season_id": {
+                    "type": "string"
+                },
+                "stage_type": {
+                    "$ref": "#/definitions/api.StageType"
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "api.GameStatus": {
+        "api.StageSummary": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "order_index": {
+                    "type": "integer"
+                },
+                "stage_type": {
+                    "$ref": "#/definitions/api.StageType"
+                }
+            }
+        },
+        "api.StageType": {
             "type": "string",
             "enum": [
-                "scheduled",
-                "playing",
-                "finished",
-                "cancelled"
+                "regular",
+                "finals"
             ],
             "x-enum-varnames": [
-                "GameStatusScheduled",
-                "GameStatusPlaying",
-                "GameStatusFinished",
-                "GameStatusCancelled"
+                "StageTypeRegular",
+                "StageTypeFinals"
             ]
         },
-        "api.PaginatedResponse-api_CompetitionResponse": {
+        "api.TeamAliasRequest": {
+            "type": "object",
+            "required": [
+                "abbreviation",
+                "name",
+                "valid_from"
+            ],
+            "properties": {
+                "abbreviation": {
+                    "type": "string",
+                    "maxLength": 4,
+                    "minLength": 2,
+                    "example": "BOP"
+                },
+                "logo_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/steamers.png"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Bay of Plenty Steamers"
+                },
+                "valid_from": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "valid_to": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                }
+            }
+        },
+        "api.TeamAliasResponse": {
+            "type": "object",
+            "properties": {
+                "abbreviation": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "team_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.TeamFormResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "away": {
+                    "$ref": "#/definitions/api.TeamRecordResponse"
+                },
+                "form": {
+                    "type": "string",
+                    "example": "WWLDW"
+                },
+                "home": {
+                    "$ref": "#/definitions/api.TeamRecordResponse"
+                },
+                "overall": {
+                    "$ref": "#/definitions/api.TeamRecordResponse"
+                },
+                "recent": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api.CompetitionResponse"
+                        "$ref": "#/definitions/api.TeamResultResponse"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/api.PaginationMeta"
+                "streak": {
+                    "$ref": "#/definitions/api.TeamStreakResponse"
+                },
+                "team_id": {
+                    "type": "string"
                 }
             }
         },
-        "api.PaginatedResponse-api_TeamResponse": {
+        "api.TeamLineupResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "bench": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api.TeamResponse"
+                        "$ref": "#/definitions/api.LineupPlayerResponse"
                     }
                 },
-                "pagination": {
-                    "$ref": "#/definitions/api.PaginationMeta"
+                "captain_id": {
+                    "type": "string"
+                },
+                "replacements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ReplacementResponse"
+                    }
+                },
+                "starters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.LineupPlayerResponse"
+                    }
+                },
+                "team_id": {
+                    "type": "string"
                 }
             }
         },
-        "api.PaginationMeta": {
+        "api.TeamRecordResponse": {
             "type": "object",
             "properties": {
-                "page": {
+                "drawn": {
                     "type": "integer"
                 },
-                "page_size": {
+                "lost": {
                     "type": "integer"
                 },
-                "total": {
+                "played": {
                     "type": "integer"
                 },
-                "total_pages": {
+                "points_against": {
+                    "type": "integer"
+                },
+                "points_difference": {
+                    "type": "integer"
+                },
+                "points_for": {
+                    "type": "integer"
+                },
+                "won": {
                     "type": "integer"
                 }
             }
         },
-        "api.SeasonRequest": {
+        "api.TeamRequest": {
             "type": "object",
             "required": [
-                "end_date",
-                "stages",
-                "start_date",
-                "teams"
+                "abbreviation",
+                "name"
             ],
             "properties": {
-                "end_date": {
+                "abbreviation": {
                     "type": "string",
-                    "example": "2025-12-31T23:59:59Z"
+                    "maxLength": 4,
+                    "minLength": 2,
+                    "example": "ABV"
                 },
-                "stages": {
-                    "type": "array",
-                    "maxItems": 50,
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/api.StageRequest"
+                "crest_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/crest.svg"
+                },
+                "external_refs": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 },
-                "start_date": {
+                "founded_year": {
+                    "type": "integer",
+                    "example": 1996
+                },
+                "home_venue_id": {
                     "type": "string",
-                    "example": "2025-01-01T00:00:00Z"
+                    "example": "5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01"
                 },
-                "teams": {
-                    "type": "array",
-                    "maxItems": 100,
-                    "minItems": 2,
-                    "items": {
+                "location": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "logo_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
+                "primary_colour": {
+                    "type": "string",
+                    "example": "#FFD700"
+                },
+                "secondary_colour": {
+                    "type": "string",
+                    "example": "#000000"
+                },
+                "short_name": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "example": "Chiefs"
+                },
+                "social_handles": {
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "string"
-                    },
-                    "example": [
-                        "013952a5-87e1-4d26-a312-09b2aff54241",
-                        "7b6cdb33-3bc6-4b0c-bac2-82d2a6bc6a97"
-                    ]
+                    }
                 }
             }
         },
-        "api.SeasonResponse": {
+        "api.TeamResponse": {
             "type": "object",
             "properties": {
-                "competition_id": {
+                "abbreviation": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
+                "crest_url": {
+                    "type": "string"
+                },
                 "deleted_at": {
                     "type": "string"
                 },
-                "end_date": {
+                "external_refs": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "founded_year": {
+                    "type": "integer"
+                },
+                "home_venue_id": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "stages": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api.StageResponse"
-                    }
+                "location": {
+                    "type": "string"
                 },
-                "start_date": {
+                "logo_url": {
                     "type": "string"
                 },
-                "teams": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api.TeamResponse"
+                "name": {
+                    "type": "string"
+                },
+                "primary_colour": {
+                    "type": "string"
+                },
+                "secondary_colour": {
+                    "type": "string"
+                },
+                "short_name": {
+                    "type": "string"
+                },
+                "social_handles": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 },
                 "updated_at": {
@@ -1332,98 +7576,143 @@ const docTemplate = `{
                 }
             }
         },
-        "api.StageRequest": {
+        "api.TeamResultResponse": {
             "type": "object",
-            "required": [
-                "name",
-                "order_index",
-                "stage_type"
-            ],
             "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
+                "date": {
+                    "type": "string"
                 },
-                "order_index": {
+                "game_id": {
+                    "type": "string"
+                },
+                "home": {
+                    "type": "boolean"
+                },
+                "opponent_id": {
+                    "type": "string"
+                },
+                "points_against": {
+                    "type": "integer"
+                },
+                "points_for": {
+                    "type": "integer"
+                },
+                "result": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.GameResult"
+                        }
+                    ],
+                    "example": "W"
+                },
+                "season_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.TeamStreakResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
+                    "example": 3
                 },
-                "stage_type": {
-                    "$ref": "#/definitions/api.StageType"
+                "result": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/api.GameResult"
+                        }
+                    ],
+                    "example": "W"
                 }
             }
         },
-        "api.StageResponse": {
+        "api.TeamSummary": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "abbreviation": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "logo_url": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "order_index": {
-                    "type": "integer"
-                },
-                "season_id": {
+                "primary_colour": {
                     "type": "string"
                 },
-                "stage_type": {
-                    "$ref": "#/definitions/api.StageType"
+                "secondary_colour": {
+                    "type": "string"
                 },
-                "updated_at": {
+                "short_name": {
                     "type": "string"
                 }
             }
         },
-        "api.StageType": {
-            "type": "string",
-            "enum": [
-                "regular",
-                "finals"
-            ],
-            "x-enum-varnames": [
-                "StageTypeRegular",
-                "StageTypeFinals"
-            ]
-        },
-        "api.TeamRequest": {
+        "api.VenueRequest": {
             "type": "object",
             "required": [
-                "abbreviation",
-                "name"
+                "city",
+                "country",
+                "name",
+                "timezone"
             ],
             "properties": {
-                "abbreviation": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 50000
+                },
+                "city": {
                     "type": "string",
-                    "maxLength": 4,
+                    "maxLength": 100,
                     "minLength": 2,
-                    "example": "ABV"
+                    "example": "Auckland"
                 },
-                "location": {
+                "country": {
                     "type": "string",
                     "maxLength": 100,
-                    "minLength": 2
+                    "minLength": 2,
+                    "example": "New Zealand"
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "example": -36.8751
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "example": 174.7447
                 },
                 "name": {
                     "type": "string",
                     "maxLength": 100,
-                    "minLength": 3
+                    "minLength": 3,
+                    "example": "Eden Park"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Pacific/Auckland"
                 }
             }
         },
-        "api.TeamResponse": {
+        "api.VenueResponse": {
             "type": "object",
             "properties": {
-                "abbreviation": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
                     "type": "string"
                 },
                 "created_at": {
@@ -1435,22 +7724,68 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "location": {
-                    "type": "string"
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
                 },
                 "name": {
                     "type": "string"
                 },
+                "timezone": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "response.ErrorResponse": {
+        "response.FieldProblem": {
             "type": "object",
             "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "home_team_id"
+                },
                 "message": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "home and away teams must be different"
+                },
+                "rule": {
+                    "type": "string",
+                    "example": "home_and_away_teams_must_differ"
+                }
+            }
+        },
+        "response.Problem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid game: home team not in season"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.FieldProblem"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/v1/competitions"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
                 }
             }
         }
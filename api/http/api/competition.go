@@ -4,28 +4,141 @@ import (
 	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/guregu/null/zero"
 )
 
+// SportCode is the code of rugby a competition is played under.
+type SportCode string
+
+const (
+	SportCodeRugbyUnion  SportCode = "rugby_union"
+	SportCodeRugbySevens SportCode = "rugby_sevens"
+	SportCodeRugbyLeague SportCode = "rugby_league"
+)
+
+// CompetitionGender is who a competition is played by.
+type CompetitionGender string
+
+const (
+	CompetitionGenderMen   CompetitionGender = "men"
+	CompetitionGenderWomen CompetitionGender = "women"
+	CompetitionGenderMixed CompetitionGender = "mixed"
+)
+
+// CompetitionLevel is the tier a competition is played at.
+type CompetitionLevel string
+
+const (
+	CompetitionLevelInternational CompetitionLevel = "international"
+	CompetitionLevelProfessional  CompetitionLevel = "professional"
+	CompetitionLevelProvincial    CompetitionLevel = "provincial"
+	CompetitionLevelClub          CompetitionLevel = "club"
+	CompetitionLevelSchool        CompetitionLevel = "school"
+)
+
+// CompetitionRequest describes a competition. Sport defaults to rugby_union and the
+// remaining metadata is optional.
 type CompetitionRequest struct {
-	Name string `json:"name" validate:"required,min=3,max=100,entity_name"`
+	Name            string            `json:"name" validate:"required,min=3,max=100,entity_name"`
+	Sport           SportCode         `json:"sport,omitempty" validate:"omitempty,sport_code" example:"rugby_union"`
+	Gender          CompetitionGender `json:"gender,omitempty" validate:"omitempty,competition_gender" example:"men"`
+	Level           CompetitionLevel  `json:"level,omitempty" validate:"omitempty,competition_level" example:"provincial"`
+	Country         string            `json:"country,omitempty" validate:"omitempty,iso3166_1_alpha2" example:"NZ"`
+	Region          string            `json:"region,omitempty" validate:"omitempty,max=100" example:"Pacific"`
+	GoverningBody   string            `json:"governing_body,omitempty" validate:"omitempty,max=100" example:"New Zealand Rugby"`
+	LogoURL         string            `json:"logo_url,omitempty" validate:"omitempty,max=2048,http_url" example:"https://example.com/logo.png"`
+	PrimaryColour   string            `json:"primary_colour,omitempty" validate:"omitempty,hex_colour" example:"#000000"`
+	SecondaryColour string            `json:"secondary_colour,omitempty" validate:"omitempty,hex_colour" example:"#FFFFFF"`
+	Website         string            `json:"website,omitempty" validate:"omitempty,max=2048,http_url" example:"https://example.com"`
+}
+
+// CompetitionListRequest filters the competitions list. Every filter is optional.
+type CompetitionListRequest struct {
+	PaginationRequest
+	Sport   SportCode         `form:"sport" validate:"omitempty,sport_code"`
+	Gender  CompetitionGender `form:"gender" validate:"omitempty,competition_gender"`
+	Level   CompetitionLevel  `form:"level" validate:"omitempty,competition_level"`
+	Country string            `form:"country" validate:"omitempty,iso3166_1_alpha2"`
 }
 
 type CompetitionResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	DeletedAt zero.Time `json:"deleted_at"`
+	ID              uuid.UUID         `json:"id"`
+	Name            string            `json:"name"`
+	Sport           SportCode         `json:"sport"`
+	Gender          CompetitionGender `json:"gender,omitempty"`
+	Level           CompetitionLevel  `json:"level,omitempty"`
+	Country         string            `json:"country,omitempty"`
+	Region          string            `json:"region,omitempty"`
+	GoverningBody   string            `json:"governing_body,omitempty"`
+	LogoURL         string            `json:"logo_url,omitempty"`
+	PrimaryColour   string            `json:"primary_colour,omitempty"`
+	SecondaryColour string            `json:"secondary_colour,omitempty"`
+	Website         string            `json:"website,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	DeletedAt       zero.Time         `json:"deleted_at"`
+}
+
+// ToCompetitionRequest returns the request that would save c as it is.
+func ToCompetitionRequest(c db.Competition) CompetitionRequest {
+	return CompetitionRequest{
+		Name:            c.Name,
+		Sport:           SportCode(c.Sport),
+		Gender:          CompetitionGender(c.Gender.CompetitionGender),
+		Level:           CompetitionLevel(c.Level.CompetitionLevel),
+		Country:         c.Country.String,
+		Region:          c.Region.String,
+		GoverningBody:   c.GoverningBody.String,
+		LogoURL:         c.LogoUrl.String,
+		PrimaryColour:   c.PrimaryColour.String,
+		SecondaryColour: c.SecondaryColour.String,
+		Website:         c.Website.String,
+	}
 }
 
 func ToCompetitionResponse(c db.Competition) CompetitionResponse {
 	return CompetitionResponse{
-		ID:        c.ID,
-		Name:      c.Name,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
-		DeletedAt: zero.TimeFrom(c.DeletedAt.Time),
+		ID:              c.ID,
+		Name:            c.Name,
+		Sport:           SportCode(c.Sport),
+		Gender:          CompetitionGender(c.Gender.CompetitionGender),
+		Level:           CompetitionLevel(c.Level.CompetitionLevel),
+		Country:         c.Country.String,
+		Region:          c.Region.String,
+		GoverningBody:   c.GoverningBody.String,
+		LogoURL:         c.LogoUrl.String,
+		PrimaryColour:   c.PrimaryColour.String,
+		SecondaryColour: c.SecondaryColour.String,
+		Website:         c.Website.String,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+		DeletedAt:       zero.TimeFrom(c.DeletedAt.Time),
+	}
+}
+
+func ValidateSportCode(fl validator.FieldLevel) bool {
+	switch SportCode(fl.Field().String()) {
+	case SportCodeRugbyUnion, SportCodeRugbySevens, SportCodeRugbyLeague:
+		return true
+	}
+	return false
+}
+
+func ValidateCompetitionGender(fl validator.FieldLevel) bool {
+	switch CompetitionGender(fl.Field().String()) {
+	case CompetitionGenderMen, CompetitionGenderWomen, CompetitionGenderMixed:
+		return true
+	}
+	return false
+}
+
+func ValidateCompetitionLevel(fl validator.FieldLevel) bool {
+	switch CompetitionLevel(fl.Field().String()) {
+	case CompetitionLevelInternational, CompetitionLevelProfessional, CompetitionLevelProvincial,
+		CompetitionLevelClub, CompetitionLevelSchool:
+		return true
 	}
+	return false
 }
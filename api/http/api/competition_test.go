@@ -14,7 +14,8 @@ var _ = Describe("validation", func() {
 
 	BeforeEach(func() {
 		validate = validator.New()
-		validate.RegisterValidation("entity_name", validation.ValidateEntityName)
+		validation.Register(validate)
+		Register(validate)
 	})
 
 	Describe("ValidateCompetitionRequest", func() {
@@ -55,4 +56,41 @@ var _ = Describe("validation", func() {
 			Expect(validationErrors[0].Tag()).To(Equal("max"))
 		})
 	})
+
+	Describe("ValidateCompetitionRequest metadata", func() {
+		It("should pass with every metadata field set", func() {
+			comp := &CompetitionRequest{
+				Name:            "Super Rugby Pacific",
+				Sport:           SportCodeRugbyUnion,
+				Gender:          CompetitionGenderMen,
+				Level:           CompetitionLevelProfessional,
+				Country:         "NZ",
+				Region:          "Pacific",
+				GoverningBody:   "Super Rugby Pacific",
+				LogoURL:         "https://example.com/logo.png",
+				PrimaryColour:   "#1A2B3C",
+				SecondaryColour: "#ffffff",
+				Website:         "https://super.rugby",
+			}
+			Expect(validate.Struct(comp)).To(Succeed())
+		})
+
+		DescribeTable("should fail for invalid metadata",
+			func(comp CompetitionRequest, tag string) {
+				comp.Name = "Super Rugby Pacific"
+				err := validate.Struct(comp)
+				Expect(err).To(HaveOccurred())
+				Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal(tag))
+			},
+			Entry("unknown sport", CompetitionRequest{Sport: "touch"}, "sport_code"),
+			Entry("unknown gender", CompetitionRequest{Gender: "open"}, "competition_gender"),
+			Entry("unknown level", CompetitionRequest{Level: "amateur"}, "competition_level"),
+			Entry("lower case country", CompetitionRequest{Country: "nz"}, "iso3166_1_alpha2"),
+			Entry("three letter country", CompetitionRequest{Country: "NZL"}, "iso3166_1_alpha2"),
+			Entry("short hex colour", CompetitionRequest{PrimaryColour: "#FFF"}, "hex_colour"),
+			Entry("named colour", CompetitionRequest{SecondaryColour: "black"}, "hex_colour"),
+			Entry("non http logo", CompetitionRequest{LogoURL: "ftp://example.com/logo.png"}, "http_url"),
+			Entry("relative website", CompetitionRequest{Website: "super.rugby"}, "http_url"),
+		)
+	})
 })
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// GameEventType is a scoring or disciplinary event in a game.
+type GameEventType string
+
+const (
+	GameEventTypeTry         GameEventType = "try"
+	GameEventTypeConversion  GameEventType = "conversion"
+	GameEventTypePenaltyGoal GameEventType = "penalty_goal"
+	GameEventTypeDropGoal    GameEventType = "drop_goal"
+	GameEventTypePenaltyTry  GameEventType = "penalty_try"
+	GameEventTypeYellowCard  GameEventType = "yellow_card"
+	GameEventTypeRedCard     GameEventType = "red_card"
+)
+
+// GameEventRequest records an event in a game. A penalty try is awarded to the team,
+// every other event names the player involved.
+type GameEventRequest struct {
+	TeamID   uuid.UUID     `json:"team_id" validate:"required" example:"013952a5-87e1-4d26-a312-09b2aff54241"`
+	PlayerID *uuid.UUID    `json:"player_id,omitempty" swaggertype:"string" example:"7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"`
+	Type     GameEventType `json:"type" validate:"required,game_event_type" example:"try"`
+	Minute   int32         `json:"minute" validate:"required,min=1,max=120" example:"23"`
+}
+
+type GameEventResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	TeamID    uuid.UUID     `json:"team_id"`
+	PlayerID  *uuid.UUID    `json:"player_id,omitempty"`
+	Type      GameEventType `json:"type"`
+	Minute    int32         `json:"minute"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+func ToGameEventResponse(e db.GameEvent) GameEventResponse {
+	var playerID *uuid.UUID
+	if e.PlayerID.Valid {
+		playerID = &e.PlayerID.UUID
+	}
+
+	return GameEventResponse{
+		ID:        e.ID,
+		TeamID:    e.TeamID,
+		PlayerID:  playerID,
+		Type:      GameEventType(e.EventType),
+		Minute:    e.Minute,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func ValidateGameEventType(fl validator.FieldLevel) bool {
+	switch GameEventType(fl.Field().String()) {
+	case GameEventTypeTry, GameEventTypeConversion, GameEventTypePenaltyGoal, GameEventTypeDropGoal,
+		GameEventTypePenaltyTry, GameEventTypeYellowCard, GameEventTypeRedCard:
+		return true
+	}
+	return false
+}
+
+func ValidateGameEventRequest(sl validator.StructLevel) {
+	req := sl.Current().Interface().(GameEventRequest)
+
+	if req.Type == GameEventTypePenaltyTry {
+		if req.PlayerID != nil {
+			sl.ReportError(req.PlayerID, "player_id", "PlayerID", "no_player_for_penalty_tries", "")
+		}
+		return
+	}
+
+	if req.PlayerID == nil || *req.PlayerID == uuid.Nil {
+		sl.ReportError(req.PlayerID, "player_id", "PlayerID", "player_required_for_event", "")
+	}
+}
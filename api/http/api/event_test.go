@@ -0,0 +1,55 @@
+package api
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GameEventRequest validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterValidation("game_event_type", ValidateGameEventType)
+		validate.RegisterStructValidation(ValidateGameEventRequest, GameEventRequest{})
+	})
+
+	tagOf := func(err error) string {
+		return err.(validator.ValidationErrors)[0].Tag()
+	}
+
+	It("passes for a try by a player", func() {
+		playerID := uuid.New()
+		req := GameEventRequest{TeamID: uuid.New(), PlayerID: &playerID, Type: GameEventTypeTry, Minute: 12}
+
+		Expect(validate.Struct(req)).To(Succeed())
+	})
+
+	It("passes for a penalty try without a player", func() {
+		req := GameEventRequest{TeamID: uuid.New(), Type: GameEventTypePenaltyTry, Minute: 79}
+
+		Expect(validate.Struct(req)).To(Succeed())
+	})
+
+	It("fails for a penalty try that names a player", func() {
+		playerID := uuid.New()
+		req := GameEventRequest{TeamID: uuid.New(), PlayerID: &playerID, Type: GameEventTypePenaltyTry, Minute: 79}
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("no_player_for_penalty_tries"))
+	})
+
+	It("fails for a card without a player", func() {
+		req := GameEventRequest{TeamID: uuid.New(), Type: GameEventTypeYellowCard, Minute: 30}
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("player_required_for_event"))
+	})
+
+	It("fails for an unknown event type", func() {
+		playerID := uuid.New()
+		req := GameEventRequest{TeamID: uuid.New(), PlayerID: &playerID, Type: "mark", Minute: 30}
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("game_event_type"))
+	})
+})
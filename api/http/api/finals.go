@@ -0,0 +1,56 @@
+package api
+
+import "github.com/go-playground/validator/v10"
+
+// FinalsFormat sets how many teams from the top of the ladder qualify for finals.
+// Byes go to the top seeds when the field is not a power of two, so top_6 sends
+// seeds 1 and 2 straight to the semi-finals.
+type FinalsFormat string
+
+const (
+	FinalsFormatTop4 FinalsFormat = "top_4"
+	FinalsFormatTop6 FinalsFormat = "top_6"
+	FinalsFormatTop8 FinalsFormat = "top_8"
+)
+
+// FinalsRequest configures a season's finals. Each finals round is played in the next
+// finals stage by order_index, RoundIntervalDays after the latest game in the season.
+type FinalsRequest struct {
+	Format            FinalsFormat `json:"format" validate:"required,finals_format" example:"top_6"`
+	RoundIntervalDays int          `json:"round_interval_days" validate:"omitempty,min=1,max=28" example:"7"`
+}
+
+func (r *FinalsRequest) SetDefaults() {
+	if r.RoundIntervalDays == 0 {
+		r.RoundIntervalDays = DefaultRoundIntervalDays
+	}
+}
+
+type FinalsSeedResponse struct {
+	Seed   int                `json:"seed" example:"1"`
+	Team   TeamSummary        `json:"team"`
+	Points int                `json:"points" example:"52"`
+	Record TeamRecordResponse `json:"record"`
+}
+
+type FinalsRoundResponse struct {
+	Stage StageSummary   `json:"stage"`
+	Games []GameResponse `json:"games"`
+}
+
+// FinalsResponse is a season's finals bracket. Seeds stay empty until every regular
+// season game is finished.
+type FinalsResponse struct {
+	Format            FinalsFormat          `json:"format"`
+	RoundIntervalDays int32                 `json:"round_interval_days"`
+	Seeds             []FinalsSeedResponse  `json:"seeds"`
+	Rounds            []FinalsRoundResponse `json:"rounds"`
+}
+
+func ValidateFinalsFormat(fl validator.FieldLevel) bool {
+	switch FinalsFormat(fl.Field().String()) {
+	case FinalsFormatTop4, FinalsFormatTop6, FinalsFormatTop8:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FinalsRequest", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterValidation("finals_format", ValidateFinalsFormat)
+	})
+
+	It("accepts every supported format", func() {
+		for _, format := range []FinalsFormat{FinalsFormatTop4, FinalsFormatTop6, FinalsFormatTop8} {
+			Expect(validate.Struct(FinalsRequest{Format: format})).To(Succeed())
+		}
+	})
+
+	It("rejects an unknown format", func() {
+		Expect(validate.Struct(FinalsRequest{Format: "top_5"})).NotTo(Succeed())
+	})
+
+	It("rejects a round interval over four weeks", func() {
+		Expect(validate.Struct(FinalsRequest{Format: FinalsFormatTop4, RoundIntervalDays: 29})).NotTo(Succeed())
+	})
+
+	It("defaults the round interval", func() {
+		req := FinalsRequest{Format: FinalsFormatTop4}
+		req.SetDefaults()
+		Expect(req.RoundIntervalDays).To(Equal(DefaultRoundIntervalDays))
+	})
+})
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultRoundIntervalDays is the gap between generated rounds when none is given.
+const DefaultRoundIntervalDays = 7
+
+// FixtureGenerateRequest describes a round-robin draw for a season. Each regular stage,
+// in order_index order, holds one round. Kickoff slots are offsets from the round date
+// and are handed out to a round's games in turn; without any, every game kicks off at
+// the time of day of FirstRoundDate.
+type FixtureGenerateRequest struct {
+	DoubleRoundRobin  bool          `json:"double_round_robin"`
+	FirstRoundDate    time.Time     `json:"first_round_date" validate:"required" example:"2025-08-01T00:00:00Z"`
+	RoundIntervalDays int           `json:"round_interval_days" validate:"omitempty,min=1,max=28" example:"7"`
+	KickoffSlots      []KickoffSlot `json:"kickoff_slots" validate:"omitempty,max=20,dive"`
+}
+
+// KickoffSlot is a kickoff time DayOffset days after the round date, e.g. Saturday 19:05
+// for a round starting on a Friday is {1, "19:05"}.
+type KickoffSlot struct {
+	DayOffset int    `json:"day_offset" validate:"min=0,max=6" example:"1"`
+	Time      string `json:"time" validate:"required,datetime=15:04" example:"19:05"`
+}
+
+func (r *FixtureGenerateRequest) SetDefaults() {
+	if r.RoundIntervalDays == 0 {
+		r.RoundIntervalDays = DefaultRoundIntervalDays
+	}
+	if len(r.KickoffSlots) == 0 {
+		r.KickoffSlots = []KickoffSlot{{Time: r.FirstRoundDate.Format("15:04")}}
+	}
+}
+
+// FixtureGenerateQuery holds the query parameters for fixture generation. A dry run
+// returns the draw without saving it.
+type FixtureGenerateQuery struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type FixtureGameResponse struct {
+	// Only set once the game has been saved.
+	ID       *uuid.UUID  `json:"id,omitempty"`
+	Date     time.Time   `json:"date"`
+	HomeTeam TeamSummary `json:"home_team"`
+	AwayTeam TeamSummary `json:"away_team"`
+}
+
+type FixtureRoundResponse struct {
+	Stage StageSummary          `json:"stage"`
+	Games []FixtureGameResponse `json:"games"`
+	Byes  []TeamSummary         `json:"byes"`
+}
+
+type FixtureGenerateResponse struct {
+	DryRun    bool                   `json:"dry_run"`
+	GameCount int                    `json:"game_count"`
+	Rounds    []FixtureRoundResponse `json:"rounds"`
+}
+
+// DefaultMinRestDays is the fewest full days a team must have off between games when
+// FIXTURE_MIN_REST_DAYS is not set.
+const DefaultMinRestDays = 3
+
+// FixtureRule names a scheduling rule that a season's games can break.
+type FixtureRule string
+
+const (
+	FixtureRuleSelfMatch    FixtureRule = "self_match"
+	FixtureRuleDoubleBooked FixtureRule = "team_double_booked"
+	FixtureRuleMinRestDays  FixtureRule = "min_rest_days"
+)
+
+// FixtureValidateQuery holds the query parameters for a fixture report. MinRestDays
+// overrides the configured minimum for this report only; 0 skips the rest-day check.
+type FixtureValidateQuery struct {
+	MinRestDays *int `form:"min_rest_days" validate:"omitempty,min=0,max=28" example:"3"`
+}
+
+type FixtureIssueResponse struct {
+	Rule    FixtureRule `json:"rule" example:"team_double_booked"`
+	TeamID  uuid.UUID   `json:"team_id"`
+	GameIDs []uuid.UUID `json:"game_ids"`
+	Message string      `json:"message" example:"Auckland plays twice in Round 1"`
+}
+
+type FixtureValidateResponse struct {
+	Valid       bool                   `json:"valid"`
+	GameCount   int                    `json:"game_count"`
+	MinRestDays int                    `json:"min_rest_days"`
+	Issues      []FixtureIssueResponse `json:"issues"`
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
@@ -22,7 +24,11 @@ func (gs GameStatus) String() string {
 	return string(gs)
 }
 
+// GameRequest saves a game. A new game without a venue_id is played at the home team's
+// home venue for the season, unless neutral_venue is set, which requires a venue_id.
 type GameRequest struct {
+	// ID is only read by the batch endpoint, where it marks a game to update.
+	ID         *uuid.UUID `json:"id,omitempty" swaggerignore:"true"`
 	StageID    uuid.UUID  `json:"stage_id" validate:"required,uuid" swaggertype:"string" example:"eab15533-dea6-4a3d-8a95-d38e4fba2d5a"`
 	Date       time.Time  `json:"date" validate:"required" example:"2025-08-02T00:00:00Z"`
 	HomeTeamID uuid.UUID  `json:"home_team_id" validate:"required,uuid" swaggertype:"string" example:"013952a5-87e1-4d26-a312-09b2aff54241"`
@@ -30,54 +36,264 @@ type GameRequest struct {
 	HomeScore  *int32     `json:"home_score,omitempty" validate:"omitempty,min=0"`
 	AwayScore  *int32     `json:"away_score,omitempty" validate:"omitempty,min=0"`
 	Status     GameStatus `json:"status,omitempty" validate:"omitempty,game_status" example:"playing"`
+	VenueID    *uuid.UUID `json:"venue_id,omitempty" swaggertype:"string" example:"5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01"`
+	Neutral    bool       `json:"neutral_venue,omitempty"`
 }
 
-type GameResponse struct {
-	ID         uuid.UUID  `json:"id"`
-	SeasonID   uuid.UUID  `json:"season_id"`
-	StageID    uuid.UUID  `json:"stage_id"`
-	Date       time.Time  `json:"date"`
-	HomeTeamID uuid.UUID  `json:"home_team_id"`
-	AwayTeamID uuid.UUID  `json:"away_team_id"`
-	HomeScore  *int32     `json:"home_score,omitempty"`
-	AwayScore  *int32     `json:"away_score,omitempty"`
-	Status     GameStatus `json:"status"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
-	DeletedAt  zero.Time  `json:"deleted_at"`
+// GameBatchRequest creates and updates up to 100 games in one transaction. Games with
+// an id update that game and games without one are created.
+type GameBatchRequest struct {
+	Games []GameRequest `json:"games" validate:"required,min=1,max=100,dive"`
 }
 
-func ToGameResponse(g db.Game) GameResponse {
-	var homeScore *int32
-	if g.HomeScore.Valid {
-		h := int32(g.HomeScore.Int32)
-		homeScore = &h
-	}
+// GameBatchResponse lists the saved games in request order.
+type GameBatchResponse struct {
+	Created int            `json:"created" example:"2"`
+	Updated int            `json:"updated" example:"6"`
+	Games   []GameResponse `json:"games"`
+}
 
-	var awayScore *int32
-	if g.AwayScore.Valid {
-		a := int32(g.AwayScore.Int32)
-		awayScore = &a
+const (
+	GameSortDateAsc  = "date"
+	GameSortDateDesc = "-date"
+)
+
+// GameListRequest holds the query parameters for listing a season's games. From and
+// To are RFC 3339 timestamps and bound the game date inclusively.
+type GameListRequest struct {
+	PaginationRequest
+	StageID string     `form:"stage_id" validate:"omitempty,uuid"`
+	TeamID  string     `form:"team_id" validate:"omitempty,uuid"`
+	Status  GameStatus `form:"status" validate:"omitempty,game_status"`
+	From    time.Time  `form:"from"`
+	To      time.Time  `form:"to" validate:"omitempty,gtefield=From"`
+	Sort    string     `form:"sort" validate:"omitempty,oneof=date -date"`
+}
+
+const (
+	GameExpandTeams = "teams"
+	GameExpandStage = "stage"
+)
+
+// GameExpandRequest holds the expand query parameter accepted by game endpoints, a
+// comma-separated list of related resources to embed in each game.
+type GameExpandRequest struct {
+	Expand string `form:"expand" validate:"omitempty,game_expand" example:"teams,stage"`
+}
+
+// Includes reports whether the client asked for the named resource to be embedded.
+func (q GameExpandRequest) Includes(name string) bool {
+	for _, e := range strings.Split(q.Expand, ",") {
+		if strings.TrimSpace(e) == name {
+			return true
+		}
 	}
+	return false
+}
 
-	status := GameStatus(g.Status)
+// Any reports whether the client asked for anything to be embedded.
+func (q GameExpandRequest) Any() bool {
+	return q.Includes(GameExpandTeams) || q.Includes(GameExpandStage)
+}
 
-	return GameResponse{
-		ID:         g.ID,
-		SeasonID:   g.SeasonID,
+// GameFeedRequest holds the query parameters for the cross-competition games feed.
+// Stages belong to a single season, so unlike GameListRequest it has no stage filter.
+type GameFeedRequest struct {
+	PaginationRequest
+	CompetitionID string     `form:"competition_id" validate:"omitempty,uuid"`
+	TeamID        string     `form:"team_id" validate:"omitempty,uuid"`
+	Status        GameStatus `form:"status" validate:"omitempty,game_status"`
+	From          time.Time  `form:"from"`
+	To            time.Time  `form:"to" validate:"omitempty,gtefield=From"`
+	Sort          string     `form:"sort" validate:"omitempty,oneof=date -date"`
+}
+
+type GameResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	SeasonID     uuid.UUID  `json:"season_id"`
+	StageID      uuid.UUID  `json:"stage_id"`
+	Date         time.Time  `json:"date"`
+	HomeTeamID   uuid.UUID  `json:"home_team_id"`
+	AwayTeamID   uuid.UUID  `json:"away_team_id"`
+	HomeScore    *int32     `json:"home_score,omitempty"`
+	AwayScore    *int32     `json:"away_score,omitempty"`
+	Status       GameStatus `json:"status"`
+	VenueID      *uuid.UUID `json:"venue_id" swaggertype:"string"`
+	NeutralVenue bool       `json:"neutral_venue"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    zero.Time  `json:"deleted_at"`
+
+	// LocalKickoff is Date in the venue's timezone, set when the game has a venue.
+	LocalKickoff *time.Time `json:"local_kickoff,omitempty" example:"2025-08-02T19:05:00+12:00"`
+
+	// Only set when requested with expand.
+	HomeTeam *TeamSummary  `json:"home_team,omitempty"`
+	AwayTeam *TeamSummary  `json:"away_team,omitempty"`
+	Stage    *StageSummary `json:"stage,omitempty"`
+}
+
+// ToGameRequest returns the request that would save g as it is, which a merge patch
+// is then applied to.
+func ToGameRequest(g db.Game) GameRequest {
+	return GameRequest{
 		StageID:    g.StageID,
 		Date:       g.Date,
 		HomeTeamID: g.HomeTeamID,
 		AwayTeamID: g.AwayTeamID,
-		HomeScore:  homeScore,
-		AwayScore:  awayScore,
-		Status:     status,
-		CreatedAt:  g.CreatedAt,
-		UpdatedAt:  g.UpdatedAt,
-		DeletedAt:  zero.TimeFrom(g.DeletedAt.Time),
+		HomeScore:  toInt32Ptr(g.HomeScore),
+		AwayScore:  toInt32Ptr(g.AwayScore),
+		Status:     GameStatus(g.Status),
+		VenueID:    toUUIDPtr(g.VenueID),
+		Neutral:    g.NeutralVenue,
+	}
+}
+
+func ToGameResponse(g db.Game) GameResponse {
+	return GameResponse{
+		ID:           g.ID,
+		SeasonID:     g.SeasonID,
+		StageID:      g.StageID,
+		Date:         g.Date,
+		HomeTeamID:   g.HomeTeamID,
+		AwayTeamID:   g.AwayTeamID,
+		HomeScore:    toInt32Ptr(g.HomeScore),
+		AwayScore:    toInt32Ptr(g.AwayScore),
+		Status:       GameStatus(g.Status),
+		VenueID:      toUUIDPtr(g.VenueID),
+		NeutralVenue: g.NeutralVenue,
+		CreatedAt:    g.CreatedAt,
+		UpdatedAt:    g.UpdatedAt,
+		DeletedAt:    zero.TimeFrom(g.DeletedAt.Time),
 	}
 }
 
+// Expand embeds the teams and stage from d that expand asks for.
+func (r *GameResponse) Expand(d db.GetGameDetailsRow, expand GameExpandRequest) {
+	if expand.Includes(GameExpandTeams) {
+		r.HomeTeam = &TeamSummary{
+			ID:              d.HomeTeamID,
+			Name:            d.HomeTeamName,
+			Abbreviation:    d.HomeTeamAbbreviation,
+			ShortName:       d.HomeTeamShortName.String,
+			LogoURL:         d.HomeTeamLogoUrl.String,
+			PrimaryColour:   d.HomeTeamPrimaryColour.String,
+			SecondaryColour: d.HomeTeamSecondaryColour.String,
+		}
+		r.AwayTeam = &TeamSummary{
+			ID:              d.AwayTeamID,
+			Name:            d.AwayTeamName,
+			Abbreviation:    d.AwayTeamAbbreviation,
+			ShortName:       d.AwayTeamShortName.String,
+			LogoURL:         d.AwayTeamLogoUrl.String,
+			PrimaryColour:   d.AwayTeamPrimaryColour.String,
+			SecondaryColour: d.AwayTeamSecondaryColour.String,
+		}
+	}
+	if expand.Includes(GameExpandStage) {
+		r.Stage = &StageSummary{
+			ID:         d.StageID,
+			Name:       d.StageName,
+			StageType:  StageType(d.StageType),
+			OrderIndex: d.StageOrderIndex,
+		}
+	}
+}
+
+// Localize sets LocalKickoff from the venue timezone in d. Games without a venue, or
+// whose venue timezone cannot be loaded, are left without one.
+func (r *GameResponse) Localize(d db.GetGameDetailsRow) {
+	r.LocalKickoff = localKickoff(r.Date, d.VenueTimezone)
+}
+
+// GameFeedResponse is a game with the competition, stage and team details needed
+// to display it outside of its season.
+type GameFeedResponse struct {
+	ID              uuid.UUID   `json:"id"`
+	CompetitionID   uuid.UUID   `json:"competition_id"`
+	CompetitionName string      `json:"competition_name"`
+	SeasonID        uuid.UUID   `json:"season_id"`
+	StageID         uuid.UUID   `json:"stage_id"`
+	StageName       string      `json:"stage_name"`
+	Date            time.Time   `json:"date"`
+	HomeTeam        TeamSummary `json:"home_team"`
+	AwayTeam        TeamSummary `json:"away_team"`
+	HomeScore       *int32      `json:"home_score,omitempty"`
+	AwayScore       *int32      `json:"away_score,omitempty"`
+	Status          GameStatus  `json:"status"`
+	VenueID         *uuid.UUID  `json:"venue_id" swaggertype:"string"`
+	NeutralVenue    bool        `json:"neutral_venue"`
+	LocalKickoff    *time.Time  `json:"local_kickoff,omitempty" example:"2025-08-02T19:05:00+12:00"`
+}
+
+func ToGameFeedResponse(g db.GetGameFeedRow) GameFeedResponse {
+	return GameFeedResponse{
+		ID:              g.ID,
+		CompetitionID:   g.CompetitionID,
+		CompetitionName: g.CompetitionName,
+		SeasonID:        g.SeasonID,
+		StageID:         g.StageID,
+		StageName:       g.StageName,
+		Date:            g.Date,
+		HomeTeam: TeamSummary{
+			ID:              g.HomeTeamID,
+			Name:            g.HomeTeamName,
+			Abbreviation:    g.HomeTeamAbbreviation,
+			ShortName:       g.HomeTeamShortName.String,
+			LogoURL:         g.HomeTeamLogoUrl.String,
+			PrimaryColour:   g.HomeTeamPrimaryColour.String,
+			SecondaryColour: g.HomeTeamSecondaryColour.String,
+		},
+		AwayTeam: TeamSummary{
+			ID:              g.AwayTeamID,
+			Name:            g.AwayTeamName,
+			Abbreviation:    g.AwayTeamAbbreviation,
+			ShortName:       g.AwayTeamShortName.String,
+			LogoURL:         g.AwayTeamLogoUrl.String,
+			PrimaryColour:   g.AwayTeamPrimaryColour.String,
+			SecondaryColour: g.AwayTeamSecondaryColour.String,
+		},
+		HomeScore:    toInt32Ptr(g.HomeScore),
+		AwayScore:    toInt32Ptr(g.AwayScore),
+		Status:       GameStatus(g.Status),
+		VenueID:      toUUIDPtr(g.VenueID),
+		NeutralVenue: g.NeutralVenue,
+		LocalKickoff: localKickoff(g.Date, g.VenueTimezone),
+	}
+}
+
+// localKickoff returns date in timezone, or nil when there is no timezone or it is not
+// a known IANA zone.
+func localKickoff(date time.Time, timezone sql.NullString) *time.Time {
+	if !timezone.Valid {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(timezone.String)
+	if err != nil {
+		return nil
+	}
+
+	local := date.In(loc)
+	return &local
+}
+
+func toUUIDPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	v := id.UUID
+	return &v
+}
+
+func toInt32Ptr(i sql.NullInt32) *int32 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int32
+	return &v
+}
+
 func ValidateGameStatus(fl validator.FieldLevel) bool {
 	status, ok := fl.Field().Interface().(GameStatus)
 	if !ok {
@@ -92,39 +308,57 @@ func ValidateGameStatus(fl validator.FieldLevel) bool {
 	}
 }
 
+// ValidateGameExpand checks that every comma-separated expand value is one the game
+// endpoints support.
+func ValidateGameExpand(fl validator.FieldLevel) bool {
+	for _, e := range strings.Split(fl.Field().String(), ",") {
+		switch strings.TrimSpace(e) {
+		case GameExpandTeams, GameExpandStage:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func ValidateGameRequest(sl validator.StructLevel) {
 	game := sl.Current().Interface().(GameRequest)
 
 	// Teams must be different
 	if game.HomeTeamID == game.AwayTeamID {
-		sl.ReportError(game.HomeTeamID, "HomeTeamID", "home_team_id", "home_and_away_teams_must_differ", "")
-		sl.ReportError(game.AwayTeamID, "AwayTeamID", "away_team_id", "home_and_away_teams_must_differ", "")
+		sl.ReportError(game.HomeTeamID, "home_team_id", "HomeTeamID", "home_and_away_teams_must_differ", "")
+		sl.ReportError(game.AwayTeamID, "away_team_id", "AwayTeamID", "home_and_away_teams_must_differ", "")
 	}
 
 	// Scheduled games must NOT have scores
 	if game.Status == GameStatusScheduled && (game.HomeScore != nil || game.AwayScore != nil) {
-		sl.ReportError(game.HomeScore, "HomeScore", "home_score", "no_scores_for_scheduled_games", "")
-		sl.ReportError(game.AwayScore, "AwayScore", "away_score", "no_scores_for_scheduled_games", "")
+		sl.ReportError(game.HomeScore, "home_score", "HomeScore", "no_scores_for_scheduled_games", "")
+		sl.ReportError(game.AwayScore, "away_score", "AwayScore", "no_scores_for_scheduled_games", "")
 	}
 
 	// Cancelled games must NOT have scores
 	if game.Status == GameStatusCancelled && (game.HomeScore != nil || game.AwayScore != nil) {
-		sl.ReportError(game.HomeScore, "HomeScore", "home_score", "no_scores_for_cancelled_games", "")
-		sl.ReportError(game.AwayScore, "AwayScore", "away_score", "no_scores_for_cancelled_games", "")
+		sl.ReportError(game.HomeScore, "home_score", "HomeScore", "no_scores_for_cancelled_games", "")
+		sl.ReportError(game.AwayScore, "away_score", "AwayScore", "no_scores_for_cancelled_games", "")
 	}
 
 	// Playing or Finished games must have scores
 	if (game.Status == GameStatusPlaying || game.Status == GameStatusFinished) &&
 		(game.HomeScore == nil || game.AwayScore == nil) {
-		sl.ReportError(game.HomeScore, "HomeScore", "home_score", "scores_required_for_playing_or_finished_games", "")
-		sl.ReportError(game.AwayScore, "AwayScore", "away_score", "scores_required_for_playing_or_finished_games", "")
+		sl.ReportError(game.HomeScore, "home_score", "HomeScore", "scores_required_for_playing_or_finished_games", "")
+		sl.ReportError(game.AwayScore, "away_score", "AwayScore", "scores_required_for_playing_or_finished_games", "")
 	}
 
 	// Scores cannot be negative
 	if game.HomeScore != nil && *game.HomeScore < 0 {
-		sl.ReportError(game.HomeScore, "HomeScore", "home_score", "scores_cannot_be_negative", "")
+		sl.ReportError(game.HomeScore, "home_score", "HomeScore", "scores_cannot_be_negative", "")
 	}
 	if game.AwayScore != nil && *game.AwayScore < 0 {
-		sl.ReportError(game.AwayScore, "AwayScore", "away_score", "scores_cannot_be_negative", "")
+		sl.ReportError(game.AwayScore, "away_score", "AwayScore", "scores_cannot_be_negative", "")
+	}
+
+	// Neutral games must say where they are played
+	if game.Neutral && game.VenueID == nil {
+		sl.ReportError(game.VenueID, "venue_id", "VenueID", "venue_required_for_neutral_games", "")
 	}
 }
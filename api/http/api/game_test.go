@@ -1,8 +1,10 @@
 package api
 
 import (
+	"database/sql"
 	"time"
 
+	"github.com/bradley-adams/gainline/db/db"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -168,6 +170,30 @@ var _ = Describe("GameRequest validation", func() {
 		Expect(validate.Struct(&game)).To(HaveOccurred())
 	})
 
+	It("fails if a neutral game has no venue", func() {
+		game := GameRequest{
+			StageID:    stage,
+			Date:       date1,
+			HomeTeamID: team1,
+			AwayTeamID: team2,
+			Neutral:    true,
+		}
+		Expect(validate.Struct(&game)).To(HaveOccurred())
+	})
+
+	It("passes with a neutral game at a venue", func() {
+		venue := uuid.MustParse("5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01")
+		game := GameRequest{
+			StageID:    stage,
+			Date:       date1,
+			HomeTeamID: team1,
+			AwayTeamID: team2,
+			VenueID:    &venue,
+			Neutral:    true,
+		}
+		Expect(validate.Struct(&game)).NotTo(HaveOccurred())
+	})
+
 	It("passes if Status is empty and game is scheduled (omitempty)", func() {
 		game := GameRequest{
 			StageID:    stage,
@@ -179,3 +205,55 @@ var _ = Describe("GameRequest validation", func() {
 		Expect(validate.Struct(&game)).NotTo(HaveOccurred())
 	})
 })
+
+var _ = Describe("GameExpandRequest", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterValidation("game_expand", ValidateGameExpand)
+	})
+
+	It("accepts teams and stage in any order", func() {
+		q := GameExpandRequest{Expand: "stage, teams"}
+		Expect(validate.Struct(q)).To(Succeed())
+		Expect(q.Includes(GameExpandTeams)).To(BeTrue())
+		Expect(q.Includes(GameExpandStage)).To(BeTrue())
+	})
+
+	It("accepts an empty expand", func() {
+		q := GameExpandRequest{}
+		Expect(validate.Struct(q)).To(Succeed())
+		Expect(q.Any()).To(BeFalse())
+	})
+
+	It("rejects unknown values", func() {
+		Expect(validate.Struct(GameExpandRequest{Expand: "teams,venue"})).NotTo(Succeed())
+	})
+})
+
+var _ = Describe("GameResponse Expand", func() {
+	It("embeds the team branding with the teams", func() {
+		r := GameResponse{}
+		r.Expand(db.GetGameDetailsRow{
+			HomeTeamName:            "Chiefs",
+			HomeTeamShortName:       sql.NullString{String: "Chiefs", Valid: true},
+			HomeTeamLogoUrl:         sql.NullString{String: "https://example.com/chiefs.png", Valid: true},
+			HomeTeamPrimaryColour:   sql.NullString{String: "#FFD700", Valid: true},
+			HomeTeamSecondaryColour: sql.NullString{String: "#000000", Valid: true},
+			AwayTeamName:            "Blues",
+			AwayTeamPrimaryColour:   sql.NullString{String: "#0033A0", Valid: true},
+		}, GameExpandRequest{Expand: "teams"})
+
+		Expect(*r.HomeTeam).To(Equal(TeamSummary{
+			Name:            "Chiefs",
+			ShortName:       "Chiefs",
+			LogoURL:         "https://example.com/chiefs.png",
+			PrimaryColour:   "#FFD700",
+			SecondaryColour: "#000000",
+		}))
+		Expect(r.AwayTeam.PrimaryColour).To(Equal("#0033A0"))
+		Expect(r.AwayTeam.SecondaryColour).To(BeEmpty())
+		Expect(r.Stage).To(BeNil())
+	})
+})
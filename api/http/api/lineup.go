@@ -0,0 +1,116 @@
+package api
+
+import (
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// StartingShirts is the number of players who start a game. Shirts 1-15 start and
+// 16-23 are the bench.
+const StartingShirts = 15
+
+// LineupRequest is a side's matchday team sheet. It replaces any sheet already saved.
+type LineupRequest struct {
+	Players   []LineupPlayerRequest `json:"players" validate:"required,min=15,max=23,dive"`
+	CaptainID uuid.UUID             `json:"captain_id" validate:"required" example:"7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"`
+}
+
+type LineupPlayerRequest struct {
+	PlayerID    uuid.UUID `json:"player_id" validate:"required" example:"7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"`
+	ShirtNumber int32     `json:"shirt_number" validate:"required,min=1,max=23" example:"8"`
+}
+
+// ReplacementRequest records a bench player coming on for a player on the field.
+type ReplacementRequest struct {
+	PlayerOffID uuid.UUID `json:"player_off_id" validate:"required" example:"7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10"`
+	PlayerOnID  uuid.UUID `json:"player_on_id" validate:"required" example:"8d2a4b63-7f1c-4e9b-a3c5-2b0f6e4d8c21"`
+	Minute      int32     `json:"minute" validate:"required,min=1,max=120" example:"55"`
+}
+
+type GameLineupsResponse struct {
+	GameID uuid.UUID          `json:"game_id"`
+	Home   TeamLineupResponse `json:"home"`
+	Away   TeamLineupResponse `json:"away"`
+}
+
+type TeamLineupResponse struct {
+	TeamID       uuid.UUID              `json:"team_id"`
+	CaptainID    *uuid.UUID             `json:"captain_id,omitempty"`
+	Starters     []LineupPlayerResponse `json:"starters"`
+	Bench        []LineupPlayerResponse `json:"bench"`
+	Replacements []ReplacementResponse  `json:"replacements"`
+}
+
+type LineupPlayerResponse struct {
+	ShirtNumber int32         `json:"shirt_number"`
+	Player      PlayerSummary `json:"player"`
+	Captain     bool          `json:"captain"`
+}
+
+type ReplacementResponse struct {
+	ID          uuid.UUID `json:"id"`
+	PlayerOffID uuid.UUID `json:"player_off_id"`
+	PlayerOnID  uuid.UUID `json:"player_on_id"`
+	Minute      int32     `json:"minute"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func ToLineupPlayerResponse(l db.GameLineupPlayer, p db.Player) LineupPlayerResponse {
+	return LineupPlayerResponse{
+		ShirtNumber: l.ShirtNumber,
+		Player:      ToPlayerSummary(p),
+		Captain:     l.Captain,
+	}
+}
+
+func ToReplacementResponse(r db.GameReplacement) ReplacementResponse {
+	return ReplacementResponse{
+		ID:          r.ID,
+		PlayerOffID: r.PlayerOffID,
+		PlayerOnID:  r.PlayerOnID,
+		Minute:      r.Minute,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+func ValidateLineupRequest(sl validator.StructLevel) {
+	req := sl.Current().Interface().(LineupRequest)
+
+	shirts := make(map[int32]bool, len(req.Players))
+	players := make(map[uuid.UUID]bool, len(req.Players))
+	for _, p := range req.Players {
+		if shirts[p.ShirtNumber] {
+			sl.ReportError(req.Players, "players", "Players", "duplicate_shirt_number", "")
+			return
+		}
+		if players[p.PlayerID] {
+			sl.ReportError(req.Players, "players", "Players", "duplicate_lineup_player", "")
+			return
+		}
+		shirts[p.ShirtNumber] = true
+		players[p.PlayerID] = true
+	}
+
+	// Every starting shirt must be filled; the bench can be short
+	for i := int32(1); i <= StartingShirts; i++ {
+		if !shirts[i] {
+			sl.ReportError(req.Players, "players", "Players", "incomplete_starting_xv", "")
+			return
+		}
+	}
+
+	if req.CaptainID != uuid.Nil && !players[req.CaptainID] {
+		sl.ReportError(req.CaptainID, "captain_id", "CaptainID", "captain_not_in_lineup", "")
+	}
+}
+
+func ValidateReplacementRequest(sl validator.StructLevel) {
+	req := sl.Current().Interface().(ReplacementRequest)
+
+	if req.PlayerOffID != uuid.Nil && req.PlayerOffID == req.PlayerOnID {
+		sl.ReportError(req.PlayerOnID, "player_on_id", "PlayerOnID", "replacement_players_must_differ", "")
+	}
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LineupRequest validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterStructValidation(ValidateLineupRequest, LineupRequest{})
+	})
+
+	// valid returns a team sheet of n players wearing shirts 1 to n
+	valid := func(n int) LineupRequest {
+		req := LineupRequest{}
+		for i := 1; i <= n; i++ {
+			req.Players = append(req.Players, LineupPlayerRequest{PlayerID: uuid.New(), ShirtNumber: int32(i)})
+		}
+		req.CaptainID = req.Players[0].PlayerID
+		return req
+	}
+
+	tagOf := func(err error) string {
+		return err.(validator.ValidationErrors)[0].Tag()
+	}
+
+	It("passes for a starting XV without a bench", func() {
+		Expect(validate.Struct(valid(15))).To(Succeed())
+	})
+
+	It("passes for a full matchday 23", func() {
+		Expect(validate.Struct(valid(23))).To(Succeed())
+	})
+
+	It("fails for more than 23 players", func() {
+		req := valid(23)
+		req.Players = append(req.Players, LineupPlayerRequest{PlayerID: uuid.New(), ShirtNumber: 23})
+
+		Expect(validate.Struct(req)).To(HaveOccurred())
+	})
+
+	It("fails when two players share a shirt", func() {
+		req := valid(16)
+		req.Players[15].ShirtNumber = 1
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("duplicate_shirt_number"))
+	})
+
+	It("fails when a player is named twice", func() {
+		req := valid(16)
+		req.Players[15].PlayerID = req.Players[0].PlayerID
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("duplicate_lineup_player"))
+	})
+
+	It("fails when a starting shirt is empty", func() {
+		req := valid(16)
+		req.Players = append(req.Players[:4], req.Players[5:]...)
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("incomplete_starting_xv"))
+	})
+
+	It("fails when the captain is not on the sheet", func() {
+		req := valid(15)
+		req.CaptainID = uuid.New()
+
+		Expect(tagOf(validate.Struct(req))).To(Equal("captain_not_in_lineup"))
+	})
+})
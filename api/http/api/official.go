@@ -0,0 +1,133 @@
+package api
+
+import (
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/guregu/null/zero"
+)
+
+// OfficialRole is the role an official has in a game.
+type OfficialRole string
+
+const (
+	OfficialRoleReferee          OfficialRole = "referee"
+	OfficialRoleAssistantReferee OfficialRole = "assistant_referee"
+	OfficialRoleTMO              OfficialRole = "tmo"
+)
+
+// OfficialRequest describes a match official.
+type OfficialRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100,entity_name" example:"Ben O'Keeffe"`
+	Nationality string `json:"nationality,omitempty" validate:"omitempty,min=2,max=100" example:"New Zealand"`
+}
+
+type OfficialResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Nationality string    `json:"nationality,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	DeletedAt   zero.Time `json:"deleted_at"`
+}
+
+// ToOfficialRequest returns the request that would save o as it is.
+func ToOfficialRequest(o db.Official) OfficialRequest {
+	return OfficialRequest{
+		Name:        o.Name,
+		Nationality: o.Nationality.String,
+	}
+}
+
+func ToOfficialResponse(o db.Official) OfficialResponse {
+	return OfficialResponse{
+		ID:          o.ID,
+		Name:        o.Name,
+		Nationality: o.Nationality.String,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+		DeletedAt:   zero.TimeFrom(o.DeletedAt.Time),
+	}
+}
+
+// OfficialSummary is the subset of an official embedded in other resources.
+type OfficialSummary struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func ToOfficialSummary(o db.Official) OfficialSummary {
+	return OfficialSummary{
+		ID:   o.ID,
+		Name: o.Name,
+	}
+}
+
+// GameOfficialRequest appoints an official to a game, or changes their role in it.
+type GameOfficialRequest struct {
+	Role OfficialRole `json:"role" validate:"required,official_role" example:"referee"`
+}
+
+// GameOfficialResponse is an official's appointment to a game.
+type GameOfficialResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	Official  OfficialSummary `json:"official"`
+	Role      OfficialRole    `json:"role"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+func ToGameOfficialResponse(g db.GameOfficial, o db.Official) GameOfficialResponse {
+	return GameOfficialResponse{
+		ID:        g.ID,
+		Official:  ToOfficialSummary(o),
+		Role:      OfficialRole(g.Role),
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
+
+// AppointmentResponse is a game an official is appointed to.
+type AppointmentResponse struct {
+	ID            uuid.UUID    `json:"id"`
+	Role          OfficialRole `json:"role"`
+	GameID        uuid.UUID    `json:"game_id"`
+	CompetitionID uuid.UUID    `json:"competition_id"`
+	SeasonID      uuid.UUID    `json:"season_id"`
+	Date          time.Time    `json:"date"`
+	HomeTeamID    uuid.UUID    `json:"home_team_id"`
+	AwayTeamID    uuid.UUID    `json:"away_team_id"`
+	Status        GameStatus   `json:"status"`
+}
+
+// OfficialAppointmentsResponse splits an official's appointments by kickoff. Upcoming
+// games are soonest first and past games most recent first.
+type OfficialAppointmentsResponse struct {
+	OfficialID uuid.UUID             `json:"official_id"`
+	Upcoming   []AppointmentResponse `json:"upcoming"`
+	Past       []AppointmentResponse `json:"past"`
+}
+
+func ToAppointmentResponse(a db.GetOfficialAppointmentsRow) AppointmentResponse {
+	return AppointmentResponse{
+		ID:            a.ID,
+		Role:          OfficialRole(a.Role),
+		GameID:        a.GameID,
+		CompetitionID: a.CompetitionID,
+		SeasonID:      a.SeasonID,
+		Date:          a.Date,
+		HomeTeamID:    a.HomeTeamID,
+		AwayTeamID:    a.AwayTeamID,
+		Status:        GameStatus(a.Status),
+	}
+}
+
+func ValidateOfficialRole(fl validator.FieldLevel) bool {
+	switch OfficialRole(fl.Field().String()) {
+	case OfficialRoleReferee, OfficialRoleAssistantReferee, OfficialRoleTMO:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GameOfficialRequest validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterValidation("official_role", ValidateOfficialRole)
+	})
+
+	It("passes for every official role", func() {
+		for _, role := range []OfficialRole{OfficialRoleReferee, OfficialRoleAssistantReferee, OfficialRoleTMO} {
+			Expect(validate.Struct(GameOfficialRequest{Role: role})).To(Succeed(), string(role))
+		}
+	})
+
+	It("fails for an unknown role", func() {
+		err := validate.Struct(GameOfficialRequest{Role: "touch_judge"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal("official_role"))
+	})
+})
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/guregu/null/zero"
+)
+
+// PlayerPosition is the position a player is picked in.
+type PlayerPosition string
+
+const (
+	PlayerPositionProp        PlayerPosition = "prop"
+	PlayerPositionHooker      PlayerPosition = "hooker"
+	PlayerPositionLock        PlayerPosition = "lock"
+	PlayerPositionFlanker     PlayerPosition = "flanker"
+	PlayerPositionNumberEight PlayerPosition = "number_eight"
+	PlayerPositionScrumHalf   PlayerPosition = "scrum_half"
+	PlayerPositionFlyHalf     PlayerPosition = "fly_half"
+	PlayerPositionCentre      PlayerPosition = "centre"
+	PlayerPositionWing        PlayerPosition = "wing"
+	PlayerPositionFullback    PlayerPosition = "fullback"
+)
+
+// PlayerRequest describes a player contracted to a team.
+type PlayerRequest struct {
+	Name        string         `json:"name" validate:"required,min=2,max=100,entity_name" example:"Ardie Savea"`
+	DateOfBirth *time.Time     `json:"date_of_birth,omitempty" validate:"omitempty,lt" example:"1993-10-14T00:00:00Z"`
+	Position    PlayerPosition `json:"position" validate:"required,player_position" example:"number_eight"`
+	Nationality string         `json:"nationality,omitempty" validate:"omitempty,min=2,max=100" example:"New Zealand"`
+}
+
+type PlayerResponse struct {
+	ID          uuid.UUID      `json:"id"`
+	TeamID      uuid.UUID      `json:"team_id"`
+	Name        string         `json:"name"`
+	DateOfBirth *time.Time     `json:"date_of_birth,omitempty"`
+	Position    PlayerPosition `json:"position"`
+	Nationality string         `json:"nationality,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   zero.Time      `json:"deleted_at"`
+}
+
+// ToPlayerRequest returns the request that would save p as it is.
+func ToPlayerRequest(p db.Player) PlayerRequest {
+	return PlayerRequest{
+		Name:        p.Name,
+		DateOfBirth: toTimePtr(p.DateOfBirth),
+		Position:    PlayerPosition(p.Position),
+		Nationality: p.Nationality.String,
+	}
+}
+
+func ToPlayerResponse(p db.Player) PlayerResponse {
+	return PlayerResponse{
+		ID:          p.ID,
+		TeamID:      p.TeamID,
+		Name:        p.Name,
+		DateOfBirth: toTimePtr(p.DateOfBirth),
+		Position:    PlayerPosition(p.Position),
+		Nationality: p.Nationality.String,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		DeletedAt:   zero.TimeFrom(p.DeletedAt.Time),
+	}
+}
+
+// PlayerSummary is the subset of a player embedded in other resources.
+type PlayerSummary struct {
+	ID       uuid.UUID      `json:"id"`
+	Name     string         `json:"name"`
+	Position PlayerPosition `json:"position"`
+}
+
+func ToPlayerSummary(p db.Player) PlayerSummary {
+	return PlayerSummary{
+		ID:       p.ID,
+		Name:     p.Name,
+		Position: PlayerPosition(p.Position),
+	}
+}
+
+// SquadPlayerRequest names a player in a team's squad for a season. Without active
+// dates the player is in the squad for the whole season; a jersey number can only be
+// worn by one player at a time.
+type SquadPlayerRequest struct {
+	JerseyNumber int32      `json:"jersey_number" validate:"required,min=1,max=99" example:"8"`
+	ActiveFrom   *time.Time `json:"active_from,omitempty" example:"2025-02-14T00:00:00Z"`
+	ActiveTo     *time.Time `json:"active_to,omitempty" example:"2025-06-21T00:00:00Z"`
+}
+
+// SquadPlayerResponse is a player's place in a team's squad for a season.
+type SquadPlayerResponse struct {
+	ID           uuid.UUID     `json:"id"`
+	Player       PlayerSummary `json:"player"`
+	JerseyNumber int32         `json:"jersey_number"`
+	ActiveFrom   *time.Time    `json:"active_from,omitempty"`
+	ActiveTo     *time.Time    `json:"active_to,omitempty"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+func ToSquadPlayerResponse(m db.SeasonTeamPlayer, p db.Player) SquadPlayerResponse {
+	return SquadPlayerResponse{
+		ID:           m.ID,
+		Player:       ToPlayerSummary(p),
+		JerseyNumber: m.JerseyNumber,
+		ActiveFrom:   toTimePtr(m.ActiveFrom),
+		ActiveTo:     toTimePtr(m.ActiveTo),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func ValidatePlayerPosition(fl validator.FieldLevel) bool {
+	switch PlayerPosition(fl.Field().String()) {
+	case PlayerPositionProp, PlayerPositionHooker, PlayerPositionLock, PlayerPositionFlanker,
+		PlayerPositionNumberEight, PlayerPositionScrumHalf, PlayerPositionFlyHalf,
+		PlayerPositionCentre, PlayerPositionWing, PlayerPositionFullback:
+		return true
+	}
+	return false
+}
+
+func ValidateSquadPlayerRequest(sl validator.StructLevel) {
+	req := sl.Current().Interface().(SquadPlayerRequest)
+
+	// A player cannot leave the squad before joining it
+	if req.ActiveFrom != nil && req.ActiveTo != nil && req.ActiveTo.Before(*req.ActiveFrom) {
+		sl.ReportError(req.ActiveTo, "active_to", "ActiveTo", "active_to_before_active_from", "")
+	}
+}
+
+func toTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
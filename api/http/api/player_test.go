@@ -0,0 +1,73 @@
+package api
+
+import (
+	"time"
+
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PlayerRequest validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterValidation("entity_name", validation.ValidateEntityName)
+		validate.RegisterValidation("player_position", ValidatePlayerPosition)
+	})
+
+	valid := func() PlayerRequest {
+		return PlayerRequest{
+			Name:     "Ardie Savea",
+			Position: PlayerPositionNumberEight,
+		}
+	}
+
+	It("passes without the optional details", func() {
+		Expect(validate.Struct(valid())).To(Succeed())
+	})
+
+	It("fails for an unknown position", func() {
+		req := valid()
+		req.Position = "rover"
+
+		Expect(validate.Struct(req)).To(HaveOccurred())
+	})
+
+	It("fails for a date of birth in the future", func() {
+		req := valid()
+		dob := time.Now().AddDate(1, 0, 0)
+		req.DateOfBirth = &dob
+
+		Expect(validate.Struct(req)).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("SquadPlayerRequest validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterStructValidation(ValidateSquadPlayerRequest, SquadPlayerRequest{})
+	})
+
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	It("passes with open-ended active dates", func() {
+		Expect(validate.Struct(SquadPlayerRequest{JerseyNumber: 8, ActiveFrom: &march})).To(Succeed())
+	})
+
+	It("fails for a jersey number out of range", func() {
+		Expect(validate.Struct(SquadPlayerRequest{JerseyNumber: 100})).To(HaveOccurred())
+	})
+
+	It("fails when active_to is before active_from", func() {
+		err := validate.Struct(SquadPlayerRequest{JerseyNumber: 8, ActiveFrom: &april, ActiveTo: &march})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal("active_to_before_active_from"))
+	})
+})
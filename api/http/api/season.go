@@ -8,7 +8,11 @@ import (
 	"github.com/guregu/null/zero"
 )
 
+// SeasonRequest describes a season. Name and year are optional: year defaults to the year
+// the season starts and name to the year.
 type SeasonRequest struct {
+	Name      string         `json:"name,omitempty" validate:"omitempty,min=1,max=100,entity_name" example:"2025"`
+	Year      int32          `json:"year,omitempty" validate:"omitempty,gte=1871,lte=9999" example:"2025"`
 	StartDate time.Time      `json:"start_date" validate:"required" example:"2025-01-01T00:00:00Z"`
 	EndDate   time.Time      `json:"end_date" validate:"required,gtfield=StartDate" example:"2025-12-31T23:59:59Z"`
 	Stages    []StageRequest `json:"stages" validate:"required,min=1,max=50,dive"`
@@ -18,6 +22,8 @@ type SeasonRequest struct {
 type SeasonResponse struct {
 	ID            uuid.UUID       `json:"id"`
 	CompetitionID uuid.UUID       `json:"competition_id"`
+	Name          string          `json:"name"`
+	Year          int32           `json:"year"`
 	StartDate     time.Time       `json:"start_date"`
 	EndDate       time.Time       `json:"end_date"`
 	Stages        []StageResponse `json:"stages"`
@@ -25,6 +31,28 @@ type SeasonResponse struct {
 	CreatedAt     time.Time       `json:"created_at"`
 	UpdatedAt     time.Time       `json:"updated_at"`
 	DeletedAt     zero.Time       `json:"deleted_at"`
+
+	// HomeVenues maps a team ID to the venue replacing its home venue this season.
+	HomeVenues map[uuid.UUID]uuid.UUID `json:"home_venues,omitempty" swaggertype:"object,string"`
+}
+
+// SeasonResolution says how the current season for a competition was chosen.
+type SeasonResolution string
+
+const (
+	// SeasonResolutionCurrent is a season in progress.
+	SeasonResolutionCurrent SeasonResolution = "current"
+	// SeasonResolutionNext is the next season to start, when none is in progress.
+	SeasonResolutionNext SeasonResolution = "next"
+	// SeasonResolutionPrevious is the last season to finish, when none is in progress or
+	// still to come.
+	SeasonResolutionPrevious SeasonResolution = "previous"
+)
+
+// CurrentSeasonResponse is the season a competition is currently on.
+type CurrentSeasonResponse struct {
+	Resolution SeasonResolution `json:"resolution" enums:"current,next,previous" example:"current"`
+	Season     SeasonResponse   `json:"season"`
 }
 
 func ValidateSeasonStages(sl validator.StructLevel) {
@@ -60,3 +88,8 @@ func ValidateSeasonStages(sl validator.StructLevel) {
 		}
 	}
 }
+
+// SeasonTeamHomeVenueRequest sets the venue a team plays its home games at for one season.
+type SeasonTeamHomeVenueRequest struct {
+	VenueID uuid.UUID `json:"venue_id" validate:"required" swaggertype:"string" example:"5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01"`
+}
@@ -49,6 +49,23 @@ func ToStageResponse(s db.Stage) StageResponse {
 	}
 }
 
+// StageSummary is the subset of a stage embedded in other resources.
+type StageSummary struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	StageType  StageType `json:"stage_type"`
+	OrderIndex int32     `json:"order_index"`
+}
+
+func ToStageSummary(s db.Stage) StageSummary {
+	return StageSummary{
+		ID:         s.ID,
+		Name:       s.Name,
+		StageType:  StageType(s.StageType),
+		OrderIndex: s.OrderIndex,
+	}
+}
+
 func ValidateStageType(fl validator.FieldLevel) bool {
 	stageType := fl.Field().String()
 	return stageType == "regular" || stageType == "finals"
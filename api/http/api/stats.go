@@ -0,0 +1,139 @@
+package api
+
+import (
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/google/uuid"
+)
+
+const (
+	LeaderboardStatTries  = "tries"
+	LeaderboardStatPoints = "points"
+)
+
+// DefaultLeaderboardLength is how many players a leaderboard lists when the client
+// does not ask for a specific number.
+const DefaultLeaderboardLength = 10
+
+// LeaderboardRequest holds the query parameters for a leaderboard. Players are ranked
+// by points unless stat asks for tries.
+type LeaderboardRequest struct {
+	Stat  string `form:"stat" validate:"omitempty,oneof=tries points"`
+	Limit int    `form:"limit" validate:"omitempty,gte=1,lte=100"`
+}
+
+func (q *LeaderboardRequest) SetDefaults() {
+	if q.Stat == "" {
+		q.Stat = LeaderboardStatPoints
+	}
+	if q.Limit == 0 {
+		q.Limit = DefaultLeaderboardLength
+	}
+}
+
+// PlayerStatsResponse totals a player's stats over one or more finished games.
+type PlayerStatsResponse struct {
+	Appearances  int32 `json:"appearances"`
+	Minutes      int32 `json:"minutes"`
+	Tries        int32 `json:"tries"`
+	Conversions  int32 `json:"conversions"`
+	PenaltyGoals int32 `json:"penalty_goals"`
+	DropGoals    int32 `json:"drop_goals"`
+	YellowCards  int32 `json:"yellow_cards"`
+	RedCards     int32 `json:"red_cards"`
+	Points       int32 `json:"points"`
+}
+
+// Add adds other's totals to s.
+func (s *PlayerStatsResponse) Add(other PlayerStatsResponse) {
+	s.Appearances += other.Appearances
+	s.Minutes += other.Minutes
+	s.Tries += other.Tries
+	s.Conversions += other.Conversions
+	s.PenaltyGoals += other.PenaltyGoals
+	s.DropGoals += other.DropGoals
+	s.YellowCards += other.YellowCards
+	s.RedCards += other.RedCards
+	s.Points += other.Points
+}
+
+// PlayerGameStatsResponse is a player's stats for one finished game.
+type PlayerGameStatsResponse struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	TeamID   uuid.UUID `json:"team_id"`
+	PlayerStatsResponse
+}
+
+// PlayerSeasonStatsResponse is a player's stats for one team in one season.
+type PlayerSeasonStatsResponse struct {
+	CompetitionID uuid.UUID `json:"competition_id"`
+	SeasonID      uuid.UUID `json:"season_id"`
+	TeamID        uuid.UUID `json:"team_id"`
+	PlayerStatsResponse
+}
+
+// PlayerCareerResponse lists a player's stats season by season, across every
+// competition and team they have played for, with career totals.
+type PlayerCareerResponse struct {
+	Player  PlayerSummary               `json:"player"`
+	Seasons []PlayerSeasonStatsResponse `json:"seasons"`
+	Totals  PlayerStatsResponse         `json:"totals"`
+}
+
+// LeaderboardEntryResponse is a player's place on a leaderboard. Players level on the
+// ranked stat share a rank.
+type LeaderboardEntryResponse struct {
+	Rank        int        `json:"rank"`
+	PlayerID    uuid.UUID  `json:"player_id"`
+	PlayerName  string     `json:"player_name"`
+	TeamID      *uuid.UUID `json:"team_id,omitempty"`
+	Appearances int32      `json:"appearances"`
+	Tries       int32      `json:"tries"`
+	Points      int32      `json:"points"`
+}
+
+type LeaderboardResponse struct {
+	Stat    string                     `json:"stat"`
+	Entries []LeaderboardEntryResponse `json:"entries"`
+}
+
+func ToPlayerGameStatsResponse(s db.PlayerGameStat) PlayerGameStatsResponse {
+	appearances := int32(0)
+	if s.Appeared {
+		appearances = 1
+	}
+
+	return PlayerGameStatsResponse{
+		PlayerID: s.PlayerID,
+		TeamID:   s.TeamID,
+		PlayerStatsResponse: PlayerStatsResponse{
+			Appearances:  appearances,
+			Minutes:      s.Minutes,
+			Tries:        s.Tries,
+			Conversions:  s.Conversions,
+			PenaltyGoals: s.PenaltyGoals,
+			DropGoals:    s.DropGoals,
+			YellowCards:  s.YellowCards,
+			RedCards:     s.RedCards,
+			Points:       s.Points,
+		},
+	}
+}
+
+func ToPlayerSeasonStatsResponse(s db.GetPlayerSeasonStatsRow) PlayerSeasonStatsResponse {
+	return PlayerSeasonStatsResponse{
+		CompetitionID: s.CompetitionID,
+		SeasonID:      s.SeasonID,
+		TeamID:        s.TeamID,
+		PlayerStatsResponse: PlayerStatsResponse{
+			Appearances:  s.Appearances,
+			Minutes:      s.Minutes,
+			Tries:        s.Tries,
+			Conversions:  s.Conversions,
+			PenaltyGoals: s.PenaltyGoals,
+			DropGoals:    s.DropGoals,
+			YellowCards:  s.YellowCards,
+			RedCards:     s.RedCards,
+			Points:       s.Points,
+		},
+	}
+}
@@ -1,37 +1,241 @@
 package api
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/guregu/null/zero"
 )
 
+// SocialPlatform is a social network a team can list a handle for.
+type SocialPlatform string
+
+const (
+	SocialPlatformX         SocialPlatform = "x"
+	SocialPlatformInstagram SocialPlatform = "instagram"
+	SocialPlatformFacebook  SocialPlatform = "facebook"
+	SocialPlatformTikTok    SocialPlatform = "tiktok"
+	SocialPlatformYouTube   SocialPlatform = "youtube"
+)
+
+// EarliestFoundedYear is the earliest year a team can be founded in.
+const EarliestFoundedYear = 1800
+
+// TeamRequest describes a team. Everything after location is optional branding and
+// profile detail: social handles are keyed by platform and given without the @, and
+// external_refs maps another system's name to the team's ID in it.
 type TeamRequest struct {
-	Name         string `json:"name" validate:"required,min=3,max=100,entity_name"`
-	Abbreviation string `json:"abbreviation" validate:"required,alpha,min=2,max=4" example:"ABV"`
-	Location     string `json:"location" validate:"omitempty,min=2,max=100"`
+	Name            string                    `json:"name" validate:"required,min=3,max=100,entity_name"`
+	Abbreviation    string                    `json:"abbreviation" validate:"required,alpha,min=2,max=4" example:"ABV"`
+	Location        string                    `json:"location" validate:"omitempty,min=2,max=100"`
+	HomeVenueID     *uuid.UUID                `json:"home_venue_id,omitempty" swaggertype:"string" example:"5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01"`
+	ShortName       string                    `json:"short_name,omitempty" validate:"omitempty,min=2,max=30,entity_name" example:"Chiefs"`
+	LogoURL         string                    `json:"logo_url,omitempty" validate:"omitempty,max=2048,http_url" example:"https://example.com/logo.png"`
+	CrestURL        string                    `json:"crest_url,omitempty" validate:"omitempty,max=2048,http_url" example:"https://example.com/crest.svg"`
+	PrimaryColour   string                    `json:"primary_colour,omitempty" validate:"omitempty,hex_colour" example:"#FFD700"`
+	SecondaryColour string                    `json:"secondary_colour,omitempty" validate:"omitempty,hex_colour" example:"#000000"`
+	FoundedYear     *int32                    `json:"founded_year,omitempty" validate:"omitempty,founded_year" example:"1996"`
+	SocialHandles   map[SocialPlatform]string `json:"social_handles,omitempty" validate:"omitempty,dive,keys,social_platform,endkeys,social_handle"`
+	ExternalRefs    map[string]string         `json:"external_refs,omitempty" validate:"omitempty,max=20,dive,keys,ref_key,endkeys,min=1,max=100"`
 }
 
 type TeamResponse struct {
-	ID           uuid.UUID `json:"id"`
-	Name         string    `json:"name"`
-	Abbreviation string    `json:"abbreviation"`
-	Location     string    `json:"location"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	DeletedAt    zero.Time `json:"deleted_at"`
+	ID              uuid.UUID                 `json:"id"`
+	Name            string                    `json:"name"`
+	Abbreviation    string                    `json:"abbreviation"`
+	Location        string                    `json:"location"`
+	HomeVenueID     *uuid.UUID                `json:"home_venue_id" swaggertype:"string"`
+	ShortName       string                    `json:"short_name,omitempty"`
+	LogoURL         string                    `json:"logo_url,omitempty"`
+	CrestURL        string                    `json:"crest_url,omitempty"`
+	PrimaryColour   string                    `json:"primary_colour,omitempty"`
+	SecondaryColour string                    `json:"secondary_colour,omitempty"`
+	FoundedYear     *int32                    `json:"founded_year,omitempty"`
+	SocialHandles   map[SocialPlatform]string `json:"social_handles"`
+	ExternalRefs    map[string]string         `json:"external_refs"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+	DeletedAt       zero.Time                 `json:"deleted_at"`
+}
+
+// ToTeamRequest returns the request that would save t as it is.
+func ToTeamRequest(t db.Team) TeamRequest {
+	return TeamRequest{
+		Name:            t.Name,
+		Abbreviation:    t.Abbreviation,
+		Location:        t.Location,
+		HomeVenueID:     toUUIDPtr(t.HomeVenueID),
+		ShortName:       t.ShortName.String,
+		LogoURL:         t.LogoUrl.String,
+		CrestURL:        t.CrestUrl.String,
+		PrimaryColour:   t.PrimaryColour.String,
+		SecondaryColour: t.SecondaryColour.String,
+		FoundedYear:     toInt32Ptr(t.FoundedYear),
+		SocialHandles:   toStringMap[SocialPlatform](t.SocialHandles),
+		ExternalRefs:    toStringMap[string](t.ExternalRefs),
+	}
 }
 
 func ToTeamResponse(t db.Team) TeamResponse {
 	return TeamResponse{
-		ID:           t.ID,
-		Name:         t.Name,
-		Abbreviation: t.Abbreviation,
-		Location:     t.Location,
-		CreatedAt:    t.CreatedAt,
-		UpdatedAt:    t.UpdatedAt,
-		DeletedAt:    zero.TimeFrom(t.DeletedAt.Time),
+		ID:              t.ID,
+		Name:            t.Name,
+		Abbreviation:    t.Abbreviation,
+		Location:        t.Location,
+		HomeVenueID:     toUUIDPtr(t.HomeVenueID),
+		ShortName:       t.ShortName.String,
+		LogoURL:         t.LogoUrl.String,
+		CrestURL:        t.CrestUrl.String,
+		PrimaryColour:   t.PrimaryColour.String,
+		SecondaryColour: t.SecondaryColour.String,
+		FoundedYear:     toInt32Ptr(t.FoundedYear),
+		SocialHandles:   toStringMap[SocialPlatform](t.SocialHandles),
+		ExternalRefs:    toStringMap[string](t.ExternalRefs),
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+		DeletedAt:       zero.TimeFrom(t.DeletedAt.Time),
+	}
+}
+
+// TeamListRequest holds the query parameters for listing teams. Search matches the
+// name or abbreviation of a team or any of its aliases.
+type TeamListRequest struct {
+	PaginationRequest
+	Search string `form:"search" validate:"omitempty,max=100"`
+}
+
+// TeamSummary is the subset of a team embedded in other resources. Within a game it
+// carries the name, abbreviation and logo the team used on the game date; the short
+// name and colours are always the team's current ones.
+type TeamSummary struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name"`
+	Abbreviation    string    `json:"abbreviation"`
+	ShortName       string    `json:"short_name,omitempty"`
+	LogoURL         string    `json:"logo_url,omitempty"`
+	PrimaryColour   string    `json:"primary_colour,omitempty"`
+	SecondaryColour string    `json:"secondary_colour,omitempty"`
+}
+
+// GameResult is the outcome of a finished game from one team's point of view.
+type GameResult string
+
+const (
+	GameResultWin  GameResult = "W"
+	GameResultDraw GameResult = "D"
+	GameResultLoss GameResult = "L"
+)
+
+// DefaultFormLength is how many recent results a form summary lists when the
+// client does not ask for a specific number.
+const DefaultFormLength = 5
+
+// TeamGameListRequest holds the query parameters for listing a team's games across seasons.
+type TeamGameListRequest struct {
+	PaginationRequest
+	SeasonID string     `form:"season_id" validate:"omitempty,uuid"`
+	Status   GameStatus `form:"status" validate:"omitempty,game_status"`
+	Sort     string     `form:"sort" validate:"omitempty,oneof=date -date"`
+}
+
+// TeamFormRequest holds the query parameters for a team's form summary.
+type TeamFormRequest struct {
+	SeasonID string `form:"season_id" validate:"omitempty,uuid"`
+	Last     int    `form:"last" validate:"omitempty,gte=1,lte=20"`
+}
+
+func (q *TeamFormRequest) SetDefaults() {
+	if q.Last == 0 {
+		q.Last = DefaultFormLength
 	}
 }
+
+// TeamRecordResponse tallies a team's results and points over a set of games.
+type TeamRecordResponse struct {
+	Played           int   `json:"played"`
+	Won              int   `json:"won"`
+	Drawn            int   `json:"drawn"`
+	Lost             int   `json:"lost"`
+	PointsFor        int32 `json:"points_for"`
+	PointsAgainst    int32 `json:"points_against"`
+	PointsDifference int32 `json:"points_difference"`
+}
+
+// TeamResultResponse is a finished game seen from one team's side.
+type TeamResultResponse struct {
+	GameID        uuid.UUID  `json:"game_id"`
+	SeasonID      uuid.UUID  `json:"season_id"`
+	Date          time.Time  `json:"date"`
+	OpponentID    uuid.UUID  `json:"opponent_id"`
+	Home          bool       `json:"home"`
+	PointsFor     int32      `json:"points_for"`
+	PointsAgainst int32      `json:"points_against"`
+	Result        GameResult `json:"result" example:"W"`
+}
+
+// TeamStreakResponse is the run of identical results ending with the team's latest game.
+type TeamStreakResponse struct {
+	Result GameResult `json:"result,omitempty" example:"W"`
+	Count  int        `json:"count" example:"3"`
+}
+
+// TeamFormResponse summarises a team's recent results. Form lists the latest
+// results newest first, e.g. "WWLDW".
+type TeamFormResponse struct {
+	TeamID  uuid.UUID            `json:"team_id"`
+	Form    string               `json:"form" example:"WWLDW"`
+	Recent  []TeamResultResponse `json:"recent"`
+	Streak  TeamStreakResponse   `json:"streak"`
+	Overall TeamRecordResponse   `json:"overall"`
+	Home    TeamRecordResponse   `json:"home"`
+	Away    TeamRecordResponse   `json:"away"`
+}
+
+// HeadToHeadResponse summarises every finished meeting between two teams. Record,
+// results and margins are from Team's side; Meetings are newest first.
+type HeadToHeadResponse struct {
+	Team        TeamSummary          `json:"team"`
+	Opponent    TeamSummary          `json:"opponent"`
+	Record      TeamRecordResponse   `json:"record"`
+	BiggestWin  *TeamResultResponse  `json:"biggest_win,omitempty"`
+	BiggestLoss *TeamResultResponse  `json:"biggest_loss,omitempty"`
+	LastMeeting *TeamResultResponse  `json:"last_meeting,omitempty"`
+	Meetings    []TeamResultResponse `json:"meetings"`
+}
+
+func ToTeamSummary(t db.Team) TeamSummary {
+	return TeamSummary{
+		ID:              t.ID,
+		Name:            t.Name,
+		Abbreviation:    t.Abbreviation,
+		ShortName:       t.ShortName.String,
+		LogoURL:         t.LogoUrl.String,
+		PrimaryColour:   t.PrimaryColour.String,
+		SecondaryColour: t.SecondaryColour.String,
+	}
+}
+
+func ValidateSocialPlatform(fl validator.FieldLevel) bool {
+	switch SocialPlatform(fl.Field().String()) {
+	case SocialPlatformX, SocialPlatformInstagram, SocialPlatformFacebook, SocialPlatformTikTok, SocialPlatformYouTube:
+		return true
+	}
+	return false
+}
+
+// ValidateFoundedYear accepts years from EarliestFoundedYear up to the current year.
+func ValidateFoundedYear(fl validator.FieldLevel) bool {
+	year := fl.Field().Int()
+	return year >= EarliestFoundedYear && year <= int64(time.Now().Year())
+}
+
+// toStringMap decodes a JSON object column. Columns are always objects, so a value
+// that does not decode is returned as an empty map.
+func toStringMap[K ~string](raw json.RawMessage) map[K]string {
+	m := make(map[K]string)
+	_ = json.Unmarshal(raw, &m)
+	return m
+}
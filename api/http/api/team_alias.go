@@ -0,0 +1,54 @@
+package api
+
+import (
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// TeamAliasRequest describes a name a team played under. Both dates are inclusive and
+// an alias without valid_to is still in use.
+type TeamAliasRequest struct {
+	Name         string     `json:"name" validate:"required,min=3,max=100,entity_name" example:"Bay of Plenty Steamers"`
+	Abbreviation string     `json:"abbreviation" validate:"required,alpha,min=2,max=4" example:"BOP"`
+	LogoURL      string     `json:"logo_url,omitempty" validate:"omitempty,max=2048,http_url" example:"https://example.com/steamers.png"`
+	ValidFrom    time.Time  `json:"valid_from" validate:"required" example:"2024-01-01T00:00:00Z"`
+	ValidTo      *time.Time `json:"valid_to,omitempty" example:"2024-12-31T00:00:00Z"`
+}
+
+type TeamAliasResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	TeamID       uuid.UUID  `json:"team_id"`
+	Name         string     `json:"name"`
+	Abbreviation string     `json:"abbreviation"`
+	LogoURL      string     `json:"logo_url,omitempty"`
+	ValidFrom    time.Time  `json:"valid_from"`
+	ValidTo      *time.Time `json:"valid_to,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func ToTeamAliasResponse(a db.TeamAlias) TeamAliasResponse {
+	return TeamAliasResponse{
+		ID:           a.ID,
+		TeamID:       a.TeamID,
+		Name:         a.Name,
+		Abbreviation: a.Abbreviation,
+		LogoURL:      a.LogoUrl.String,
+		ValidFrom:    a.ValidFrom,
+		ValidTo:      toTimePtr(a.ValidTo),
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
+
+func ValidateTeamAliasRequest(sl validator.StructLevel) {
+	req := sl.Current().Interface().(TeamAliasRequest)
+
+	// An alias cannot stop being used before it starts
+	if req.ValidTo != nil && req.ValidTo.Before(req.ValidFrom) {
+		sl.ReportError(req.ValidTo, "valid_to", "ValidTo", "valid_to_before_valid_from", "")
+	}
+}
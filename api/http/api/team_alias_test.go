@@ -0,0 +1,55 @@
+package api
+
+import (
+	"time"
+
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamAliasRequest validation", func() {
+	var validate *validator.Validate
+
+	from := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	newAlias := func(validTo *time.Time) *TeamAliasRequest {
+		return &TeamAliasRequest{
+			Name:         "Bay of Plenty Steamers",
+			Abbreviation: "BOP",
+			ValidFrom:    from,
+			ValidTo:      validTo,
+		}
+	}
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validation.Register(validate)
+		Register(validate)
+	})
+
+	It("passes for an alias still in use", func() {
+		Expect(validate.Struct(newAlias(nil))).To(Succeed())
+	})
+
+	It("passes for a single day alias", func() {
+		Expect(validate.Struct(newAlias(&from))).To(Succeed())
+	})
+
+	It("fails when valid_to is before valid_from", func() {
+		to := from.AddDate(0, 0, -1)
+
+		err := validate.Struct(newAlias(&to))
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal("valid_to_before_valid_from"))
+	})
+
+	It("fails for a logo URL that is not http", func() {
+		req := newAlias(nil)
+		req.LogoURL = "ftp://example.com/logo.png"
+
+		Expect(validate.Struct(req)).NotTo(Succeed())
+	})
+})
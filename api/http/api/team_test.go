@@ -1,6 +1,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/bradley-adams/gainline/http/validation"
 	"github.com/go-playground/validator/v10"
 	. "github.com/onsi/ginkgo/v2"
@@ -12,7 +14,8 @@ var _ = Describe("ValidateTeamRequest", func() {
 
 	BeforeEach(func() {
 		validate = validator.New()
-		validate.RegisterValidation("entity_name", validation.ValidateEntityName)
+		validation.Register(validate)
+		Register(validate)
 	})
 
 	It("passes with valid team data", func() {
@@ -120,4 +123,76 @@ var _ = Describe("ValidateTeamRequest", func() {
 		}
 		Expect(validate.Struct(team)).To(HaveOccurred())
 	})
+
+	It("passes with full branding and profile", func() {
+		founded := int32(1996)
+		team := &TeamRequest{
+			Name:            "Chiefs",
+			Abbreviation:    "CHI",
+			ShortName:       "Chiefs",
+			LogoURL:         "https://example.com/chiefs.png",
+			CrestURL:        "https://example.com/chiefs.svg",
+			PrimaryColour:   "#FFD700",
+			SecondaryColour: "#000000",
+			FoundedYear:     &founded,
+			SocialHandles:   map[SocialPlatform]string{SocialPlatformX: "ChiefsRugby"},
+			ExternalRefs:    map[string]string{"world_rugby": "1234"},
+		}
+		Expect(validate.Struct(team)).NotTo(HaveOccurred())
+	})
+
+	It("fails when a colour is not a hex colour", func() {
+		team := &TeamRequest{Name: "Chiefs", Abbreviation: "CHI", PrimaryColour: "gold"}
+
+		err := validate.Struct(team)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal("hex_colour"))
+	})
+
+	It("fails when the crest URL is not http", func() {
+		team := &TeamRequest{Name: "Chiefs", Abbreviation: "CHI", CrestURL: "javascript:alert(1)"}
+		Expect(validate.Struct(team)).To(HaveOccurred())
+	})
+
+	It("fails when the founded year is in the future", func() {
+		next := int32(time.Now().Year() + 1)
+		team := &TeamRequest{Name: "Chiefs", Abbreviation: "CHI", FoundedYear: &next}
+
+		err := validate.Struct(team)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal("founded_year"))
+	})
+
+	It("fails for an unknown social platform", func() {
+		team := &TeamRequest{
+			Name:          "Chiefs",
+			Abbreviation:  "CHI",
+			SocialHandles: map[SocialPlatform]string{"myspace": "chiefs"},
+		}
+
+		err := validate.Struct(team)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(validator.ValidationErrors)[0].Tag()).To(Equal("social_platform"))
+	})
+
+	It("fails when a handle includes the @", func() {
+		team := &TeamRequest{
+			Name:          "Chiefs",
+			Abbreviation:  "CHI",
+			SocialHandles: map[SocialPlatform]string{SocialPlatformInstagram: "@chiefsrugby"},
+		}
+		Expect(validate.Struct(team)).To(HaveOccurred())
+	})
+
+	It("fails for an empty external ref", func() {
+		team := &TeamRequest{
+			Name:         "Chiefs",
+			Abbreviation: "CHI",
+			ExternalRefs: map[string]string{"espn": ""},
+		}
+		Expect(validate.Struct(team)).To(HaveOccurred())
+	})
 })
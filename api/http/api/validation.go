@@ -1,12 +1,70 @@
 package api
 
-import "github.com/go-playground/validator/v10"
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/bradley-adams/gainline/http/validation"
+)
 
 func Register(v *validator.Validate) {
 	v.RegisterValidation("game_status", ValidateGameStatus)
 	v.RegisterValidation("stage_type", ValidateStageType)
+	v.RegisterValidation("game_expand", ValidateGameExpand)
+	v.RegisterValidation("finals_format", ValidateFinalsFormat)
+	v.RegisterValidation("player_position", ValidatePlayerPosition)
+	v.RegisterValidation("game_event_type", ValidateGameEventType)
+	v.RegisterValidation("official_role", ValidateOfficialRole)
+	v.RegisterValidation("sport_code", ValidateSportCode)
+	v.RegisterValidation("competition_gender", ValidateCompetitionGender)
+	v.RegisterValidation("competition_level", ValidateCompetitionLevel)
+	v.RegisterValidation("social_platform", ValidateSocialPlatform)
+	v.RegisterValidation("founded_year", ValidateFoundedYear)
 
 	v.RegisterStructValidation(ValidateGameRequest, GameRequest{})
 	v.RegisterStructValidation(ValidateSeasonStages, SeasonRequest{})
+	v.RegisterStructValidation(ValidateSquadPlayerRequest, SquadPlayerRequest{})
+	v.RegisterStructValidation(ValidateLineupRequest, LineupRequest{})
+	v.RegisterStructValidation(ValidateReplacementRequest, ReplacementRequest{})
+	v.RegisterStructValidation(ValidateGameEventRequest, GameEventRequest{})
+	v.RegisterStructValidation(ValidateTeamAliasRequest, TeamAliasRequest{})
+
+	registerTranslations(v)
+}
+
+func registerTranslations(v *validator.Validate) {
+	validation.RegisterTranslation(v, "game_status", "{0} must be one of scheduled, playing, finished or cancelled")
+	validation.RegisterTranslation(v, "stage_type", "{0} must be one of regular or finals")
+	validation.RegisterTranslation(v, "game_expand", "{0} may only contain teams and stage")
+	validation.RegisterTranslation(v, "finals_format", "{0} must be one of top_4, top_6 or top_8")
+	validation.RegisterTranslation(v, "player_position", "{0} must be one of prop, hooker, lock, flanker, number_eight, scrum_half, fly_half, centre, wing or fullback")
+	validation.RegisterTranslation(v, "game_event_type", "{0} must be one of try, conversion, penalty_goal, drop_goal, penalty_try, yellow_card or red_card")
+	validation.RegisterTranslation(v, "official_role", "{0} must be one of referee, assistant_referee or tmo")
+	validation.RegisterTranslation(v, "sport_code", "{0} must be one of rugby_union, rugby_sevens or rugby_league")
+	validation.RegisterTranslation(v, "competition_gender", "{0} must be one of men, women or mixed")
+	validation.RegisterTranslation(v, "competition_level", "{0} must be one of international, professional, provincial, club or school")
+	validation.RegisterTranslation(v, "social_platform", "{0} must be one of x, instagram, facebook, tiktok or youtube")
+	validation.RegisterTranslation(v, "founded_year", "{0} must be between 1800 and the current year")
+
+	validation.RegisterTranslation(v, "home_and_away_teams_must_differ", "home and away teams must be different")
+	validation.RegisterTranslation(v, "no_scores_for_scheduled_games", "{0} must be empty for scheduled games")
+	validation.RegisterTranslation(v, "no_scores_for_cancelled_games", "{0} must be empty for cancelled games")
+	validation.RegisterTranslation(v, "scores_required_for_playing_or_finished_games", "{0} is required once a game is playing or finished")
+	validation.RegisterTranslation(v, "scores_cannot_be_negative", "{0} cannot be negative")
+	validation.RegisterTranslation(v, "venue_required_for_neutral_games", "{0} is required for neutral venue games")
+
+	validation.RegisterTranslation(v, "duplicate_order", "{0} must not share an order_index")
+	validation.RegisterTranslation(v, "non_contiguous_order", "{0} order_index values must be contiguous starting at 1")
+
+	validation.RegisterTranslation(v, "active_to_before_active_from", "{0} must not be before active_from")
+
+	validation.RegisterTranslation(v, "duplicate_shirt_number", "{0} must not share a shirt_number")
+	validation.RegisterTranslation(v, "duplicate_lineup_player", "{0} must not name a player twice")
+	validation.RegisterTranslation(v, "incomplete_starting_xv", "{0} must fill shirts 1 to 15")
+	validation.RegisterTranslation(v, "captain_not_in_lineup", "{0} must be one of the players")
+	validation.RegisterTranslation(v, "replacement_players_must_differ", "{0} must differ from player_off_id")
+
+	validation.RegisterTranslation(v, "no_player_for_penalty_tries", "{0} must be empty for penalty tries")
+	validation.RegisterTranslation(v, "player_required_for_event", "{0} is required for every event except a penalty try")
 
+	validation.RegisterTranslation(v, "valid_to_before_valid_from", "{0} must not be before valid_from")
 }
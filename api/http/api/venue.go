@@ -0,0 +1,75 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+	// Embed the IANA timezone database so venue timezones load without tzdata installed.
+	_ "time/tzdata"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/google/uuid"
+	"github.com/guregu/null/zero"
+)
+
+// VenueRequest describes a venue. Timezone is an IANA name such as Pacific/Auckland and
+// is used to report each game's local kickoff. Latitude and longitude are set together.
+type VenueRequest struct {
+	Name      string   `json:"name" validate:"required,min=3,max=100,entity_name" example:"Eden Park"`
+	City      string   `json:"city" validate:"required,min=2,max=100" example:"Auckland"`
+	Country   string   `json:"country" validate:"required,min=2,max=100" example:"New Zealand"`
+	Timezone  string   `json:"timezone" validate:"required,timezone" example:"Pacific/Auckland"`
+	Capacity  *int32   `json:"capacity,omitempty" validate:"omitempty,min=0" example:"50000"`
+	Latitude  *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90" example:"-36.8751"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180" example:"174.7447"`
+}
+
+type VenueResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	City      string    `json:"city"`
+	Country   string    `json:"country"`
+	Timezone  string    `json:"timezone"`
+	Capacity  *int32    `json:"capacity,omitempty"`
+	Latitude  *float64  `json:"latitude,omitempty"`
+	Longitude *float64  `json:"longitude,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt zero.Time `json:"deleted_at"`
+}
+
+// ToVenueRequest returns the request that would save v as it is.
+func ToVenueRequest(v db.Venue) VenueRequest {
+	return VenueRequest{
+		Name:      v.Name,
+		City:      v.City,
+		Country:   v.Country,
+		Timezone:  v.Timezone,
+		Capacity:  toInt32Ptr(v.Capacity),
+		Latitude:  toFloat64Ptr(v.Latitude),
+		Longitude: toFloat64Ptr(v.Longitude),
+	}
+}
+
+func ToVenueResponse(v db.Venue) VenueResponse {
+	return VenueResponse{
+		ID:        v.ID,
+		Name:      v.Name,
+		City:      v.City,
+		Country:   v.Country,
+		Timezone:  v.Timezone,
+		Capacity:  toInt32Ptr(v.Capacity),
+		Latitude:  toFloat64Ptr(v.Latitude),
+		Longitude: toFloat64Ptr(v.Longitude),
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+		DeletedAt: zero.TimeFrom(v.DeletedAt.Time),
+	}
+}
+
+func toFloat64Ptr(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	v := f.Float64
+	return &v
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VenueRequest validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		validate.RegisterValidation("entity_name", validation.ValidateEntityName)
+	})
+
+	valid := func() VenueRequest {
+		return VenueRequest{
+			Name:     "Eden Park",
+			City:     "Auckland",
+			Country:  "New Zealand",
+			Timezone: "Pacific/Auckland",
+		}
+	}
+
+	It("passes without the optional details", func() {
+		Expect(validate.Struct(valid())).To(Succeed())
+	})
+
+	It("passes with capacity and both coordinates", func() {
+		req := valid()
+		capacity := int32(50000)
+		lat, lng := -36.8751, 174.7447
+		req.Capacity, req.Latitude, req.Longitude = &capacity, &lat, &lng
+
+		Expect(validate.Struct(req)).To(Succeed())
+	})
+
+	It("fails for a timezone that is not an IANA name", func() {
+		for _, tz := range []string{"NZST", "Pacific/Atlantis", "Local"} {
+			req := valid()
+			req.Timezone = tz
+			Expect(validate.Struct(req)).To(HaveOccurred(), tz)
+		}
+	})
+
+	It("fails when only one coordinate is given", func() {
+		req := valid()
+		lat := -36.8751
+		req.Latitude = &lat
+
+		Expect(validate.Struct(req)).To(HaveOccurred())
+	})
+
+	It("fails for coordinates out of range", func() {
+		req := valid()
+		lat, lng := -91.0, 174.7447
+		req.Latitude, req.Longitude = &lat, &lng
+
+		Expect(validate.Struct(req)).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("local kickoff", func() {
+	kickoff := time.Date(2025, time.August, 2, 7, 5, 0, 0, time.UTC)
+
+	It("converts the game date to the venue timezone", func() {
+		resp := ToGameFeedResponse(db.GetGameFeedRow{
+			Date:          kickoff,
+			VenueTimezone: sql.NullString{String: "Pacific/Auckland", Valid: true},
+		})
+
+		Expect(resp.LocalKickoff).NotTo(BeNil())
+		Expect(resp.LocalKickoff.Format(time.RFC3339)).To(Equal("2025-08-02T19:05:00+12:00"))
+		Expect(resp.LocalKickoff.Equal(kickoff)).To(BeTrue())
+	})
+
+	It("follows daylight saving at the venue", func() {
+		summer := time.Date(2025, time.February, 21, 6, 5, 0, 0, time.UTC)
+		resp := GameResponse{Date: summer}
+		resp.Localize(db.GetGameDetailsRow{VenueTimezone: sql.NullString{String: "Pacific/Auckland", Valid: true}})
+
+		Expect(resp.LocalKickoff.Format(time.RFC3339)).To(Equal("2025-02-21T19:05:00+13:00"))
+	})
+
+	It("is left out for games without a venue", func() {
+		resp := ToGameFeedResponse(db.GetGameFeedRow{Date: kickoff})
+
+		Expect(resp.LocalKickoff).To(BeNil())
+		Expect(resp.VenueID).To(BeNil())
+	})
+})
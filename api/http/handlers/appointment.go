@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleGetGameOfficials retrieves the officials appointed to a game
+//
+//	@Summary	Get the officials for a game
+//	@ID			get-game-officials
+//	@Tags		Officials
+//	@Produce	json
+//	@Param		competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Success	200				{array}		api.GameOfficialResponse	"Officials found"
+//	@Failure	400				{object}	response.Problem			"Invalid game ID"
+//	@Failure	403				{object}	response.Problem			"Forbidden"
+//	@Failure	404				{object}	response.Problem			"Not found"
+//	@Failure	500				{object}	response.Problem			"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/officials [get]
+func handleGetGameOfficials(logger zerolog.Logger, appointmentService service.AppointmentService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		officials, err := appointmentService.GetAll(ctx.Request.Context(), gameID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game officials")
+			return
+		}
+
+		data := make([]api.GameOfficialResponse, 0, len(officials))
+		for _, official := range officials {
+			data = append(data, service.ToGameOfficialResponse(official))
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, data)
+	}
+}
+
+// handleSetGameOfficial appoints an official to a game, or changes their role if they
+// are already appointed
+//
+//	@Summary		Appoint an official to a game
+//	@Description	A game has one referee, two assistant referees and one TMO. An official cannot be appointed to games kicking off less than three hours apart.
+//	@ID				set-game-official
+//	@Tags			Officials
+//	@Accept			json
+//	@Produce		json
+//	@Param			competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param			officialID		path		string						true	"Official ID"		default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Param			appointment		body		api.GameOfficialRequest		true	"Role"
+//	@Success		200				{object}	api.GameOfficialResponse	"Role changed"
+//	@Success		201				{object}	api.GameOfficialResponse	"Official appointed"
+//	@Failure		400				{object}	response.Problem			"Bad request"
+//	@Failure		404				{object}	response.Problem			"Not found"
+//	@Failure		409				{object}	response.Problem			"Role already filled or official double-booked"
+//	@Failure		500				{object}	response.Problem			"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/officials/{officialID} [put]
+func handleSetGameOfficial(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	appointmentService service.AppointmentService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		req := &api.GameOfficialRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		official, created, err := appointmentService.Set(ctx.Request.Context(), req, gameID, officialID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to appoint official")
+			return
+		}
+
+		status := http.StatusOK
+		if created {
+			status = http.StatusCreated
+		}
+
+		response.RespondSuccess(ctx, logger, status, service.ToGameOfficialResponse(official))
+	}
+}
+
+// handleRemoveGameOfficial removes an official's appointment to a game
+//
+//	@Summary	Remove an official from a game
+//	@ID			remove-game-official
+//	@Tags		Officials
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path			string	true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param		officialID		path			string	true	"Official ID"		default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Success	204				"No Content"	"Official removed"
+//	@Failure	400				{object}		response.Problem	"Invalid game or official ID"
+//	@Failure	404				{object}		response.Problem	"Official not appointed to the game"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/officials/{officialID} [delete]
+func handleRemoveGameOfficial(logger zerolog.Logger, appointmentService service.AppointmentService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		err = appointmentService.Remove(ctx.Request.Context(), gameID, officialID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to remove official")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
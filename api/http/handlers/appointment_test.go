@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for AppointmentService
+type mockAppointmentService struct {
+	GetAllFn func(ctx context.Context, gameID uuid.UUID) ([]service.GameOfficial, error)
+	SetFn    func(ctx context.Context, req *api.GameOfficialRequest, gameID, officialID uuid.UUID) (service.GameOfficial, bool, error)
+	RemoveFn func(ctx context.Context, gameID, officialID uuid.UUID) error
+}
+
+func (m *mockAppointmentService) GetAll(ctx context.Context, gameID uuid.UUID) ([]service.GameOfficial, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, gameID)
+	}
+	return nil, nil
+}
+
+func (m *mockAppointmentService) Set(ctx context.Context, req *api.GameOfficialRequest, gameID, officialID uuid.UUID) (service.GameOfficial, bool, error) {
+	if m.SetFn != nil {
+		return m.SetFn(ctx, req, gameID, officialID)
+	}
+	return service.GameOfficial{}, false, nil
+}
+
+func (m *mockAppointmentService) Remove(ctx context.Context, gameID, officialID uuid.UUID) error {
+	if m.RemoveFn != nil {
+		return m.RemoveFn(ctx, gameID, officialID)
+	}
+	return nil
+}
+
+var _ = Describe("game official handlers", func() {
+	var (
+		router     *gin.Engine
+		validate   *validator.Validate
+		logger     zerolog.Logger
+		mockSvc    *mockAppointmentService
+		gameID     uuid.UUID
+		officialID uuid.UUID
+		url        string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockAppointmentService{}
+		router = gin.New()
+
+		route := "/competitions/:competitionID/seasons/:seasonID/games/:gameID/officials"
+		router.GET(route, handleGetGameOfficials(logger, mockSvc))
+		router.PUT(route+"/:officialID", handleSetGameOfficial(logger, validate, mockSvc))
+		router.DELETE(route+"/:officialID", handleRemoveGameOfficial(logger, mockSvc))
+
+		gameID = uuid.New()
+		officialID = uuid.New()
+		url = "/competitions/" + uuid.NewString() + "/seasons/" + uuid.NewString() + "/games/" + gameID.String() + "/officials"
+	})
+
+	Describe("get game officials", func() {
+		It("returns 200 with an empty list when no officials are appointed", func() {
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal("[]"))
+		})
+	})
+
+	Describe("set game official", func() {
+		appoint := func(gID, oID uuid.UUID, role db.OfficialRole) service.GameOfficial {
+			return service.GameOfficial{
+				Appointment: db.GameOfficial{ID: uuid.New(), GameID: gID, OfficialID: oID, Role: role},
+				Official:    db.Official{ID: oID, Name: "Ben O'Keeffe"},
+			}
+		}
+
+		It("returns 201 when the official is newly appointed", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.GameOfficialRequest, gID, oID uuid.UUID) (service.GameOfficial, bool, error) {
+				Expect(gID).To(Equal(gameID))
+				Expect(oID).To(Equal(officialID))
+				return appoint(gID, oID, db.OfficialRole(req.Role)), true, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+officialID.String(), bytes.NewBufferString(`{"role":"referee"}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.GameOfficialResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Role).To(Equal(api.OfficialRoleReferee))
+			Expect(resp.Official.Name).To(Equal("Ben O'Keeffe"))
+		})
+
+		It("returns 200 when the official's role changes", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.GameOfficialRequest, gID, oID uuid.UUID) (service.GameOfficial, bool, error) {
+				return appoint(gID, oID, db.OfficialRole(req.Role)), false, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+officialID.String(), bytes.NewBufferString(`{"role":"tmo"}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("returns 400 for an unknown role", func() {
+			req := httptest.NewRequest(http.MethodPut, url+"/"+officialID.String(), bytes.NewBufferString(`{"role":"touch_judge"}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("role must be one of referee, assistant_referee or tmo"))
+		})
+
+		It("returns 409 when the official is double-booked", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.GameOfficialRequest, gID, oID uuid.UUID) (service.GameOfficial, bool, error) {
+				return service.GameOfficial{}, false, service.NewConflictError("official is already appointed to game", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+officialID.String(), bytes.NewBufferString(`{"role":"referee"}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("remove game official", func() {
+		It("returns 404 when the official is not appointed", func() {
+			mockSvc.RemoveFn = func(ctx context.Context, gID, oID uuid.UUID) error {
+				return service.NewNotFoundError("game official", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+officialID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -22,9 +22,10 @@ import (
 //	@Produce	json
 //	@Param		competition	body		api.CompetitionRequest	true	"Competition details to create"
 //	@Success	201			{object}	api.CompetitionResponse	"Successful operation"
-//	@Failure	400			{object}	response.ErrorResponse	"Bad request"
-//	@Failure	404			{object}	response.ErrorResponse	"Not found"
-//	@Failure	500			{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400			{object}	response.Problem	"Bad request"
+//	@Failure	404			{object}	response.Problem	"Not found"
+//	@Failure	409			{object}	response.Problem	"Conflict"
+//	@Failure	500			{object}	response.Problem	"Internal server error"
 //	@Router		/competitions [post]
 func handleCreateCompetition(
 	logger zerolog.Logger,
@@ -59,10 +60,15 @@ func handleCreateCompetition(
 //	@ID			get-competitions
 //	@Tags		Competitions
 //	@Produce	json
-//	@Param		page		query		int	false	"Page number"		default(1)
-//	@Param		page_size	query		int	false	"Items per page"	default(20)
+//	@Param		page		query		int		false	"Page number"		default(1)
+//	@Param		page_size	query		int		false	"Items per page"	default(20)
+//	@Param		sport		query		string	false	"Only competitions of this code"	Enums(rugby_union, rugby_sevens, rugby_league)
+//	@Param		gender		query		string	false	"Only competitions for this gender"	Enums(men, women, mixed)
+//	@Param		level		query		string	false	"Only competitions at this level"	Enums(international, professional, provincial, club, school)
+//	@Param		country		query		string	false	"Only competitions in this ISO 3166 country"
 //	@Success	200			{object}	api.PaginatedResponse[api.CompetitionResponse]
-//	@Failure	500			{object}	response.ErrorResponse
+//	@Failure	400			{object}	response.Problem
+//	@Failure	500			{object}	response.Problem
 //	@Router		/competitions [get]
 func handleGetCompetitions(
 	logger zerolog.Logger,
@@ -70,7 +76,7 @@ func handleGetCompetitions(
 	competitionService service.CompetitionService,
 ) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		q := api.PaginationRequest{}
+		q := api.CompetitionListRequest{}
 
 		if err := ctx.ShouldBindQuery(&q); err != nil {
 			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
@@ -78,7 +84,7 @@ func handleGetCompetitions(
 		}
 
 		if err := validate.Struct(q); err != nil {
-			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid pagination params")
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
 			return
 		}
 
@@ -86,6 +92,7 @@ func handleGetCompetitions(
 
 		competitions, total, err := competitionService.GetAll(
 			ctx.Request.Context(),
+			newCompetitionFilter(q),
 			q.PageSize,
 			q.Offset(),
 		)
@@ -112,6 +119,15 @@ func handleGetCompetitions(
 	}
 }
 
+func newCompetitionFilter(q api.CompetitionListRequest) service.CompetitionFilter {
+	return service.CompetitionFilter{
+		Sport:   q.Sport,
+		Gender:  q.Gender,
+		Level:   q.Level,
+		Country: q.Country,
+	}
+}
+
 // handleGetCompetition retrieves a competition by ID
 //
 //	@Summary	Get a single competition by ID
@@ -120,8 +136,10 @@ func handleGetCompetitions(
 //	@Produce	json
 //	@Param		competitionID	path		string					true	"UUID of the competition"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Success	200				{object}	api.CompetitionResponse	"Competition found"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid competition ID"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200				{string}	ETag					"Version of the competition, for If-Match"
+//	@Failure	400				{object}	response.Problem	"Invalid competition ID"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID} [get]
 func handleGetCompetition(logger zerolog.Logger, competitionService service.CompetitionService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -137,6 +155,7 @@ func handleGetCompetition(logger zerolog.Logger, competitionService service.Comp
 			return
 		}
 
+		setETag(ctx, competition.UpdatedAt)
 		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToCompetitionResponse(competition))
 	}
 }
@@ -150,9 +169,14 @@ func handleGetCompetition(logger zerolog.Logger, competitionService service.Comp
 //	@Produce	json
 //	@Param		competitionID	path		string					true	"UUID of the competition"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Param		competition		body		api.CompetitionRequest	true	"Competition details to update"
+//	@Param		If-Match		header		string					false	"ETag of the competition being replaced"
 //	@Success	200				{object}	api.CompetitionResponse	"Competition updated"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid request"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200				{string}	ETag					"Version of the updated competition"
+//	@Failure	400				{object}	response.Problem	"Invalid request"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	409				{object}	response.Problem	"Conflict"
+//	@Failure	412				{object}	response.Problem	"Competition changed since it was read"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID} [put]
 func handleUpdateCompetition(
 	logger zerolog.Logger,
@@ -180,12 +204,90 @@ func handleUpdateCompetition(
 			return
 		}
 
-		competition, err := competitionService.Update(ctx.Request.Context(), competitionID, req)
+		version, err := ifMatch(ctx, "competition")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		competition, err := competitionService.Update(ctx.Request.Context(), competitionID, req, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update competition")
+			return
+		}
+
+		setETag(ctx, competition.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToCompetitionResponse(competition))
+	}
+}
+
+// handlePatchCompetition applies a JSON merge patch to a competition
+//
+//	@Summary		Partially update a competition
+//	@Description	Applies an RFC 7386 JSON merge patch. The write fails with 412 if the competition changes after it is read, or if If-Match names an older version.
+//	@ID				patch-competition
+//	@Tags			Competitions
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			competitionID	path		string					true	"UUID of the competition"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			competition		body		api.CompetitionRequest	true	"Fields to change"
+//	@Param			If-Match		header		string					false	"ETag of the competition being patched"
+//	@Success		200				{object}	api.CompetitionResponse	"Competition updated"
+//	@Header			200				{string}	ETag					"Version of the updated competition"
+//	@Failure		400				{object}	response.Problem		"Invalid request"
+//	@Failure		404				{object}	response.Problem		"Not found"
+//	@Failure		409				{object}	response.Problem		"Conflict"
+//	@Failure		412				{object}	response.Problem		"Competition changed since it was read"
+//	@Failure		415				{object}	response.Problem		"Unsupported media type"
+//	@Failure		500				{object}	response.Problem		"Internal server error"
+//	@Router			/competitions/{competitionID} [patch]
+func handlePatchCompetition(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	competitionService service.CompetitionService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		competitionID, err := uuid.Parse(ctx.Param("competitionID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid competition ID format")
+			return
+		}
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		current, err := competitionService.Get(ctx.Request.Context(), competitionID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get competition")
+			return
+		}
+
+		version, err := patchVersion(ctx, "competition", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := api.ToCompetitionRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		competition, err := competitionService.Update(ctx.Request.Context(), competitionID, &req, version)
 		if err != nil {
 			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update competition")
 			return
 		}
 
+		setETag(ctx, competition.UpdatedAt)
 		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToCompetitionResponse(competition))
 	}
 }
@@ -198,8 +300,9 @@ func handleUpdateCompetition(
 //	@Produce	json
 //	@Param		competitionID	path		string					true	"UUID of the competition"	default(a973dd2c-ecd3-4578-b5c3-9022a3f0ecbd)
 //	@Success	204				{string}	string					"Successfully deleted"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid competition ID"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}	response.Problem	"Invalid competition ID"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID} [delete]
 func handleDeleteCompetition(logger zerolog.Logger, competitionService service.CompetitionService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
 	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -21,9 +24,9 @@ import (
 // Manual mock for CompetitionService
 type mockCompetitionService struct {
 	CreateFn func(ctx context.Context, req *api.CompetitionRequest) (db.Competition, error)
-	GetAllFn func(ctx context.Context, limit, offset int) ([]db.Competition, int64, error)
+	GetAllFn func(ctx context.Context, filter service.CompetitionFilter, limit, offset int) ([]db.Competition, int64, error)
 	GetFn    func(ctx context.Context, id uuid.UUID) (db.Competition, error)
-	UpdateFn func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest) (db.Competition, error)
+	UpdateFn func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error)
 	DeleteFn func(ctx context.Context, id uuid.UUID) error
 }
 
@@ -36,10 +39,11 @@ func (m *mockCompetitionService) Create(ctx context.Context, req *api.Competitio
 
 func (m *mockCompetitionService) GetAll(
 	ctx context.Context,
+	filter service.CompetitionFilter,
 	limit, offset int,
 ) ([]db.Competition, int64, error) {
 	if m.GetAllFn != nil {
-		return m.GetAllFn(ctx, limit, offset)
+		return m.GetAllFn(ctx, filter, limit, offset)
 	}
 	return nil, 0, nil
 }
@@ -51,9 +55,9 @@ func (m *mockCompetitionService) Get(ctx context.Context, id uuid.UUID) (db.Comp
 	return db.Competition{}, nil
 }
 
-func (m *mockCompetitionService) Update(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest) (db.Competition, error) {
+func (m *mockCompetitionService) Update(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error) {
 	if m.UpdateFn != nil {
-		return m.UpdateFn(ctx, id, req)
+		return m.UpdateFn(ctx, id, req, version)
 	}
 	return db.Competition{}, nil
 }
@@ -77,9 +81,8 @@ var _ = Describe("competition handlers", func() {
 		gin.SetMode(gin.TestMode)
 
 		validate = validator.New()
-		_ = validate.RegisterValidation("entity_name", func(fl validator.FieldLevel) bool {
-			return fl.Field().String() != ""
-		})
+		validation.Register(validate)
+		api.Register(validate)
 		logger = zerolog.Nop()
 
 		mockSvc = &mockCompetitionService{
@@ -96,6 +99,7 @@ var _ = Describe("competition handlers", func() {
 		router.GET("/competitions", handleGetCompetitions(logger, validate, mockSvc))
 		router.GET("/competitions/:competitionID", handleGetCompetition(logger, mockSvc))
 		router.PUT("/competitions/:competitionID", handleUpdateCompetition(logger, validate, mockSvc))
+		router.PATCH("/competitions/:competitionID", handlePatchCompetition(logger, validate, mockSvc))
 		router.DELETE("/competitions/:competitionID", handleDeleteCompetition(logger, mockSvc))
 	})
 
@@ -131,6 +135,26 @@ var _ = Describe("competition handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusBadRequest))
 		})
 
+		It("returns 400 for a colour that is not six digit hex", func() {
+			reqBody := `{"name":"Test Competition","primary_colour":"#FFF"}`
+			req := httptest.NewRequest(http.MethodPost, "/competitions", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 400 for a website that is not an http URL", func() {
+			reqBody := `{"name":"Test Competition","website":"ftp://example.com"}`
+			req := httptest.NewRequest(http.MethodPost, "/competitions", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
 		It("returns 500 when service fails", func() {
 			mockSvc.CreateFn = func(ctx context.Context, req *api.CompetitionRequest) (db.Competition, error) {
 				return db.Competition{}, fmt.Errorf("db failure")
@@ -150,7 +174,8 @@ var _ = Describe("competition handlers", func() {
 		It("returns 200 and competitions", func() {
 			id := uuid.New()
 
-			mockSvc.GetAllFn = func(ctx context.Context, limit, offset int) ([]db.Competition, int64, error) {
+			mockSvc.GetAllFn = func(ctx context.Context, filter service.CompetitionFilter, limit, offset int) ([]db.Competition, int64, error) {
+				Expect(filter).To(Equal(service.CompetitionFilter{}))
 				Expect(limit).To(Equal(10))
 				Expect(offset).To(Equal(0))
 
@@ -167,7 +192,7 @@ var _ = Describe("competition handlers", func() {
 		})
 
 		It("returns 500 when service fails", func() {
-			mockSvc.GetAllFn = func(ctx context.Context, limit, offset int) ([]db.Competition, int64, error) {
+			mockSvc.GetAllFn = func(ctx context.Context, filter service.CompetitionFilter, limit, offset int) ([]db.Competition, int64, error) {
 				return nil, int64(0), fmt.Errorf("db failure")
 			}
 
@@ -179,6 +204,42 @@ var _ = Describe("competition handlers", func() {
 		})
 	})
 
+	Describe("filter competitions", func() {
+		It("passes the filters to the service", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, filter service.CompetitionFilter, limit, offset int) ([]db.Competition, int64, error) {
+				Expect(filter).To(Equal(service.CompetitionFilter{
+					Sport:   api.SportCodeRugbySevens,
+					Gender:  api.CompetitionGenderWomen,
+					Level:   api.CompetitionLevelInternational,
+					Country: "NZ",
+				}))
+				return nil, int64(0), nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/competitions?sport=rugby_sevens&gender=women&level=international&country=NZ", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("returns 400 for an unknown level", func() {
+			req := httptest.NewRequest(http.MethodGet, "/competitions?level=amateur", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 400 for a country that is not a country code", func() {
+			req := httptest.NewRequest(http.MethodGet, "/competitions?country=New%20Zealand", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	Describe("get one competition", func() {
 		It("returns 200 for valid ID", func() {
 			compID := uuid.New()
@@ -215,7 +276,7 @@ var _ = Describe("competition handlers", func() {
 	Describe("update competition", func() {
 		It("returns 200 for valid update", func() {
 			compID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest) (db.Competition, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error) {
 				return db.Competition{ID: id, Name: req.Name}, nil
 			}
 
@@ -250,7 +311,7 @@ var _ = Describe("competition handlers", func() {
 
 		It("returns 500 when service fails", func() {
 			compID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest) (db.Competition, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error) {
 				return db.Competition{}, fmt.Errorf("db failure")
 			}
 
@@ -263,6 +324,46 @@ var _ = Describe("competition handlers", func() {
 		})
 	})
 
+	Describe("patch competition", func() {
+		It("renames the competition against the version it read", func() {
+			current := db.Competition{ID: uuid.New(), Name: "Old Name", UpdatedAt: time.Now().UTC().Add(-time.Hour)}
+			mockSvc.GetFn = func(ctx context.Context, id uuid.UUID) (db.Competition, error) {
+				return current, nil
+			}
+			var gotVersion *time.Time
+			mockSvc.UpdateFn = func(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error) {
+				gotVersion = version
+				return db.Competition{ID: id, Name: req.Name, UpdatedAt: time.Now().UTC()}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/competitions/"+current.ID.String(), bytes.NewBufferString(`{"name":"New Name"}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			req.Header.Set("If-Match", etag(current.UpdatedAt))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring("New Name"))
+			Expect(w.Header().Get("ETag")).NotTo(BeEmpty())
+			Expect(*gotVersion).To(Equal(current.UpdatedAt))
+		})
+
+		It("returns 412 when If-Match is stale", func() {
+			current := db.Competition{ID: uuid.New(), Name: "Old Name", UpdatedAt: time.Now().UTC()}
+			mockSvc.GetFn = func(ctx context.Context, id uuid.UUID) (db.Competition, error) {
+				return current, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/competitions/"+current.ID.String(), bytes.NewBufferString(`{"name":"New Name"}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			req.Header.Set("If-Match", etag(current.UpdatedAt.Add(-time.Hour)))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+		})
+	})
+
 	Describe("delete competition", func() {
 		It("returns 204 for successful deletion", func() {
 			compID := uuid.New()
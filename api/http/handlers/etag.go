@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/bradley-adams/gainline/service"
+)
+
+// etag is the strong entity tag for a resource last written at updatedAt. Every write
+// moves updated_at, so the tag changes whenever the resource does.
+func etag(updatedAt time.Time) string {
+	return strconv.Quote(strconv.FormatInt(updatedAt.UnixNano(), 10))
+}
+
+func setETag(ctx *gin.Context, updatedAt time.Time) {
+	ctx.Header("ETag", etag(updatedAt))
+}
+
+// ifMatch reads the If-Match header as the updated_at the client expects to replace.
+// A missing header or "*" returns nil, skipping the check. A tag this API could not have
+// issued, including a weak tag or a list of tags, can never match and fails with
+// PreconditionFailedError.
+func ifMatch(ctx *gin.Context, resource string) (*time.Time, error) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	value, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, service.NewPreconditionFailedError(resource)
+	}
+
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, service.NewPreconditionFailedError(resource)
+	}
+
+	version := time.Unix(0, nanos)
+	return &version, nil
+}
+
+// patchVersion checks If-Match against current, the updated_at of the resource a patch
+// was applied to, and returns the version the write must still find. The patch is built
+// from current, so the write is conditional even when the client sent no If-Match.
+func patchVersion(ctx *gin.Context, resource string, current time.Time) (*time.Time, error) {
+	version, err := ifMatch(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+	if version != nil && !version.Equal(current) {
+		return nil, service.NewPreconditionFailedError(resource)
+	}
+	return &current, nil
+}
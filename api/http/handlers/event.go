@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleGetGameEvents lists the scoring and disciplinary events in a game
+//
+//	@Summary	Get the events in a game
+//	@ID			get-game-events
+//	@Tags		Events
+//	@Produce	json
+//	@Param		competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Success	200				{array}		api.GameEventResponse		"Events found"
+//	@Failure	400				{object}	response.Problem			"Invalid game ID"
+//	@Failure	403				{object}	response.Problem			"Forbidden"
+//	@Failure	404				{object}	response.Problem			"Not found"
+//	@Failure	500				{object}	response.Problem			"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/events [get]
+func handleGetGameEvents(logger zerolog.Logger, eventService service.EventService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		events, err := eventService.GetAll(ctx.Request.Context(), gameID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game events")
+			return
+		}
+
+		resp := make([]api.GameEventResponse, 0, len(events))
+		for _, e := range events {
+			resp = append(resp, api.ToGameEventResponse(e))
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, resp)
+	}
+}
+
+// handleCreateGameEvent records a try, kick at goal or card in a game
+//
+//	@Summary		Record a game event
+//	@Description	Events can be recorded once a game is playing, and corrected after it has finished. A penalty try names no player; every other event names a player in the team's squad on the game date. Events feed player statistics and do not change the score.
+//	@ID				create-game-event
+//	@Tags			Events
+//	@Accept			json
+//	@Produce		json
+//	@Param			competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param			event			body		api.GameEventRequest		true	"Event"
+//	@Success		201				{object}	api.GameEventResponse		"Event recorded"
+//	@Failure		400				{object}	response.Problem			"Bad request"
+//	@Failure		403				{object}	response.Problem			"Team is not playing in the game"
+//	@Failure		404				{object}	response.Problem			"Not found"
+//	@Failure		409				{object}	response.Problem			"Game has not kicked off"
+//	@Failure		500				{object}	response.Problem			"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/events [post]
+func handleCreateGameEvent(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	eventService service.EventService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		req := &api.GameEventRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		event, err := eventService.Create(ctx.Request.Context(), req, gameID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to record game event")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToGameEventResponse(event))
+	}
+}
+
+// handleDeleteGameEvent removes an event recorded in error
+//
+//	@Summary	Delete a game event
+//	@ID			delete-game-event
+//	@Tags		Events
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path			string	true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param		eventID			path			string	true	"Event ID"			default(3a7d9c2e-5b1f-4e8a-b6d4-0c2f8e1a9b57)
+//	@Success	204				"No Content"	"Event deleted"
+//	@Failure	400				{object}		response.Problem	"Invalid ID"
+//	@Failure	404				{object}		response.Problem	"Not found"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/events/{eventID} [delete]
+func handleDeleteGameEvent(logger zerolog.Logger, eventService service.EventService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		eventID, err := uuid.Parse(ctx.Param("eventID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid event ID")
+			return
+		}
+
+		err = eventService.Delete(ctx.Request.Context(), gameID, eventID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to delete game event")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for EventService
+type mockEventService struct {
+	GetAllFn func(ctx context.Context, gameID uuid.UUID) ([]db.GameEvent, error)
+	CreateFn func(ctx context.Context, req *api.GameEventRequest, gameID uuid.UUID) (db.GameEvent, error)
+	DeleteFn func(ctx context.Context, gameID, eventID uuid.UUID) error
+}
+
+func (m *mockEventService) GetAll(ctx context.Context, gameID uuid.UUID) ([]db.GameEvent, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, gameID)
+	}
+	return nil, nil
+}
+
+func (m *mockEventService) Create(ctx context.Context, req *api.GameEventRequest, gameID uuid.UUID) (db.GameEvent, error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(ctx, req, gameID)
+	}
+	return db.GameEvent{}, nil
+}
+
+func (m *mockEventService) Delete(ctx context.Context, gameID, eventID uuid.UUID) error {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(ctx, gameID, eventID)
+	}
+	return nil
+}
+
+var _ = Describe("event handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockEventService
+		gameID   uuid.UUID
+		url      string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockEventService{}
+		router = gin.New()
+
+		route := "/competitions/:competitionID/seasons/:seasonID/games/:gameID/events"
+		router.GET(route, handleGetGameEvents(logger, mockSvc))
+		router.POST(route, handleCreateGameEvent(logger, validate, mockSvc))
+		router.DELETE(route+"/:eventID", handleDeleteGameEvent(logger, mockSvc))
+
+		gameID = uuid.New()
+		url = "/competitions/" + uuid.NewString() + "/seasons/" + uuid.NewString() + "/games/" + gameID.String() + "/events"
+	})
+
+	Describe("get events", func() {
+		It("returns 200 with an empty list for a game without events", func() {
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal("[]"))
+		})
+	})
+
+	Describe("create event", func() {
+		It("returns 201 with the event", func() {
+			teamID, playerID := uuid.New(), uuid.New()
+			mockSvc.CreateFn = func(ctx context.Context, req *api.GameEventRequest, gID uuid.UUID) (db.GameEvent, error) {
+				Expect(gID).To(Equal(gameID))
+				return db.GameEvent{
+					ID:        uuid.New(),
+					GameID:    gID,
+					TeamID:    req.TeamID,
+					PlayerID:  uuid.NullUUID{UUID: *req.PlayerID, Valid: true},
+					EventType: db.GameEventType(req.Type),
+					Minute:    req.Minute,
+				}, nil
+			}
+
+			body := fmt.Sprintf(`{"team_id":"%s","player_id":"%s","type":"try","minute":23}`, teamID, playerID)
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.GameEventResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(*resp.PlayerID).To(Equal(playerID))
+			Expect(resp.Type).To(Equal(api.GameEventTypeTry))
+		})
+
+		It("returns 400 when a try names no player", func() {
+			body := fmt.Sprintf(`{"team_id":"%s","type":"try","minute":23}`, uuid.New())
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("is required for every event except a penalty try"))
+		})
+
+		It("returns 409 before kickoff", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.GameEventRequest, gID uuid.UUID) (db.GameEvent, error) {
+				return db.GameEvent{}, service.NewConflictError("events can only be recorded once a game has kicked off", nil)
+			}
+
+			body := fmt.Sprintf(`{"team_id":"%s","type":"penalty_try","minute":40}`, uuid.New())
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("delete event", func() {
+		It("returns 204 when the event is deleted", func() {
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+uuid.NewString(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("returns 400 for an invalid event ID", func() {
+			req := httptest.NewRequest(http.MethodDelete, url+"/not-a-uuid", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
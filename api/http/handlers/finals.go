@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog"
+)
+
+// handleGetFinals returns a season's finals bracket
+//
+//	@Summary	Get a season's finals bracket
+//	@ID			get-finals
+//	@Tags		Finals
+//	@Produce	json
+//	@Param		competitionID	path		string				true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string				true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Success	200				{object}	api.FinalsResponse	"Finals bracket"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/finals [get]
+func handleGetFinals(logger zerolog.Logger, finalsService service.FinalsService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		bracket, err := finalsService.Get(ctx.Request.Context(), season)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get finals")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, toFinalsResponse(bracket))
+	}
+}
+
+// handleSetFinals sets a season's finals format
+//
+//	@Summary	Set a season's finals format
+//	@ID			set-finals
+//	@Tags		Finals
+//	@Accept		json
+//	@Produce	json
+//	@Param		competitionID	path		string				true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string				true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		finals			body		api.FinalsRequest	true	"Finals format"
+//	@Success	200				{object}	api.FinalsResponse	"Finals bracket"
+//	@Failure	400				{object}	response.Problem	"Bad request"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/finals [put]
+func handleSetFinals(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	finalsService service.FinalsService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		req := &api.FinalsRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		req.SetDefaults()
+
+		bracket, err := finalsService.Set(ctx.Request.Context(), req, season)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to set finals")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, toFinalsResponse(bracket))
+	}
+}
+
+// handleDeleteFinals removes a season's finals format
+//
+//	@Summary	Remove a season's finals format
+//	@ID			delete-finals
+//	@Tags		Finals
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Success	204				"No Content"	"Finals format removed"
+//	@Failure	403				{object}		response.Problem	"Forbidden"
+//	@Failure	404				{object}		response.Problem	"Not found"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/finals [delete]
+func handleDeleteFinals(logger zerolog.Logger, finalsService service.FinalsService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		if err := finalsService.Delete(ctx.Request.Context(), season); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to delete finals")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func toFinalsResponse(bracket service.FinalsBracket) api.FinalsResponse {
+	resp := api.FinalsResponse{
+		Format:            api.FinalsFormat(bracket.Finals.Format),
+		RoundIntervalDays: bracket.Finals.RoundIntervalDays,
+		Seeds:             make([]api.FinalsSeedResponse, 0, len(bracket.Seeds)),
+		Rounds:            make([]api.FinalsRoundResponse, 0, len(bracket.Rounds)),
+	}
+
+	for i, entry := range bracket.Seeds {
+		resp.Seeds = append(resp.Seeds, api.FinalsSeedResponse{
+			Seed:   i + 1,
+			Team:   api.ToTeamSummary(entry.Team),
+			Points: entry.Points,
+			Record: toTeamRecordResponse(entry.Record),
+		})
+	}
+
+	for _, round := range bracket.Rounds {
+		games := make([]api.GameResponse, 0, len(round.Games))
+		for _, g := range round.Games {
+			games = append(games, api.ToGameResponse(g))
+		}
+
+		resp.Rounds = append(resp.Rounds, api.FinalsRoundResponse{
+			Stage: api.ToStageSummary(round.Stage),
+			Games: games,
+		})
+	}
+
+	return resp
+}
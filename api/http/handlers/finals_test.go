@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for FinalsService
+type mockFinalsService struct {
+	GetFn    func(ctx context.Context, season service.SeasonAggregate) (service.FinalsBracket, error)
+	SetFn    func(ctx context.Context, req *api.FinalsRequest, season service.SeasonAggregate) (service.FinalsBracket, error)
+	DeleteFn func(ctx context.Context, season service.SeasonAggregate) error
+}
+
+func (m *mockFinalsService) Get(ctx context.Context, season service.SeasonAggregate) (service.FinalsBracket, error) {
+	if m.GetFn != nil {
+		return m.GetFn(ctx, season)
+	}
+	return service.FinalsBracket{}, nil
+}
+
+func (m *mockFinalsService) Set(ctx context.Context, req *api.FinalsRequest, season service.SeasonAggregate) (service.FinalsBracket, error) {
+	if m.SetFn != nil {
+		return m.SetFn(ctx, req, season)
+	}
+	return service.FinalsBracket{}, nil
+}
+
+func (m *mockFinalsService) Delete(ctx context.Context, season service.SeasonAggregate) error {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(ctx, season)
+	}
+	return nil
+}
+
+var _ = Describe("finals handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockFinalsService
+		season   service.SeasonAggregate
+		bracket  service.FinalsBracket
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockFinalsService{}
+		router = gin.New()
+
+		season = service.SeasonAggregate{ID: uuid.New()}
+
+		top := db.Team{ID: uuid.New(), Name: "Crusaders", Abbreviation: "CRU"}
+		fourth := db.Team{ID: uuid.New(), Name: "Blues", Abbreviation: "BLU"}
+		semis := db.Stage{ID: uuid.New(), Name: "Semi Finals", StageType: db.StageTypeFinals, OrderIndex: 15}
+		bracket = service.FinalsBracket{
+			Finals: db.SeasonFinal{SeasonID: season.ID, Format: db.FinalsFormatTop4, RoundIntervalDays: 7},
+			Seeds: []service.LadderEntry{
+				{Team: top, Points: 48, Record: service.TeamRecord{Played: 14, Won: 12, Lost: 2, PointsFor: 400, PointsAgainst: 250}},
+				{Team: fourth, Points: 36},
+			},
+			Rounds: []service.FinalsRound{{
+				Stage: semis,
+				Games: []db.Game{{
+					ID:         uuid.New(),
+					SeasonID:   season.ID,
+					StageID:    semis.ID,
+					Date:       time.Date(2025, time.June, 14, 19, 5, 0, 0, time.UTC),
+					HomeTeamID: top.ID,
+					AwayTeamID: fourth.ID,
+					Status:     db.GameStatusScheduled,
+				}},
+			}},
+		}
+
+		withSeason := func(h gin.HandlerFunc) gin.HandlerFunc {
+			return func(c *gin.Context) {
+				c.Set("season", season)
+				h(c)
+			}
+		}
+		router.GET("/seasons/:seasonID/finals", withSeason(handleGetFinals(logger, mockSvc)))
+		router.PUT("/seasons/:seasonID/finals", withSeason(handleSetFinals(logger, validate, mockSvc)))
+		router.DELETE("/seasons/:seasonID/finals", withSeason(handleDeleteFinals(logger, mockSvc)))
+	})
+
+	do := func(method, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/seasons/"+season.ID.String()+"/finals", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("get finals", func() {
+		It("returns the bracket with seeds and rounds", func() {
+			mockSvc.GetFn = func(ctx context.Context, s service.SeasonAggregate) (service.FinalsBracket, error) {
+				Expect(s.ID).To(Equal(season.ID))
+				return bracket, nil
+			}
+
+			w := do(http.MethodGet, "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var body api.FinalsResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Format).To(Equal(api.FinalsFormatTop4))
+			Expect(body.RoundIntervalDays).To(Equal(int32(7)))
+			Expect(body.Seeds).To(HaveLen(2))
+			Expect(body.Seeds[0].Seed).To(Equal(1))
+			Expect(body.Seeds[0].Team.Abbreviation).To(Equal("CRU"))
+			Expect(body.Seeds[0].Points).To(Equal(48))
+			Expect(body.Seeds[0].Record.PointsDifference).To(Equal(int32(150)))
+			Expect(body.Rounds[0].Stage.Name).To(Equal("Semi Finals"))
+			Expect(body.Rounds[0].Games[0].HomeTeamID).To(Equal(bracket.Seeds[0].Team.ID))
+		})
+
+		It("returns 404 when the season has no finals format", func() {
+			mockSvc.GetFn = func(ctx context.Context, s service.SeasonAggregate) (service.FinalsBracket, error) {
+				return service.FinalsBracket{}, errors.Wrap(service.NewNotFoundError("finals", sql.ErrNoRows), "unable to get season finals")
+			}
+
+			w := do(http.MethodGet, "")
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("set finals", func() {
+		It("defaults the round interval", func() {
+			var got *api.FinalsRequest
+			mockSvc.SetFn = func(ctx context.Context, req *api.FinalsRequest, s service.SeasonAggregate) (service.FinalsBracket, error) {
+				got = req
+				return bracket, nil
+			}
+
+			w := do(http.MethodPut, `{"format":"top_6"}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(got.Format).To(Equal(api.FinalsFormatTop6))
+			Expect(got.RoundIntervalDays).To(Equal(api.DefaultRoundIntervalDays))
+		})
+
+		It("returns 400 for an unknown format", func() {
+			w := do(http.MethodPut, `{"format":"top_5"}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring(`"rule":"finals_format"`))
+		})
+
+		It("returns 400 when the season cannot hold the format", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.FinalsRequest, s service.SeasonAggregate) (service.FinalsBracket, error) {
+				return service.FinalsBracket{}, service.NewValidationError("invalid finals", service.FieldError{Field: "format", Rule: "enough_rounds", Message: "top_8 needs 3 finals stages, season has 1"})
+			}
+
+			w := do(http.MethodPut, `{"format":"top_8"}`)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.FinalsRequest, s service.SeasonAggregate) (service.FinalsBracket, error) {
+				return service.FinalsBracket{}, fmt.Errorf("db failure")
+			}
+
+			w := do(http.MethodPut, `{"format":"top_4"}`)
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("delete finals", func() {
+		It("returns 204", func() {
+			called := false
+			mockSvc.DeleteFn = func(ctx context.Context, s service.SeasonAggregate) error {
+				called = true
+				return nil
+			}
+
+			w := do(http.MethodDelete, "")
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(called).To(BeTrue())
+		})
+	})
+})
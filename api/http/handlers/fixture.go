@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog"
+)
+
+// handleGenerateFixtures generates a round-robin draw for a season
+//
+//	@Summary	Generate a round-robin draw
+//	@ID			generate-fixtures
+//	@Tags		Fixtures
+//	@Accept		json
+//	@Produce	json
+//	@Param		competitionID	path		string							true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string							true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		dry_run			query		bool							false	"Preview the draw without saving it"
+//	@Param		fixtures		body		api.FixtureGenerateRequest		true	"Draw options"
+//	@Success	200				{object}	api.FixtureGenerateResponse	"Draw preview"
+//	@Success	201				{object}	api.FixtureGenerateResponse	"Draw saved"
+//	@Failure	400				{object}	response.Problem				"Bad request"
+//	@Failure	403				{object}	response.Problem				"Forbidden"
+//	@Failure	404				{object}	response.Problem				"Not found"
+//	@Failure	409				{object}	response.Problem				"Stages already have games"
+//	@Failure	500				{object}	response.Problem				"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/fixtures/generate [post]
+func handleGenerateFixtures(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	fixtureService service.FixtureService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		q := api.FixtureGenerateQuery{}
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		req := &api.FixtureGenerateRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		req.SetDefaults()
+
+		plan, err := fixtureService.Generate(ctx.Request.Context(), req, season, q.DryRun)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to generate fixtures")
+			return
+		}
+
+		status := http.StatusCreated
+		if q.DryRun {
+			status = http.StatusOK
+		}
+
+		response.RespondSuccess(ctx, logger, status, toFixtureGenerateResponse(plan, q.DryRun))
+	}
+}
+
+// handleValidateFixtures reports the scheduling rules broken by a season's games
+//
+//	@Summary	Check a season's fixtures
+//	@ID			validate-fixtures
+//	@Tags		Fixtures
+//	@Produce	json
+//	@Param		competitionID	path		string							true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string							true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		min_rest_days	query		int								false	"Override the minimum rest days between a team's games"
+//	@Success	200				{object}	api.FixtureValidateResponse	"Fixture report"
+//	@Failure	400				{object}	response.Problem				"Bad request"
+//	@Failure	403				{object}	response.Problem				"Forbidden"
+//	@Failure	404				{object}	response.Problem				"Not found"
+//	@Failure	500				{object}	response.Problem				"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/fixtures/validate [get]
+func handleValidateFixtures(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	fixtureService service.FixtureService,
+	rules service.FixtureRules,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		q := api.FixtureValidateQuery{}
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		// Copy the rules so one request's override does not leak into the next.
+		r := rules
+		if q.MinRestDays != nil {
+			r.MinRestDays = *q.MinRestDays
+		}
+
+		report, err := fixtureService.Validate(ctx.Request.Context(), season, r)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to validate fixtures")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, toFixtureValidateResponse(report))
+	}
+}
+
+func toFixtureGenerateResponse(plan service.FixturePlan, dryRun bool) api.FixtureGenerateResponse {
+	resp := api.FixtureGenerateResponse{
+		DryRun:    dryRun,
+		GameCount: plan.GameCount(),
+		Rounds:    make([]api.FixtureRoundResponse, 0, len(plan.Rounds)),
+	}
+
+	for _, r := range plan.Rounds {
+		round := api.FixtureRoundResponse{
+			Stage: api.ToStageSummary(r.Stage),
+			Games: make([]api.FixtureGameResponse, 0, len(r.Games)),
+			Byes:  toTeamSummaries(r.Byes),
+		}
+
+		for _, g := range r.Games {
+			game := api.FixtureGameResponse{
+				Date:     g.Date,
+				HomeTeam: api.ToTeamSummary(g.HomeTeam),
+				AwayTeam: api.ToTeamSummary(g.AwayTeam),
+			}
+			if !dryRun {
+				id := g.ID
+				game.ID = &id
+			}
+			round.Games = append(round.Games, game)
+		}
+
+		resp.Rounds = append(resp.Rounds, round)
+	}
+
+	return resp
+}
+
+func toTeamSummaries(teams []db.Team) []api.TeamSummary {
+	summaries := make([]api.TeamSummary, 0, len(teams))
+	for _, t := range teams {
+		summaries = append(summaries, api.ToTeamSummary(t))
+	}
+	return summaries
+}
+
+func toFixtureValidateResponse(report service.FixtureReport) api.FixtureValidateResponse {
+	resp := api.FixtureValidateResponse{
+		Valid:       report.Valid(),
+		GameCount:   report.GameCount,
+		MinRestDays: report.Rules.MinRestDays,
+		Issues:      make([]api.FixtureIssueResponse, 0, len(report.Issues)),
+	}
+
+	for _, issue := range report.Issues {
+		resp.Issues = append(resp.Issues, api.FixtureIssueResponse{
+			Rule:    issue.Rule,
+			TeamID:  issue.TeamID,
+			GameIDs: issue.GameIDs,
+			Message: issue.Message,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for FixtureService
+type mockFixtureService struct {
+	GenerateFn func(ctx context.Context, req *api.FixtureGenerateRequest, season service.SeasonAggregate, dryRun bool) (service.FixturePlan, error)
+	ValidateFn func(ctx context.Context, season service.SeasonAggregate, rules service.FixtureRules) (service.FixtureReport, error)
+}
+
+func (m *mockFixtureService) Generate(ctx context.Context, req *api.FixtureGenerateRequest, season service.SeasonAggregate, dryRun bool) (service.FixturePlan, error) {
+	if m.GenerateFn != nil {
+		return m.GenerateFn(ctx, req, season, dryRun)
+	}
+	return service.FixturePlan{}, nil
+}
+
+func (m *mockFixtureService) Validate(ctx context.Context, season service.SeasonAggregate, rules service.FixtureRules) (service.FixtureReport, error) {
+	if m.ValidateFn != nil {
+		return m.ValidateFn(ctx, season, rules)
+	}
+	return service.FixtureReport{}, nil
+}
+
+var _ = Describe("fixture handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockFixtureService
+		season   service.SeasonAggregate
+		plan     service.FixturePlan
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockFixtureService{}
+		router = gin.New()
+
+		season = service.SeasonAggregate{ID: uuid.New()}
+
+		home := db.Team{ID: uuid.New(), Name: "Auckland", Abbreviation: "AKL"}
+		away := db.Team{ID: uuid.New(), Name: "Canterbury", Abbreviation: "CAN"}
+		plan = service.FixturePlan{
+			Rounds: []service.FixtureRound{{
+				Stage: db.Stage{ID: uuid.New(), Name: "Round 1", StageType: db.StageTypeRegular, OrderIndex: 1},
+				Games: []service.FixtureGame{{
+					ID:       uuid.New(),
+					Date:     time.Date(2025, time.August, 1, 19, 5, 0, 0, time.UTC),
+					HomeTeam: home,
+					AwayTeam: away,
+				}},
+				Byes: []db.Team{{ID: uuid.New(), Name: "Wellington", Abbreviation: "WEL"}},
+			}},
+		}
+
+		router.POST("/seasons/:seasonID/fixtures/generate", func(c *gin.Context) {
+			c.Set("season", season)
+			handleGenerateFixtures(logger, validate, mockSvc)(c)
+		})
+		validateFixtures := handleValidateFixtures(logger, validate, mockSvc, service.FixtureRules{MinRestDays: 3})
+		router.GET("/seasons/:seasonID/fixtures/validate", func(c *gin.Context) {
+			c.Set("season", season)
+			validateFixtures(c)
+		})
+	})
+
+	post := func(query, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodPost,
+			"/seasons/"+season.ID.String()+"/fixtures/generate"+query,
+			bytes.NewBufferString(body),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("generate fixtures", func() {
+		It("returns 200 with a preview for a dry run", func() {
+			var gotReq *api.FixtureGenerateRequest
+			var gotDryRun bool
+			mockSvc.GenerateFn = func(ctx context.Context, req *api.FixtureGenerateRequest, s service.SeasonAggregate, dryRun bool) (service.FixturePlan, error) {
+				gotReq, gotDryRun = req, dryRun
+				return plan, nil
+			}
+
+			w := post("?dry_run=true", `{"first_round_date":"2025-08-01T19:05:00Z","double_round_robin":true}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotDryRun).To(BeTrue())
+			Expect(gotReq.DoubleRoundRobin).To(BeTrue())
+			Expect(gotReq.RoundIntervalDays).To(Equal(api.DefaultRoundIntervalDays))
+			Expect(gotReq.KickoffSlots).To(Equal([]api.KickoffSlot{{Time: "19:05"}}))
+
+			var body api.FixtureGenerateResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.DryRun).To(BeTrue())
+			Expect(body.GameCount).To(Equal(1))
+			Expect(body.Rounds[0].Stage.Name).To(Equal("Round 1"))
+			Expect(body.Rounds[0].Games[0].ID).To(BeNil())
+			Expect(body.Rounds[0].Games[0].HomeTeam.Abbreviation).To(Equal("AKL"))
+			Expect(body.Rounds[0].Byes[0].Abbreviation).To(Equal("WEL"))
+		})
+
+		It("returns 201 with game IDs once saved", func() {
+			mockSvc.GenerateFn = func(ctx context.Context, req *api.FixtureGenerateRequest, s service.SeasonAggregate, dryRun bool) (service.FixturePlan, error) {
+				Expect(dryRun).To(BeFalse())
+				Expect(s.ID).To(Equal(season.ID))
+				return plan, nil
+			}
+
+			w := post("", `{"first_round_date":"2025-08-01T19:05:00Z"}`)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var body api.FixtureGenerateResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(*body.Rounds[0].Games[0].ID).To(Equal(plan.Rounds[0].Games[0].ID))
+		})
+
+		It("returns 400 for an invalid kickoff slot", func() {
+			w := post("", `{"first_round_date":"2025-08-01T19:05:00Z","kickoff_slots":[{"day_offset":1,"time":"7pm"}]}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring(`"field":"kickoff_slots[0].time"`))
+		})
+
+		It("returns 400 when the first round date is missing", func() {
+			w := post("", `{}`)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 409 when stages already have games", func() {
+			mockSvc.GenerateFn = func(ctx context.Context, req *api.FixtureGenerateRequest, s service.SeasonAggregate, dryRun bool) (service.FixturePlan, error) {
+				return service.FixturePlan{}, service.NewConflictError("stage Round 1 already has games", nil)
+			}
+
+			w := post("", `{"first_round_date":"2025-08-01T19:05:00Z"}`)
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.GenerateFn = func(ctx context.Context, req *api.FixtureGenerateRequest, s service.SeasonAggregate, dryRun bool) (service.FixturePlan, error) {
+				return service.FixturePlan{}, fmt.Errorf("db failure")
+			}
+
+			w := post("", `{"first_round_date":"2025-08-01T19:05:00Z"}`)
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("validate fixtures", func() {
+		get := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/fixtures/validate"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		It("returns the report using the configured rules", func() {
+			teamID := uuid.New()
+			gameIDs := []uuid.UUID{uuid.New(), uuid.New()}
+			mockSvc.ValidateFn = func(ctx context.Context, s service.SeasonAggregate, rules service.FixtureRules) (service.FixtureReport, error) {
+				Expect(s.ID).To(Equal(season.ID))
+				Expect(rules.MinRestDays).To(Equal(3))
+				return service.FixtureReport{
+					GameCount: 12,
+					Rules:     rules,
+					Issues: []service.FixtureIssue{{
+						Rule:    api.FixtureRuleDoubleBooked,
+						TeamID:  teamID,
+						GameIDs: gameIDs,
+						Message: "Auckland plays twice in Round 1",
+					}},
+				}, nil
+			}
+
+			w := get("")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var body api.FixtureValidateResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Valid).To(BeFalse())
+			Expect(body.GameCount).To(Equal(12))
+			Expect(body.MinRestDays).To(Equal(3))
+			Expect(body.Issues).To(ConsistOf(api.FixtureIssueResponse{
+				Rule:    api.FixtureRuleDoubleBooked,
+				TeamID:  teamID,
+				GameIDs: gameIDs,
+				Message: "Auckland plays twice in Round 1",
+			}))
+		})
+
+		It("lets the query override the minimum rest days", func() {
+			var got service.FixtureRules
+			mockSvc.ValidateFn = func(ctx context.Context, s service.SeasonAggregate, rules service.FixtureRules) (service.FixtureReport, error) {
+				got = rules
+				return service.FixtureReport{Rules: rules}, nil
+			}
+
+			w := get("?min_rest_days=0")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(got.MinRestDays).To(Equal(0))
+
+			var body api.FixtureValidateResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Valid).To(BeTrue())
+			Expect(body.Issues).To(BeEmpty())
+		})
+
+		It("does not keep one request's override for the next", func() {
+			var got []int
+			mockSvc.ValidateFn = func(ctx context.Context, s service.SeasonAggregate, rules service.FixtureRules) (service.FixtureReport, error) {
+				got = append(got, rules.MinRestDays)
+				return service.FixtureReport{Rules: rules}, nil
+			}
+
+			Expect(get("?min_rest_days=0").Code).To(Equal(http.StatusOK))
+			Expect(get("").Code).To(Equal(http.StatusOK))
+
+			Expect(got).To(Equal([]int{0, 3}))
+		})
+
+		It("returns 400 for an out of range minimum", func() {
+			w := get("?min_rest_days=-1")
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.ValidateFn = func(ctx context.Context, s service.SeasonAggregate, rules service.FixtureRules) (service.FixtureReport, error) {
+				return service.FixtureReport{}, fmt.Errorf("db failure")
+			}
+
+			w := get("")
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
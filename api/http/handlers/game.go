@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"math"
 	"net/http"
 
+	"github.com/bradley-adams/gainline/db/db"
 	"github.com/bradley-adams/gainline/http/api"
 	"github.com/bradley-adams/gainline/http/response"
 	"github.com/bradley-adams/gainline/service"
@@ -23,8 +25,10 @@ import (
 //	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
 //	@Param		game			body		api.GameRequest			true	"Game details to create"
 //	@Success	201				{object}	api.GameResponse		"Successful operation"
-//	@Failure	400				{object}	response.ErrorResponse	"Bad request"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}	response.Problem	"Bad request"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID}/games [post]
 func handleCreateGame(
 	logger zerolog.Logger,
@@ -52,7 +56,7 @@ func handleCreateGame(
 			return
 		}
 
-		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToGameResponse(game))
+		response.RespondSuccess(ctx, logger, http.StatusCreated, toSavedGameResponses(ctx, logger, gameService, []db.Game{game})[0])
 	}
 }
 
@@ -65,11 +69,18 @@ func handleCreateGame(
 //	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
 //	@Param		stageID			path		string					true	"Stage ID"			default(eab15533-dea6-4a3d-8a95-d38e4fba2d5a)
+//	@Param		expand			query		string					false	"Comma-separated resources to embed: teams, stage"
 //	@Success	200				{array}		api.GameResponse		"Games for stage"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid ID"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}	response.Problem	"Invalid ID"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID}/stages/{stageID}/games [get]
-func handleGetGames(logger zerolog.Logger, gameService service.GameService) gin.HandlerFunc {
+func handleGetGames(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	gameService service.GameService,
+) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		seasonID, err := uuid.Parse(ctx.Param("seasonID"))
 		if err != nil {
@@ -83,21 +94,271 @@ func handleGetGames(logger zerolog.Logger, gameService service.GameService) gin.
 			return
 		}
 
+		expand, err := bindGameExpand(ctx, validate)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
 		games, err := gameService.GetAll(ctx.Request.Context(), seasonID, stageID)
 		if err != nil {
 			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get games")
 			return
 		}
 
-		data := make([]api.GameResponse, 0, len(games))
-		for _, g := range games {
-			data = append(data, api.ToGameResponse(g))
+		data, err := toGameResponses(ctx, gameService, games, expand)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game details")
+			return
 		}
 
 		response.RespondSuccess(ctx, logger, http.StatusOK, data)
 	}
 }
 
+// handleGetSeasonGames retrieves a page of games for a season
+//
+//	@Summary	Get games for a season
+//	@ID			get-season-games
+//	@Tags		Games
+//	@Produce	json
+//	@Param		competitionID	path		string										true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string										true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		page			query		int											false	"Page number"		default(1)
+//	@Param		page_size		query		int											false	"Page size"			default(20)
+//	@Param		stage_id		query		string										false	"Only games in this stage"
+//	@Param		team_id			query		string										false	"Only games involving this team"
+//	@Param		status			query		string										false	"Only games with this status"	Enums(scheduled, playing, finished, cancelled)
+//	@Param		from			query		string										false	"Only games on or after this time (RFC 3339)"
+//	@Param		to				query		string										false	"Only games on or before this time (RFC 3339)"
+//	@Param		sort			query		string										false	"Sort order by date"	Enums(date, -date)	default(date)
+//	@Param		expand			query		string										false	"Comma-separated resources to embed: teams, stage"
+//	@Success	200				{object}	api.PaginatedResponse[api.GameResponse]	"Paginated games"
+//	@Failure	400				{object}	response.Problem							"Invalid query params"
+//	@Failure	403				{object}	response.Problem							"Forbidden"
+//	@Failure	404				{object}	response.Problem							"Not found"
+//	@Failure	500				{object}	response.Problem							"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games [get]
+func handleGetSeasonGames(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	gameService service.GameService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		q := api.GameListRequest{}
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		q.SetDefaults()
+
+		expand, err := bindGameExpand(ctx, validate)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		games, total, err := gameService.GetAllBySeason(
+			ctx.Request.Context(),
+			season.ID,
+			newGameFilter(q),
+			q.PageSize,
+			q.Offset(),
+		)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get games")
+			return
+		}
+
+		data, err := toGameResponses(ctx, gameService, games, expand)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game details")
+			return
+		}
+
+		totalPages := int(math.Ceil(float64(total) / float64(q.PageSize)))
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.PaginatedResponse[api.GameResponse]{
+			Data: data,
+			Pagination: api.PaginationMeta{
+				Page:       q.Page,
+				PageSize:   q.PageSize,
+				Total:      total,
+				TotalPages: totalPages,
+			},
+		})
+	}
+}
+
+// handleGetGameFeed retrieves games across every competition
+//
+//	@Summary	Get fixtures and results across competitions
+//	@ID			get-game-feed
+//	@Tags		Games
+//	@Produce	json
+//	@Param		page			query		int												false	"Page number"		default(1)
+//	@Param		page_size		query		int												false	"Page size"			default(20)
+//	@Param		from			query		string											false	"Only games on or after this time (RFC 3339)"
+//	@Param		to				query		string											false	"Only games on or before this time (RFC 3339)"
+//	@Param		status			query		string											false	"Only games with this status"	Enums(scheduled, playing, finished, cancelled)
+//	@Param		team_id			query		string											false	"Only games involving this team"
+//	@Param		competition_id	query		string											false	"Only games in this competition"
+//	@Param		sort			query		string											false	"Sort order by date"	Enums(date, -date)	default(date)
+//	@Success	200				{object}	api.PaginatedResponse[api.GameFeedResponse]	"Paginated games"
+//	@Failure	400				{object}	response.Problem								"Invalid query params"
+//	@Failure	500				{object}	response.Problem								"Internal server error"
+//	@Router		/games [get]
+func handleGetGameFeed(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	gameService service.GameService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		q := api.GameFeedRequest{}
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		q.SetDefaults()
+
+		filter := service.GameFilter{
+			CompetitionID: parseNullUUID(q.CompetitionID),
+			TeamID:        parseNullUUID(q.TeamID),
+			Status:        q.Status,
+			From:          q.From,
+			To:            q.To,
+			Descending:    q.Sort == api.GameSortDateDesc,
+		}
+
+		games, total, err := gameService.GetFeed(ctx.Request.Context(), filter, q.PageSize, q.Offset())
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get games")
+			return
+		}
+
+		data := make([]api.GameFeedResponse, 0, len(games))
+		for _, g := range games {
+			data = append(data, api.ToGameFeedResponse(g))
+		}
+
+		totalPages := int(math.Ceil(float64(total) / float64(q.PageSize)))
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.PaginatedResponse[api.GameFeedResponse]{
+			Data: data,
+			Pagination: api.PaginationMeta{
+				Page:       q.Page,
+				PageSize:   q.PageSize,
+				Total:      total,
+				TotalPages: totalPages,
+			},
+		})
+	}
+}
+
+// newGameFilter converts validated query params into a service filter.
+func newGameFilter(q api.GameListRequest) service.GameFilter {
+	return service.GameFilter{
+		StageID:    parseNullUUID(q.StageID),
+		TeamID:     parseNullUUID(q.TeamID),
+		Status:     q.Status,
+		From:       q.From,
+		To:         q.To,
+		Descending: q.Sort == api.GameSortDateDesc,
+	}
+}
+
+// bindGameExpand binds and validates the expand query parameter shared by game endpoints.
+func bindGameExpand(ctx *gin.Context, validate *validator.Validate) (api.GameExpandRequest, error) {
+	expand := api.GameExpandRequest{}
+	if err := ctx.ShouldBindQuery(&expand); err != nil {
+		return expand, err
+	}
+	return expand, validate.Struct(expand)
+}
+
+// toGameResponses converts games to responses, embedding the teams and stage that
+// expand asks for and the local kickoff of games with a venue, from a single details
+// lookup rather than one per game.
+func toGameResponses(
+	ctx *gin.Context,
+	gameService service.GameService,
+	games []db.Game,
+	expand api.GameExpandRequest,
+) ([]api.GameResponse, error) {
+	data := make([]api.GameResponse, 0, len(games))
+	ids := make([]uuid.UUID, 0, len(games))
+	hasVenue := false
+	for _, g := range games {
+		data = append(data, api.ToGameResponse(g))
+		ids = append(ids, g.ID)
+		hasVenue = hasVenue || g.VenueID.Valid
+	}
+
+	if len(games) == 0 || (!expand.Any() && !hasVenue) {
+		return data, nil
+	}
+
+	details, err := gameService.GetDetails(ctx.Request.Context(), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range data {
+		if d, ok := details[data[i].ID]; ok {
+			data[i].Expand(d, expand)
+			data[i].Localize(d)
+		}
+	}
+
+	return data, nil
+}
+
+// toSavedGameResponses converts games that have just been written. The write has
+// already succeeded, so a failed details lookup is logged and the games are returned
+// without local kickoff times rather than reported as an error.
+func toSavedGameResponses(
+	ctx *gin.Context,
+	logger zerolog.Logger,
+	gameService service.GameService,
+	games []db.Game,
+) []api.GameResponse {
+	data, err := toGameResponses(ctx, gameService, games, api.GameExpandRequest{})
+	if err == nil {
+		return data
+	}
+
+	response.RequestLogger(ctx, logger).Error().Err(err).Msg("failed to localize saved games")
+
+	data = make([]api.GameResponse, 0, len(games))
+	for _, g := range games {
+		data = append(data, api.ToGameResponse(g))
+	}
+	return data
+}
+
+// parseNullUUID parses an optional, already validated UUID query parameter.
+func parseNullUUID(s string) uuid.NullUUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}
+}
+
 // handleGetGame retrieves a single game by ID for a season
 //
 //	@Summary	Get a single game by ID
@@ -107,11 +368,19 @@ func handleGetGames(logger zerolog.Logger, gameService service.GameService) gin.
 //	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
 //	@Param		gameID			path		string					true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param		expand			query		string					false	"Comma-separated resources to embed: teams, stage"
 //	@Success	200				{object}	api.GameResponse		"Game found"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid ID"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200				{string}	ETag					"Version of the game, for If-Match"
+//	@Failure	400				{object}	response.Problem	"Invalid ID"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID} [get]
-func handleGetGame(logger zerolog.Logger, gameService service.GameService) gin.HandlerFunc {
+func handleGetGame(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	gameService service.GameService,
+) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		gameID, err := uuid.Parse(ctx.Param("gameID"))
 		if err != nil {
@@ -119,13 +388,26 @@ func handleGetGame(logger zerolog.Logger, gameService service.GameService) gin.H
 			return
 		}
 
+		expand, err := bindGameExpand(ctx, validate)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
 		game, err := gameService.Get(ctx.Request.Context(), gameID)
 		if err != nil {
 			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game")
 			return
 		}
 
-		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToGameResponse(game))
+		data, err := toGameResponses(ctx, gameService, []db.Game{game}, expand)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game details")
+			return
+		}
+
+		setETag(ctx, game.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, data[0])
 	}
 }
 
@@ -140,9 +422,14 @@ func handleGetGame(logger zerolog.Logger, gameService service.GameService) gin.H
 //	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
 //	@Param		gameID			path		string					true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
 //	@Param		game			body		api.GameRequest			true	"Game details to update"
+//	@Param		If-Match		header		string					false	"ETag of the game being replaced"
 //	@Success	200				{object}	api.GameResponse		"Game updated"
-//	@Failure	400				{object}	response.ErrorResponse	"Bad request"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200				{string}	ETag					"Version of the updated game"
+//	@Failure	400				{object}	response.Problem	"Bad request"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	412				{object}	response.Problem	"Game changed since it was read"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID} [put]
 func handleUpdateGame(
 	logger zerolog.Logger,
@@ -172,7 +459,13 @@ func handleUpdateGame(
 			return
 		}
 
-		game, err := gameService.Update(ctx.Request.Context(), req, gameID, season)
+		version, err := ifMatch(ctx, "game")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		game, err := gameService.Update(ctx.Request.Context(), req, gameID, season, version)
 		if err != nil {
 			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update game")
 			return
@@ -180,7 +473,139 @@ func handleUpdateGame(
 
 		broadcastGameState(ctx, logger, gameStateService, gameID, req)
 
-		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToGameResponse(game))
+		setETag(ctx, game.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, toSavedGameResponses(ctx, logger, gameService, []db.Game{game})[0])
+	}
+}
+
+// handlePatchGame applies a JSON merge patch to a game for a season
+//
+//	@Summary		Partially update a game
+//	@Description	Applies an RFC 7386 JSON merge patch, so a scorer can send just {"home_score": 7}. The write fails with 412 if the game changes after it is read, or if If-Match names an older version.
+//	@ID				patch-game
+//	@Tags			Games
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			competitionID	path		string				true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string				true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			gameID			path		string				true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param			game			body		api.GameRequest		true	"Fields to change"
+//	@Param			If-Match		header		string				false	"ETag of the game being patched"
+//	@Success		200				{object}	api.GameResponse	"Game updated"
+//	@Header			200				{string}	ETag				"Version of the updated game"
+//	@Failure		400				{object}	response.Problem	"Bad request"
+//	@Failure		403				{object}	response.Problem	"Forbidden"
+//	@Failure		404				{object}	response.Problem	"Not found"
+//	@Failure		412				{object}	response.Problem	"Game changed since it was read"
+//	@Failure		415				{object}	response.Problem	"Unsupported media type"
+//	@Failure		500				{object}	response.Problem	"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games/{gameID} [patch]
+func handlePatchGame(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	gameService service.GameService,
+	gameStateService service.GameStateService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		current, err := gameService.Get(ctx.Request.Context(), gameID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game")
+			return
+		}
+
+		version, err := patchVersion(ctx, "game", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := api.ToGameRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		game, err := gameService.Update(ctx.Request.Context(), &req, gameID, season, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update game")
+			return
+		}
+
+		broadcastGameState(ctx, logger, gameStateService, gameID, &req)
+
+		setETag(ctx, game.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, toSavedGameResponses(ctx, logger, gameService, []db.Game{game})[0])
+	}
+}
+
+// handleBatchGames creates and updates many games for a season in one transaction
+//
+//	@Summary		Create and update games in bulk
+//	@Description	Games with an "id" update that game and games without one are created. Nothing is saved unless every game is valid, and errors are reported per game as games[i].field.
+//	@ID				batch-games
+//	@Tags			Games
+//	@Accept			json
+//	@Produce		json
+//	@Param			competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			games			body		api.GameBatchRequest	true	"Games to create or update"
+//	@Success		200				{object}	api.GameBatchResponse	"Games saved"
+//	@Failure		400				{object}	response.Problem		"Bad request"
+//	@Failure		403				{object}	response.Problem		"Forbidden"
+//	@Failure		404				{object}	response.Problem		"Not found"
+//	@Failure		500				{object}	response.Problem		"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games:batch [post]
+func handleBatchGames(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	gameService service.GameService,
+	gameStateService service.GameStateService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		req := &api.GameBatchRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		result, err := gameService.Batch(ctx.Request.Context(), req.Games, season)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to save games")
+			return
+		}
+
+		broadcastGameStates(ctx, logger, gameStateService, result.Games)
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.GameBatchResponse{
+			Created: result.Created,
+			Updated: result.Updated,
+			Games:   toSavedGameResponses(ctx, logger, gameService, result.Games),
+		})
 	}
 }
 
@@ -193,7 +618,25 @@ func broadcastGameState(ctx *gin.Context, logger zerolog.Logger, gameStateServic
 		awayScore = *req.AwayScore
 	}
 	if err := gameStateService.UpdateGameState(ctx.Request.Context(), gameID, homeScore, awayScore, string(req.Status)); err != nil {
-		logger.Error().Err(err).Str("game_id", gameID.String()).Msg("failed to broadcast game state update")
+		response.RequestLogger(ctx, logger).Error().Err(err).Str("game_id", gameID.String()).Msg("failed to broadcast game state update")
+	}
+}
+
+// broadcastGameStates sends the saved state of every game in a batch in one call, after
+// the batch has been committed.
+func broadcastGameStates(ctx *gin.Context, logger zerolog.Logger, gameStateService service.GameStateService, games []db.Game) {
+	updates := make([]service.GameStateUpdate, 0, len(games))
+	for _, g := range games {
+		updates = append(updates, service.GameStateUpdate{
+			GameID:    g.ID,
+			HomeScore: g.HomeScore.Int32,
+			AwayScore: g.AwayScore.Int32,
+			Status:    string(g.Status),
+		})
+	}
+
+	if err := gameStateService.UpdateGameStates(ctx.Request.Context(), updates); err != nil {
+		response.RequestLogger(ctx, logger).Error().Err(err).Int("game_count", len(updates)).Msg("failed to broadcast game state updates")
 	}
 }
 
@@ -207,8 +650,10 @@ func broadcastGameState(ctx *gin.Context, logger zerolog.Logger, gameStateServic
 //	@Param		seasonID		path			string	true	"Season ID"			default(fe04fe69-834f-42be-9821-04e53e8de26d)
 //	@Param		gameID			path			string	true	"Game ID"			default(30f8181f-0a44-4ad7-a163-3ef2d29e504e)
 //	@Success	204				"No Content"	"Game deleted successfully"
-//	@Failure	400				{object}		response.ErrorResponse	"Invalid game ID"
-//	@Failure	500				{object}		response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}		response.Problem	"Invalid game ID"
+//	@Failure	403				{object}		response.Problem	"Forbidden"
+//	@Failure	404				{object}		response.Problem	"Not found"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID} [delete]
 func handleDeleteGame(logger zerolog.Logger, gameService service.GameService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -3,6 +3,8 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/bradley-adams/gainline/db/db"
 	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
 	"github.com/bradley-adams/gainline/http/validation"
 	gamestatev1 "github.com/bradley-adams/gainline/proto/gen/gamestate/v1"
 	"github.com/bradley-adams/gainline/service"
@@ -24,11 +27,15 @@ import (
 
 // Manual mock for GameService
 type mockGameService struct {
-	CreateFn func(ctx context.Context, req *api.GameRequest, season service.SeasonAggregate) (db.Game, error)
-	GetAllFn func(ctx context.Context, seasonID, stageID uuid.UUID) ([]db.Game, error)
-	GetFn    func(ctx context.Context, gameID uuid.UUID) (db.Game, error)
-	UpdateFn func(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season service.SeasonAggregate) (db.Game, error)
-	DeleteFn func(ctx context.Context, gameID uuid.UUID) error
+	CreateFn         func(ctx context.Context, req *api.GameRequest, season service.SeasonAggregate) (db.Game, error)
+	GetAllFn         func(ctx context.Context, seasonID, stageID uuid.UUID) ([]db.Game, error)
+	GetAllBySeasonFn func(ctx context.Context, seasonID uuid.UUID, filter service.GameFilter, limit, offset int) ([]db.Game, int64, error)
+	GetFeedFn        func(ctx context.Context, filter service.GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error)
+	GetFn            func(ctx context.Context, gameID uuid.UUID) (db.Game, error)
+	GetDetailsFn     func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error)
+	UpdateFn         func(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season service.SeasonAggregate, version *time.Time) (db.Game, error)
+	DeleteFn         func(ctx context.Context, gameID uuid.UUID) error
+	BatchFn          func(ctx context.Context, reqs []api.GameRequest, season service.SeasonAggregate) (service.GameBatchResult, error)
 }
 
 func (m *mockGameService) Create(ctx context.Context, req *api.GameRequest, season service.SeasonAggregate) (db.Game, error) {
@@ -43,15 +50,34 @@ func (m *mockGameService) GetAll(ctx context.Context, seasonID, stageID uuid.UUI
 	}
 	return nil, nil
 }
+func (m *mockGameService) GetAllBySeason(ctx context.Context, seasonID uuid.UUID, filter service.GameFilter, limit, offset int) ([]db.Game, int64, error) {
+	if m.GetAllBySeasonFn != nil {
+		return m.GetAllBySeasonFn(ctx, seasonID, filter, limit, offset)
+	}
+	return nil, 0, nil
+}
+func (m *mockGameService) GetFeed(ctx context.Context, filter service.GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error) {
+	if m.GetFeedFn != nil {
+		return m.GetFeedFn(ctx, filter, limit, offset)
+	}
+	return nil, 0, nil
+}
+func (m *mockGameService) GetDetails(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+	if m.GetDetailsFn != nil {
+		return m.GetDetailsFn(ctx, gameIDs)
+	}
+	return nil, nil
+}
+
 func (m *mockGameService) Get(ctx context.Context, gameID uuid.UUID) (db.Game, error) {
 	if m.GetFn != nil {
 		return m.GetFn(ctx, gameID)
 	}
 	return db.Game{}, nil
 }
-func (m *mockGameService) Update(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season service.SeasonAggregate) (db.Game, error) {
+func (m *mockGameService) Update(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season service.SeasonAggregate, version *time.Time) (db.Game, error) {
 	if m.UpdateFn != nil {
-		return m.UpdateFn(ctx, req, gameID, season)
+		return m.UpdateFn(ctx, req, gameID, season, version)
 	}
 	return db.Game{}, nil
 }
@@ -61,11 +87,18 @@ func (m *mockGameService) Delete(ctx context.Context, gameID uuid.UUID) error {
 	}
 	return nil
 }
+func (m *mockGameService) Batch(ctx context.Context, reqs []api.GameRequest, season service.SeasonAggregate) (service.GameBatchResult, error) {
+	if m.BatchFn != nil {
+		return m.BatchFn(ctx, reqs, season)
+	}
+	return service.GameBatchResult{}, nil
+}
 
 // Manual mock for GameStateService
 type mockGameStateService struct {
-	UpdateGameStateFn func(ctx context.Context, gameID uuid.UUID, homeScore, awayScore int32, status string) error
-	WatchGameStateFn  func(ctx context.Context, gameID uuid.UUID) (<-chan *gamestatev1.GameState, error)
+	UpdateGameStateFn  func(ctx context.Context, gameID uuid.UUID, homeScore, awayScore int32, status string) error
+	UpdateGameStatesFn func(ctx context.Context, updates []service.GameStateUpdate) error
+	WatchGameStateFn   func(ctx context.Context, gameID uuid.UUID) (<-chan *gamestatev1.GameState, error)
 }
 
 func (m *mockGameStateService) UpdateGameState(ctx context.Context, gameID uuid.UUID, homeScore, awayScore int32, status string) error {
@@ -75,6 +108,13 @@ func (m *mockGameStateService) UpdateGameState(ctx context.Context, gameID uuid.
 	return nil
 }
 
+func (m *mockGameStateService) UpdateGameStates(ctx context.Context, updates []service.GameStateUpdate) error {
+	if m.UpdateGameStatesFn != nil {
+		return m.UpdateGameStatesFn(ctx, updates)
+	}
+	return nil
+}
+
 func (m *mockGameStateService) WatchGameState(ctx context.Context, gameID uuid.UUID) (<-chan *gamestatev1.GameState, error) {
 	if m.WatchGameStateFn != nil {
 		return m.WatchGameStateFn(ctx, gameID)
@@ -122,14 +162,27 @@ var _ = Describe("game handlers", func() {
 		})
 		router.GET(
 			"/competitions/:competitionID/seasons/:seasonID/stages/:stageID/games",
-			handleGetGames(logger, mockSvc),
+			handleGetGames(logger, validate, mockSvc),
 		)
-		router.GET("/seasons/:seasonID/games/:gameID", handleGetGame(logger, mockSvc))
+		router.GET("/seasons/:seasonID/games", func(c *gin.Context) {
+			c.Set("season", season)
+			handleGetSeasonGames(logger, validate, mockSvc)(c)
+		})
+		router.GET("/games", handleGetGameFeed(logger, validate, mockSvc))
+		router.GET("/seasons/:seasonID/games/:gameID", handleGetGame(logger, validate, mockSvc))
 		router.PUT("/seasons/:seasonID/games/:gameID", func(c *gin.Context) {
 			c.Set("season", season)
 			handleUpdateGame(logger, mockSvc, validate, mockGameStateSvc)(c)
 		})
+		router.PATCH("/seasons/:seasonID/games/:gameID", func(c *gin.Context) {
+			c.Set("season", season)
+			handlePatchGame(logger, validate, mockSvc, mockGameStateSvc)(c)
+		})
 		router.DELETE("/seasons/:seasonID/games/:gameID", handleDeleteGame(logger, mockSvc))
+		router.POST("/seasons/:seasonID/games:batch", func(c *gin.Context) {
+			c.Set("season", season)
+			customMethod("batch", handleBatchGames(logger, validate, mockSvc, mockGameStateSvc))(c)
+		})
 	})
 
 	Describe("create game", func() {
@@ -150,6 +203,30 @@ var _ = Describe("game handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusCreated))
 		})
 
+		It("still returns 201 without a local kickoff when the venue lookup fails", func() {
+			venueID := uuid.New()
+			mockSvc.CreateFn = func(ctx context.Context, req *api.GameRequest, s service.SeasonAggregate) (db.Game, error) {
+				Expect(*req.VenueID).To(Equal(venueID))
+				return db.Game{ID: uuid.New(), SeasonID: s.ID, VenueID: uuid.NullUUID{UUID: venueID, Valid: true}}, nil
+			}
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				return nil, fmt.Errorf("db failure")
+			}
+
+			reqBody := fmt.Sprintf(`{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s","venue_id":"%s"}`,
+				uuid.New(), time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID, venueID)
+
+			req := httptest.NewRequest(http.MethodPost, "/seasons/"+season.ID.String()+"/games", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(w.Body.String()).To(ContainSubstring(`"venue_id":"` + venueID.String() + `"`))
+			Expect(w.Body.String()).NotTo(ContainSubstring(`"local_kickoff"`))
+		})
+
 		It("returns 400 for invalid JSON", func() {
 			req := httptest.NewRequest(http.MethodPost, "/seasons/"+season.ID.String()+"/games", bytes.NewBufferString(`{"round":`))
 			req.Header.Set("Content-Type", "application/json")
@@ -171,6 +248,228 @@ var _ = Describe("game handlers", func() {
 			w := httptest.NewRecorder()
 			router.ServeHTTP(w, req)
 			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+			Expect(w.Header().Get("Content-Type")).To(Equal(response.ProblemContentType))
+			Expect(w.Body.String()).NotTo(ContainSubstring("db failure"))
+		})
+
+		It("returns 400 with field errors when the request fails validation", func() {
+			req := httptest.NewRequest(http.MethodPost, "/seasons/"+season.ID.String()+"/games", bytes.NewBufferString(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+			var problem response.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Status).To(Equal(http.StatusBadRequest))
+			Expect(problem.Errors).NotTo(BeEmpty())
+		})
+
+		It("returns translated field errors for struct-level rules", func() {
+			teamID := season.Teams[0].ID
+			reqBody := fmt.Sprintf(`{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"}`,
+				uuid.New(), time.Now().Format(time.RFC3339), teamID, teamID)
+
+			req := httptest.NewRequest(http.MethodPost, "/seasons/"+season.ID.String()+"/games", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+			var problem response.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Errors).To(ConsistOf(
+				response.FieldProblem{Field: "home_team_id", Rule: "home_and_away_teams_must_differ", Message: "home and away teams must be different"},
+				response.FieldProblem{Field: "away_team_id", Rule: "home_and_away_teams_must_differ", Message: "home and away teams must be different"},
+			))
+		})
+
+		It("returns 400 with field errors when the service rejects the game", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.GameRequest, s service.SeasonAggregate) (db.Game, error) {
+				return db.Game{}, service.NewValidationError("invalid game",
+					service.FieldError{Field: "home_team_id", Message: "home team not in season"},
+				)
+			}
+
+			reqBody := fmt.Sprintf(`{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"}`,
+				uuid.New(), time.Now().Format(time.RFC3339), uuid.New(), season.Teams[1].ID)
+
+			req := httptest.NewRequest(http.MethodPost, "/seasons/"+season.ID.String()+"/games", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+			var problem response.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Detail).To(Equal("invalid game"))
+			Expect(problem.Errors).To(ConsistOf(response.FieldProblem{Field: "home_team_id", Message: "home team not in season"}))
+		})
+	})
+
+	Describe("get season games", func() {
+		It("returns 200 with a page of games and passes filters through", func() {
+			teamID := season.Teams[0].ID
+			var gotFilter service.GameFilter
+			var gotLimit, gotOffset int
+			mockSvc.GetAllBySeasonFn = func(ctx context.Context, seasonID uuid.UUID, filter service.GameFilter, limit, offset int) ([]db.Game, int64, error) {
+				gotFilter, gotLimit, gotOffset = filter, limit, offset
+				return []db.Game{{ID: uuid.New(), SeasonID: seasonID}}, 11, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+
+				"/games?page=2&page_size=5&status=finished&sort=-date&team_id="+teamID.String()+
+				"&from=2025-01-01T00:00:00Z", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotLimit).To(Equal(5))
+			Expect(gotOffset).To(Equal(5))
+			Expect(gotFilter.TeamID).To(Equal(uuid.NullUUID{UUID: teamID, Valid: true}))
+			Expect(gotFilter.StageID.Valid).To(BeFalse())
+			Expect(gotFilter.Status).To(Equal(api.GameStatusFinished))
+			Expect(gotFilter.From).To(Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(gotFilter.Descending).To(BeTrue())
+
+			var body api.PaginatedResponse[api.GameResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Data).To(HaveLen(1))
+			Expect(body.Pagination).To(Equal(api.PaginationMeta{Page: 2, PageSize: 5, Total: 11, TotalPages: 3}))
+		})
+
+		It("returns 400 for invalid filters", func() {
+			for _, query := range []string{"status=postponed", "team_id=nope", "sort=home", "from=2025-02-01T00:00:00Z&to=2025-01-01T00:00:00Z", "page_size=500"} {
+				req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games?"+query, nil)
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				Expect(w.Code).To(Equal(http.StatusBadRequest), query)
+			}
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.GetAllBySeasonFn = func(ctx context.Context, seasonID uuid.UUID, filter service.GameFilter, limit, offset int) ([]db.Game, int64, error) {
+				return nil, 0, fmt.Errorf("db failure")
+			}
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("get season games with expand", func() {
+		It("looks up details for the whole page at once", func() {
+			games := []db.Game{{ID: uuid.New(), SeasonID: season.ID}, {ID: uuid.New(), SeasonID: season.ID}}
+			mockSvc.GetAllBySeasonFn = func(ctx context.Context, seasonID uuid.UUID, filter service.GameFilter, limit, offset int) ([]db.Game, int64, error) {
+				return games, 2, nil
+			}
+
+			calls := 0
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				calls++
+				Expect(gameIDs).To(Equal([]uuid.UUID{games[0].ID, games[1].ID}))
+				return map[uuid.UUID]db.GetGameDetailsRow{
+					games[0].ID: {ID: games[0].ID, HomeTeamName: "Home"},
+					games[1].ID: {ID: games[1].ID, HomeTeamName: "Other"},
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games?expand=teams", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(calls).To(Equal(1))
+
+			var body api.PaginatedResponse[api.GameResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Data[0].HomeTeam.Name).To(Equal("Home"))
+			Expect(body.Data[1].HomeTeam.Name).To(Equal("Other"))
+			Expect(body.Data[0].Stage).To(BeNil())
+		})
+	})
+
+	Describe("get game feed", func() {
+		It("returns 200 with enriched games and passes filters through", func() {
+			competitionID := uuid.New()
+			homeScore := sql.NullInt32{Int32: 24, Valid: true}
+			row := db.GetGameFeedRow{
+				ID:                   uuid.New(),
+				CompetitionID:        competitionID,
+				CompetitionName:      "Bunnings NPC",
+				StageName:            "Round 1",
+				HomeTeamID:           season.Teams[0].ID,
+				HomeTeamName:         "Auckland",
+				HomeTeamAbbreviation: "AKL",
+				AwayTeamID:           season.Teams[1].ID,
+				AwayTeamName:         "Canterbury",
+				AwayTeamAbbreviation: "CAN",
+				HomeScore:            homeScore,
+				Status:               db.GameStatus(api.GameStatusPlaying),
+			}
+
+			var gotFilter service.GameFilter
+			mockSvc.GetFeedFn = func(ctx context.Context, filter service.GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error) {
+				gotFilter = filter
+				return []db.GetGameFeedRow{row}, 1, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/games?competition_id="+competitionID.String()+
+				"&from=2025-08-01T00:00:00Z&to=2025-08-02T00:00:00Z", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotFilter.CompetitionID).To(Equal(uuid.NullUUID{UUID: competitionID, Valid: true}))
+			Expect(gotFilter.From).To(Equal(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(gotFilter.To).To(Equal(time.Date(2025, time.August, 2, 0, 0, 0, 0, time.UTC)))
+
+			var body api.PaginatedResponse[api.GameFeedResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Data).To(HaveLen(1))
+			Expect(body.Data[0].CompetitionName).To(Equal("Bunnings NPC"))
+			Expect(body.Data[0].StageName).To(Equal("Round 1"))
+			Expect(body.Data[0].HomeTeam).To(Equal(api.TeamSummary{ID: season.Teams[0].ID, Name: "Auckland", Abbreviation: "AKL"}))
+			Expect(body.Data[0].AwayTeam.Abbreviation).To(Equal("CAN"))
+			Expect(*body.Data[0].HomeScore).To(Equal(int32(24)))
+			Expect(body.Data[0].AwayScore).To(BeNil())
+		})
+
+		It("ignores a stage filter", func() {
+			var gotFilter service.GameFilter
+			mockSvc.GetFeedFn = func(ctx context.Context, filter service.GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error) {
+				gotFilter = filter
+				return nil, 0, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/games?stage_id="+uuid.New().String()+"&sort=-date", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotFilter.StageID.Valid).To(BeFalse())
+			Expect(gotFilter.Descending).To(BeTrue())
+		})
+
+		It("returns 400 for an invalid competition ID", func() {
+			req := httptest.NewRequest(http.MethodGet, "/games?competition_id=nope", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.GetFeedFn = func(ctx context.Context, filter service.GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error) {
+				return nil, 0, fmt.Errorf("db failure")
+			}
+			req := httptest.NewRequest(http.MethodGet, "/games", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
 		})
 	})
 
@@ -232,6 +531,133 @@ var _ = Describe("game handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusOK))
 		})
 
+		It("embeds teams and stage when expanded", func() {
+			gameID := uuid.New()
+			stageID := uuid.New()
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (db.Game, error) {
+				return db.Game{ID: gID, SeasonID: season.ID, StageID: stageID}, nil
+			}
+
+			var gotIDs []uuid.UUID
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				gotIDs = gameIDs
+				return map[uuid.UUID]db.GetGameDetailsRow{
+					gameID: {
+						ID:                   gameID,
+						HomeTeamID:           season.Teams[0].ID,
+						HomeTeamName:         "Home",
+						HomeTeamAbbreviation: "HOM",
+						AwayTeamID:           season.Teams[1].ID,
+						AwayTeamName:         "Away",
+						AwayTeamAbbreviation: "AWY",
+						StageID:              stageID,
+						StageName:            "Round 1",
+						StageType:            db.StageTypeRegular,
+						StageOrderIndex:      1,
+					},
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+gameID.String()+"?expand=teams,stage", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotIDs).To(Equal([]uuid.UUID{gameID}))
+
+			var body api.GameResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.HomeTeam).To(Equal(&api.TeamSummary{ID: season.Teams[0].ID, Name: "Home", Abbreviation: "HOM"}))
+			Expect(body.AwayTeam.Abbreviation).To(Equal("AWY"))
+			Expect(body.Stage).To(Equal(&api.StageSummary{ID: stageID, Name: "Round 1", StageType: api.StageTypeRegular, OrderIndex: 1}))
+		})
+
+		It("only embeds what was asked for", func() {
+			gameID := uuid.New()
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (db.Game, error) {
+				return db.Game{ID: gID, SeasonID: season.ID}, nil
+			}
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				return map[uuid.UUID]db.GetGameDetailsRow{gameID: {ID: gameID, StageName: "Round 1"}}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+gameID.String()+"?expand=stage", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"stage":{`))
+			Expect(w.Body.String()).NotTo(ContainSubstring(`"home_team"`))
+		})
+
+		It("does not look up details without expand", func() {
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (db.Game, error) {
+				return db.Game{ID: gID, SeasonID: season.ID}, nil
+			}
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				Fail("GetDetails should not be called")
+				return nil, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).NotTo(ContainSubstring(`"stage"`))
+		})
+
+		It("reports the kickoff in the venue's timezone", func() {
+			gameID := uuid.New()
+			venueID := uuid.New()
+			kickoff := time.Date(2025, time.August, 2, 7, 5, 0, 0, time.UTC)
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (db.Game, error) {
+				return db.Game{ID: gID, SeasonID: season.ID, Date: kickoff, VenueID: uuid.NullUUID{UUID: venueID, Valid: true}}, nil
+			}
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				return map[uuid.UUID]db.GetGameDetailsRow{
+					gameID: {ID: gameID, VenueTimezone: sql.NullString{String: "Pacific/Auckland", Valid: true}},
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+gameID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"local_kickoff":"2025-08-02T19:05:00+12:00"`))
+			Expect(w.Body.String()).NotTo(ContainSubstring(`"stage"`))
+
+			var body api.GameResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(*body.VenueID).To(Equal(venueID))
+			Expect(body.LocalKickoff.Equal(kickoff)).To(BeTrue())
+		})
+
+		It("returns 400 for an unknown expand value", func() {
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+uuid.New().String()+"?expand=venue", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring(`"rule":"game_expand"`))
+		})
+
+		It("returns 500 when the details lookup fails", func() {
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (db.Game, error) {
+				return db.Game{ID: gID, SeasonID: season.ID}, nil
+			}
+			mockSvc.GetDetailsFn = func(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+				return nil, fmt.Errorf("db failure")
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+uuid.New().String()+"?expand=teams", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+
 		It("returns 400 for invalid UUID", func() {
 			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/invalid", nil)
 			w := httptest.NewRecorder()
@@ -249,12 +675,24 @@ var _ = Describe("game handlers", func() {
 			router.ServeHTTP(w, req)
 			Expect(w.Code).To(Equal(http.StatusInternalServerError))
 		})
+
+		It("returns 404 when the game does not exist", func() {
+			gameID := uuid.New()
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (db.Game, error) {
+				return db.Game{}, service.NewNotFoundError("game", nil)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+gameID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+			Expect(w.Body.String()).To(ContainSubstring(`"detail":"game not found"`))
+		})
 	})
 
 	Describe("update game", func() {
 		It("returns 200 for valid update", func() {
 			gameID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate) (db.Game, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
 				return db.Game{ID: gID, StageID: req.StageID, SeasonID: s.ID}, nil
 			}
 
@@ -280,7 +718,7 @@ var _ = Describe("game handlers", func() {
 
 		It("returns 500 when service fails", func() {
 			gameID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate) (db.Game, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
 				return db.Game{}, fmt.Errorf("db failure")
 			}
 
@@ -296,7 +734,7 @@ var _ = Describe("game handlers", func() {
 
 		It("returns 200 even when game state broadcast fails", func() {
 			gameID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate) (db.Game, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
 				return db.Game{ID: gID, StageID: req.StageID, SeasonID: s.ID}, nil
 			}
 			mockGameStateSvc.UpdateGameStateFn = func(ctx context.Context, gID uuid.UUID, homeScore, awayScore int32, status string) error {
@@ -314,6 +752,312 @@ var _ = Describe("game handlers", func() {
 		})
 	})
 
+	Describe("patch game", func() {
+		var (
+			current  db.Game
+			gameURL  string
+			patchReq func(body, ifMatch string) *httptest.ResponseRecorder
+		)
+
+		BeforeEach(func() {
+			current = db.Game{
+				ID:         uuid.New(),
+				SeasonID:   season.ID,
+				StageID:    uuid.New(),
+				Date:       time.Now().UTC().Truncate(time.Second),
+				HomeTeamID: season.Teams[0].ID,
+				AwayTeamID: season.Teams[1].ID,
+				HomeScore:  sql.NullInt32{Int32: 3, Valid: true},
+				AwayScore:  sql.NullInt32{Int32: 0, Valid: true},
+				Status:     db.GameStatusPlaying,
+				UpdatedAt:  time.Now().UTC().Add(-time.Minute),
+			}
+			gameURL = "/seasons/" + season.ID.String() + "/games/" + current.ID.String()
+
+			mockSvc.GetFn = func(ctx context.Context, gameID uuid.UUID) (db.Game, error) {
+				return current, nil
+			}
+
+			patchReq = func(body, ifMatch string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodPatch, gameURL, bytes.NewBufferString(body))
+				req.Header.Set("Content-Type", MergePatchContentType)
+				if ifMatch != "" {
+					req.Header.Set("If-Match", ifMatch)
+				}
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w
+			}
+		})
+
+		It("changes only the patched fields and writes against the version it read", func() {
+			updatedAt := time.Now().UTC()
+			var gotReq *api.GameRequest
+			var gotVersion *time.Time
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
+				gotReq, gotVersion = req, version
+				game := current
+				game.HomeScore = sql.NullInt32{Int32: *req.HomeScore, Valid: true}
+				game.UpdatedAt = updatedAt
+				return game, nil
+			}
+			var broadcastScore int32
+			mockGameStateSvc.UpdateGameStateFn = func(ctx context.Context, gID uuid.UUID, homeScore, awayScore int32, status string) error {
+				broadcastScore = homeScore
+				return nil
+			}
+
+			w := patchReq(`{"home_score":7}`, "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(etag(updatedAt)))
+			Expect(*gotReq.HomeScore).To(Equal(int32(7)))
+			Expect(*gotReq.AwayScore).To(Equal(int32(0)))
+			Expect(gotReq.HomeTeamID).To(Equal(current.HomeTeamID))
+			Expect(gotReq.StageID).To(Equal(current.StageID))
+			Expect(gotReq.Date.Equal(current.Date)).To(BeTrue())
+			Expect(gotReq.Status).To(Equal(api.GameStatusPlaying))
+			Expect(*gotVersion).To(Equal(current.UpdatedAt))
+			Expect(broadcastScore).To(Equal(int32(7)))
+		})
+
+		It("clears fields patched to null", func() {
+			var gotReq *api.GameRequest
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
+				gotReq = req
+				return current, nil
+			}
+
+			w := patchReq(`{"status":"scheduled","home_score":null,"away_score":null}`, "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotReq.Status).To(Equal(api.GameStatusScheduled))
+			Expect(gotReq.HomeScore).To(BeNil())
+			Expect(gotReq.AwayScore).To(BeNil())
+			Expect(gotReq.HomeTeamID).To(Equal(current.HomeTeamID))
+		})
+
+		It("returns 412 when If-Match names an older version", func() {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
+				Fail("a stale patch must not be written")
+				return db.Game{}, nil
+			}
+
+			w := patchReq(`{"home_score":7}`, etag(current.UpdatedAt.Add(-time.Second)))
+
+			Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			Expect(w.Header().Get("Content-Type")).To(Equal(response.ProblemContentType))
+		})
+
+		It("returns 412 when the game changes before the write", func() {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
+				return db.Game{}, service.NewPreconditionFailedError("game")
+			}
+
+			w := patchReq(`{"home_score":7}`, etag(current.UpdatedAt))
+			Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+		})
+
+		It("returns 400 when the patched game fails validation", func() {
+			w := patchReq(fmt.Sprintf(`{"away_team_id":"%s"}`, current.HomeTeamID), "")
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			var problem response.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Errors).To(ContainElement(HaveField("Rule", "home_and_away_teams_must_differ")))
+		})
+
+		It("returns 400 for a malformed patch", func() {
+			w := patchReq(`{"home_score":`, "")
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 415 for a body that is not a merge patch", func() {
+			req := httptest.NewRequest(http.MethodPatch, gameURL, bytes.NewBufferString(`home_score=7`))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusUnsupportedMediaType))
+		})
+	})
+
+	Describe("game versions", func() {
+		It("returns the game's ETag", func() {
+			updatedAt := time.Now().UTC()
+			mockSvc.GetFn = func(ctx context.Context, gameID uuid.UUID) (db.Game, error) {
+				return db.Game{ID: gameID, UpdatedAt: updatedAt}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+season.ID.String()+"/games/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(etag(updatedAt)))
+		})
+
+		It("passes If-Match through to the update", func() {
+			readAt := time.Now().UTC().Add(-time.Minute)
+			var gotVersion *time.Time
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.GameRequest, gID uuid.UUID, s service.SeasonAggregate, version *time.Time) (db.Game, error) {
+				gotVersion = version
+				return db.Game{}, service.NewPreconditionFailedError("game")
+			}
+
+			reqBody := fmt.Sprintf(`{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"}`,
+				uuid.New(), time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID)
+
+			req := httptest.NewRequest(http.MethodPut, "/seasons/"+season.ID.String()+"/games/"+uuid.New().String(), bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", etag(readAt))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			Expect(gotVersion.Equal(readAt)).To(BeTrue())
+		})
+	})
+
+	Describe("batch games", func() {
+		var batchURL string
+
+		BeforeEach(func() {
+			batchURL = "/seasons/" + season.ID.String() + "/games:batch"
+		})
+
+		It("returns 200 with the saved games and broadcasts them once", func() {
+			existingID := uuid.New()
+			var gotReqs []api.GameRequest
+			mockSvc.BatchFn = func(ctx context.Context, reqs []api.GameRequest, s service.SeasonAggregate) (service.GameBatchResult, error) {
+				gotReqs = reqs
+				return service.GameBatchResult{
+					Games: []db.Game{
+						{ID: uuid.New(), SeasonID: s.ID, Status: db.GameStatusScheduled},
+						{ID: existingID, SeasonID: s.ID, Status: db.GameStatusFinished,
+							HomeScore: sql.NullInt32{Int32: 21, Valid: true}, AwayScore: sql.NullInt32{Int32: 14, Valid: true}},
+					},
+					Created: 1,
+					Updated: 1,
+				}, nil
+			}
+			broadcasts := 0
+			var gotUpdates []service.GameStateUpdate
+			mockGameStateSvc.UpdateGameStatesFn = func(ctx context.Context, updates []service.GameStateUpdate) error {
+				broadcasts++
+				gotUpdates = updates
+				return nil
+			}
+
+			reqBody := fmt.Sprintf(`{"games":[
+				{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"},
+				{"id":"%s","stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s","home_score":21,"away_score":14,"status":"finished"}
+			]}`,
+				uuid.New(), time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID,
+				existingID, uuid.New(), time.Now().Format(time.RFC3339), season.Teams[1].ID, season.Teams[0].ID)
+
+			req := httptest.NewRequest(http.MethodPost, batchURL, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotReqs).To(HaveLen(2))
+			Expect(gotReqs[0].ID).To(BeNil())
+			Expect(*gotReqs[1].ID).To(Equal(existingID))
+
+			var resp api.GameBatchResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Created).To(Equal(1))
+			Expect(resp.Updated).To(Equal(1))
+			Expect(resp.Games).To(HaveLen(2))
+
+			Expect(broadcasts).To(Equal(1))
+			Expect(gotUpdates).To(HaveLen(2))
+			Expect(gotUpdates[1]).To(Equal(service.GameStateUpdate{GameID: existingID, HomeScore: 21, AwayScore: 14, Status: "finished"}))
+		})
+
+		It("returns 200 even when the broadcast fails", func() {
+			mockSvc.BatchFn = func(ctx context.Context, reqs []api.GameRequest, s service.SeasonAggregate) (service.GameBatchResult, error) {
+				return service.GameBatchResult{Games: []db.Game{{ID: uuid.New()}}, Created: 1}, nil
+			}
+			mockGameStateSvc.UpdateGameStatesFn = func(ctx context.Context, updates []service.GameStateUpdate) error {
+				return fmt.Errorf("gamestate unreachable")
+			}
+
+			reqBody := fmt.Sprintf(`{"games":[{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"}]}`,
+				uuid.New(), time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID)
+
+			req := httptest.NewRequest(http.MethodPost, batchURL, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("returns 400 with indexed field errors when a game fails validation", func() {
+			reqBody := fmt.Sprintf(`{"games":[
+				{"stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"},
+				{"date":"%s","home_team_id":"%s","away_team_id":"%s"}
+			]}`,
+				uuid.New(), time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID,
+				time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID)
+
+			req := httptest.NewRequest(http.MethodPost, batchURL, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+			var problem response.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Errors).To(ContainElement(HaveField("Field", "games[1].stage_id")))
+		})
+
+		It("returns 400 for an empty batch", func() {
+			req := httptest.NewRequest(http.MethodPost, batchURL, bytes.NewBufferString(`{"games":[]}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 400 with the service's per-game errors", func() {
+			mockSvc.BatchFn = func(ctx context.Context, reqs []api.GameRequest, s service.SeasonAggregate) (service.GameBatchResult, error) {
+				return service.GameBatchResult{}, service.NewValidationError("invalid games",
+					service.FieldError{Field: "games[0].id", Rule: "exists", Message: "game not found"},
+				)
+			}
+			broadcasts := 0
+			mockGameStateSvc.UpdateGameStatesFn = func(ctx context.Context, updates []service.GameStateUpdate) error {
+				broadcasts++
+				return nil
+			}
+
+			reqBody := fmt.Sprintf(`{"games":[{"id":"%s","stage_id":"%s","date":"%s","home_team_id":"%s","away_team_id":"%s"}]}`,
+				uuid.New(), uuid.New(), time.Now().Format(time.RFC3339), season.Teams[0].ID, season.Teams[1].ID)
+
+			req := httptest.NewRequest(http.MethodPost, batchURL, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			var problem response.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Errors).To(ConsistOf(response.FieldProblem{Field: "games[0].id", Rule: "exists", Message: "game not found"}))
+			Expect(broadcasts).To(Equal(0))
+		})
+
+		It("returns 404 for a custom method other than batch", func() {
+			req := httptest.NewRequest(http.MethodPost, "/seasons/"+season.ID.String()+"/games:import", bytes.NewBufferString(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	Describe("delete game", func() {
 		It("returns 204 for successful deletion", func() {
 			gameID := uuid.New()
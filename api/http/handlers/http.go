@@ -8,6 +8,7 @@ import (
 	"github.com/bradley-adams/gainline/db/db_handler"
 	"github.com/bradley-adams/gainline/docs"
 	"github.com/bradley-adams/gainline/http/middleware"
+	"github.com/bradley-adams/gainline/http/response"
 	"github.com/bradley-adams/gainline/service"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -24,6 +25,7 @@ type RouterConfig struct {
 	GameStateClient *gamestate.Client
 	Auth0Domain     string
 	Auth0Audience   string
+	FixtureRules    service.FixtureRules
 }
 
 // SetupRouter initializes and configures the HTTP router for handling incoming requests
@@ -37,6 +39,13 @@ func SetupRouter(cfg RouterConfig) *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 
+	router.Use(
+		middleware.Tracing(),
+		middleware.RequestID(cfg.Logger),
+		middleware.AccessLog(),
+		middleware.Metrics(),
+	)
+
 	router.GET("/health", healthCheck(cfg.DB, cfg.Logger))
 
 	docs.SwaggerInfo.BasePath = "/v1"
@@ -60,6 +69,14 @@ func SetupRouter(cfg RouterConfig) *gin.Engine {
 			"Content-Length",
 			"Content-Type",
 			"Authorization",
+			"If-Match",
+			middleware.RequestIDHeader,
+			"traceparent",
+			"tracestate",
+		},
+		ExposeHeaders: []string{
+			middleware.RequestIDHeader,
+			"ETag",
 		},
 		MaxAge: 12 * time.Hour,
 	})
@@ -72,10 +89,21 @@ func SetupRouter(cfg RouterConfig) *gin.Engine {
 	{
 		// services
 		seasonService := service.NewSeasonService(cfg.DB)
-		gameService := service.NewGameService(cfg.DB)
+		gameService := service.NewGameService(cfg.DB, cfg.FixtureRules)
 		gameStateService := service.NewGameStateService(cfg.GameStateClient)
 		competitionService := service.NewCompetitionService(cfg.DB)
 		teamService := service.NewTeamService(cfg.DB)
+		fixtureService := service.NewFixtureService(cfg.DB, cfg.FixtureRules)
+		finalsService := service.NewFinalsService(cfg.DB)
+		venueService := service.NewVenueService(cfg.DB)
+		playerService := service.NewPlayerService(cfg.DB)
+		squadService := service.NewSquadService(cfg.DB)
+		lineupService := service.NewLineupService(cfg.DB)
+		eventService := service.NewEventService(cfg.DB)
+		statsService := service.NewStatsService(cfg.DB)
+		officialService := service.NewOfficialService(cfg.DB)
+		appointmentService := service.NewAppointmentService(cfg.DB)
+		teamAliasService := service.NewTeamAliasService(cfg.DB)
 
 		// middleware
 		v1protected.Use(middleware.CompetitionStructureValidator(cfg.Logger, seasonService, gameService))
@@ -85,38 +113,131 @@ func SetupRouter(cfg RouterConfig) *gin.Engine {
 		v1protected.GET("/competitions", handleGetCompetitions(cfg.Logger, cfg.Validate, competitionService))
 		v1protected.GET("/competitions/:competitionID", handleGetCompetition(cfg.Logger, competitionService))
 		v1protected.PUT("/competitions/:competitionID", handleUpdateCompetition(cfg.Logger, cfg.Validate, competitionService))
+		v1protected.PATCH("/competitions/:competitionID", handlePatchCompetition(cfg.Logger, cfg.Validate, competitionService))
 		v1protected.DELETE("/competitions/:competitionID", handleDeleteCompetition(cfg.Logger, competitionService))
 
 		// seasons
 		v1protected.POST("/competitions/:competitionID/seasons", handleCreateSeason(cfg.Logger, cfg.Validate, seasonService))
 		v1protected.GET("/competitions/:competitionID/seasons", handleGetSeasons(cfg.Logger, cfg.Validate, seasonService))
+		v1protected.GET("/competitions/:competitionID/seasons/current", handleGetCurrentSeason(cfg.Logger, seasonService))
 		v1protected.GET("/competitions/:competitionID/seasons/:seasonID", handleGetSeason(cfg.Logger))
 		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID", handleUpdateSeason(cfg.Logger, cfg.Validate, seasonService))
+		v1protected.PATCH("/competitions/:competitionID/seasons/:seasonID", handlePatchSeason(cfg.Logger, cfg.Validate, seasonService))
 		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID", handleDeleteSeason(cfg.Logger, seasonService))
+		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID/teams/:teamID/home-venue", handleSetSeasonTeamHomeVenue(cfg.Logger, cfg.Validate, seasonService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/teams/:teamID/home-venue", handleDeleteSeasonTeamHomeVenue(cfg.Logger, seasonService))
+
+		// squads
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/teams/:teamID/squad", handleGetSquad(cfg.Logger, squadService))
+		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID/teams/:teamID/squad/:playerID", handleSetSquadPlayer(cfg.Logger, cfg.Validate, squadService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/teams/:teamID/squad/:playerID", handleRemoveSquadPlayer(cfg.Logger, squadService))
 
 		// games
+		v1protected.GET("/games", handleGetGameFeed(cfg.Logger, cfg.Validate, gameService))
 		v1protected.POST("/competitions/:competitionID/seasons/:seasonID/games", handleCreateGame(cfg.Logger, cfg.Validate, gameService))
-		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/stages/:stageID/games", handleGetGames(cfg.Logger, gameService))
-		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID", handleGetGame(cfg.Logger, gameService))
+		v1protected.POST("/competitions/:competitionID/seasons/:seasonID/games:batch", customMethod("batch", handleBatchGames(cfg.Logger, cfg.Validate, gameService, gameStateService)))
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games", handleGetSeasonGames(cfg.Logger, cfg.Validate, gameService))
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/stages/:stageID/games", handleGetGames(cfg.Logger, cfg.Validate, gameService))
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID", handleGetGame(cfg.Logger, cfg.Validate, gameService))
 		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID/games/:gameID", handleUpdateGame(cfg.Logger, gameService, cfg.Validate, gameStateService))
+		v1protected.PATCH("/competitions/:competitionID/seasons/:seasonID/games/:gameID", handlePatchGame(cfg.Logger, cfg.Validate, gameService, gameStateService))
 		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/games/:gameID", handleDeleteGame(cfg.Logger, gameService))
 		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID/live", handleWatchGame(cfg.Logger, gameStateService))
 
+		// lineups
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID/lineups", handleGetLineups(cfg.Logger, lineupService))
+		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID/games/:gameID/lineups/:teamID", handleSetLineup(cfg.Logger, cfg.Validate, lineupService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/games/:gameID/lineups/:teamID", handleClearLineup(cfg.Logger, lineupService))
+		v1protected.POST("/competitions/:competitionID/seasons/:seasonID/games/:gameID/lineups/:teamID/replacements", handleAddReplacement(cfg.Logger, cfg.Validate, lineupService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/games/:gameID/lineups/:teamID/replacements/:replacementID", handleRemoveReplacement(cfg.Logger, lineupService))
+
+		// events
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID/events", handleGetGameEvents(cfg.Logger, eventService))
+		v1protected.POST("/competitions/:competitionID/seasons/:seasonID/games/:gameID/events", handleCreateGameEvent(cfg.Logger, cfg.Validate, eventService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/games/:gameID/events/:eventID", handleDeleteGameEvent(cfg.Logger, eventService))
+
+		// game officials
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID/officials", handleGetGameOfficials(cfg.Logger, appointmentService))
+		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID/games/:gameID/officials/:officialID", handleSetGameOfficial(cfg.Logger, cfg.Validate, appointmentService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/games/:gameID/officials/:officialID", handleRemoveGameOfficial(cfg.Logger, appointmentService))
+
+		// stats
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/games/:gameID/stats", handleGetGameStats(cfg.Logger, statsService))
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/leaderboard", handleGetSeasonLeaderboard(cfg.Logger, cfg.Validate, statsService))
+		v1protected.GET("/competitions/:competitionID/leaderboard", handleGetCompetitionLeaderboard(cfg.Logger, cfg.Validate, statsService))
+		v1protected.GET("/teams/:teamID/players/:playerID/stats", handleGetPlayerCareer(cfg.Logger, statsService))
+
+		// fixtures
+		v1protected.POST("/competitions/:competitionID/seasons/:seasonID/fixtures/generate", handleGenerateFixtures(cfg.Logger, cfg.Validate, fixtureService))
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/fixtures/validate", handleValidateFixtures(cfg.Logger, cfg.Validate, fixtureService, cfg.FixtureRules))
+
+		// finals
+		v1protected.GET("/competitions/:competitionID/seasons/:seasonID/finals", handleGetFinals(cfg.Logger, finalsService))
+		v1protected.PUT("/competitions/:competitionID/seasons/:seasonID/finals", handleSetFinals(cfg.Logger, cfg.Validate, finalsService))
+		v1protected.DELETE("/competitions/:competitionID/seasons/:seasonID/finals", handleDeleteFinals(cfg.Logger, finalsService))
+
 		// teams
 		v1protected.POST("/teams", handleCreateTeam(cfg.Logger, cfg.Validate, teamService))
 		v1protected.GET("/teams", handleGetTeams(cfg.Logger, cfg.Validate, teamService))
 		v1protected.GET("/teams/:teamID", handleGetTeam(cfg.Logger, teamService))
+		v1protected.GET("/teams/:teamID/games", handleGetTeamGames(cfg.Logger, cfg.Validate, teamService, gameService))
+		v1protected.GET("/teams/:teamID/form", handleGetTeamForm(cfg.Logger, cfg.Validate, teamService))
+		v1protected.GET("/teams/:teamID/head-to-head/:opponentID", handleGetHeadToHead(cfg.Logger, teamService))
 		v1protected.PUT("/teams/:teamID", handleUpdateTeam(cfg.Logger, cfg.Validate, teamService))
+		v1protected.PATCH("/teams/:teamID", handlePatchTeam(cfg.Logger, cfg.Validate, teamService))
 		v1protected.DELETE("/teams/:teamID", handleDeleteTeam(cfg.Logger, teamService))
+
+		// team aliases
+		v1protected.GET("/teams/:teamID/aliases", handleGetTeamAliases(cfg.Logger, teamAliasService))
+		v1protected.POST("/teams/:teamID/aliases", handleCreateTeamAlias(cfg.Logger, cfg.Validate, teamAliasService))
+		v1protected.PUT("/teams/:teamID/aliases/:aliasID", handleUpdateTeamAlias(cfg.Logger, cfg.Validate, teamAliasService))
+		v1protected.DELETE("/teams/:teamID/aliases/:aliasID", handleDeleteTeamAlias(cfg.Logger, teamAliasService))
+
+		// players
+		v1protected.POST("/teams/:teamID/players", handleCreatePlayer(cfg.Logger, cfg.Validate, playerService))
+		v1protected.GET("/teams/:teamID/players", handleGetPlayers(cfg.Logger, cfg.Validate, playerService))
+		v1protected.GET("/teams/:teamID/players/:playerID", handleGetPlayer(cfg.Logger, playerService))
+		v1protected.PUT("/teams/:teamID/players/:playerID", handleUpdatePlayer(cfg.Logger, cfg.Validate, playerService))
+		v1protected.PATCH("/teams/:teamID/players/:playerID", handlePatchPlayer(cfg.Logger, cfg.Validate, playerService))
+		v1protected.DELETE("/teams/:teamID/players/:playerID", handleDeletePlayer(cfg.Logger, playerService))
+
+		// venues
+		v1protected.POST("/venues", handleCreateVenue(cfg.Logger, cfg.Validate, venueService))
+		v1protected.GET("/venues", handleGetVenues(cfg.Logger, cfg.Validate, venueService))
+		v1protected.GET("/venues/:venueID", handleGetVenue(cfg.Logger, venueService))
+		v1protected.PUT("/venues/:venueID", handleUpdateVenue(cfg.Logger, cfg.Validate, venueService))
+		v1protected.PATCH("/venues/:venueID", handlePatchVenue(cfg.Logger, cfg.Validate, venueService))
+		v1protected.DELETE("/venues/:venueID", handleDeleteVenue(cfg.Logger, venueService))
+
+		// officials
+		v1protected.POST("/officials", handleCreateOfficial(cfg.Logger, cfg.Validate, officialService))
+		v1protected.GET("/officials", handleGetOfficials(cfg.Logger, cfg.Validate, officialService))
+		v1protected.GET("/officials/:officialID", handleGetOfficial(cfg.Logger, officialService))
+		v1protected.GET("/officials/:officialID/appointments", handleGetOfficialAppointments(cfg.Logger, officialService))
+		v1protected.PUT("/officials/:officialID", handleUpdateOfficial(cfg.Logger, cfg.Validate, officialService))
+		v1protected.PATCH("/officials/:officialID", handlePatchOfficial(cfg.Logger, cfg.Validate, officialService))
+		v1protected.DELETE("/officials/:officialID", handleDeleteOfficial(cfg.Logger, officialService))
 	}
 
 	return router
 }
 
+// customMethod guards a route registered as "/resource:verb". gin reads ":verb" as a
+// path parameter, so anything other than the literal verb is answered with a 404.
+func customMethod(verb string, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.Param(verb) != ":"+verb {
+			ctx.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		handler(ctx)
+	}
+}
+
 func healthCheck(db db_handler.DB, logger zerolog.Logger) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		if err := db.HealthCheck(); err != nil {
-			logger.Error().
+			response.RequestLogger(ctx, logger).Error().
 				Err(err).
 				Msg("database health check failed")
 
@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleGetLineups retrieves both sides' team sheets for a game
+//
+//	@Summary	Get the lineups for a game
+//	@ID			get-lineups
+//	@Tags		Lineups
+//	@Produce	json
+//	@Param		competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Success	200				{object}	api.GameLineupsResponse		"Lineups found"
+//	@Failure	400				{object}	response.Problem			"Invalid game ID"
+//	@Failure	403				{object}	response.Problem			"Forbidden"
+//	@Failure	404				{object}	response.Problem			"Not found"
+//	@Failure	500				{object}	response.Problem			"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups [get]
+func handleGetLineups(logger zerolog.Logger, lineupService service.LineupService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		lineups, err := lineupService.Get(ctx.Request.Context(), gameID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get lineups")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToGameLineupsResponse(lineups))
+	}
+}
+
+// handleSetLineup replaces a side's matchday team sheet. Shirts 1-15 start and 16-23
+// are the bench.
+//
+//	@Summary		Set a side's lineup for a game
+//	@Description	Every player must be in the team's season squad on the game date. The lineup is locked once the kickoff time passes or the game is no longer scheduled.
+//	@ID				set-lineup
+//	@Tags			Lineups
+//	@Accept			json
+//	@Produce		json
+//	@Param			competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param			teamID			path		string						true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param			lineup			body		api.LineupRequest			true	"Team sheet"
+//	@Success		200				{object}	api.TeamLineupResponse		"Lineup saved"
+//	@Failure		400				{object}	response.Problem			"Bad request"
+//	@Failure		403				{object}	response.Problem			"Team is not playing in the game"
+//	@Failure		404				{object}	response.Problem			"Not found"
+//	@Failure		409				{object}	response.Problem			"Game has kicked off"
+//	@Failure		500				{object}	response.Problem			"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID} [put]
+func handleSetLineup(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	lineupService service.LineupService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		req := &api.LineupRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		lineup, err := lineupService.Set(ctx.Request.Context(), req, gameID, teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to set lineup")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToTeamLineupResponse(lineup))
+	}
+}
+
+// handleClearLineup removes a side's team sheet before kickoff
+//
+//	@Summary	Clear a side's lineup for a game
+//	@ID			clear-lineup
+//	@Tags		Lineups
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path			string	true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param		teamID			path			string	true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Success	204				"No Content"	"Lineup cleared"
+//	@Failure	400				{object}		response.Problem	"Invalid game or team ID"
+//	@Failure	403				{object}		response.Problem	"Team is not playing in the game"
+//	@Failure	409				{object}		response.Problem	"Game has kicked off"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID} [delete]
+func handleClearLineup(logger zerolog.Logger, lineupService service.LineupService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		err = lineupService.Clear(ctx.Request.Context(), gameID, teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to clear lineup")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// handleAddReplacement records a replacement made during a game
+//
+//	@Summary		Record a replacement
+//	@Description	A bench player comes on for a player on the field. Replacements can only be recorded while the game is playing.
+//	@ID				add-replacement
+//	@Tags			Lineups
+//	@Accept			json
+//	@Produce		json
+//	@Param			competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			gameID			path		string						true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param			teamID			path		string						true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param			replacement		body		api.ReplacementRequest		true	"Players and minute"
+//	@Success		201				{object}	api.ReplacementResponse		"Replacement recorded"
+//	@Failure		400				{object}	response.Problem			"Bad request"
+//	@Failure		403				{object}	response.Problem			"Team is not playing in the game"
+//	@Failure		409				{object}	response.Problem			"Game is not playing"
+//	@Failure		500				{object}	response.Problem			"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID}/replacements [post]
+func handleAddReplacement(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	lineupService service.LineupService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		req := &api.ReplacementRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		replacement, err := lineupService.AddReplacement(ctx.Request.Context(), req, gameID, teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to add replacement")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToReplacementResponse(replacement))
+	}
+}
+
+// handleRemoveReplacement removes a replacement recorded in error
+//
+//	@Summary	Remove a replacement
+//	@ID			remove-replacement
+//	@Tags		Lineups
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		gameID			path			string	true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Param		teamID			path			string	true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		replacementID	path			string	true	"Replacement ID"	default(2e6f8a1b-4c3d-4b5e-9a7f-6d1c0b2e3f40)
+//	@Success	204				"No Content"	"Replacement removed"
+//	@Failure	400				{object}		response.Problem	"Invalid ID"
+//	@Failure	403				{object}		response.Problem	"Team is not playing in the game"
+//	@Failure	404				{object}		response.Problem	"Not found"
+//	@Failure	409				{object}		response.Problem	"Game is not playing"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/lineups/{teamID}/replacements/{replacementID} [delete]
+func handleRemoveReplacement(logger zerolog.Logger, lineupService service.LineupService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		replacementID, err := uuid.Parse(ctx.Param("replacementID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid replacement ID")
+			return
+		}
+
+		err = lineupService.RemoveReplacement(ctx.Request.Context(), gameID, teamID, replacementID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to remove replacement")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for LineupService
+type mockLineupService struct {
+	GetFn               func(ctx context.Context, gameID uuid.UUID) (service.GameLineups, error)
+	SetFn               func(ctx context.Context, req *api.LineupRequest, gameID, teamID uuid.UUID) (service.TeamLineup, error)
+	ClearFn             func(ctx context.Context, gameID, teamID uuid.UUID) error
+	AddReplacementFn    func(ctx context.Context, req *api.ReplacementRequest, gameID, teamID uuid.UUID) (db.GameReplacement, error)
+	RemoveReplacementFn func(ctx context.Context, gameID, teamID, replacementID uuid.UUID) error
+}
+
+func (m *mockLineupService) Get(ctx context.Context, gameID uuid.UUID) (service.GameLineups, error) {
+	if m.GetFn != nil {
+		return m.GetFn(ctx, gameID)
+	}
+	return service.GameLineups{}, nil
+}
+
+func (m *mockLineupService) Set(ctx context.Context, req *api.LineupRequest, gameID, teamID uuid.UUID) (service.TeamLineup, error) {
+	if m.SetFn != nil {
+		return m.SetFn(ctx, req, gameID, teamID)
+	}
+	return service.TeamLineup{}, nil
+}
+
+func (m *mockLineupService) Clear(ctx context.Context, gameID, teamID uuid.UUID) error {
+	if m.ClearFn != nil {
+		return m.ClearFn(ctx, gameID, teamID)
+	}
+	return nil
+}
+
+func (m *mockLineupService) AddReplacement(ctx context.Context, req *api.ReplacementRequest, gameID, teamID uuid.UUID) (db.GameReplacement, error) {
+	if m.AddReplacementFn != nil {
+		return m.AddReplacementFn(ctx, req, gameID, teamID)
+	}
+	return db.GameReplacement{}, nil
+}
+
+func (m *mockLineupService) RemoveReplacement(ctx context.Context, gameID, teamID, replacementID uuid.UUID) error {
+	if m.RemoveReplacementFn != nil {
+		return m.RemoveReplacementFn(ctx, gameID, teamID, replacementID)
+	}
+	return nil
+}
+
+var _ = Describe("lineup handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockLineupService
+		gameID   uuid.UUID
+		teamID   uuid.UUID
+		url      string
+	)
+
+	// lineupBody builds a team sheet of n players wearing shirts 1 to n, captained by
+	// the player in shirt 8.
+	lineupBody := func(n int) string {
+		players := make([]string, 0, n)
+		var captainID uuid.UUID
+		for i := 1; i <= n; i++ {
+			id := uuid.New()
+			if i == 8 {
+				captainID = id
+			}
+			players = append(players, fmt.Sprintf(`{"player_id":"%s","shirt_number":%d}`, id, i))
+		}
+		return fmt.Sprintf(`{"players":[%s],"captain_id":"%s"}`, strings.Join(players, ","), captainID)
+	}
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockLineupService{}
+		router = gin.New()
+
+		route := "/competitions/:competitionID/seasons/:seasonID/games/:gameID/lineups"
+		router.GET(route, handleGetLineups(logger, mockSvc))
+		router.PUT(route+"/:teamID", handleSetLineup(logger, validate, mockSvc))
+		router.DELETE(route+"/:teamID", handleClearLineup(logger, mockSvc))
+		router.POST(route+"/:teamID/replacements", handleAddReplacement(logger, validate, mockSvc))
+		router.DELETE(route+"/:teamID/replacements/:replacementID", handleRemoveReplacement(logger, mockSvc))
+
+		gameID = uuid.New()
+		teamID = uuid.New()
+		url = "/competitions/" + uuid.NewString() + "/seasons/" + uuid.NewString() + "/games/" + gameID.String() + "/lineups"
+	})
+
+	Describe("get lineups", func() {
+		It("returns 200 with empty lists for a side without a sheet", func() {
+			mockSvc.GetFn = func(ctx context.Context, gID uuid.UUID) (service.GameLineups, error) {
+				Expect(gID).To(Equal(gameID))
+				return service.GameLineups{GameID: gID, Home: service.TeamLineup{TeamID: teamID}}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"starters":[]`))
+		})
+	})
+
+	Describe("set lineup", func() {
+		It("returns 200 with the saved sheet", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.LineupRequest, gID, tID uuid.UUID) (service.TeamLineup, error) {
+				Expect(req.Players).To(HaveLen(23))
+				Expect(tID).To(Equal(teamID))
+
+				lineup := service.TeamLineup{TeamID: tID}
+				for _, p := range req.Players {
+					lineup.Players = append(lineup.Players, service.LineupPlayer{
+						Selection: db.GameLineupPlayer{PlayerID: p.PlayerID, ShirtNumber: p.ShirtNumber, Captain: p.PlayerID == req.CaptainID},
+						Player:    db.Player{ID: p.PlayerID},
+					})
+				}
+				return lineup, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+teamID.String(), bytes.NewBufferString(lineupBody(23)))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp api.TeamLineupResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Starters).To(HaveLen(15))
+			Expect(resp.Bench).To(HaveLen(8))
+			Expect(resp.CaptainID).NotTo(BeNil())
+		})
+
+		It("returns 400 when the starting XV is incomplete", func() {
+			body := strings.Replace(lineupBody(15), `"shirt_number":15`, `"shirt_number":16`, 1)
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+teamID.String(), bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("players must fill shirts 1 to 15"))
+		})
+
+		It("returns 409 once the game has kicked off", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.LineupRequest, gID, tID uuid.UUID) (service.TeamLineup, error) {
+				return service.TeamLineup{}, service.NewConflictError("lineups are locked once a game has kicked off", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+teamID.String(), bytes.NewBufferString(lineupBody(15)))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("clear lineup", func() {
+		It("returns 204 when the sheet is cleared", func() {
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+teamID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("add replacement", func() {
+		It("returns 201 with the replacement", func() {
+			offID, onID := uuid.New(), uuid.New()
+			mockSvc.AddReplacementFn = func(ctx context.Context, req *api.ReplacementRequest, gID, tID uuid.UUID) (db.GameReplacement, error) {
+				return db.GameReplacement{ID: uuid.New(), PlayerOffID: req.PlayerOffID, PlayerOnID: req.PlayerOnID, Minute: req.Minute}, nil
+			}
+
+			body := fmt.Sprintf(`{"player_off_id":"%s","player_on_id":"%s","minute":55}`, offID, onID)
+			req := httptest.NewRequest(http.MethodPost, url+"/"+teamID.String()+"/replacements", bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.ReplacementResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Minute).To(Equal(int32(55)))
+		})
+
+		It("returns 400 when a player replaces themselves", func() {
+			id := uuid.New()
+			body := fmt.Sprintf(`{"player_off_id":"%s","player_on_id":"%s","minute":55}`, id, id)
+			req := httptest.NewRequest(http.MethodPost, url+"/"+teamID.String()+"/replacements", bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("remove replacement", func() {
+		It("returns 404 for an unknown replacement", func() {
+			mockSvc.RemoveReplacementFn = func(ctx context.Context, gID, tID, rID uuid.UUID) error {
+				return service.NewNotFoundError("replacement", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+teamID.String()+"/replacements/"+uuid.NewString(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/metrics"
 	"github.com/bradley-adams/gainline/service"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -21,8 +22,10 @@ import (
 //	@Param		seasonID		path	string	true	"Season ID"
 //	@Param		gameID			path	string	true	"Game ID"
 //	@Success	200
-//	@Failure	400	{object}	response.ErrorResponse	"Invalid game ID"
-//	@Failure	500	{object}	response.ErrorResponse	"Unable to watch game"
+//	@Failure	400	{object}	response.Problem	"Invalid game ID"
+//	@Failure	403	{object}	response.Problem	"Forbidden"
+//	@Failure	404	{object}	response.Problem	"Not found"
+//	@Failure	500	{object}	response.Problem	"Unable to watch game"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/live [get]
 func handleWatchGame(logger zerolog.Logger, gameStateService service.GameStateService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -38,6 +41,9 @@ func handleWatchGame(logger zerolog.Logger, gameStateService service.GameStateSe
 			return
 		}
 
+		metrics.LiveWatchers.Inc()
+		defer metrics.LiveWatchers.Dec()
+
 		ctx.Writer.Header().Set("Content-Type", "text/event-stream")
 		ctx.Writer.Header().Set("Cache-Control", "no-cache")
 		ctx.Writer.Header().Set("Connection", "keep-alive")
@@ -45,7 +51,7 @@ func handleWatchGame(logger zerolog.Logger, gameStateService service.GameStateSe
 		for state := range updates {
 			data, err := json.Marshal(state)
 			if err != nil {
-				logger.Error().Err(err).Msg("failed to marshal game state")
+				response.RequestLogger(ctx, logger).Error().Err(err).Msg("failed to marshal game state")
 				continue
 			}
 			ctx.SSEvent("update", string(data))
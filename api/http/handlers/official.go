@@ -0,0 +1,338 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleCreateOfficial creates a new official with the provided details
+//
+//	@Summary	Create a new official
+//	@ID			create-official
+//	@Tags		Officials
+//	@Accept		json
+//	@Produce	json
+//	@Param		official	body		api.OfficialRequest			true	"Official details to create"
+//	@Success	201		{object}	api.OfficialResponse		"Successful operation"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/officials [post]
+func handleCreateOfficial(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	officialService service.OfficialService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &api.OfficialRequest{}
+		err := ctx.ShouldBindJSON(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		// Validate tags on OfficialRequest struct
+		err = validate.Struct(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, 400, "invalid request")
+			return
+		}
+
+		official, err := officialService.Create(ctx.Request.Context(), req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to add official")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToOfficialResponse(official))
+	}
+}
+
+// handleGetOfficials retrieves officials with pagination
+//
+//	@Summary	Retrieve officials with pagination
+//	@ID			get-officials
+//	@Tags		Officials
+//	@Produce	json
+//	@Param		page		query		int	false	"Page number"		default(1)
+//	@Param		page_size	query		int	false	"Items per page"	default(20)
+//	@Success	200			{object}	api.PaginatedResponse[api.OfficialResponse]
+//	@Failure	500			{object}	response.Problem
+//	@Router		/officials [get]
+func handleGetOfficials(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	officialService service.OfficialService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		q := api.PaginationRequest{}
+
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid pagination params")
+			return
+		}
+
+		q.SetDefaults()
+
+		officials, total, err := officialService.GetAll(
+			ctx.Request.Context(),
+			q.PageSize,
+			q.Offset(),
+		)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get officials")
+			return
+		}
+
+		data := make([]api.OfficialResponse, 0, len(officials))
+		for _, official := range officials {
+			data = append(data, api.ToOfficialResponse(official))
+		}
+
+		totalPages := int(math.Ceil(float64(total) / float64(q.PageSize)))
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.PaginatedResponse[api.OfficialResponse]{
+			Data: data,
+			Pagination: api.PaginationMeta{
+				Page:       q.Page,
+				PageSize:   q.PageSize,
+				Total:      total,
+				TotalPages: totalPages,
+			},
+		})
+	}
+}
+
+// handleGetOfficial retrieves an official by ID
+//
+//	@Summary	Get a single official by ID
+//	@ID			get-official
+//	@Tags		Officials
+//	@Produce	json
+//	@Param		officialID	path		string					true	"Official ID"	default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Success	200		{object}	api.OfficialResponse		"Official found"
+//	@Header		200		{string}	ETag					"Version of the official, for If-Match"
+//	@Failure	400		{object}	response.Problem	"Invalid official ID"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/officials/{officialID} [get]
+func handleGetOfficial(logger zerolog.Logger, officialService service.OfficialService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		official, err := officialService.Get(ctx.Request.Context(), officialID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get official")
+			return
+		}
+
+		setETag(ctx, official.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToOfficialResponse(official))
+	}
+}
+
+// handleUpdateOfficial updates an existing official
+//
+//	@Summary	Update an existing official
+//	@ID			update-official
+//	@Tags		Officials
+//	@Accept		json
+//	@Produce	json
+//	@Param		officialID	path		string					true	"Official ID"	default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Param		official	body		api.OfficialRequest			true	"Official details to update"
+//	@Param		If-Match	header		string					false	"ETag of the official being replaced"
+//	@Success	200		{object}	api.OfficialResponse		"Official updated"
+//	@Header		200		{string}	ETag					"Version of the updated official"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	412		{object}	response.Problem	"Official changed since it was read"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/officials/{officialID} [put]
+func handleUpdateOfficial(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	officialService service.OfficialService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		req := &api.OfficialRequest{}
+		err = ctx.ShouldBindJSON(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		// Validate tags on OfficialRequest struct
+		err = validate.Struct(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, 400, "invalid request")
+			return
+		}
+
+		version, err := ifMatch(ctx, "official")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		official, err := officialService.Update(ctx.Request.Context(), req, officialID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update official")
+			return
+		}
+
+		setETag(ctx, official.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToOfficialResponse(official))
+	}
+}
+
+// handlePatchOfficial applies a JSON merge patch to an official
+//
+//	@Summary		Partially update an official
+//	@Description	Applies an RFC 7386 JSON merge patch. The write fails with 412 if the official changes after it is read, or if If-Match names an older version.
+//	@ID				patch-official
+//	@Tags			Officials
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			officialID		path		string				true	"Official ID"	default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Param			official		body		api.OfficialRequest		true	"Fields to change"
+//	@Param			If-Match	header		string				false	"ETag of the official being patched"
+//	@Success		200			{object}	api.OfficialResponse	"Official updated"
+//	@Header			200			{string}	ETag				"Version of the updated official"
+//	@Failure		400			{object}	response.Problem	"Bad request"
+//	@Failure		404			{object}	response.Problem	"Not found"
+//	@Failure		412			{object}	response.Problem	"Official changed since it was read"
+//	@Failure		415			{object}	response.Problem	"Unsupported media type"
+//	@Failure		500			{object}	response.Problem	"Internal server error"
+//	@Router			/officials/{officialID} [patch]
+func handlePatchOfficial(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	officialService service.OfficialService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		current, err := officialService.Get(ctx.Request.Context(), officialID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get official")
+			return
+		}
+
+		version, err := patchVersion(ctx, "official", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := api.ToOfficialRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		official, err := officialService.Update(ctx.Request.Context(), &req, officialID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update official")
+			return
+		}
+
+		setETag(ctx, official.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToOfficialResponse(official))
+	}
+}
+
+// handleDeleteOfficial deletes an official by ID along with their appointments
+//
+//	@Summary	Delete an official by ID
+//	@ID			delete-official
+//	@Tags		Officials
+//	@Produce	json
+//	@Param		officialID	path			string	true	"Official ID"	default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Success	204		"No Content"	"Official deleted successfully"
+//	@Failure	400		{object}		response.Problem	"Invalid official ID"
+//	@Failure	404		{object}		response.Problem	"Not found"
+//	@Failure	500		{object}		response.Problem	"Internal server error"
+//	@Router		/officials/{officialID} [delete]
+func handleDeleteOfficial(logger zerolog.Logger, officialService service.OfficialService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		err = officialService.Delete(ctx.Request.Context(), officialID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to delete official")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// handleGetOfficialAppointments lists the games an official is appointed to
+//
+//	@Summary	Get an official's appointments
+//	@ID			get-official-appointments
+//	@Tags		Officials
+//	@Produce	json
+//	@Param		officialID	path		string								true	"Official ID"	default(5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090)
+//	@Success	200			{object}	api.OfficialAppointmentsResponse	"Upcoming and past appointments"
+//	@Failure	400			{object}	response.Problem					"Invalid official ID"
+//	@Failure	404			{object}	response.Problem					"Not found"
+//	@Failure	500			{object}	response.Problem					"Internal server error"
+//	@Router		/officials/{officialID}/appointments [get]
+func handleGetOfficialAppointments(logger zerolog.Logger, officialService service.OfficialService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		officialID, err := uuid.Parse(ctx.Param("officialID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid official ID")
+			return
+		}
+
+		appointments, err := officialService.GetAppointments(ctx.Request.Context(), officialID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get appointments")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToOfficialAppointmentsResponse(appointments))
+	}
+}
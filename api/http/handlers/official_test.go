@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for OfficialService
+type mockOfficialService struct {
+	CreateFn          func(ctx context.Context, req *api.OfficialRequest) (db.Official, error)
+	GetAllFn          func(ctx context.Context, limit, offset int) ([]db.Official, int64, error)
+	GetFn             func(ctx context.Context, officialID uuid.UUID) (db.Official, error)
+	UpdateFn          func(ctx context.Context, req *api.OfficialRequest, officialID uuid.UUID, version *time.Time) (db.Official, error)
+	DeleteFn          func(ctx context.Context, officialID uuid.UUID) error
+	GetAppointmentsFn func(ctx context.Context, officialID uuid.UUID) (service.OfficialAppointments, error)
+}
+
+func (m *mockOfficialService) Create(ctx context.Context, req *api.OfficialRequest) (db.Official, error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(ctx, req)
+	}
+	return db.Official{}, nil
+}
+
+func (m *mockOfficialService) GetAll(ctx context.Context, limit, offset int) ([]db.Official, int64, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockOfficialService) Get(ctx context.Context, officialID uuid.UUID) (db.Official, error) {
+	if m.GetFn != nil {
+		return m.GetFn(ctx, officialID)
+	}
+	return db.Official{}, nil
+}
+
+func (m *mockOfficialService) Update(ctx context.Context, req *api.OfficialRequest, officialID uuid.UUID, version *time.Time) (db.Official, error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(ctx, req, officialID, version)
+	}
+	return db.Official{}, nil
+}
+
+func (m *mockOfficialService) Delete(ctx context.Context, officialID uuid.UUID) error {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(ctx, officialID)
+	}
+	return nil
+}
+
+func (m *mockOfficialService) GetAppointments(ctx context.Context, officialID uuid.UUID) (service.OfficialAppointments, error) {
+	if m.GetAppointmentsFn != nil {
+		return m.GetAppointmentsFn(ctx, officialID)
+	}
+	return service.OfficialAppointments{}, nil
+}
+
+var _ = Describe("official handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockOfficialService
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockOfficialService{}
+		router = gin.New()
+
+		router.POST("/officials", handleCreateOfficial(logger, validate, mockSvc))
+		router.GET("/officials", handleGetOfficials(logger, validate, mockSvc))
+		router.GET("/officials/:officialID", handleGetOfficial(logger, mockSvc))
+		router.GET("/officials/:officialID/appointments", handleGetOfficialAppointments(logger, mockSvc))
+		router.PUT("/officials/:officialID", handleUpdateOfficial(logger, validate, mockSvc))
+		router.DELETE("/officials/:officialID", handleDeleteOfficial(logger, mockSvc))
+	})
+
+	Describe("create official", func() {
+		It("returns 201 for valid request", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.OfficialRequest) (db.Official, error) {
+				return db.Official{ID: uuid.New(), Name: req.Name}, nil
+			}
+
+			reqBody := `{"name":"Ben O'Keeffe","nationality":"New Zealand"}`
+			req := httptest.NewRequest(http.MethodPost, "/officials", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.OfficialResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Name).To(Equal("Ben O'Keeffe"))
+		})
+
+		It("returns 400 when the name is missing", func() {
+			req := httptest.NewRequest(http.MethodPost, "/officials", bytes.NewBufferString(`{"nationality":"New Zealand"}`))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("get official appointments", func() {
+		It("returns 200 with upcoming and past games", func() {
+			officialID := uuid.New()
+			mockSvc.GetAppointmentsFn = func(ctx context.Context, oID uuid.UUID) (service.OfficialAppointments, error) {
+				Expect(oID).To(Equal(officialID))
+				return service.OfficialAppointments{
+					OfficialID: oID,
+					Upcoming: []db.GetOfficialAppointmentsRow{
+						{ID: uuid.New(), GameID: uuid.New(), Role: db.OfficialRoleReferee},
+					},
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/officials/"+officialID.String()+"/appointments", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp api.OfficialAppointmentsResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Upcoming).To(HaveLen(1))
+			Expect(resp.Past).To(BeEmpty())
+		})
+
+		It("returns 404 for an unknown official", func() {
+			mockSvc.GetAppointmentsFn = func(ctx context.Context, oID uuid.UUID) (service.OfficialAppointments, error) {
+				return service.OfficialAppointments{}, service.NewNotFoundError("official", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/officials/"+uuid.NewString()+"/appointments", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("delete official", func() {
+		It("returns 204 for successful deletion", func() {
+			req := httptest.NewRequest(http.MethodDelete, "/officials/"+uuid.NewString(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// MergePatchContentType is the media type for RFC 7386 JSON merge patch bodies.
+const MergePatchContentType = "application/merge-patch+json"
+
+var errUnsupportedPatch = errors.New("patch must be sent as " + MergePatchContentType)
+
+// isMergePatch reports whether the request body is a JSON merge patch. Plain JSON is
+// accepted too, since a merge patch is an ordinary JSON object.
+func isMergePatch(ctx *gin.Context) bool {
+	contentType := ctx.ContentType()
+	return contentType == MergePatchContentType || contentType == gin.MIMEJSON
+}
+
+// bindMergePatch applies the request body as a JSON merge patch to req, which holds the
+// resource as it is now. Members set to null are cleared and arrays are replaced whole.
+func bindMergePatch[T any](ctx *gin.Context, req *T) error {
+	patch, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		return errors.Wrap(err, "unable to read patch")
+	}
+
+	current, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode current resource")
+	}
+
+	merged, err := mergePatch(current, patch)
+	if err != nil {
+		return err
+	}
+
+	// Decode into a zero value so members the patch removed do not keep their old value.
+	var patched T
+	if err := json.Unmarshal(merged, &patched); err != nil {
+		return errors.Wrap(err, "unable to decode patched resource")
+	}
+
+	*req = patched
+	return nil
+}
+
+// mergePatch applies patch to doc as described by RFC 7386.
+func mergePatch(doc, patch []byte) ([]byte, error) {
+	var target, changes any
+	if err := unmarshalNumbers(doc, &target); err != nil {
+		return nil, errors.Wrap(err, "invalid document")
+	}
+	if err := unmarshalNumbers(patch, &changes); err != nil {
+		return nil, errors.Wrap(err, "invalid merge patch")
+	}
+
+	return json.Marshal(mergeValue(target, changes))
+}
+
+func mergeValue(target, patch any) any {
+	changes, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	merged, ok := target.(map[string]any)
+	if !ok {
+		merged = make(map[string]any, len(changes))
+	}
+
+	for key, value := range changes {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = mergeValue(merged[key], value)
+	}
+
+	return merged
+}
+
+// unmarshalNumbers decodes data keeping numbers as written, so large scores and IDs
+// survive the round trip unchanged.
+func unmarshalNumbers(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/bradley-adams/gainline/service"
+)
+
+var _ = Describe("merge patch", func() {
+	// Examples from RFC 7386 appendix A.
+	DescribeTable("mergePatch",
+		func(doc, patch, want string) {
+			got, err := mergePatch([]byte(doc), []byte(patch))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(MatchJSON(want))
+		},
+		Entry("replaces a member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`),
+		Entry("adds a member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`),
+		Entry("removes a member set to null", `{"a":"b"}`, `{"a":null}`, `{}`),
+		Entry("keeps untouched members", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`),
+		Entry("replaces arrays whole", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`),
+		Entry("merges nested objects", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`),
+		Entry("replaces a non-object document", `["a"]`, `{"a":"b"}`, `{"a":"b"}`),
+		Entry("keeps large numbers exact", `{"a":1}`, `{"b":12345678901234567890}`, `{"a":1,"b":12345678901234567890}`),
+	)
+
+	It("rejects a patch that is not JSON", func() {
+		_, err := mergePatch([]byte(`{}`), []byte(`{"a":`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("etag", func() {
+	newContext := func(ifMatch string) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+		if ifMatch != "" {
+			ctx.Request.Header.Set("If-Match", ifMatch)
+		}
+		return ctx
+	}
+
+	updatedAt := time.Date(2025, time.August, 2, 19, 35, 0, 123456000, time.UTC)
+
+	It("reads back the version it was issued for", func() {
+		version, err := ifMatch(newContext(etag(updatedAt)), "game")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).NotTo(BeNil())
+		Expect(version.Equal(updatedAt)).To(BeTrue())
+	})
+
+	It("skips the check without a header or with a wildcard", func() {
+		for _, header := range []string{"", "*"} {
+			version, err := ifMatch(newContext(header), "game")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(BeNil())
+		}
+	})
+
+	It("never matches a tag it could not have issued", func() {
+		for _, header := range []string{"W/" + etag(updatedAt), "abc", `"abc"`, etag(updatedAt) + ", " + etag(updatedAt)} {
+			_, err := ifMatch(newContext(header), "game")
+
+			var preconditionErr *service.PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue(), header)
+		}
+	})
+
+	It("returns the current version for a patch without If-Match", func() {
+		version, err := patchVersion(newContext(""), "game", updatedAt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*version).To(Equal(updatedAt))
+	})
+
+	It("rejects a patch against an older version", func() {
+		_, err := patchVersion(newContext(etag(updatedAt.Add(-time.Second))), "game", updatedAt)
+
+		var preconditionErr *service.PreconditionFailedError
+		Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+	})
+})
@@ -0,0 +1,353 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleCreatePlayer adds a new player to a team
+//
+//	@Summary	Add a player to a team
+//	@ID			create-player
+//	@Tags		Players
+//	@Accept		json
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		player	body		api.PlayerRequest			true	"Player details to create"
+//	@Success	201		{object}	api.PlayerResponse		"Successful operation"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	404		{object}	response.Problem	"Team not found"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/teams/{teamID}/players [post]
+func handleCreatePlayer(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	playerService service.PlayerService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		req := &api.PlayerRequest{}
+		err = ctx.ShouldBindJSON(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		// Validate tags on PlayerRequest struct
+		err = validate.Struct(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, 400, "invalid request")
+			return
+		}
+
+		player, err := playerService.Create(ctx.Request.Context(), req, teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to add player")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToPlayerResponse(player))
+	}
+}
+
+// handleGetPlayers retrieves a team's players with pagination
+//
+//	@Summary	Retrieve a team's players with pagination
+//	@ID			get-players
+//	@Tags		Players
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		page		query		int	false	"Page number"		default(1)
+//	@Param		page_size	query		int	false	"Items per page"	default(20)
+//	@Success	200			{object}	api.PaginatedResponse[api.PlayerResponse]
+//	@Failure	404			{object}	response.Problem
+//	@Failure	500			{object}	response.Problem
+//	@Router		/teams/{teamID}/players [get]
+func handleGetPlayers(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	playerService service.PlayerService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		q := api.PaginationRequest{}
+
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid pagination params")
+			return
+		}
+
+		q.SetDefaults()
+
+		players, total, err := playerService.GetAll(
+			ctx.Request.Context(),
+			teamID,
+			q.PageSize,
+			q.Offset(),
+		)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get players")
+			return
+		}
+
+		data := make([]api.PlayerResponse, 0, len(players))
+		for _, player := range players {
+			data = append(data, api.ToPlayerResponse(player))
+		}
+
+		totalPages := int(math.Ceil(float64(total) / float64(q.PageSize)))
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.PaginatedResponse[api.PlayerResponse]{
+			Data: data,
+			Pagination: api.PaginationMeta{
+				Page:       q.Page,
+				PageSize:   q.PageSize,
+				Total:      total,
+				TotalPages: totalPages,
+			},
+		})
+	}
+}
+
+// handleGetPlayer retrieves a player by ID
+//
+//	@Summary	Get a single player by ID
+//	@ID			get-player
+//	@Tags		Players
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		playerID	path		string					true	"Player ID"	default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Success	200		{object}	api.PlayerResponse		"Player found"
+//	@Header		200		{string}	ETag					"Version of the player, for If-Match"
+//	@Failure	400		{object}	response.Problem	"Invalid team or player ID"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/teams/{teamID}/players/{playerID} [get]
+func handleGetPlayer(logger zerolog.Logger, playerService service.PlayerService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team or player ID")
+			return
+		}
+
+		player, err := playerService.Get(ctx.Request.Context(), teamID, playerID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get player")
+			return
+		}
+
+		setETag(ctx, player.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToPlayerResponse(player))
+	}
+}
+
+// handleUpdatePlayer updates an existing player
+//
+//	@Summary	Update an existing player
+//	@ID			update-player
+//	@Tags		Players
+//	@Accept		json
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		playerID	path		string					true	"Player ID"	default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Param		player	body		api.PlayerRequest			true	"Player details to update"
+//	@Param		If-Match	header		string					false	"ETag of the player being replaced"
+//	@Success	200		{object}	api.PlayerResponse		"Player updated"
+//	@Header		200		{string}	ETag					"Version of the updated player"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	412		{object}	response.Problem	"Player changed since it was read"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/teams/{teamID}/players/{playerID} [put]
+func handleUpdatePlayer(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	playerService service.PlayerService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team or player ID")
+			return
+		}
+
+		req := &api.PlayerRequest{}
+		err = ctx.ShouldBindJSON(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		// Validate tags on PlayerRequest struct
+		err = validate.Struct(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, 400, "invalid request")
+			return
+		}
+
+		version, err := ifMatch(ctx, "player")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		player, err := playerService.Update(ctx.Request.Context(), req, teamID, playerID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update player")
+			return
+		}
+
+		setETag(ctx, player.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToPlayerResponse(player))
+	}
+}
+
+// handlePatchPlayer applies a JSON merge patch to a player
+//
+//	@Summary		Partially update a player
+//	@Description	Applies an RFC 7386 JSON merge patch. The write fails with 412 if the player changes after it is read, or if If-Match names an older version.
+//	@ID				patch-player
+//	@Tags			Players
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			teamID		path		string				true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param			playerID	path		string				true	"Player ID"	default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Param			player		body		api.PlayerRequest		true	"Fields to change"
+//	@Param			If-Match	header		string				false	"ETag of the player being patched"
+//	@Success		200			{object}	api.PlayerResponse	"Player updated"
+//	@Header			200			{string}	ETag				"Version of the updated player"
+//	@Failure		400			{object}	response.Problem	"Bad request"
+//	@Failure		404			{object}	response.Problem	"Not found"
+//	@Failure		412			{object}	response.Problem	"Player changed since it was read"
+//	@Failure		415			{object}	response.Problem	"Unsupported media type"
+//	@Failure		500			{object}	response.Problem	"Internal server error"
+//	@Router			/teams/{teamID}/players/{playerID} [patch]
+func handlePatchPlayer(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	playerService service.PlayerService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team or player ID")
+			return
+		}
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		current, err := playerService.Get(ctx.Request.Context(), teamID, playerID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get player")
+			return
+		}
+
+		version, err := patchVersion(ctx, "player", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := api.ToPlayerRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		player, err := playerService.Update(ctx.Request.Context(), &req, teamID, playerID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update player")
+			return
+		}
+
+		setETag(ctx, player.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToPlayerResponse(player))
+	}
+}
+
+// handleDeletePlayer deletes a player by ID
+//
+//	@Summary	Delete a player by ID
+//	@ID			delete-player
+//	@Tags		Players
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		playerID	path			string	true	"Player ID"	default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Success	204		"No Content"	"Player deleted successfully"
+//	@Failure	400		{object}		response.Problem	"Invalid team or player ID"
+//	@Failure	404		{object}		response.Problem	"Not found"
+//	@Failure	500		{object}		response.Problem	"Internal server error"
+//	@Router		/teams/{teamID}/players/{playerID} [delete]
+func handleDeletePlayer(logger zerolog.Logger, playerService service.PlayerService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team or player ID")
+			return
+		}
+
+		err = playerService.Delete(ctx.Request.Context(), teamID, playerID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to delete player")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
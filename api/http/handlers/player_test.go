@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for PlayerService
+type mockPlayerService struct {
+	CreateFn func(ctx context.Context, req *api.PlayerRequest, teamID uuid.UUID) (db.Player, error)
+	GetAllFn func(ctx context.Context, teamID uuid.UUID, limit, offset int) ([]db.Player, int64, error)
+	GetFn    func(ctx context.Context, teamID, playerID uuid.UUID) (db.Player, error)
+	UpdateFn func(ctx context.Context, req *api.PlayerRequest, teamID, playerID uuid.UUID, version *time.Time) (db.Player, error)
+	DeleteFn func(ctx context.Context, teamID, playerID uuid.UUID) error
+}
+
+func (m *mockPlayerService) Create(ctx context.Context, req *api.PlayerRequest, teamID uuid.UUID) (db.Player, error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(ctx, req, teamID)
+	}
+	return db.Player{}, nil
+}
+
+func (m *mockPlayerService) GetAll(ctx context.Context, teamID uuid.UUID, limit, offset int) ([]db.Player, int64, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, teamID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockPlayerService) Get(ctx context.Context, teamID, playerID uuid.UUID) (db.Player, error) {
+	if m.GetFn != nil {
+		return m.GetFn(ctx, teamID, playerID)
+	}
+	return db.Player{}, nil
+}
+
+func (m *mockPlayerService) Update(ctx context.Context, req *api.PlayerRequest, teamID, playerID uuid.UUID, version *time.Time) (db.Player, error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(ctx, req, teamID, playerID, version)
+	}
+	return db.Player{}, nil
+}
+
+func (m *mockPlayerService) Delete(ctx context.Context, teamID, playerID uuid.UUID) error {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(ctx, teamID, playerID)
+	}
+	return nil
+}
+
+var _ = Describe("player handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockPlayerService
+		teamID   uuid.UUID
+		url      string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockPlayerService{}
+		router = gin.New()
+
+		router.POST("/teams/:teamID/players", handleCreatePlayer(logger, validate, mockSvc))
+		router.GET("/teams/:teamID/players", handleGetPlayers(logger, validate, mockSvc))
+		router.GET("/teams/:teamID/players/:playerID", handleGetPlayer(logger, mockSvc))
+		router.PUT("/teams/:teamID/players/:playerID", handleUpdatePlayer(logger, validate, mockSvc))
+		router.PATCH("/teams/:teamID/players/:playerID", handlePatchPlayer(logger, validate, mockSvc))
+		router.DELETE("/teams/:teamID/players/:playerID", handleDeletePlayer(logger, mockSvc))
+
+		teamID = uuid.New()
+		url = "/teams/" + teamID.String() + "/players"
+	})
+
+	Describe("create player", func() {
+		It("returns 201 for valid request", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.PlayerRequest, tID uuid.UUID) (db.Player, error) {
+				Expect(tID).To(Equal(teamID))
+				return db.Player{
+					ID:          uuid.New(),
+					TeamID:      tID,
+					Name:        req.Name,
+					DateOfBirth: sql.NullTime{Time: *req.DateOfBirth, Valid: true},
+					Position:    db.PlayerPosition(req.Position),
+				}, nil
+			}
+
+			reqBody := `{"name":"Ardie Savea","date_of_birth":"1993-10-14T00:00:00Z","position":"number_eight"}`
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.PlayerResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Position).To(Equal(api.PlayerPositionNumberEight))
+			Expect(resp.DateOfBirth).NotTo(BeNil())
+		})
+
+		It("returns 400 for an unknown position", func() {
+			reqBody := `{"name":"Ardie Savea","position":"rover"}`
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("position must be one of"))
+		})
+
+		It("returns 404 when the team does not exist", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.PlayerRequest, tID uuid.UUID) (db.Player, error) {
+				return db.Player{}, service.NewNotFoundError("team", nil)
+			}
+
+			reqBody := `{"name":"Ardie Savea","position":"number_eight"}`
+			req := httptest.NewRequest(http.MethodPost, url, bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("get players with pagination", func() {
+		It("returns 200 and the team's players", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, tID uuid.UUID, limit, offset int) ([]db.Player, int64, error) {
+				Expect(tID).To(Equal(teamID))
+				Expect(limit).To(Equal(10))
+				Expect(offset).To(Equal(10))
+
+				return []db.Player{
+					{ID: uuid.New(), TeamID: tID, Name: "Beauden Barrett", Position: db.PlayerPositionFlyHalf},
+				}, int64(11), nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, url+"?page=2&page_size=10", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp api.PaginatedResponse[api.PlayerResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Data).To(HaveLen(1))
+			Expect(resp.Pagination.TotalPages).To(Equal(2))
+		})
+	})
+
+	Describe("get player by ID", func() {
+		It("returns 200 with an ETag", func() {
+			updatedAt := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+			mockSvc.GetFn = func(ctx context.Context, tID, pID uuid.UUID) (db.Player, error) {
+				return db.Player{ID: pID, TeamID: tID, Name: "Codie Taylor", Position: db.PlayerPositionHooker, UpdatedAt: updatedAt}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, url+"/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(etag(updatedAt)))
+		})
+
+		It("returns 400 for invalid team UUID", func() {
+			req := httptest.NewRequest(http.MethodGet, "/teams/invalid/players/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("patch player", func() {
+		It("keeps the fields the patch leaves out", func() {
+			dob := time.Date(1993, time.October, 14, 0, 0, 0, 0, time.UTC)
+			current := db.Player{
+				ID:          uuid.New(),
+				TeamID:      teamID,
+				Name:        "Ardie Savea",
+				DateOfBirth: sql.NullTime{Time: dob, Valid: true},
+				Position:    db.PlayerPositionNumberEight,
+				Nationality: sql.NullString{String: "New Zealand", Valid: true},
+			}
+			mockSvc.GetFn = func(ctx context.Context, tID, pID uuid.UUID) (db.Player, error) {
+				return current, nil
+			}
+			var gotReq *api.PlayerRequest
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.PlayerRequest, tID, pID uuid.UUID, version *time.Time) (db.Player, error) {
+				gotReq = req
+				return current, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, url+"/"+current.ID.String(), bytes.NewBufferString(`{"position":"flanker"}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(*gotReq).To(Equal(api.PlayerRequest{
+				Name:        "Ardie Savea",
+				DateOfBirth: &dob,
+				Position:    api.PlayerPositionFlanker,
+				Nationality: "New Zealand",
+			}))
+		})
+	})
+
+	Describe("delete player", func() {
+		It("returns 204 for successful deletion", func() {
+			mockSvc.DeleteFn = func(ctx context.Context, tID, pID uuid.UUID) error { return nil }
+
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("returns 404 when the player belongs to another team", func() {
+			mockSvc.DeleteFn = func(ctx context.Context, tID, pID uuid.UUID) error {
+				return service.NewNotFoundError("player", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
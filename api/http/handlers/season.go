@@ -23,8 +23,9 @@ import (
 //	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Param		season			body		api.SeasonRequest		true	"Season details to create"
 //	@Success	201				{object}	api.SeasonResponse		"Successful operation"
-//	@Failure	400				{object}	response.ErrorResponse	"Bad request"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}	response.Problem	"Bad request"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons [post]
 func handleCreateSeason(
 	logger zerolog.Logger,
@@ -72,8 +73,9 @@ func handleCreateSeason(
 //	@Param		page			query		int						false	"Page number"		default(1)
 //	@Param		page_size		query		int						false	"Page size"			default(10)
 //	@Success	200				{object}	map[string]interface{}	"Paginated seasons"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid competition ID"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}	response.Problem	"Invalid competition ID"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons [get]
 func handleGetSeasons(
 	logger zerolog.Logger,
@@ -132,6 +134,37 @@ func handleGetSeasons(
 	}
 }
 
+// handleGetCurrentSeason retrieves the season a competition is currently on
+//
+//	@Summary		Get the current season for a competition
+//	@Description	Returns the season in progress. When none is, returns the next season to start, or failing that the last season to finish. The resolution says which.
+//	@ID				get-current-season
+//	@Tags			Seasons
+//	@Produce		json
+//	@Param			competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Success		200				{object}	api.CurrentSeasonResponse	"Season found"
+//	@Failure		400				{object}	response.Problem			"Invalid competition ID"
+//	@Failure		404				{object}	response.Problem			"Competition not found or has no seasons"
+//	@Failure		500				{object}	response.Problem			"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/current [get]
+func handleGetCurrentSeason(logger zerolog.Logger, seasonService service.SeasonService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		competitionID, err := uuid.Parse(ctx.Param("competitionID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid competition ID")
+			return
+		}
+
+		current, err := seasonService.GetCurrent(ctx.Request.Context(), competitionID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get current season")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToCurrentSeasonResponse(current))
+	}
+}
+
 // handleGetSeason retrieves a season by ID
 //
 //	@Summary	Get a single season by ID
@@ -141,13 +174,17 @@ func handleGetSeasons(
 //	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
 //	@Success	200				{object}	api.SeasonResponse		"Season found"
-//	@Failure	400				{object}	response.ErrorResponse	"Invalid season ID"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200				{string}	ETag					"Version of the season, for If-Match"
+//	@Failure	400				{object}	response.Problem	"Invalid season ID"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID} [get]
 func handleGetSeason(logger zerolog.Logger) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		season := ctx.MustGet("season").(service.SeasonAggregate)
 
+		setETag(ctx, season.UpdatedAt)
 		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToSeasonResponse(season))
 	}
 }
@@ -162,9 +199,14 @@ func handleGetSeason(logger zerolog.Logger) gin.HandlerFunc {
 //	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
 //	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
 //	@Param		season			body		api.SeasonRequest		true	"Season details to update"
+//	@Param		If-Match		header		string					false	"ETag of the season being replaced"
 //	@Success	200				{object}	api.SeasonResponse		"Season updated"
-//	@Failure	400				{object}	response.ErrorResponse	"Bad request"
-//	@Failure	500				{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200				{string}	ETag					"Version of the updated season"
+//	@Failure	400				{object}	response.Problem	"Bad request"
+//	@Failure	403				{object}	response.Problem	"Forbidden"
+//	@Failure	404				{object}	response.Problem	"Not found"
+//	@Failure	412				{object}	response.Problem	"Season changed since it was read"
+//	@Failure	500				{object}	response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID} [put]
 func handleUpdateSeason(
 	logger zerolog.Logger,
@@ -198,12 +240,81 @@ func handleUpdateSeason(
 			return
 		}
 
-		season, err := seasonService.Update(ctx.Request.Context(), req, competitionID, seasonID)
+		version, err := ifMatch(ctx, "season")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		season, err := seasonService.Update(ctx.Request.Context(), req, competitionID, seasonID, version)
 		if err != nil {
 			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update season")
 			return
 		}
 
+		setETag(ctx, season.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToSeasonResponse(season))
+	}
+}
+
+// handlePatchSeason applies a JSON merge patch to a season
+//
+//	@Summary		Partially update a season
+//	@Description	Applies an RFC 7386 JSON merge patch. Arrays such as stages and teams are replaced whole, so send every stage (with its id) or team to keep. The write fails with 412 if the season changes after it is read, or if If-Match names an older version.
+//	@ID				patch-season
+//	@Tags			Seasons
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			competitionID	path		string				true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string				true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			season			body		api.SeasonRequest	true	"Fields to change"
+//	@Param			If-Match		header		string				false	"ETag of the season being patched"
+//	@Success		200				{object}	api.SeasonResponse	"Season updated"
+//	@Header			200				{string}	ETag				"Version of the updated season"
+//	@Failure		400				{object}	response.Problem	"Bad request"
+//	@Failure		403				{object}	response.Problem	"Forbidden"
+//	@Failure		404				{object}	response.Problem	"Not found"
+//	@Failure		412				{object}	response.Problem	"Season changed since it was read"
+//	@Failure		415				{object}	response.Problem	"Unsupported media type"
+//	@Failure		500				{object}	response.Problem	"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID} [patch]
+func handlePatchSeason(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	seasonService service.SeasonService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		current := ctx.MustGet("season").(service.SeasonAggregate)
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		version, err := patchVersion(ctx, "season", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := service.ToSeasonRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		season, err := seasonService.Update(ctx.Request.Context(), &req, current.CompetitionID, current.ID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update season")
+			return
+		}
+
+		setETag(ctx, season.UpdatedAt)
 		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToSeasonResponse(season))
 	}
 }
@@ -217,8 +328,10 @@ func handleUpdateSeason(
 //	@Param		competitionID	path			string	true	"Competition ID"	default(a973dd2c-ecd3-4578-b5c3-9022a3f0ecbd)
 //	@Param		seasonID		path			string	true	"Season ID"			default(fe04fe69-834f-42be-9821-04e53e8de26d)
 //	@Success	204				"No Content"	"Season deleted successfully"
-//	@Failure	400				{object}		response.ErrorResponse	"Invalid season ID"
-//	@Failure	500				{object}		response.ErrorResponse	"Internal server error"
+//	@Failure	400				{object}		response.Problem	"Invalid season ID"
+//	@Failure	403				{object}		response.Problem	"Forbidden"
+//	@Failure	404				{object}		response.Problem	"Not found"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
 //	@Router		/competitions/{competitionID}/seasons/{seasonID} [delete]
 func handleDeleteSeason(logger zerolog.Logger, seasonService service.SeasonService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -237,3 +350,93 @@ func handleDeleteSeason(logger zerolog.Logger, seasonService service.SeasonServi
 		ctx.Status(http.StatusNoContent)
 	}
 }
+
+// handleSetSeasonTeamHomeVenue overrides a team's home venue for one season
+//
+//	@Summary	Set a team's home venue for a season
+//	@ID			set-season-team-home-venue
+//	@Tags		Seasons
+//	@Accept		json
+//	@Produce	json
+//	@Param		competitionID	path		string							true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string							true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		teamID			path		string							true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		venue			body		api.SeasonTeamHomeVenueRequest	true	"Home venue for the season"
+//	@Success	200				{object}	api.SeasonResponse				"Home venue set"
+//	@Failure	400				{object}	response.Problem				"Bad request"
+//	@Failure	403				{object}	response.Problem				"Forbidden"
+//	@Failure	404				{object}	response.Problem				"Team not in season"
+//	@Failure	500				{object}	response.Problem				"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/home-venue [put]
+func handleSetSeasonTeamHomeVenue(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	seasonService service.SeasonService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		current := ctx.MustGet("season").(service.SeasonAggregate)
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		req := &api.SeasonTeamHomeVenueRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		venueID := uuid.NullUUID{UUID: req.VenueID, Valid: true}
+		season, err := seasonService.SetTeamHomeVenue(ctx.Request.Context(), current.ID, teamID, venueID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to set home venue")
+			return
+		}
+
+		setETag(ctx, season.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToSeasonResponse(season))
+	}
+}
+
+// handleDeleteSeasonTeamHomeVenue removes a team's home venue override for one season,
+// so the team's own home venue applies again
+//
+//	@Summary	Remove a team's home venue for a season
+//	@ID			delete-season-team-home-venue
+//	@Tags		Seasons
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		teamID			path			string	true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Success	204				"No Content"	"Home venue removed"
+//	@Failure	400				{object}		response.Problem	"Invalid team ID"
+//	@Failure	403				{object}		response.Problem	"Forbidden"
+//	@Failure	404				{object}		response.Problem	"Team not in season"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/home-venue [delete]
+func handleDeleteSeasonTeamHomeVenue(logger zerolog.Logger, seasonService service.SeasonService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		current := ctx.MustGet("season").(service.SeasonAggregate)
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		_, err = seasonService.SetTeamHomeVenue(ctx.Request.Context(), current.ID, teamID, uuid.NullUUID{})
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to remove home venue")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
@@ -3,10 +3,13 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
+	"github.com/bradley-adams/gainline/db/db"
 	"github.com/bradley-adams/gainline/http/api"
 	"github.com/bradley-adams/gainline/http/validation"
 	"github.com/bradley-adams/gainline/service"
@@ -24,8 +27,11 @@ type mockSeasonService struct {
 	CreateFn func(ctx context.Context, req *api.SeasonRequest, competitionID uuid.UUID) (service.SeasonAggregate, error)
 	GetAllFn func(ctx context.Context, competitionID uuid.UUID, limit, offset int) ([]service.SeasonAggregate, int64, error)
 	GetFn    func(ctx context.Context, competitionID, seasonID uuid.UUID) (service.SeasonAggregate, error)
-	UpdateFn func(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID) (service.SeasonAggregate, error)
+	UpdateFn func(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID, version *time.Time) (service.SeasonAggregate, error)
 	DeleteFn func(ctx context.Context, seasonID uuid.UUID) error
+
+	SetTeamHomeVenueFn func(ctx context.Context, seasonID, teamID uuid.UUID, venueID uuid.NullUUID) (service.SeasonAggregate, error)
+	GetCurrentFn       func(ctx context.Context, competitionID uuid.UUID) (service.CurrentSeason, error)
 }
 
 func (m *mockSeasonService) Create(ctx context.Context, req *api.SeasonRequest, competitionID uuid.UUID) (service.SeasonAggregate, error) {
@@ -49,9 +55,9 @@ func (m *mockSeasonService) Get(ctx context.Context, competitionID, seasonID uui
 	return service.SeasonAggregate{}, nil
 }
 
-func (m *mockSeasonService) Update(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID) (service.SeasonAggregate, error) {
+func (m *mockSeasonService) Update(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID, version *time.Time) (service.SeasonAggregate, error) {
 	if m.UpdateFn != nil {
-		return m.UpdateFn(ctx, req, competitionID, seasonID)
+		return m.UpdateFn(ctx, req, competitionID, seasonID, version)
 	}
 	return service.SeasonAggregate{}, nil
 }
@@ -63,6 +69,20 @@ func (m *mockSeasonService) Delete(ctx context.Context, seasonID uuid.UUID) erro
 	return nil
 }
 
+func (m *mockSeasonService) SetTeamHomeVenue(ctx context.Context, seasonID, teamID uuid.UUID, venueID uuid.NullUUID) (service.SeasonAggregate, error) {
+	if m.SetTeamHomeVenueFn != nil {
+		return m.SetTeamHomeVenueFn(ctx, seasonID, teamID, venueID)
+	}
+	return service.SeasonAggregate{}, nil
+}
+
+func (m *mockSeasonService) GetCurrent(ctx context.Context, competitionID uuid.UUID) (service.CurrentSeason, error) {
+	if m.GetCurrentFn != nil {
+		return m.GetCurrentFn(ctx, competitionID)
+	}
+	return service.CurrentSeason{}, nil
+}
+
 var _ = Describe("season handlers", func() {
 	var (
 		router   *gin.Engine
@@ -84,11 +104,52 @@ var _ = Describe("season handlers", func() {
 
 		router.POST("/competitions/:competitionID/seasons", handleCreateSeason(logger, validate, mockSvc))
 		router.GET("/competitions/:competitionID/seasons", handleGetSeasons(logger, validate, mockSvc))
+		router.GET("/competitions/:competitionID/seasons/current", handleGetCurrentSeason(logger, mockSvc))
 		router.GET("/competitions/:competitionID/seasons/:seasonID", handleGetSeason(logger))
 		router.PUT("/competitions/:competitionID/seasons/:seasonID", handleUpdateSeason(logger, validate, mockSvc))
 		router.DELETE("/competitions/:competitionID/seasons/:seasonID", handleDeleteSeason(logger, mockSvc))
 	})
 
+	Describe("patch season", func() {
+		It("keeps the stages and teams the patch leaves out", func() {
+			stageID := uuid.New()
+			current := service.SeasonAggregate{
+				ID:            uuid.New(),
+				CompetitionID: uuid.New(),
+				StartDate:     time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:       time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+				Stages:        []db.Stage{{ID: stageID, Name: "Round 1", StageType: db.StageTypeRegular, OrderIndex: 1}},
+				Teams:         []db.Team{{ID: uuid.New()}, {ID: uuid.New()}},
+				UpdatedAt:     time.Now().UTC(),
+			}
+			router.PATCH("/competitions/:competitionID/seasons/:seasonID", func(c *gin.Context) {
+				c.Set("season", current)
+				handlePatchSeason(logger, validate, mockSvc)(c)
+			})
+
+			var gotReq *api.SeasonRequest
+			var gotVersion *time.Time
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.SeasonRequest, competitionID, sID uuid.UUID, version *time.Time) (service.SeasonAggregate, error) {
+				gotReq, gotVersion = req, version
+				return current, nil
+			}
+
+			url := "/competitions/" + current.CompetitionID.String() + "/seasons/" + current.ID.String()
+			req := httptest.NewRequest(http.MethodPatch, url, bytes.NewBufferString(`{"end_date":"2025-07-31T00:00:00Z"}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotReq.EndDate).To(Equal(time.Date(2025, time.July, 31, 0, 0, 0, 0, time.UTC)))
+			Expect(gotReq.StartDate).To(Equal(current.StartDate))
+			Expect(gotReq.Stages).To(HaveLen(1))
+			Expect(*gotReq.Stages[0].ID).To(Equal(stageID))
+			Expect(gotReq.Teams).To(Equal([]uuid.UUID{current.Teams[0].ID, current.Teams[1].ID}))
+			Expect(*gotVersion).To(Equal(current.UpdatedAt))
+		})
+	})
+
 	Describe("create season", func() {
 		It("returns 201 for valid request", func() {
 			mockSvc.CreateFn = func(ctx context.Context, req *api.SeasonRequest, competitionID uuid.UUID) (service.SeasonAggregate, error) {
@@ -183,11 +244,48 @@ var _ = Describe("season handlers", func() {
 		})
 	})
 
+	Describe("get current season", func() {
+		It("returns 200 with the season and how it was resolved", func() {
+			compID := uuid.New()
+			mockSvc.GetCurrentFn = func(ctx context.Context, competitionID uuid.UUID) (service.CurrentSeason, error) {
+				Expect(competitionID).To(Equal(compID))
+				return service.CurrentSeason{
+					Season:     service.SeasonAggregate{ID: uuid.New(), CompetitionID: competitionID, Name: "2026", Year: 2026},
+					Resolution: api.SeasonResolutionNext,
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/competitions/"+compID.String()+"/seasons/current", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp api.CurrentSeasonResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Resolution).To(Equal(api.SeasonResolutionNext))
+			Expect(resp.Season.Name).To(Equal("2026"))
+			Expect(resp.Season.Year).To(Equal(int32(2026)))
+		})
+
+		It("returns 404 when the competition has no seasons", func() {
+			mockSvc.GetCurrentFn = func(ctx context.Context, competitionID uuid.UUID) (service.CurrentSeason, error) {
+				return service.CurrentSeason{}, service.NewNotFoundError("season", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/competitions/"+uuid.NewString()+"/seasons/current", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	Describe("update season", func() {
 		It("returns 200 for valid update", func() {
 			compID := uuid.New()
 			seasonID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.SeasonRequest, competitionID, sID uuid.UUID) (service.SeasonAggregate, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.SeasonRequest, competitionID, sID uuid.UUID, version *time.Time) (service.SeasonAggregate, error) {
 				return service.SeasonAggregate{ID: sID}, nil
 			}
 
@@ -220,7 +318,7 @@ var _ = Describe("season handlers", func() {
 		It("returns 500 when service fails", func() {
 			compID := uuid.New()
 			seasonID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.SeasonRequest, competitionID, sID uuid.UUID) (service.SeasonAggregate, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.SeasonRequest, competitionID, sID uuid.UUID, version *time.Time) (service.SeasonAggregate, error) {
 				return service.SeasonAggregate{}, fmt.Errorf("db failure")
 			}
 
@@ -270,4 +368,75 @@ var _ = Describe("season handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusInternalServerError))
 		})
 	})
+
+	Describe("season team home venue", func() {
+		var current service.SeasonAggregate
+		var url string
+		teamID := uuid.New()
+		venueID := uuid.New()
+
+		BeforeEach(func() {
+			current = service.SeasonAggregate{ID: uuid.New(), CompetitionID: uuid.New(), UpdatedAt: time.Now().UTC()}
+			setSeason := func(c *gin.Context) { c.Set("season", current) }
+			route := "/competitions/:competitionID/seasons/:seasonID/teams/:teamID/home-venue"
+			router.PUT(route, setSeason, handleSetSeasonTeamHomeVenue(logger, validate, mockSvc))
+			router.DELETE(route, setSeason, handleDeleteSeasonTeamHomeVenue(logger, mockSvc))
+			url = "/competitions/" + current.CompetitionID.String() + "/seasons/" + current.ID.String() + "/teams/" + teamID.String() + "/home-venue"
+		})
+
+		It("returns 200 with the season after setting the venue", func() {
+			var gotVenue uuid.NullUUID
+			mockSvc.SetTeamHomeVenueFn = func(ctx context.Context, sID, tID uuid.UUID, vID uuid.NullUUID) (service.SeasonAggregate, error) {
+				Expect(sID).To(Equal(current.ID))
+				Expect(tID).To(Equal(teamID))
+				gotVenue = vID
+				season := current
+				season.HomeVenues = map[uuid.UUID]uuid.UUID{teamID: venueID}
+				return season, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url, bytes.NewBufferString(`{"venue_id":"`+venueID.String()+`"}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotVenue).To(Equal(uuid.NullUUID{UUID: venueID, Valid: true}))
+			Expect(w.Body.String()).To(ContainSubstring(`"home_venues":{"` + teamID.String() + `":"` + venueID.String() + `"}`))
+		})
+
+		It("returns 400 when the venue is missing", func() {
+			req := httptest.NewRequest(http.MethodPut, url, bytes.NewBufferString(`{}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 404 when the team is not in the season", func() {
+			mockSvc.SetTeamHomeVenueFn = func(ctx context.Context, sID, tID uuid.UUID, vID uuid.NullUUID) (service.SeasonAggregate, error) {
+				return service.SeasonAggregate{}, service.NewNotFoundError("team", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url, bytes.NewBufferString(`{"venue_id":"`+venueID.String()+`"}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("returns 204 after clearing the venue", func() {
+			var gotVenue *uuid.NullUUID
+			mockSvc.SetTeamHomeVenueFn = func(ctx context.Context, sID, tID uuid.UUID, vID uuid.NullUUID) (service.SeasonAggregate, error) {
+				gotVenue = &vID
+				return current, nil
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(*gotVenue).To(Equal(uuid.NullUUID{}))
+		})
+	})
 })
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleGetSquad retrieves the players a team has named for a season
+//
+//	@Summary	Get a team's squad for a season
+//	@ID			get-squad
+//	@Tags		Squads
+//	@Produce	json
+//	@Param		competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		teamID			path		string						true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Success	200				{array}		api.SquadPlayerResponse		"Squad found"
+//	@Failure	400				{object}	response.Problem			"Invalid team ID"
+//	@Failure	404				{object}	response.Problem			"Team not in season"
+//	@Failure	500				{object}	response.Problem			"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/squad [get]
+func handleGetSquad(logger zerolog.Logger, squadService service.SquadService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		squad, err := squadService.GetAll(ctx.Request.Context(), season.ID, teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get squad")
+			return
+		}
+
+		data := make([]api.SquadPlayerResponse, 0, len(squad))
+		for _, member := range squad {
+			data = append(data, service.ToSquadPlayerResponse(member))
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, data)
+	}
+}
+
+// handleSetSquadPlayer names a player in a team's squad for a season, or updates their
+// jersey number and active dates if they are already in it
+//
+//	@Summary	Add or update a squad player
+//	@ID			set-squad-player
+//	@Tags		Squads
+//	@Accept		json
+//	@Produce	json
+//	@Param		competitionID	path		string						true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string						true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		teamID			path		string						true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		playerID		path		string						true	"Player ID"			default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Param		squadPlayer		body		api.SquadPlayerRequest		true	"Jersey number and active dates"
+//	@Success	200				{object}	api.SquadPlayerResponse		"Squad player updated"
+//	@Success	201				{object}	api.SquadPlayerResponse		"Squad player added"
+//	@Failure	400				{object}	response.Problem			"Bad request"
+//	@Failure	404				{object}	response.Problem			"Team not in season or player not found"
+//	@Failure	409				{object}	response.Problem			"Jersey number already taken"
+//	@Failure	500				{object}	response.Problem			"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/squad/{playerID} [put]
+func handleSetSquadPlayer(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	squadService service.SquadService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid player ID")
+			return
+		}
+
+		req := &api.SquadPlayerRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		member, created, err := squadService.Set(ctx.Request.Context(), req, season.ID, teamID, playerID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to set squad player")
+			return
+		}
+
+		status := http.StatusOK
+		if created {
+			status = http.StatusCreated
+		}
+
+		response.RespondSuccess(ctx, logger, status, service.ToSquadPlayerResponse(member))
+	}
+}
+
+// handleRemoveSquadPlayer removes a player from a team's squad for a season
+//
+//	@Summary	Remove a squad player
+//	@ID			remove-squad-player
+//	@Tags		Squads
+//	@Produce	json
+//	@Param		competitionID	path			string	true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path			string	true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		teamID			path			string	true	"Team ID"			default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		playerID		path			string	true	"Player ID"			default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Success	204				"No Content"	"Squad player removed"
+//	@Failure	400				{object}		response.Problem	"Invalid team or player ID"
+//	@Failure	404				{object}		response.Problem	"Not in squad"
+//	@Failure	500				{object}		response.Problem	"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/teams/{teamID}/squad/{playerID} [delete]
+func handleRemoveSquadPlayer(logger zerolog.Logger, squadService service.SquadService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid player ID")
+			return
+		}
+
+		err = squadService.Remove(ctx.Request.Context(), season.ID, teamID, playerID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to remove squad player")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for SquadService
+type mockSquadService struct {
+	GetAllFn func(ctx context.Context, seasonID, teamID uuid.UUID) ([]service.SquadPlayer, error)
+	SetFn    func(ctx context.Context, req *api.SquadPlayerRequest, seasonID, teamID, playerID uuid.UUID) (service.SquadPlayer, bool, error)
+	RemoveFn func(ctx context.Context, seasonID, teamID, playerID uuid.UUID) error
+}
+
+func (m *mockSquadService) GetAll(ctx context.Context, seasonID, teamID uuid.UUID) ([]service.SquadPlayer, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, seasonID, teamID)
+	}
+	return nil, nil
+}
+
+func (m *mockSquadService) Set(ctx context.Context, req *api.SquadPlayerRequest, seasonID, teamID, playerID uuid.UUID) (service.SquadPlayer, bool, error) {
+	if m.SetFn != nil {
+		return m.SetFn(ctx, req, seasonID, teamID, playerID)
+	}
+	return service.SquadPlayer{}, false, nil
+}
+
+func (m *mockSquadService) Remove(ctx context.Context, seasonID, teamID, playerID uuid.UUID) error {
+	if m.RemoveFn != nil {
+		return m.RemoveFn(ctx, seasonID, teamID, playerID)
+	}
+	return nil
+}
+
+var _ = Describe("squad handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockSquadService
+		season   service.SeasonAggregate
+		teamID   uuid.UUID
+		playerID uuid.UUID
+		url      string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockSquadService{}
+		router = gin.New()
+
+		season = service.SeasonAggregate{ID: uuid.New(), CompetitionID: uuid.New()}
+		teamID = uuid.New()
+		playerID = uuid.New()
+
+		setSeason := func(c *gin.Context) { c.Set("season", season) }
+		route := "/competitions/:competitionID/seasons/:seasonID/teams/:teamID/squad"
+		router.GET(route, setSeason, handleGetSquad(logger, mockSvc))
+		router.PUT(route+"/:playerID", setSeason, handleSetSquadPlayer(logger, validate, mockSvc))
+		router.DELETE(route+"/:playerID", setSeason, handleRemoveSquadPlayer(logger, mockSvc))
+
+		url = "/competitions/" + season.CompetitionID.String() + "/seasons/" + season.ID.String() + "/teams/" + teamID.String() + "/squad"
+	})
+
+	Describe("get squad", func() {
+		It("returns 200 with each player", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, sID, tID uuid.UUID) ([]service.SquadPlayer, error) {
+				Expect(sID).To(Equal(season.ID))
+				Expect(tID).To(Equal(teamID))
+				return []service.SquadPlayer{{
+					Membership: db.SeasonTeamPlayer{ID: uuid.New(), PlayerID: playerID, JerseyNumber: 8},
+					Player:     db.Player{ID: playerID, Name: "Ardie Savea", Position: db.PlayerPositionNumberEight},
+				}}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp []api.SquadPlayerResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(HaveLen(1))
+			Expect(resp[0].JerseyNumber).To(Equal(int32(8)))
+			Expect(resp[0].Player.Name).To(Equal("Ardie Savea"))
+		})
+
+		It("returns 404 when the team is not in the season", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, sID, tID uuid.UUID) ([]service.SquadPlayer, error) {
+				return nil, service.NewNotFoundError("team", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("set squad player", func() {
+		It("returns 201 when the player is added", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.SquadPlayerRequest, sID, tID, pID uuid.UUID) (service.SquadPlayer, bool, error) {
+				Expect(pID).To(Equal(playerID))
+				return service.SquadPlayer{
+					Membership: db.SeasonTeamPlayer{ID: uuid.New(), PlayerID: pID, JerseyNumber: req.JerseyNumber},
+					Player:     db.Player{ID: pID},
+				}, true, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+playerID.String(), bytes.NewBufferString(`{"jersey_number":8}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+		})
+
+		It("returns 200 when the player is updated", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.SquadPlayerRequest, sID, tID, pID uuid.UUID) (service.SquadPlayer, bool, error) {
+				return service.SquadPlayer{Membership: db.SeasonTeamPlayer{JerseyNumber: req.JerseyNumber}}, false, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+playerID.String(), bytes.NewBufferString(`{"jersey_number":20}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("returns 400 when active_to is before active_from", func() {
+			reqBody := `{"jersey_number":8,"active_from":"2025-04-01T00:00:00Z","active_to":"2025-03-01T00:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPut, url+"/"+playerID.String(), bytes.NewBufferString(reqBody))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("active_to must not be before active_from"))
+		})
+
+		It("returns 409 when the jersey number is taken", func() {
+			mockSvc.SetFn = func(ctx context.Context, req *api.SquadPlayerRequest, sID, tID, pID uuid.UUID) (service.SquadPlayer, bool, error) {
+				return service.SquadPlayer{}, false, service.NewConflictError("jersey number 8 is already taken", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, url+"/"+playerID.String(), bytes.NewBufferString(`{"jersey_number":8}`))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("remove squad player", func() {
+		It("returns 204 when the player is removed", func() {
+			mockSvc.RemoveFn = func(ctx context.Context, sID, tID, pID uuid.UUID) error {
+				Expect(pID).To(Equal(playerID))
+				return nil
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, url+"/"+playerID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("returns 400 for invalid player UUID", func() {
+			req := httptest.NewRequest(http.MethodDelete, url+"/invalid", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleGetGameStats returns every player's stats for a finished game
+//
+//	@Summary		Get player stats for a game
+//	@Description	Lists everyone who took the field or scored, by team and top scorer first. Games that have not finished have no stats.
+//	@ID				get-game-stats
+//	@Tags			Stats
+//	@Produce		json
+//	@Param			competitionID	path		string							true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param			seasonID		path		string							true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param			gameID			path		string							true	"Game ID"			default(4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01)
+//	@Success		200				{array}		api.PlayerGameStatsResponse		"Game stats"
+//	@Failure		400				{object}	response.Problem				"Invalid game ID"
+//	@Failure		403				{object}	response.Problem				"Forbidden"
+//	@Failure		404				{object}	response.Problem				"Not found"
+//	@Failure		500				{object}	response.Problem				"Internal server error"
+//	@Router			/competitions/{competitionID}/seasons/{seasonID}/games/{gameID}/stats [get]
+func handleGetGameStats(logger zerolog.Logger, statsService service.StatsService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		gameID, err := uuid.Parse(ctx.Param("gameID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid game ID")
+			return
+		}
+
+		stats, err := statsService.GetGameStats(ctx.Request.Context(), gameID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game stats")
+			return
+		}
+
+		resp := make([]api.PlayerGameStatsResponse, 0, len(stats))
+		for _, s := range stats {
+			resp = append(resp, api.ToPlayerGameStatsResponse(s))
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, resp)
+	}
+}
+
+// handleGetPlayerCareer summarises a player's stats across every season and competition
+//
+//	@Summary		Get a player's career stats
+//	@Description	One row per season and team the player has played for, across every competition, with career totals. A player can be named in squads outside the team they are registered with.
+//	@ID				get-player-career
+//	@Tags			Stats
+//	@Produce		json
+//	@Param			teamID		path		string						true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param			playerID	path		string						true	"Player ID"	default(7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10)
+//	@Success		200			{object}	api.PlayerCareerResponse	"Career stats"
+//	@Failure		400			{object}	response.Problem			"Invalid team or player ID"
+//	@Failure		404			{object}	response.Problem			"Not found"
+//	@Failure		500			{object}	response.Problem			"Internal server error"
+//	@Router			/teams/{teamID}/players/{playerID}/stats [get]
+func handleGetPlayerCareer(logger zerolog.Logger, statsService service.StatsService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		playerID, err := uuid.Parse(ctx.Param("playerID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid player ID")
+			return
+		}
+
+		career, err := statsService.GetPlayerCareer(ctx.Request.Context(), teamID, playerID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get player stats")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToPlayerCareerResponse(career))
+	}
+}
+
+// handleGetSeasonLeaderboard ranks a season's players by tries or points
+//
+//	@Summary	Get a season's leaderboard
+//	@ID			get-season-leaderboard
+//	@Tags		Stats
+//	@Produce	json
+//	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		seasonID		path		string					true	"Season ID"			default(9300778f-cce0-4efe-af6c-e399d8170315)
+//	@Param		stat			query		string					false	"Stat to rank by"				Enums(tries, points)	default(points)
+//	@Param		limit			query		int						false	"Number of players to list"		default(10)	minimum(1)	maximum(100)
+//	@Success	200				{object}	api.LeaderboardResponse	"Leaderboard"
+//	@Failure	400				{object}	response.Problem		"Invalid query params"
+//	@Failure	403				{object}	response.Problem		"Forbidden"
+//	@Failure	404				{object}	response.Problem		"Not found"
+//	@Failure	500				{object}	response.Problem		"Internal server error"
+//	@Router		/competitions/{competitionID}/seasons/{seasonID}/leaderboard [get]
+func handleGetSeasonLeaderboard(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	statsService service.StatsService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		season := ctx.MustGet("season").(service.SeasonAggregate)
+
+		q, ok := bindLeaderboardRequest(ctx, logger, validate)
+		if !ok {
+			return
+		}
+
+		entries, err := statsService.GetSeasonLeaderboard(ctx.Request.Context(), season.ID, q.Stat, q.Limit)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get leaderboard")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToLeaderboardResponse(q.Stat, entries))
+	}
+}
+
+// handleGetCompetitionLeaderboard ranks a competition's players by tries or points
+// across all of its seasons
+//
+//	@Summary	Get a competition's all-time leaderboard
+//	@ID			get-competition-leaderboard
+//	@Tags		Stats
+//	@Produce	json
+//	@Param		competitionID	path		string					true	"Competition ID"	default(44dd315c-1abc-43aa-9843-642f920190d1)
+//	@Param		stat			query		string					false	"Stat to rank by"				Enums(tries, points)	default(points)
+//	@Param		limit			query		int						false	"Number of players to list"		default(10)	minimum(1)	maximum(100)
+//	@Success	200				{object}	api.LeaderboardResponse	"Leaderboard"
+//	@Failure	400				{object}	response.Problem		"Invalid query params"
+//	@Failure	404				{object}	response.Problem		"Not found"
+//	@Failure	500				{object}	response.Problem		"Internal server error"
+//	@Router		/competitions/{competitionID}/leaderboard [get]
+func handleGetCompetitionLeaderboard(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	statsService service.StatsService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		competitionID, err := uuid.Parse(ctx.Param("competitionID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid competition ID")
+			return
+		}
+
+		q, ok := bindLeaderboardRequest(ctx, logger, validate)
+		if !ok {
+			return
+		}
+
+		entries, err := statsService.GetCompetitionLeaderboard(ctx.Request.Context(), competitionID, q.Stat, q.Limit)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get leaderboard")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, service.ToLeaderboardResponse(q.Stat, entries))
+	}
+}
+
+// bindLeaderboardRequest reads and validates the leaderboard query parameters,
+// responding with a 400 if they are invalid.
+func bindLeaderboardRequest(ctx *gin.Context, logger zerolog.Logger, validate *validator.Validate) (api.LeaderboardRequest, bool) {
+	q := api.LeaderboardRequest{}
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+		return q, false
+	}
+
+	if err := validate.Struct(q); err != nil {
+		response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+		return q, false
+	}
+
+	q.SetDefaults()
+	return q, true
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for StatsService
+type mockStatsService struct {
+	GetGameStatsFn              func(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error)
+	GetPlayerCareerFn           func(ctx context.Context, teamID, playerID uuid.UUID) (service.PlayerCareer, error)
+	GetSeasonLeaderboardFn      func(ctx context.Context, seasonID uuid.UUID, stat string, limit int) ([]service.LeaderboardEntry, error)
+	GetCompetitionLeaderboardFn func(ctx context.Context, competitionID uuid.UUID, stat string, limit int) ([]service.LeaderboardEntry, error)
+}
+
+func (m *mockStatsService) GetGameStats(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error) {
+	if m.GetGameStatsFn != nil {
+		return m.GetGameStatsFn(ctx, gameID)
+	}
+	return nil, nil
+}
+
+func (m *mockStatsService) GetPlayerCareer(ctx context.Context, teamID, playerID uuid.UUID) (service.PlayerCareer, error) {
+	if m.GetPlayerCareerFn != nil {
+		return m.GetPlayerCareerFn(ctx, teamID, playerID)
+	}
+	return service.PlayerCareer{}, nil
+}
+
+func (m *mockStatsService) GetSeasonLeaderboard(ctx context.Context, seasonID uuid.UUID, stat string, limit int) ([]service.LeaderboardEntry, error) {
+	if m.GetSeasonLeaderboardFn != nil {
+		return m.GetSeasonLeaderboardFn(ctx, seasonID, stat, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockStatsService) GetCompetitionLeaderboard(ctx context.Context, competitionID uuid.UUID, stat string, limit int) ([]service.LeaderboardEntry, error) {
+	if m.GetCompetitionLeaderboardFn != nil {
+		return m.GetCompetitionLeaderboardFn(ctx, competitionID, stat, limit)
+	}
+	return nil, nil
+}
+
+var _ = Describe("stats handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockStatsService
+		season   service.SeasonAggregate
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockStatsService{}
+		router = gin.New()
+
+		season = service.SeasonAggregate{ID: uuid.New(), CompetitionID: uuid.New()}
+		withSeason := func(h gin.HandlerFunc) gin.HandlerFunc {
+			return func(c *gin.Context) {
+				c.Set("season", season)
+				h(c)
+			}
+		}
+
+		router.GET("/games/:gameID/stats", handleGetGameStats(logger, mockSvc))
+		router.GET("/teams/:teamID/players/:playerID/stats", handleGetPlayerCareer(logger, mockSvc))
+		router.GET("/seasons/:seasonID/leaderboard", withSeason(handleGetSeasonLeaderboard(logger, validate, mockSvc)))
+		router.GET("/competitions/:competitionID/leaderboard", handleGetCompetitionLeaderboard(logger, validate, mockSvc))
+	})
+
+	get := func(url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("game stats", func() {
+		It("returns 200 with each player's stats", func() {
+			mockSvc.GetGameStatsFn = func(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error) {
+				return []db.PlayerGameStat{{GameID: gameID, PlayerID: uuid.New(), Appeared: true, Minutes: 80, Tries: 2, Points: 10}}, nil
+			}
+
+			w := get("/games/" + uuid.NewString() + "/stats")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp []api.PlayerGameStatsResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(HaveLen(1))
+			Expect(resp[0].Appearances).To(Equal(int32(1)))
+			Expect(resp[0].Points).To(Equal(int32(10)))
+		})
+	})
+
+	Describe("player career", func() {
+		It("returns 404 for an unknown player", func() {
+			mockSvc.GetPlayerCareerFn = func(ctx context.Context, teamID, playerID uuid.UUID) (service.PlayerCareer, error) {
+				return service.PlayerCareer{}, service.NewNotFoundError("player", nil)
+			}
+
+			w := get("/teams/" + uuid.NewString() + "/players/" + uuid.NewString() + "/stats")
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("season leaderboard", func() {
+		It("ranks by points and lists 10 players by default", func() {
+			mockSvc.GetSeasonLeaderboardFn = func(ctx context.Context, seasonID uuid.UUID, stat string, limit int) ([]service.LeaderboardEntry, error) {
+				Expect(seasonID).To(Equal(season.ID))
+				Expect(stat).To(Equal(api.LeaderboardStatPoints))
+				Expect(limit).To(Equal(api.DefaultLeaderboardLength))
+				return []service.LeaderboardEntry{{PlayerID: uuid.New(), PlayerName: "Damian McKenzie", Points: 120}}, nil
+			}
+
+			w := get("/seasons/" + season.ID.String() + "/leaderboard")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp api.LeaderboardResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Stat).To(Equal(api.LeaderboardStatPoints))
+			Expect(resp.Entries[0].Rank).To(Equal(1))
+		})
+
+		It("returns 400 for an unknown stat", func() {
+			w := get("/seasons/" + season.ID.String() + "/leaderboard?stat=tackles")
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("competition leaderboard", func() {
+		It("passes the requested stat and limit", func() {
+			mockSvc.GetCompetitionLeaderboardFn = func(ctx context.Context, competitionID uuid.UUID, stat string, limit int) ([]service.LeaderboardEntry, error) {
+				Expect(stat).To(Equal(api.LeaderboardStatTries))
+				Expect(limit).To(Equal(5))
+				return nil, nil
+			}
+
+			w := get("/competitions/" + uuid.NewString() + "/leaderboard?stat=tries&limit=5")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(ContainSubstring(`"entries":[]`))
+		})
+	})
+})
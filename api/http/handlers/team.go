@@ -3,6 +3,7 @@ package handlers
 import (
 	"math"
 	"net/http"
+	"strings"
 
 	"github.com/bradley-adams/gainline/http/api"
 	"github.com/bradley-adams/gainline/http/response"
@@ -22,8 +23,8 @@ import (
 //	@Produce	json
 //	@Param		team	body		api.TeamRequest			true	"Team details to create"
 //	@Success	201		{object}	api.TeamResponse		"Successful operation"
-//	@Failure	400		{object}	response.ErrorResponse	"Bad request"
-//	@Failure	500		{object}	response.ErrorResponse	"Internal server error"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
 //	@Router		/teams [post]
 func handleCreateTeam(
 	logger zerolog.Logger,
@@ -61,10 +62,12 @@ func handleCreateTeam(
 //	@ID			get-teams
 //	@Tags		Teams
 //	@Produce	json
-//	@Param		page		query		int	false	"Page number"		default(1)
-//	@Param		page_size	query		int	false	"Items per page"	default(20)
+//	@Param		page		query		int		false	"Page number"		default(1)
+//	@Param		page_size	query		int		false	"Items per page"	default(20)
+//	@Param		search		query		string	false	"Only teams whose name, abbreviation or any alias contains this text"
 //	@Success	200			{object}	api.PaginatedResponse[api.TeamResponse]
-//	@Failure	500			{object}	response.ErrorResponse
+//	@Failure	400			{object}	response.Problem
+//	@Failure	500			{object}	response.Problem
 //	@Router		/teams [get]
 func handleGetTeams(
 	logger zerolog.Logger,
@@ -72,7 +75,7 @@ func handleGetTeams(
 	teamService service.TeamService,
 ) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		q := api.PaginationRequest{}
+		q := api.TeamListRequest{}
 
 		if err := ctx.ShouldBindQuery(&q); err != nil {
 			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
@@ -80,7 +83,7 @@ func handleGetTeams(
 		}
 
 		if err := validate.Struct(q); err != nil {
-			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid pagination params")
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
 			return
 		}
 
@@ -88,6 +91,7 @@ func handleGetTeams(
 
 		teams, total, err := teamService.GetAll(
 			ctx.Request.Context(),
+			service.TeamFilter{Search: q.Search},
 			q.PageSize,
 			q.Offset(),
 		)
@@ -123,8 +127,10 @@ func handleGetTeams(
 //	@Produce	json
 //	@Param		teamID	path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
 //	@Success	200		{object}	api.TeamResponse		"Team found"
-//	@Failure	400		{object}	response.ErrorResponse	"Invalid team ID"
-//	@Failure	500		{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200		{string}	ETag					"Version of the team, for If-Match"
+//	@Failure	400		{object}	response.Problem	"Invalid team ID"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
 //	@Router		/teams/{teamID} [get]
 func handleGetTeam(logger zerolog.Logger, teamService service.TeamService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -140,6 +146,7 @@ func handleGetTeam(logger zerolog.Logger, teamService service.TeamService) gin.H
 			return
 		}
 
+		setETag(ctx, team.UpdatedAt)
 		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToTeamResponse(team))
 	}
 }
@@ -153,9 +160,13 @@ func handleGetTeam(logger zerolog.Logger, teamService service.TeamService) gin.H
 //	@Produce	json
 //	@Param		teamID	path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
 //	@Param		team	body		api.TeamRequest			true	"Team details to update"
+//	@Param		If-Match	header		string					false	"ETag of the team being replaced"
 //	@Success	200		{object}	api.TeamResponse		"Team updated"
-//	@Failure	400		{object}	response.ErrorResponse	"Bad request"
-//	@Failure	500		{object}	response.ErrorResponse	"Internal server error"
+//	@Header		200		{string}	ETag					"Version of the updated team"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	412		{object}	response.Problem	"Team changed since it was read"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
 //	@Router		/teams/{teamID} [put]
 func handleUpdateTeam(
 	logger zerolog.Logger,
@@ -183,12 +194,89 @@ func handleUpdateTeam(
 			return
 		}
 
-		team, err := teamService.Update(ctx.Request.Context(), req, teamID)
+		version, err := ifMatch(ctx, "team")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		team, err := teamService.Update(ctx.Request.Context(), req, teamID, version)
 		if err != nil {
 			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update team")
 			return
 		}
 
+		setETag(ctx, team.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToTeamResponse(team))
+	}
+}
+
+// handlePatchTeam applies a JSON merge patch to a team
+//
+//	@Summary		Partially update a team
+//	@Description	Applies an RFC 7386 JSON merge patch. The write fails with 412 if the team changes after it is read, or if If-Match names an older version.
+//	@ID				patch-team
+//	@Tags			Teams
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			teamID		path		string				true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param			team		body		api.TeamRequest		true	"Fields to change"
+//	@Param			If-Match	header		string				false	"ETag of the team being patched"
+//	@Success		200			{object}	api.TeamResponse	"Team updated"
+//	@Header			200			{string}	ETag				"Version of the updated team"
+//	@Failure		400			{object}	response.Problem	"Bad request"
+//	@Failure		404			{object}	response.Problem	"Not found"
+//	@Failure		412			{object}	response.Problem	"Team changed since it was read"
+//	@Failure		415			{object}	response.Problem	"Unsupported media type"
+//	@Failure		500			{object}	response.Problem	"Internal server error"
+//	@Router			/teams/{teamID} [patch]
+func handlePatchTeam(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	teamService service.TeamService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		current, err := teamService.Get(ctx.Request.Context(), teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get team")
+			return
+		}
+
+		version, err := patchVersion(ctx, "team", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := api.ToTeamRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		team, err := teamService.Update(ctx.Request.Context(), &req, teamID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update team")
+			return
+		}
+
+		setETag(ctx, team.UpdatedAt)
 		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToTeamResponse(team))
 	}
 }
@@ -201,8 +289,9 @@ func handleUpdateTeam(
 //	@Produce	json
 //	@Param		teamID	path			string	true	"Team ID"	default(2c6f1e7b-1d3e-4e0a-9c4b-3e5e0b9f0001)
 //	@Success	204		"No Content"	"Team deleted successfully"
-//	@Failure	400		{object}		response.ErrorResponse	"Invalid team ID"
-//	@Failure	500		{object}		response.ErrorResponse	"Internal server error"
+//	@Failure	400		{object}		response.Problem	"Invalid team ID"
+//	@Failure	404		{object}		response.Problem	"Not found"
+//	@Failure	500		{object}		response.Problem	"Internal server error"
 //	@Router		/teams/{teamID} [delete]
 func handleDeleteTeam(logger zerolog.Logger, teamService service.TeamService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -221,3 +310,247 @@ func handleDeleteTeam(logger zerolog.Logger, teamService service.TeamService) gi
 		ctx.Status(http.StatusNoContent)
 	}
 }
+
+// handleGetTeamGames retrieves a page of a team's games across every season
+//
+//	@Summary	Get games for a team
+//	@ID			get-team-games
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		teamID		path		string										true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		page		query		int											false	"Page number"		default(1)
+//	@Param		page_size	query		int											false	"Page size"			default(20)
+//	@Param		season_id	query		string										false	"Only games in this season"
+//	@Param		status		query		string										false	"Only games with this status"	Enums(scheduled, playing, finished, cancelled)
+//	@Param		sort		query		string										false	"Sort order by date"	Enums(date, -date)	default(date)
+//	@Param		expand		query		string										false	"Comma-separated resources to embed: teams, stage"
+//	@Success	200			{object}	api.PaginatedResponse[api.GameResponse]	"Paginated games"
+//	@Failure	400			{object}	response.Problem							"Invalid request"
+//	@Failure	404			{object}	response.Problem							"Not found"
+//	@Failure	500			{object}	response.Problem							"Internal server error"
+//	@Router		/teams/{teamID}/games [get]
+func handleGetTeamGames(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	teamService service.TeamService,
+	gameService service.GameService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		q := api.TeamGameListRequest{}
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		q.SetDefaults()
+
+		expand, err := bindGameExpand(ctx, validate)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		filter := service.TeamGameFilter{
+			SeasonID:   parseNullUUID(q.SeasonID),
+			Status:     q.Status,
+			Descending: q.Sort == api.GameSortDateDesc,
+		}
+
+		games, total, err := teamService.GetGames(ctx.Request.Context(), teamID, filter, q.PageSize, q.Offset())
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get team games")
+			return
+		}
+
+		data, err := toGameResponses(ctx, gameService, games, expand)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get game details")
+			return
+		}
+
+		totalPages := int(math.Ceil(float64(total) / float64(q.PageSize)))
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.PaginatedResponse[api.GameResponse]{
+			Data: data,
+			Pagination: api.PaginationMeta{
+				Page:       q.Page,
+				PageSize:   q.PageSize,
+				Total:      total,
+				TotalPages: totalPages,
+			},
+		})
+	}
+}
+
+// handleGetTeamForm summarises a team's recent results
+//
+//	@Summary	Get a team's form
+//	@ID			get-team-form
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"	default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		season_id	query		string					false	"Only games in this season"
+//	@Param		last		query		int						false	"Number of recent results to list"	default(5)	minimum(1)	maximum(20)
+//	@Success	200			{object}	api.TeamFormResponse	"Team form"
+//	@Failure	400			{object}	response.Problem		"Invalid request"
+//	@Failure	404			{object}	response.Problem		"Not found"
+//	@Failure	500			{object}	response.Problem		"Internal server error"
+//	@Router		/teams/{teamID}/form [get]
+func handleGetTeamForm(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	teamService service.TeamService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		q := api.TeamFormRequest{}
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid query params")
+			return
+		}
+
+		q.SetDefaults()
+
+		form, err := teamService.GetForm(ctx.Request.Context(), teamID, parseNullUUID(q.SeasonID), q.Last)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get team form")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, toTeamFormResponse(form))
+	}
+}
+
+// handleGetHeadToHead summarises every finished meeting between two teams
+//
+//	@Summary	Get the head-to-head record between two teams
+//	@ID			get-team-head-to-head
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		teamID		path		string					true	"Team ID"		default(013952a5-87e1-4d26-a312-09b2aff54241)
+//	@Param		opponentID	path		string					true	"Opponent ID"	default(7b6cdb33-3bc6-4b0c-bac2-82d2a6bc6a97)
+//	@Success	200			{object}	api.HeadToHeadResponse	"Head-to-head record"
+//	@Failure	400			{object}	response.Problem		"Invalid team ID"
+//	@Failure	404			{object}	response.Problem		"Not found"
+//	@Failure	500			{object}	response.Problem		"Internal server error"
+//	@Router		/teams/{teamID}/head-to-head/{opponentID} [get]
+func handleGetHeadToHead(logger zerolog.Logger, teamService service.TeamService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		opponentID, err := uuid.Parse(ctx.Param("opponentID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid opponent ID")
+			return
+		}
+
+		h2h, err := teamService.GetHeadToHead(ctx.Request.Context(), teamID, opponentID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get head-to-head")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, toHeadToHeadResponse(h2h))
+	}
+}
+
+func toTeamFormResponse(form service.TeamForm) api.TeamFormResponse {
+	resp := api.TeamFormResponse{
+		TeamID: form.TeamID,
+		Recent: make([]api.TeamResultResponse, 0, len(form.Recent)),
+		Streak: api.TeamStreakResponse{
+			Result: form.StreakResult,
+			Count:  form.StreakCount,
+		},
+		Overall: toTeamRecordResponse(form.Overall),
+		Home:    toTeamRecordResponse(form.Home),
+		Away:    toTeamRecordResponse(form.Away),
+	}
+
+	var sb strings.Builder
+	for _, r := range form.Recent {
+		sb.WriteString(string(r.Result))
+		resp.Recent = append(resp.Recent, toTeamResultResponse(r))
+	}
+	resp.Form = sb.String()
+
+	return resp
+}
+
+func toTeamResultResponse(r service.TeamResult) api.TeamResultResponse {
+	return api.TeamResultResponse{
+		GameID:        r.Game.ID,
+		SeasonID:      r.Game.SeasonID,
+		Date:          r.Game.Date,
+		OpponentID:    r.OpponentID,
+		Home:          r.Home,
+		PointsFor:     r.PointsFor,
+		PointsAgainst: r.PointsAgainst,
+		Result:        r.Result,
+	}
+}
+
+func toOptionalTeamResultResponse(r *service.TeamResult) *api.TeamResultResponse {
+	if r == nil {
+		return nil
+	}
+	resp := toTeamResultResponse(*r)
+	return &resp
+}
+
+func toHeadToHeadResponse(h2h service.HeadToHead) api.HeadToHeadResponse {
+	resp := api.HeadToHeadResponse{
+		Team:        api.ToTeamSummary(h2h.Team),
+		Opponent:    api.ToTeamSummary(h2h.Opponent),
+		Record:      toTeamRecordResponse(h2h.Record),
+		BiggestWin:  toOptionalTeamResultResponse(h2h.BiggestWin),
+		BiggestLoss: toOptionalTeamResultResponse(h2h.BiggestLoss),
+		Meetings:    make([]api.TeamResultResponse, 0, len(h2h.Meetings)),
+	}
+
+	for _, m := range h2h.Meetings {
+		resp.Meetings = append(resp.Meetings, toTeamResultResponse(m))
+	}
+	if len(resp.Meetings) > 0 {
+		resp.LastMeeting = &resp.Meetings[0]
+	}
+
+	return resp
+}
+
+func toTeamRecordResponse(r service.TeamRecord) api.TeamRecordResponse {
+	return api.TeamRecordResponse{
+		Played:           r.Played,
+		Won:              r.Won,
+		Drawn:            r.Drawn,
+		Lost:             r.Lost,
+		PointsFor:        r.PointsFor,
+		PointsAgainst:    r.PointsAgainst,
+		PointsDifference: r.PointsFor - r.PointsAgainst,
+	}
+}
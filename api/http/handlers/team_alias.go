@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleGetTeamAliases retrieves the names a team has played under
+//
+//	@Summary	Get a team's aliases
+//	@ID			get-team-aliases
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		teamID	path		string					true	"Team ID"	default(b5c6e9d7-8f11-4ef2-acc6-2e5a97839532)
+//	@Success	200		{array}		api.TeamAliasResponse	"Aliases oldest first"
+//	@Failure	400		{object}	response.Problem		"Invalid team ID"
+//	@Failure	404		{object}	response.Problem		"Team not found"
+//	@Failure	500		{object}	response.Problem		"Internal server error"
+//	@Router		/teams/{teamID}/aliases [get]
+func handleGetTeamAliases(logger zerolog.Logger, aliasService service.TeamAliasService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		aliases, err := aliasService.GetAll(ctx.Request.Context(), teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get team aliases")
+			return
+		}
+
+		data := make([]api.TeamAliasResponse, 0, len(aliases))
+		for _, alias := range aliases {
+			data = append(data, api.ToTeamAliasResponse(alias))
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, data)
+	}
+}
+
+// handleCreateTeamAlias records a name a team played under
+//
+//	@Summary	Add an alias to a team
+//	@ID			create-team-alias
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		teamID	path		string					true	"Team ID"	default(b5c6e9d7-8f11-4ef2-acc6-2e5a97839532)
+//	@Param		alias	body		api.TeamAliasRequest	true	"Alias details to create"
+//	@Success	201		{object}	api.TeamAliasResponse	"Successful operation"
+//	@Failure	400		{object}	response.Problem		"Bad request"
+//	@Failure	404		{object}	response.Problem		"Team not found"
+//	@Failure	409		{object}	response.Problem		"Dates overlap another alias"
+//	@Failure	500		{object}	response.Problem		"Internal server error"
+//	@Router		/teams/{teamID}/aliases [post]
+func handleCreateTeamAlias(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	aliasService service.TeamAliasService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		req := &api.TeamAliasRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		alias, err := aliasService.Create(ctx.Request.Context(), req, teamID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to add team alias")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToTeamAliasResponse(alias))
+	}
+}
+
+// handleUpdateTeamAlias updates a team alias
+//
+//	@Summary	Update a team alias
+//	@ID			update-team-alias
+//	@Tags		Teams
+//	@Accept		json
+//	@Produce	json
+//	@Param		teamID	path		string					true	"Team ID"	default(b5c6e9d7-8f11-4ef2-acc6-2e5a97839532)
+//	@Param		aliasID	path		string					true	"Alias ID"
+//	@Param		alias	body		api.TeamAliasRequest	true	"Alias details to update"
+//	@Success	200		{object}	api.TeamAliasResponse	"Alias updated"
+//	@Failure	400		{object}	response.Problem		"Bad request"
+//	@Failure	404		{object}	response.Problem		"Not found"
+//	@Failure	409		{object}	response.Problem		"Dates overlap another alias"
+//	@Failure	500		{object}	response.Problem		"Internal server error"
+//	@Router		/teams/{teamID}/aliases/{aliasID} [put]
+func handleUpdateTeamAlias(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	aliasService service.TeamAliasService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		aliasID, err := uuid.Parse(ctx.Param("aliasID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid alias ID")
+			return
+		}
+
+		req := &api.TeamAliasRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		alias, err := aliasService.Update(ctx.Request.Context(), req, teamID, aliasID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update team alias")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToTeamAliasResponse(alias))
+	}
+}
+
+// handleDeleteTeamAlias removes a team alias
+//
+//	@Summary	Delete a team alias
+//	@ID			delete-team-alias
+//	@Tags		Teams
+//	@Produce	json
+//	@Param		teamID	path			string	true	"Team ID"	default(b5c6e9d7-8f11-4ef2-acc6-2e5a97839532)
+//	@Param		aliasID	path			string	true	"Alias ID"
+//	@Success	204		"No Content"	"Alias deleted"
+//	@Failure	400		{object}		response.Problem	"Invalid team or alias ID"
+//	@Failure	404		{object}		response.Problem	"Not found"
+//	@Failure	500		{object}		response.Problem	"Internal server error"
+//	@Router		/teams/{teamID}/aliases/{aliasID} [delete]
+func handleDeleteTeamAlias(logger zerolog.Logger, aliasService service.TeamAliasService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		teamID, err := uuid.Parse(ctx.Param("teamID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid team ID")
+			return
+		}
+
+		aliasID, err := uuid.Parse(ctx.Param("aliasID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid alias ID")
+			return
+		}
+
+		if err := aliasService.Delete(ctx.Request.Context(), teamID, aliasID); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to delete team alias")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for TeamAliasService
+type mockTeamAliasService struct {
+	GetAllFn func(ctx context.Context, teamID uuid.UUID) ([]db.TeamAlias, error)
+	CreateFn func(ctx context.Context, req *api.TeamAliasRequest, teamID uuid.UUID) (db.TeamAlias, error)
+	UpdateFn func(ctx context.Context, req *api.TeamAliasRequest, teamID, aliasID uuid.UUID) (db.TeamAlias, error)
+	DeleteFn func(ctx context.Context, teamID, aliasID uuid.UUID) error
+}
+
+func (m *mockTeamAliasService) GetAll(ctx context.Context, teamID uuid.UUID) ([]db.TeamAlias, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, teamID)
+	}
+	return nil, nil
+}
+
+func (m *mockTeamAliasService) Create(ctx context.Context, req *api.TeamAliasRequest, teamID uuid.UUID) (db.TeamAlias, error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(ctx, req, teamID)
+	}
+	return db.TeamAlias{}, nil
+}
+
+func (m *mockTeamAliasService) Update(ctx context.Context, req *api.TeamAliasRequest, teamID, aliasID uuid.UUID) (db.TeamAlias, error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(ctx, req, teamID, aliasID)
+	}
+	return db.TeamAlias{}, nil
+}
+
+func (m *mockTeamAliasService) Delete(ctx context.Context, teamID, aliasID uuid.UUID) error {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(ctx, teamID, aliasID)
+	}
+	return nil
+}
+
+var _ = Describe("team alias handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockTeamAliasService
+	)
+
+	teamID := uuid.MustParse("b5c6e9d7-8f11-4ef2-acc6-2e5a97839532")
+	aliasID := uuid.MustParse("2c8f1e4a-7b3d-4a6e-9f5c-1d2e3f4a5b6c")
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockTeamAliasService{}
+		router = gin.New()
+
+		router.GET("/teams/:teamID/aliases", handleGetTeamAliases(logger, mockSvc))
+		router.POST("/teams/:teamID/aliases", handleCreateTeamAlias(logger, validate, mockSvc))
+		router.PUT("/teams/:teamID/aliases/:aliasID", handleUpdateTeamAlias(logger, validate, mockSvc))
+		router.DELETE("/teams/:teamID/aliases/:aliasID", handleDeleteTeamAlias(logger, mockSvc))
+	})
+
+	Describe("get team aliases", func() {
+		It("returns 200 with the aliases", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, id uuid.UUID) ([]db.TeamAlias, error) {
+				Expect(id).To(Equal(teamID))
+				return []db.TeamAlias{{ID: aliasID, TeamID: teamID, Name: "Bay of Plenty Steamers"}}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+teamID.String()+"/aliases", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp []api.TeamAliasResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(HaveLen(1))
+			Expect(resp[0].Name).To(Equal("Bay of Plenty Steamers"))
+		})
+
+		It("returns 404 when the team does not exist", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, id uuid.UUID) ([]db.TeamAlias, error) {
+				return nil, service.NewNotFoundError("team", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+teamID.String()+"/aliases", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("create team alias", func() {
+		It("returns 201 for valid request", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.TeamAliasRequest, id uuid.UUID) (db.TeamAlias, error) {
+				Expect(id).To(Equal(teamID))
+				return db.TeamAlias{ID: aliasID, TeamID: id, Name: req.Name, ValidFrom: req.ValidFrom}, nil
+			}
+
+			reqBody := `{"name":"Bay of Plenty Steamers","abbreviation":"BOP","valid_from":"2010-01-01T00:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/teams/"+teamID.String()+"/aliases", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+		})
+
+		It("returns 400 when valid_to is before valid_from", func() {
+			reqBody := `{"name":"Bay of Plenty Steamers","abbreviation":"BOP","valid_from":"2010-01-01T00:00:00Z","valid_to":"2009-01-01T00:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/teams/"+teamID.String()+"/aliases", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 409 when the dates overlap another alias", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.TeamAliasRequest, id uuid.UUID) (db.TeamAlias, error) {
+				return db.TeamAlias{}, service.NewConflictError("dates overlap the team alias Steamers", nil)
+			}
+
+			reqBody := `{"name":"Bay of Plenty Steamers","abbreviation":"BOP","valid_from":"2010-01-01T00:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/teams/"+teamID.String()+"/aliases", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("update team alias", func() {
+		It("returns 400 for an invalid alias ID", func() {
+			reqBody := `{"name":"Bay of Plenty Steamers","abbreviation":"BOP","valid_from":"2010-01-01T00:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPut, "/teams/"+teamID.String()+"/aliases/not-a-uuid", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("delete team alias", func() {
+		It("returns 204 on success", func() {
+			mockSvc.DeleteFn = func(ctx context.Context, tID, aID uuid.UUID) error {
+				Expect(tID).To(Equal(teamID))
+				Expect(aID).To(Equal(aliasID))
+				return nil
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/teams/"+teamID.String()+"/aliases/"+aliasID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+})
@@ -3,13 +3,17 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
 	"github.com/bradley-adams/gainline/http/api"
 	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -21,11 +25,14 @@ import (
 
 // Manual mock for TeamService
 type mockTeamService struct {
-	CreateFn func(ctx context.Context, req *api.TeamRequest) (db.Team, error)
-	GetAllFn func(ctx context.Context, limit, offset int) ([]db.Team, int64, error)
-	GetFn    func(ctx context.Context, teamID uuid.UUID) (db.Team, error)
-	UpdateFn func(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID) (db.Team, error)
-	DeleteFn func(ctx context.Context, teamID uuid.UUID) error
+	CreateFn        func(ctx context.Context, req *api.TeamRequest) (db.Team, error)
+	GetAllFn        func(ctx context.Context, filter service.TeamFilter, limit, offset int) ([]db.Team, int64, error)
+	GetFn           func(ctx context.Context, teamID uuid.UUID) (db.Team, error)
+	UpdateFn        func(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID, version *time.Time) (db.Team, error)
+	DeleteFn        func(ctx context.Context, teamID uuid.UUID) error
+	GetGamesFn      func(ctx context.Context, teamID uuid.UUID, filter service.TeamGameFilter, limit, offset int) ([]db.Game, int64, error)
+	GetFormFn       func(ctx context.Context, teamID uuid.UUID, seasonID uuid.NullUUID, last int) (service.TeamForm, error)
+	GetHeadToHeadFn func(ctx context.Context, teamID, opponentID uuid.UUID) (service.HeadToHead, error)
 }
 
 func (m *mockTeamService) Create(ctx context.Context, req *api.TeamRequest) (db.Team, error) {
@@ -35,9 +42,9 @@ func (m *mockTeamService) Create(ctx context.Context, req *api.TeamRequest) (db.
 	return db.Team{}, nil
 }
 
-func (m *mockTeamService) GetAll(ctx context.Context, limit, offset int) ([]db.Team, int64, error) {
+func (m *mockTeamService) GetAll(ctx context.Context, filter service.TeamFilter, limit, offset int) ([]db.Team, int64, error) {
 	if m.GetAllFn != nil {
-		return m.GetAllFn(ctx, limit, offset)
+		return m.GetAllFn(ctx, filter, limit, offset)
 	}
 	return nil, 0, nil
 }
@@ -49,9 +56,9 @@ func (m *mockTeamService) Get(ctx context.Context, teamID uuid.UUID) (db.Team, e
 	return db.Team{}, nil
 }
 
-func (m *mockTeamService) Update(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID) (db.Team, error) {
+func (m *mockTeamService) Update(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID, version *time.Time) (db.Team, error) {
 	if m.UpdateFn != nil {
-		return m.UpdateFn(ctx, req, teamID)
+		return m.UpdateFn(ctx, req, teamID, version)
 	}
 	return db.Team{}, nil
 }
@@ -63,6 +70,27 @@ func (m *mockTeamService) Delete(ctx context.Context, teamID uuid.UUID) error {
 	return nil
 }
 
+func (m *mockTeamService) GetGames(ctx context.Context, teamID uuid.UUID, filter service.TeamGameFilter, limit, offset int) ([]db.Game, int64, error) {
+	if m.GetGamesFn != nil {
+		return m.GetGamesFn(ctx, teamID, filter, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockTeamService) GetForm(ctx context.Context, teamID uuid.UUID, seasonID uuid.NullUUID, last int) (service.TeamForm, error) {
+	if m.GetFormFn != nil {
+		return m.GetFormFn(ctx, teamID, seasonID, last)
+	}
+	return service.TeamForm{}, nil
+}
+
+func (m *mockTeamService) GetHeadToHead(ctx context.Context, teamID, opponentID uuid.UUID) (service.HeadToHead, error) {
+	if m.GetHeadToHeadFn != nil {
+		return m.GetHeadToHeadFn(ctx, teamID, opponentID)
+	}
+	return service.HeadToHead{}, nil
+}
+
 var _ = Describe("team handlers", func() {
 	var (
 		router   *gin.Engine
@@ -76,6 +104,7 @@ var _ = Describe("team handlers", func() {
 
 		validate = validator.New()
 		validation.Register(validate)
+		api.Register(validate)
 		logger = zerolog.Nop()
 
 		mockSvc = &mockTeamService{}
@@ -85,7 +114,11 @@ var _ = Describe("team handlers", func() {
 		router.GET("/teams", handleGetTeams(logger, validate, mockSvc))
 		router.GET("/teams/:teamID", handleGetTeam(logger, mockSvc))
 		router.PUT("/teams/:teamID", handleUpdateTeam(logger, validate, mockSvc))
+		router.PATCH("/teams/:teamID", handlePatchTeam(logger, validate, mockSvc))
 		router.DELETE("/teams/:teamID", handleDeleteTeam(logger, mockSvc))
+		router.GET("/teams/:teamID/games", handleGetTeamGames(logger, validate, mockSvc, &mockGameService{}))
+		router.GET("/teams/:teamID/form", handleGetTeamForm(logger, validate, mockSvc))
+		router.GET("/teams/:teamID/head-to-head/:opponentID", handleGetHeadToHead(logger, mockSvc))
 	})
 
 	Describe("create team", func() {
@@ -109,6 +142,44 @@ var _ = Describe("team handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusCreated))
 		})
 
+		It("returns the branding and profile it saved", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.TeamRequest) (db.Team, error) {
+				Expect(req.SocialHandles).To(Equal(map[api.SocialPlatform]string{api.SocialPlatformInstagram: "chiefsrugby"}))
+				return db.Team{
+					ID:            uuid.New(),
+					Name:          req.Name,
+					Abbreviation:  req.Abbreviation,
+					PrimaryColour: sql.NullString{String: req.PrimaryColour, Valid: true},
+					SocialHandles: json.RawMessage(`{"instagram":"chiefsrugby"}`),
+					ExternalRefs:  json.RawMessage(`{}`),
+				}, nil
+			}
+
+			reqBody := `{"name":"Chiefs","abbreviation":"CHI","primary_colour":"#FFD700","social_handles":{"instagram":"chiefsrugby"}}`
+			req := httptest.NewRequest(http.MethodPost, "/teams", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.TeamResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.PrimaryColour).To(Equal("#FFD700"))
+			Expect(resp.SocialHandles).To(HaveKeyWithValue(api.SocialPlatformInstagram, "chiefsrugby"))
+			Expect(resp.ExternalRefs).To(BeEmpty())
+		})
+
+		It("returns 400 for an invalid colour", func() {
+			reqBody := `{"name":"Chiefs","abbreviation":"CHI","secondary_colour":"black"}`
+			req := httptest.NewRequest(http.MethodPost, "/teams", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
 		It("returns 400 for invalid JSON", func() {
 			req := httptest.NewRequest(http.MethodPost, "/teams", bytes.NewBufferString(`{"name":`))
 			req.Header.Set("Content-Type", "application/json")
@@ -144,7 +215,7 @@ var _ = Describe("team handlers", func() {
 		It("returns 200 and teams", func() {
 			id := uuid.New()
 
-			mockSvc.GetAllFn = func(ctx context.Context, limit, offset int) ([]db.Team, int64, error) {
+			mockSvc.GetAllFn = func(ctx context.Context, filter service.TeamFilter, limit, offset int) ([]db.Team, int64, error) {
 				Expect(limit).To(Equal(10))
 				Expect(offset).To(Equal(0))
 
@@ -160,8 +231,21 @@ var _ = Describe("team handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusOK))
 		})
 
+		It("passes the search to the service", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, filter service.TeamFilter, limit, offset int) ([]db.Team, int64, error) {
+				Expect(filter.Search).To(Equal("Steamers"))
+				return nil, int64(0), nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams?search=Steamers", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
 		It("returns 500 when service fails", func() {
-			mockSvc.GetAllFn = func(ctx context.Context, limit, offset int) ([]db.Team, int64, error) {
+			mockSvc.GetAllFn = func(ctx context.Context, filter service.TeamFilter, limit, offset int) ([]db.Team, int64, error) {
 				return nil, int64(0), fmt.Errorf("db failure")
 			}
 
@@ -209,7 +293,7 @@ var _ = Describe("team handlers", func() {
 	Describe("update team", func() {
 		It("returns 200 for valid update", func() {
 			teamID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.TeamRequest, tID uuid.UUID) (db.Team, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.TeamRequest, tID uuid.UUID, version *time.Time) (db.Team, error) {
 				return db.Team{ID: tID, Name: req.Name, Abbreviation: req.Abbreviation, Location: req.Location}, nil
 			}
 
@@ -233,7 +317,7 @@ var _ = Describe("team handlers", func() {
 
 		It("returns 500 when service fails", func() {
 			teamID := uuid.New()
-			mockSvc.UpdateFn = func(ctx context.Context, req *api.TeamRequest, tID uuid.UUID) (db.Team, error) {
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.TeamRequest, tID uuid.UUID, version *time.Time) (db.Team, error) {
 				return db.Team{}, fmt.Errorf("db failure")
 			}
 
@@ -247,6 +331,72 @@ var _ = Describe("team handlers", func() {
 		})
 	})
 
+	Describe("patch team", func() {
+		It("keeps the fields the patch leaves out", func() {
+			current := db.Team{ID: uuid.New(), Name: "Old Name", Abbreviation: "OLD", Location: "Wellington", UpdatedAt: time.Now().UTC()}
+			mockSvc.GetFn = func(ctx context.Context, tID uuid.UUID) (db.Team, error) {
+				return current, nil
+			}
+			var gotReq *api.TeamRequest
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.TeamRequest, tID uuid.UUID, version *time.Time) (db.Team, error) {
+				gotReq = req
+				return current, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/teams/"+current.ID.String(), bytes.NewBufferString(`{"location":"Auckland"}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(*gotReq).To(Equal(api.TeamRequest{Name: "Old Name", Abbreviation: "OLD", Location: "Auckland"}))
+		})
+
+		It("merges social handles key by key", func() {
+			current := db.Team{
+				ID:            uuid.New(),
+				Name:          "Chiefs",
+				Abbreviation:  "CHI",
+				SocialHandles: json.RawMessage(`{"x":"ChiefsRugby","facebook":"chiefs"}`),
+				ExternalRefs:  json.RawMessage(`{}`),
+				UpdatedAt:     time.Now().UTC(),
+			}
+			mockSvc.GetFn = func(ctx context.Context, tID uuid.UUID) (db.Team, error) {
+				return current, nil
+			}
+			var gotReq *api.TeamRequest
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.TeamRequest, tID uuid.UUID, version *time.Time) (db.Team, error) {
+				gotReq = req
+				return current, nil
+			}
+
+			patch := `{"social_handles":{"facebook":null,"instagram":"chiefsrugby"}}`
+			req := httptest.NewRequest(http.MethodPatch, "/teams/"+current.ID.String(), bytes.NewBufferString(patch))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotReq.SocialHandles).To(Equal(map[api.SocialPlatform]string{
+				api.SocialPlatformX:         "ChiefsRugby",
+				api.SocialPlatformInstagram: "chiefsrugby",
+			}))
+		})
+
+		It("returns 404 when the team does not exist", func() {
+			mockSvc.GetFn = func(ctx context.Context, tID uuid.UUID) (db.Team, error) {
+				return db.Team{}, service.NewNotFoundError("team", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/teams/"+uuid.New().String(), bytes.NewBufferString(`{"location":"Auckland"}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
 	Describe("delete team", func() {
 		It("returns 204 for successful deletion", func() {
 			teamID := uuid.New()
@@ -275,4 +425,180 @@ var _ = Describe("team handlers", func() {
 			Expect(w.Code).To(Equal(http.StatusInternalServerError))
 		})
 	})
+
+	Describe("get team games", func() {
+		It("returns 200 and passes filters through", func() {
+			teamID := uuid.New()
+			seasonID := uuid.New()
+
+			var gotFilter service.TeamGameFilter
+			var gotLimit, gotOffset int
+			mockSvc.GetGamesFn = func(ctx context.Context, tID uuid.UUID, filter service.TeamGameFilter, limit, offset int) ([]db.Game, int64, error) {
+				gotFilter, gotLimit, gotOffset = filter, limit, offset
+				return []db.Game{{ID: uuid.New(), HomeTeamID: tID}}, 21, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+teamID.String()+
+				"/games?season_id="+seasonID.String()+"&status=finished&sort=-date&page=2&page_size=10", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotFilter).To(Equal(service.TeamGameFilter{
+				SeasonID:   uuid.NullUUID{UUID: seasonID, Valid: true},
+				Status:     api.GameStatusFinished,
+				Descending: true,
+			}))
+			Expect(gotLimit).To(Equal(10))
+			Expect(gotOffset).To(Equal(10))
+
+			var body api.PaginatedResponse[api.GameResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Data).To(HaveLen(1))
+			Expect(body.Pagination.TotalPages).To(Equal(3))
+		})
+
+		It("returns 400 for an invalid status", func() {
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+uuid.New().String()+"/games?status=postponed", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 404 when the team does not exist", func() {
+			mockSvc.GetGamesFn = func(ctx context.Context, tID uuid.UUID, filter service.TeamGameFilter, limit, offset int) ([]db.Game, int64, error) {
+				return nil, 0, service.NewNotFoundError("team", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+uuid.New().String()+"/games", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("get team form", func() {
+		It("returns 200 with the form string and records", func() {
+			teamID := uuid.New()
+			opponentID := uuid.New()
+
+			var gotLast int
+			mockSvc.GetFormFn = func(ctx context.Context, tID uuid.UUID, seasonID uuid.NullUUID, last int) (service.TeamForm, error) {
+				gotLast = last
+				return service.TeamForm{
+					TeamID: tID,
+					Recent: []service.TeamResult{
+						{OpponentID: opponentID, Home: true, PointsFor: 20, PointsAgainst: 10, Result: api.GameResultWin},
+						{OpponentID: opponentID, PointsFor: 15, PointsAgainst: 15, Result: api.GameResultDraw},
+					},
+					StreakResult: api.GameResultWin,
+					StreakCount:  1,
+					Overall:      service.TeamRecord{Played: 2, Won: 1, Drawn: 1, PointsFor: 35, PointsAgainst: 25},
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+teamID.String()+"/form", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotLast).To(Equal(api.DefaultFormLength))
+
+			var body api.TeamFormResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.TeamID).To(Equal(teamID))
+			Expect(body.Form).To(Equal("WD"))
+			Expect(body.Recent).To(HaveLen(2))
+			Expect(body.Streak).To(Equal(api.TeamStreakResponse{Result: api.GameResultWin, Count: 1}))
+			Expect(body.Overall.PointsDifference).To(Equal(int32(10)))
+		})
+
+		It("returns 400 when last is out of range", func() {
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+uuid.New().String()+"/form?last=50", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.GetFormFn = func(ctx context.Context, tID uuid.UUID, seasonID uuid.NullUUID, last int) (service.TeamForm, error) {
+				return service.TeamForm{}, fmt.Errorf("db failure")
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+uuid.New().String()+"/form", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("get head-to-head", func() {
+		It("returns 200 with the record, margins and last meeting", func() {
+			teamID := uuid.New()
+			opponentID := uuid.New()
+
+			mockSvc.GetHeadToHeadFn = func(ctx context.Context, tID, oID uuid.UUID) (service.HeadToHead, error) {
+				latest := service.TeamResult{Game: db.Game{ID: uuid.New()}, OpponentID: oID, PointsFor: 10, PointsAgainst: 31, Result: api.GameResultLoss}
+				earlier := service.TeamResult{Game: db.Game{ID: uuid.New()}, OpponentID: oID, Home: true, PointsFor: 24, PointsAgainst: 19, Result: api.GameResultWin}
+				return service.HeadToHead{
+					Team:        db.Team{ID: tID, Name: "Auckland", Abbreviation: "AKL"},
+					Opponent:    db.Team{ID: oID, Name: "Canterbury", Abbreviation: "CAN"},
+					Meetings:    []service.TeamResult{latest, earlier},
+					Record:      service.TeamRecord{Played: 2, Won: 1, Lost: 1, PointsFor: 34, PointsAgainst: 50},
+					BiggestWin:  &earlier,
+					BiggestLoss: &latest,
+				}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+teamID.String()+"/head-to-head/"+opponentID.String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var body api.HeadToHeadResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Team.Abbreviation).To(Equal("AKL"))
+			Expect(body.Opponent.ID).To(Equal(opponentID))
+			Expect(body.Record.PointsDifference).To(Equal(int32(-16)))
+			Expect(body.Meetings).To(HaveLen(2))
+			Expect(body.LastMeeting).NotTo(BeNil())
+			Expect(body.LastMeeting.Result).To(Equal(api.GameResultLoss))
+			Expect(body.BiggestWin.PointsFor).To(Equal(int32(24)))
+			Expect(body.BiggestLoss.PointsAgainst).To(Equal(int32(31)))
+		})
+
+		It("omits the last meeting when the teams have never met", func() {
+			mockSvc.GetHeadToHeadFn = func(ctx context.Context, tID, oID uuid.UUID) (service.HeadToHead, error) {
+				return service.HeadToHead{Team: db.Team{ID: tID}, Opponent: db.Team{ID: oID}}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+uuid.New().String()+"/head-to-head/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).NotTo(ContainSubstring("last_meeting"))
+			Expect(w.Body.String()).To(ContainSubstring(`"meetings":[]`))
+		})
+
+		It("returns 400 for an invalid opponent ID", func() {
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+uuid.New().String()+"/head-to-head/invalid", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 400 when the service rejects the pairing", func() {
+			mockSvc.GetHeadToHeadFn = func(ctx context.Context, tID, oID uuid.UUID) (service.HeadToHead, error) {
+				return service.HeadToHead{}, service.NewValidationError("invalid head-to-head")
+			}
+
+			teamID := uuid.New().String()
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+teamID+"/head-to-head/"+teamID, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
 })
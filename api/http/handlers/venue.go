@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/response"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// handleCreateVenue creates a new venue with the provided details
+//
+//	@Summary	Create a new venue
+//	@ID			create-venue
+//	@Tags		Venues
+//	@Accept		json
+//	@Produce	json
+//	@Param		venue	body		api.VenueRequest			true	"Venue details to create"
+//	@Success	201		{object}	api.VenueResponse		"Successful operation"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	409		{object}	response.Problem	"Venue already exists in that city"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/venues [post]
+func handleCreateVenue(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	venueService service.VenueService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &api.VenueRequest{}
+		err := ctx.ShouldBindJSON(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		// Validate tags on VenueRequest struct
+		err = validate.Struct(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, 400, "invalid request")
+			return
+		}
+
+		venue, err := venueService.Create(ctx.Request.Context(), req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to add venue")
+			return
+		}
+
+		response.RespondSuccess(ctx, logger, http.StatusCreated, api.ToVenueResponse(venue))
+	}
+}
+
+// handleGetVenues retrieves venues with pagination
+//
+//	@Summary	Retrieve venues with pagination
+//	@ID			get-venues
+//	@Tags		Venues
+//	@Produce	json
+//	@Param		page		query		int	false	"Page number"		default(1)
+//	@Param		page_size	query		int	false	"Items per page"	default(20)
+//	@Success	200			{object}	api.PaginatedResponse[api.VenueResponse]
+//	@Failure	500			{object}	response.Problem
+//	@Router		/venues [get]
+func handleGetVenues(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	venueService service.VenueService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		q := api.PaginationRequest{}
+
+		if err := ctx.ShouldBindQuery(&q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid query params")
+			return
+		}
+
+		if err := validate.Struct(q); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid pagination params")
+			return
+		}
+
+		q.SetDefaults()
+
+		venues, total, err := venueService.GetAll(
+			ctx.Request.Context(),
+			q.PageSize,
+			q.Offset(),
+		)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get venues")
+			return
+		}
+
+		data := make([]api.VenueResponse, 0, len(venues))
+		for _, venue := range venues {
+			data = append(data, api.ToVenueResponse(venue))
+		}
+
+		totalPages := int(math.Ceil(float64(total) / float64(q.PageSize)))
+
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.PaginatedResponse[api.VenueResponse]{
+			Data: data,
+			Pagination: api.PaginationMeta{
+				Page:       q.Page,
+				PageSize:   q.PageSize,
+				Total:      total,
+				TotalPages: totalPages,
+			},
+		})
+	}
+}
+
+// handleGetVenue retrieves a venue by ID
+//
+//	@Summary	Get a single venue by ID
+//	@ID			get-venue
+//	@Tags		Venues
+//	@Produce	json
+//	@Param		venueID	path		string					true	"Venue ID"	default(5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01)
+//	@Success	200		{object}	api.VenueResponse		"Venue found"
+//	@Header		200		{string}	ETag					"Version of the venue, for If-Match"
+//	@Failure	400		{object}	response.Problem	"Invalid venue ID"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/venues/{venueID} [get]
+func handleGetVenue(logger zerolog.Logger, venueService service.VenueService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		venueID, err := uuid.Parse(ctx.Param("venueID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid venue ID")
+			return
+		}
+
+		venue, err := venueService.Get(ctx.Request.Context(), venueID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get venue")
+			return
+		}
+
+		setETag(ctx, venue.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToVenueResponse(venue))
+	}
+}
+
+// handleUpdateVenue updates an existing venue
+//
+//	@Summary	Update an existing venue
+//	@ID			update-venue
+//	@Tags		Venues
+//	@Accept		json
+//	@Produce	json
+//	@Param		venueID	path		string					true	"Venue ID"	default(5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01)
+//	@Param		venue	body		api.VenueRequest			true	"Venue details to update"
+//	@Param		If-Match	header		string					false	"ETag of the venue being replaced"
+//	@Success	200		{object}	api.VenueResponse		"Venue updated"
+//	@Header		200		{string}	ETag					"Version of the updated venue"
+//	@Failure	400		{object}	response.Problem	"Bad request"
+//	@Failure	404		{object}	response.Problem	"Not found"
+//	@Failure	412		{object}	response.Problem	"Venue changed since it was read"
+//	@Failure	500		{object}	response.Problem	"Internal server error"
+//	@Router		/venues/{venueID} [put]
+func handleUpdateVenue(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	venueService service.VenueService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		venueID, err := uuid.Parse(ctx.Param("venueID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid venue ID")
+			return
+		}
+
+		req := &api.VenueRequest{}
+		err = ctx.ShouldBindJSON(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		// Validate tags on VenueRequest struct
+		err = validate.Struct(req)
+		if err != nil {
+			response.RespondError(ctx, logger, err, 400, "invalid request")
+			return
+		}
+
+		version, err := ifMatch(ctx, "venue")
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		venue, err := venueService.Update(ctx.Request.Context(), req, venueID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update venue")
+			return
+		}
+
+		setETag(ctx, venue.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToVenueResponse(venue))
+	}
+}
+
+// handlePatchVenue applies a JSON merge patch to a venue
+//
+//	@Summary		Partially update a venue
+//	@Description	Applies an RFC 7386 JSON merge patch. The write fails with 412 if the venue changes after it is read, or if If-Match names an older version.
+//	@ID				patch-venue
+//	@Tags			Venues
+//	@Accept			application/merge-patch+json
+//	@Produce		json
+//	@Param			venueID		path		string				true	"Venue ID"	default(5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01)
+//	@Param			venue		body		api.VenueRequest		true	"Fields to change"
+//	@Param			If-Match	header		string				false	"ETag of the venue being patched"
+//	@Success		200			{object}	api.VenueResponse	"Venue updated"
+//	@Header			200			{string}	ETag				"Version of the updated venue"
+//	@Failure		400			{object}	response.Problem	"Bad request"
+//	@Failure		404			{object}	response.Problem	"Not found"
+//	@Failure		412			{object}	response.Problem	"Venue changed since it was read"
+//	@Failure		415			{object}	response.Problem	"Unsupported media type"
+//	@Failure		500			{object}	response.Problem	"Internal server error"
+//	@Router			/venues/{venueID} [patch]
+func handlePatchVenue(
+	logger zerolog.Logger,
+	validate *validator.Validate,
+	venueService service.VenueService,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		venueID, err := uuid.Parse(ctx.Param("venueID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid venue ID")
+			return
+		}
+
+		if !isMergePatch(ctx) {
+			response.RespondError(ctx, logger, errUnsupportedPatch, http.StatusUnsupportedMediaType, "Unsupported media type")
+			return
+		}
+
+		current, err := venueService.Get(ctx.Request.Context(), venueID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to get venue")
+			return
+		}
+
+		version, err := patchVersion(ctx, "venue", current.UpdatedAt)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusPreconditionFailed, "Invalid If-Match")
+			return
+		}
+
+		req := api.ToVenueRequest(current)
+		if err := bindMergePatch(ctx, &req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "bad request")
+			return
+		}
+
+		if err := validate.Struct(req); err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "invalid request")
+			return
+		}
+
+		venue, err := venueService.Update(ctx.Request.Context(), &req, venueID, version)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to update venue")
+			return
+		}
+
+		setETag(ctx, venue.UpdatedAt)
+		response.RespondSuccess(ctx, logger, http.StatusOK, api.ToVenueResponse(venue))
+	}
+}
+
+// handleDeleteVenue deletes a venue by ID
+//
+//	@Summary	Delete a venue by ID
+//	@ID			delete-venue
+//	@Tags		Venues
+//	@Produce	json
+//	@Param		venueID	path			string	true	"Venue ID"	default(5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01)
+//	@Success	204		"No Content"	"Venue deleted successfully"
+//	@Failure	400		{object}		response.Problem	"Invalid venue ID"
+//	@Failure	404		{object}		response.Problem	"Not found"
+//	@Failure	409		{object}		response.Problem	"Venue still has games"
+//	@Failure	500		{object}		response.Problem	"Internal server error"
+//	@Router		/venues/{venueID} [delete]
+func handleDeleteVenue(logger zerolog.Logger, venueService service.VenueService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		venueID, err := uuid.Parse(ctx.Param("venueID"))
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusBadRequest, "Invalid venue ID")
+			return
+		}
+
+		err = venueService.Delete(ctx.Request.Context(), venueID)
+		if err != nil {
+			response.RespondError(ctx, logger, err, http.StatusInternalServerError, "Unable to delete venue")
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
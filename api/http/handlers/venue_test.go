@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Manual mock for VenueService
+type mockVenueService struct {
+	CreateFn func(ctx context.Context, req *api.VenueRequest) (db.Venue, error)
+	GetAllFn func(ctx context.Context, limit, offset int) ([]db.Venue, int64, error)
+	GetFn    func(ctx context.Context, venueID uuid.UUID) (db.Venue, error)
+	UpdateFn func(ctx context.Context, req *api.VenueRequest, venueID uuid.UUID, version *time.Time) (db.Venue, error)
+	DeleteFn func(ctx context.Context, venueID uuid.UUID) error
+}
+
+func (m *mockVenueService) Create(ctx context.Context, req *api.VenueRequest) (db.Venue, error) {
+	if m.CreateFn != nil {
+		return m.CreateFn(ctx, req)
+	}
+	return db.Venue{}, nil
+}
+
+func (m *mockVenueService) GetAll(ctx context.Context, limit, offset int) ([]db.Venue, int64, error) {
+	if m.GetAllFn != nil {
+		return m.GetAllFn(ctx, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockVenueService) Get(ctx context.Context, venueID uuid.UUID) (db.Venue, error) {
+	if m.GetFn != nil {
+		return m.GetFn(ctx, venueID)
+	}
+	return db.Venue{}, nil
+}
+
+func (m *mockVenueService) Update(ctx context.Context, req *api.VenueRequest, venueID uuid.UUID, version *time.Time) (db.Venue, error) {
+	if m.UpdateFn != nil {
+		return m.UpdateFn(ctx, req, venueID, version)
+	}
+	return db.Venue{}, nil
+}
+
+func (m *mockVenueService) Delete(ctx context.Context, venueID uuid.UUID) error {
+	if m.DeleteFn != nil {
+		return m.DeleteFn(ctx, venueID)
+	}
+	return nil
+}
+
+var _ = Describe("venue handlers", func() {
+	var (
+		router   *gin.Engine
+		validate *validator.Validate
+		logger   zerolog.Logger
+		mockSvc  *mockVenueService
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		validate = validator.New()
+		validation.Register(validate)
+		api.Register(validate)
+		logger = zerolog.Nop()
+
+		mockSvc = &mockVenueService{}
+		router = gin.New()
+
+		router.POST("/venues", handleCreateVenue(logger, validate, mockSvc))
+		router.GET("/venues", handleGetVenues(logger, validate, mockSvc))
+		router.GET("/venues/:venueID", handleGetVenue(logger, mockSvc))
+		router.PUT("/venues/:venueID", handleUpdateVenue(logger, validate, mockSvc))
+		router.PATCH("/venues/:venueID", handlePatchVenue(logger, validate, mockSvc))
+		router.DELETE("/venues/:venueID", handleDeleteVenue(logger, mockSvc))
+	})
+
+	Describe("create venue", func() {
+		It("returns 201 for valid request", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.VenueRequest) (db.Venue, error) {
+				return db.Venue{
+					ID:        uuid.New(),
+					Name:      req.Name,
+					City:      req.City,
+					Country:   req.Country,
+					Timezone:  req.Timezone,
+					Capacity:  sql.NullInt32{Int32: *req.Capacity, Valid: true},
+					Latitude:  sql.NullFloat64{Float64: *req.Latitude, Valid: true},
+					Longitude: sql.NullFloat64{Float64: *req.Longitude, Valid: true},
+				}, nil
+			}
+
+			reqBody := `{"name":"Eden Park","city":"Auckland","country":"New Zealand","timezone":"Pacific/Auckland","capacity":50000,"latitude":-36.8751,"longitude":174.7447}`
+			req := httptest.NewRequest(http.MethodPost, "/venues", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+
+			var resp api.VenueResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Timezone).To(Equal("Pacific/Auckland"))
+			Expect(*resp.Capacity).To(Equal(int32(50000)))
+			Expect(*resp.Latitude).To(Equal(-36.8751))
+		})
+
+		It("returns 400 for an unknown timezone", func() {
+			reqBody := `{"name":"Eden Park","city":"Auckland","country":"New Zealand","timezone":"Pacific/Atlantis"}`
+			req := httptest.NewRequest(http.MethodPost, "/venues", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("timezone must be an IANA timezone"))
+		})
+
+		It("returns 400 when only one coordinate is given", func() {
+			reqBody := `{"name":"Eden Park","city":"Auckland","country":"New Zealand","timezone":"Pacific/Auckland","latitude":-36.8751}`
+			req := httptest.NewRequest(http.MethodPost, "/venues", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("longitude"))
+		})
+
+		It("returns 409 when the venue already exists", func() {
+			mockSvc.CreateFn = func(ctx context.Context, req *api.VenueRequest) (db.Venue, error) {
+				return db.Venue{}, service.NewConflictError("venue already exists", nil)
+			}
+
+			reqBody := `{"name":"Eden Park","city":"Auckland","country":"New Zealand","timezone":"Pacific/Auckland"}`
+			req := httptest.NewRequest(http.MethodPost, "/venues", bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("get venues with pagination", func() {
+		It("returns 200 and venues", func() {
+			mockSvc.GetAllFn = func(ctx context.Context, limit, offset int) ([]db.Venue, int64, error) {
+				Expect(limit).To(Equal(10))
+				Expect(offset).To(Equal(10))
+
+				return []db.Venue{
+					{ID: uuid.New(), Name: "Sky Stadium", City: "Wellington", Country: "New Zealand", Timezone: "Pacific/Auckland"},
+				}, int64(11), nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/venues?page=2&page_size=10", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var resp api.PaginatedResponse[api.VenueResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Data).To(HaveLen(1))
+			Expect(resp.Pagination.TotalPages).To(Equal(2))
+		})
+	})
+
+	Describe("get venue by ID", func() {
+		It("returns 200 with an ETag", func() {
+			updatedAt := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+			mockSvc.GetFn = func(ctx context.Context, vID uuid.UUID) (db.Venue, error) {
+				return db.Venue{ID: vID, Name: "Forsyth Barr Stadium", Timezone: "Pacific/Auckland", UpdatedAt: updatedAt}, nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/venues/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(etag(updatedAt)))
+		})
+
+		It("returns 400 for invalid UUID", func() {
+			req := httptest.NewRequest(http.MethodGet, "/venues/invalid", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("update venue", func() {
+		It("passes the If-Match version to the service", func() {
+			version := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+			var gotVersion *time.Time
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.VenueRequest, vID uuid.UUID, v *time.Time) (db.Venue, error) {
+				gotVersion = v
+				return db.Venue{ID: vID, Name: req.Name, Timezone: req.Timezone}, nil
+			}
+
+			reqBody := `{"name":"Eden Park","city":"Auckland","country":"New Zealand","timezone":"Pacific/Auckland"}`
+			req := httptest.NewRequest(http.MethodPut, "/venues/"+uuid.New().String(), bytes.NewBufferString(reqBody))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", etag(version))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gotVersion).NotTo(BeNil())
+			Expect(gotVersion.Equal(version)).To(BeTrue())
+		})
+	})
+
+	Describe("patch venue", func() {
+		It("keeps the fields the patch leaves out and clears null ones", func() {
+			capacity := int32(50000)
+			current := db.Venue{
+				ID:       uuid.New(),
+				Name:     "Eden Park",
+				City:     "Auckland",
+				Country:  "New Zealand",
+				Timezone: "Pacific/Auckland",
+				Capacity: sql.NullInt32{Int32: capacity, Valid: true},
+			}
+			mockSvc.GetFn = func(ctx context.Context, vID uuid.UUID) (db.Venue, error) {
+				return current, nil
+			}
+			var gotReq *api.VenueRequest
+			mockSvc.UpdateFn = func(ctx context.Context, req *api.VenueRequest, vID uuid.UUID, version *time.Time) (db.Venue, error) {
+				gotReq = req
+				return current, nil
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/venues/"+current.ID.String(), bytes.NewBufferString(`{"name":"Eden Park Stadium","capacity":null}`))
+			req.Header.Set("Content-Type", MergePatchContentType)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(*gotReq).To(Equal(api.VenueRequest{
+				Name:     "Eden Park Stadium",
+				City:     "Auckland",
+				Country:  "New Zealand",
+				Timezone: "Pacific/Auckland",
+			}))
+		})
+	})
+
+	Describe("delete venue", func() {
+		It("returns 204 for successful deletion", func() {
+			mockSvc.DeleteFn = func(ctx context.Context, vID uuid.UUID) error { return nil }
+
+			req := httptest.NewRequest(http.MethodDelete, "/venues/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("returns 409 when games are played at the venue", func() {
+			mockSvc.DeleteFn = func(ctx context.Context, vID uuid.UUID) error {
+				return service.NewConflictError("venue has 2 games", nil)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/venues/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("returns 500 when service fails", func() {
+			mockSvc.DeleteFn = func(ctx context.Context, vID uuid.UUID) error { return fmt.Errorf("db failure") }
+
+			req := httptest.NewRequest(http.MethodDelete, "/venues/"+uuid.New().String(), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	RequestIDKey    = "request_id"
+)
+
+var requestIDRegex = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID assigns each request an ID, reusing a well-formed X-Request-ID from the
+// caller, echoes it on the response and stores a request-scoped logger in the request
+// context so handlers and services log with the same correlation ID. When registered
+// after Tracing the logger also carries the trace ID.
+func RequestID(logger zerolog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(RequestIDHeader)
+		if !requestIDRegex.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		ctx.Set(RequestIDKey, requestID)
+		ctx.Header(RequestIDHeader, requestID)
+
+		logCtx := logger.With().Str(RequestIDKey, requestID)
+		if spanCtx := trace.SpanContextFromContext(ctx.Request.Context()); spanCtx.HasTraceID() {
+			logCtx = logCtx.Str("trace_id", spanCtx.TraceID().String())
+		}
+		reqLogger := logCtx.Logger()
+		ctx.Request = ctx.Request.WithContext(reqLogger.WithContext(ctx.Request.Context()))
+
+		ctx.Next()
+	}
+}
+
+// AccessLog writes one structured log line per request once the handler chain has run.
+// It must be registered after RequestID so the line carries the request ID.
+func AccessLog() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		logger := zerolog.Ctx(ctx.Request.Context())
+
+		var event *zerolog.Event
+		switch {
+		case status >= 500:
+			event = logger.Error()
+		case status >= 400:
+			event = logger.Warn()
+		default:
+			event = logger.Info()
+		}
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		event.
+			Str("method", ctx.Request.Method).
+			Str("route", route).
+			Str("path", ctx.Request.URL.Path).
+			Int("status", status).
+			Dur("latency", time.Since(start)).
+			Int("size", ctx.Writer.Size()).
+			Str("client_ip", ctx.ClientIP())
+
+		if len(ctx.Errors) > 0 {
+			event.Strs("errors", ctx.Errors.Errors())
+		}
+
+		event.Msg("request handled")
+	}
+}
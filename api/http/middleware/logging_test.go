@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/bradley-adams/gainline/http/response"
+)
+
+var _ = Describe("logging middleware", func() {
+	var (
+		router    *gin.Engine
+		logBuffer *bytes.Buffer
+		logger    zerolog.Logger
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		logBuffer = new(bytes.Buffer)
+		logger = zerolog.New(logBuffer)
+
+		router = gin.New()
+		router.Use(RequestID(logger), AccessLog())
+
+		router.GET("/teams/:teamID", func(ctx *gin.Context) {
+			zerolog.Ctx(ctx.Request.Context()).Info().Msg("inside handler")
+			ctx.JSON(http.StatusOK, gin.H{"message": "ok"})
+		})
+		router.GET("/fail", func(ctx *gin.Context) {
+			response.RespondError(ctx, zerolog.Nop(), errors.New("db down"), http.StatusInternalServerError, "Unable to get teams")
+		})
+	})
+
+	logLines := func() []map[string]interface{} {
+		var lines []map[string]interface{}
+		for _, raw := range bytes.Split(bytes.TrimSpace(logBuffer.Bytes()), []byte("\n")) {
+			line := map[string]interface{}{}
+			Expect(json.Unmarshal(raw, &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	Describe("RequestID", func() {
+		It("should generate a request ID when none is provided", func() {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/teams/123", nil)
+			router.ServeHTTP(recorder, req)
+
+			requestID := recorder.Header().Get(RequestIDHeader)
+			_, err := uuid.Parse(requestID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should propagate a well-formed incoming request ID", func() {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/teams/123", nil)
+			req.Header.Set(RequestIDHeader, "abc-123")
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Header().Get(RequestIDHeader)).To(Equal("abc-123"))
+
+			for _, line := range logLines() {
+				Expect(line["request_id"]).To(Equal("abc-123"))
+			}
+		})
+
+		It("should replace a malformed incoming request ID", func() {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/teams/123", nil)
+			req.Header.Set(RequestIDHeader, "bad id\nwith newline")
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Header().Get(RequestIDHeader)).NotTo(Equal("bad id\nwith newline"))
+			_, err := uuid.Parse(recorder.Header().Get(RequestIDHeader))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("AccessLog", func() {
+		It("should log one line per request with route, status and latency", func() {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/teams/123", nil)
+			router.ServeHTTP(recorder, req)
+
+			lines := logLines()
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]["message"]).To(Equal("inside handler"))
+
+			access := lines[1]
+			Expect(access["message"]).To(Equal("request handled"))
+			Expect(access["level"]).To(Equal("info"))
+			Expect(access["method"]).To(Equal(http.MethodGet))
+			Expect(access["route"]).To(Equal("/teams/:teamID"))
+			Expect(access["path"]).To(Equal("/teams/123"))
+			Expect(access["status"]).To(BeEquivalentTo(http.StatusOK))
+			Expect(access).To(HaveKey("latency"))
+			Expect(access["request_id"]).To(Equal(recorder.Header().Get(RequestIDHeader)))
+		})
+
+		It("should correlate handler errors with the access line", func() {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/fail", nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+
+			lines := logLines()
+			Expect(lines).To(HaveLen(2))
+
+			errLine, access := lines[0], lines[1]
+			Expect(errLine["level"]).To(Equal("error"))
+			Expect(errLine["error"]).To(Equal("db down"))
+			Expect(errLine["request_id"]).To(Equal(access["request_id"]))
+
+			Expect(access["level"]).To(Equal("error"))
+			Expect(access["status"]).To(BeEquivalentTo(http.StatusInternalServerError))
+			Expect(access["errors"]).To(ConsistOf("db down"))
+		})
+
+		It("should label unmatched routes", func() {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/nope", nil)
+			router.ServeHTTP(recorder, req)
+
+			lines := logLines()
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0]["route"]).To(Equal("unmatched"))
+			Expect(lines[0]["level"]).To(Equal("warn"))
+		})
+	})
+})
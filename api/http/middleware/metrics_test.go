@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/bradley-adams/gainline/metrics"
+)
+
+var _ = Describe("metrics middleware", func() {
+	var router *gin.Engine
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		router = gin.New()
+		router.Use(Metrics())
+		router.GET("/teams/:teamID", func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{"message": "ok"})
+		})
+	})
+
+	It("should count requests by route template and status", func() {
+		counter := metrics.HTTPRequestsTotal.WithLabelValues(http.MethodGet, "/teams/:teamID", "200")
+		before := testutil.ToFloat64(counter)
+
+		for _, id := range []string{"1", "2"} {
+			req, _ := http.NewRequest(http.MethodGet, "/teams/"+id, nil)
+			router.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		Expect(testutil.ToFloat64(counter) - before).To(Equal(2.0))
+	})
+
+	It("should group unmatched paths under a single label", func() {
+		counter := metrics.HTTPRequestsTotal.WithLabelValues(http.MethodGet, "unmatched", "404")
+		before := testutil.ToFloat64(counter)
+
+		req, _ := http.NewRequest(http.MethodGet, "/does/not/exist", nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		Expect(testutil.ToFloat64(counter) - before).To(Equal(1.0))
+	})
+})
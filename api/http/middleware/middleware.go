@@ -2,7 +2,6 @@ package middleware
 
 import (
 	"context"
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -43,7 +42,7 @@ func CompetitionStructureValidator(
 
 			err := validateSeason(ctx, seasonUUID, compUUID, seasonService)
 			if err != nil {
-				response.RespondAbortError(ctx, logger, err, http.StatusForbidden, "Season does not belong to competition")
+				response.RespondAbortError(ctx, logger, err, http.StatusInternalServerError, "Unable to validate season")
 				return
 			}
 		}
@@ -57,7 +56,7 @@ func CompetitionStructureValidator(
 
 			err := validateGame(ctx.Request.Context(), gameUUID, seasonUUID, gameService)
 			if err != nil {
-				response.RespondAbortError(ctx, logger, err, http.StatusForbidden, "Game does not belong to season")
+				response.RespondAbortError(ctx, logger, err, http.StatusInternalServerError, "Unable to validate game")
 				return
 			}
 		}
@@ -72,7 +71,7 @@ func validateGame(ctx context.Context, gameID, seasonID uuid.UUID, gameService s
 		return err
 	}
 	if game.SeasonID != seasonID {
-		return errors.New("unauthorized: game does not belong to season")
+		return service.NewForbiddenError("game does not belong to season")
 	}
 	return nil
 }
@@ -83,7 +82,7 @@ func validateSeason(ctx *gin.Context, seasonID, competitionID uuid.UUID, seasonS
 		return err
 	}
 	if season.CompetitionID != competitionID {
-		return errors.New("unauthorized: season does not belong to competition")
+		return service.NewForbiddenError("season does not belong to competition")
 	}
 
 	ctx.Set("season", season)
@@ -53,7 +53,7 @@ var _ = Describe("middleware", func() {
 		router = gin.Default()
 
 		seasonService = service.NewSeasonService(mockDB)
-		gameService = service.NewGameService(mockDB)
+		gameService = service.NewGameService(mockDB, service.FixtureRules{})
 
 		createRecorder = func() *httptest.ResponseRecorder {
 			return httptest.NewRecorder()
@@ -240,8 +240,27 @@ var _ = Describe("middleware", func() {
 				"unable to get season: a valid testing error",
 			))
 
-			Expect(recorder.Code).To(Equal(http.StatusForbidden))
-			Expect(recorder.Body.String()).To(ContainSubstring("Season does not belong to competition"))
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"detail":"Unable to validate season"`))
+			Expect(recorder.Body.String()).NotTo(ContainSubstring("a valid testing error"))
+		})
+
+		It("should return not found when the season does not exist", func() {
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetSeason(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(db.Season{}, sql.ErrNoRows)
+
+			recorder := createRecorder()
+			req, _ := http.NewRequest("GET", "/test/competitions/"+validCompetitionID.String()+"/seasons/"+validSeasonID.String()+"/games/"+validGameID.String(), nil)
+			router.ServeHTTP(recorder, req)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"detail":"season not found"`))
 		})
 
 		It("should return error when GetGame returns an error", func() {
@@ -286,8 +305,8 @@ var _ = Describe("middleware", func() {
 				"unable to get game: a valid testing error",
 			))
 
-			Expect(recorder.Code).To(Equal(http.StatusForbidden))
-			Expect(recorder.Body.String()).To(ContainSubstring("Game does not belong to season"))
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"detail":"Unable to validate game"`))
 		})
 
 		It("should return error if season does not belong to competition", func() {
@@ -323,7 +342,7 @@ var _ = Describe("middleware", func() {
 			Expect(logContent).To(ContainSubstring("season does not belong to competition"))
 
 			Expect(recorder.Code).To(Equal(http.StatusForbidden))
-			Expect(recorder.Body.String()).To(ContainSubstring("Season does not belong to competition"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"detail":"season does not belong to competition"`))
 		})
 
 		It("should return error if game does not belong to season", func() {
@@ -364,10 +383,10 @@ var _ = Describe("middleware", func() {
 			router.ServeHTTP(recorder, req)
 
 			logContent := logBuffer.String()
-			Expect(logContent).To(ContainSubstring("unauthorized: game does not belong to season"))
+			Expect(logContent).To(ContainSubstring("game does not belong to season"))
 
 			Expect(recorder.Code).To(Equal(http.StatusForbidden))
-			Expect(recorder.Body.String()).To(ContainSubstring("Game does not belong to season"))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"detail":"game does not belong to season"`))
 		})
 	})
 })
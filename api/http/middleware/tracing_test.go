@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("tracing middleware", func() {
+	var (
+		router    *gin.Engine
+		recorder  *tracetest.SpanRecorder
+		logBuffer *bytes.Buffer
+		provider  *sdktrace.TracerProvider
+		previous  trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		previous = otel.GetTracerProvider()
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		logBuffer = new(bytes.Buffer)
+
+		router = gin.New()
+		router.Use(Tracing(), RequestID(zerolog.New(logBuffer)))
+		router.GET("/teams/:teamID", func(ctx *gin.Context) {
+			zerolog.Ctx(ctx.Request.Context()).Info().Msg("inside handler")
+			ctx.JSON(http.StatusOK, gin.H{"message": "ok"})
+		})
+		router.GET("/fail", func(ctx *gin.Context) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "boom"})
+		})
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	It("should record a server span named after the route", func() {
+		req, _ := http.NewRequest(http.MethodGet, "/teams/123", nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("GET /teams/:teamID"))
+		Expect(spans[0].SpanKind()).To(Equal(trace.SpanKindServer))
+		Expect(spans[0].Attributes()).To(ContainElement(attribute.Int("http.response.status_code", http.StatusOK)))
+	})
+
+	It("should continue a trace propagated by the caller", func() {
+		req, _ := http.NewRequest(http.MethodGet, "/teams/123", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(logBuffer.String()).To(ContainSubstring(`"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"`))
+	})
+
+	It("should mark server errors on the span", func() {
+		req, _ := http.NewRequest(http.MethodGet, "/fail", nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+	})
+})
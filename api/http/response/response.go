@@ -1,30 +1,137 @@
 package response
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+
+	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/service"
 )
 
-type ErrorResponse struct {
-	Message string `json:"message"`
+// ProblemContentType is the media type for RFC 7807 error bodies.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details body returned for every error response.
+type Problem struct {
+	Type     string         `json:"type" example:"about:blank"`
+	Title    string         `json:"title" example:"Bad Request"`
+	Status   int            `json:"status" example:"400"`
+	Detail   string         `json:"detail,omitempty" example:"invalid game: home team not in season"`
+	Instance string         `json:"instance,omitempty" example:"/v1/competitions"`
+	Errors   []FieldProblem `json:"errors,omitempty"`
+}
+
+// FieldProblem describes why a single request field was rejected. Rule is the
+// validation tag that failed, Message a human-readable explanation.
+type FieldProblem struct {
+	Field   string `json:"field" example:"home_team_id"`
+	Rule    string `json:"rule" example:"home_and_away_teams_must_differ"`
+	Message string `json:"message" example:"home and away teams must be different"`
 }
 
+// RequestLogger returns the request-scoped logger placed in the request context by
+// middleware.RequestID, falling back to logger when none is present.
+func RequestLogger(ctx *gin.Context, logger zerolog.Logger) *zerolog.Logger {
+	reqLogger := zerolog.Ctx(ctx.Request.Context())
+	if reqLogger.GetLevel() == zerolog.Disabled {
+		return &logger
+	}
+	return reqLogger
+}
+
+// RespondError writes err as a problem+json body. Typed service errors and validator
+// errors determine their own status and detail; any other error is reported with
+// statusCode and errMessage so internal details are not leaked to the client.
 func RespondError(ctx *gin.Context, logger zerolog.Logger, err error, statusCode int, errMessage string) {
-	logger.Error().Msg(err.Error())
+	problem := writeProblem(ctx, logger, err, statusCode, errMessage)
 
-	ctx.JSON(statusCode, ErrorResponse{
-		Message: errMessage,
-	})
+	ctx.JSON(problem.Status, problem)
 }
 
 func RespondSuccess(ctx *gin.Context, logger zerolog.Logger, status int, response interface{}) {
-	logger.Debug().Msgf("processed successfully")
+	RequestLogger(ctx, logger).Debug().Msgf("processed successfully")
 
 	ctx.JSON(status, response)
 }
 
+// RespondAbortError behaves like RespondError and also stops the remaining handlers.
 func RespondAbortError(ctx *gin.Context, logger zerolog.Logger, err error, status int, message string) {
-	logger.Error().Msg(err.Error())
+	problem := writeProblem(ctx, logger, err, status, message)
+
+	ctx.AbortWithStatusJSON(problem.Status, problem)
+}
+
+func writeProblem(ctx *gin.Context, logger zerolog.Logger, err error, statusCode int, errMessage string) Problem {
+	_ = ctx.Error(err)
+
+	problem := NewProblem(err, statusCode, errMessage)
+	problem.Instance = ctx.Request.URL.Path
+
+	event := RequestLogger(ctx, logger).Warn()
+	if problem.Status >= http.StatusInternalServerError {
+		event = RequestLogger(ctx, logger).Error()
+	}
+	event.
+		Err(err).
+		Str("route", ctx.FullPath()).
+		Int("status", problem.Status).
+		Msg(errMessage)
+
+	ctx.Header("Content-Type", ProblemContentType)
+	return problem
+}
+
+// NewProblem maps err to a problem body. It is the single place where service errors
+// are translated into HTTP status codes.
+func NewProblem(err error, statusCode int, errMessage string) Problem {
+	var (
+		notFoundErr     *service.NotFoundError
+		conflictErr     *service.ConflictError
+		validationErr   *service.ValidationError
+		forbiddenErr    *service.ForbiddenError
+		preconditionErr *service.PreconditionFailedError
+		fieldErrs       validator.ValidationErrors
+	)
+
+	switch {
+	case errors.As(err, &notFoundErr):
+		return newProblem(http.StatusNotFound, notFoundErr.Error())
+	case errors.As(err, &conflictErr):
+		return newProblem(http.StatusConflict, conflictErr.Error())
+	case errors.As(err, &forbiddenErr):
+		return newProblem(http.StatusForbidden, forbiddenErr.Error())
+	case errors.As(err, &preconditionErr):
+		return newProblem(http.StatusPreconditionFailed, preconditionErr.Error())
+	case errors.As(err, &validationErr):
+		problem := newProblem(http.StatusBadRequest, validationErr.Message)
+		for _, f := range validationErr.Fields {
+			problem.Errors = append(problem.Errors, FieldProblem{Field: f.Field, Rule: f.Rule, Message: f.Message})
+		}
+		return problem
+	case errors.As(err, &fieldErrs):
+		problem := newProblem(http.StatusBadRequest, errMessage)
+		for _, f := range fieldErrs {
+			problem.Errors = append(problem.Errors, FieldProblem{
+				Field:   validation.FieldPath(f),
+				Rule:    f.Tag(),
+				Message: validation.Translate(f),
+			})
+		}
+		return problem
+	default:
+		return newProblem(statusCode, errMessage)
+	}
+}
 
-	ctx.AbortWithStatusJSON(status, gin.H{"error": message})
+func newProblem(status int, detail string) Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
 }
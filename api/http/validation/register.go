@@ -5,4 +5,9 @@ import "github.com/go-playground/validator/v10"
 func Register(v *validator.Validate) {
 	v.RegisterValidation("entity_name", ValidateEntityName)
 	v.RegisterValidation("unique_team_uuids", ValidateUniqueUUIDs)
+	v.RegisterValidation("hex_colour", ValidateHexColour)
+	v.RegisterValidation("social_handle", ValidateSocialHandle)
+	v.RegisterValidation("ref_key", ValidateRefKey)
+
+	registerTranslations(v)
 }
@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+)
+
+// translator holds the English messages for every validator passed to Register.
+// Registrations always override so more than one validator can share it.
+var translator ut.Translator = overridingTranslator{newEnglishTranslator()}
+
+func newEnglishTranslator() ut.Translator {
+	english := en.New()
+	trans, _ := ut.New(english, english).GetTranslator("en")
+	return trans
+}
+
+type overridingTranslator struct {
+	ut.Translator
+}
+
+func (t overridingTranslator) Add(key interface{}, text string, _ bool) error {
+	return t.Translator.Add(key, text, true)
+}
+
+func (t overridingTranslator) AddCardinal(key interface{}, text string, rule locales.PluralRule, _ bool) error {
+	return t.Translator.AddCardinal(key, text, rule, true)
+}
+
+func (t overridingTranslator) AddOrdinal(key interface{}, text string, rule locales.PluralRule, _ bool) error {
+	return t.Translator.AddOrdinal(key, text, rule, true)
+}
+
+func (t overridingTranslator) AddRange(key interface{}, text string, rule locales.PluralRule, _ bool) error {
+	return t.Translator.AddRange(key, text, rule, true)
+}
+
+// registerTranslations reports fields by their JSON name and loads the default English
+// messages along with those for this package's custom tags.
+func registerTranslations(v *validator.Validate) {
+	v.RegisterTagNameFunc(fieldName)
+
+	_ = en_translations.RegisterDefaultTranslations(v, translator)
+
+	RegisterTranslation(v, "entity_name", "{0} may only contain letters, numbers, spaces and . , ' -")
+	RegisterTranslation(v, "unique_team_uuids", "{0} must not contain the same team more than once")
+	RegisterTranslation(v, "timezone", "{0} must be an IANA timezone such as Pacific/Auckland")
+	RegisterTranslation(v, "hex_colour", "{0} must be a hex colour such as #000000")
+	RegisterTranslation(v, "social_handle", "{0} must be a handle without the @, using letters, numbers, _ . or -")
+	RegisterTranslation(v, "ref_key", "{0} must be lower case letters, numbers or _")
+	RegisterTranslation(v, "http_url", "{0} must be an http or https URL")
+	RegisterTranslation(v, "iso3166_1_alpha2", "{0} must be a two letter ISO 3166 country code such as NZ")
+}
+
+// RegisterTranslation adds an English message for tag, where {0} is replaced by the field name.
+func RegisterTranslation(v *validator.Validate, tag, message string) {
+	_ = v.RegisterTranslation(
+		tag,
+		translator,
+		func(trans ut.Translator) error {
+			return trans.Add(tag, message, true)
+		},
+		func(trans ut.Translator, fe validator.FieldError) string {
+			msg, err := trans.T(fe.Tag(), fe.Field())
+			if err != nil {
+				return fe.Error()
+			}
+			return msg
+		},
+	)
+}
+
+// Translate returns a human-readable message for fe, falling back to the validator's
+// own message when no translation is registered for its tag.
+func Translate(fe validator.FieldError) string {
+	return fe.Translate(translator)
+}
+
+// FieldPath returns the JSON path of the field that failed, without the root struct
+// name, e.g. "stages[0].name".
+func FieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if i := strings.Index(ns, "."); i >= 0 {
+		return ns[i+1:]
+	}
+	return ns
+}
+
+// fieldName names a struct field after its json tag, or its form tag for query
+// parameters, so errors match what the client sent.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form"} {
+		name := strings.SplitN(field.Tag.Get(key), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type translatedRequest struct {
+	Name   string `json:"name" validate:"required,entity_name"`
+	Page   int    `form:"page" validate:"omitempty,gte=1"`
+	Stages []struct {
+		Title string `json:"title" validate:"required"`
+	} `json:"stages" validate:"dive"`
+}
+
+var _ = Describe("translations", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = validator.New()
+		Register(validate)
+	})
+
+	fieldErrors := func(req translatedRequest) validator.ValidationErrors {
+		err := validate.Struct(req)
+		Expect(err).To(HaveOccurred())
+
+		var fieldErrs validator.ValidationErrors
+		Expect(err).To(BeAssignableToTypeOf(fieldErrs))
+		return err.(validator.ValidationErrors)
+	}
+
+	It("should name fields by their json or form tag", func() {
+		errs := fieldErrors(translatedRequest{
+			Name: "ok name",
+			Page: -1,
+			Stages: []struct {
+				Title string `json:"title" validate:"required"`
+			}{{}},
+		})
+
+		paths := []string{}
+		for _, fe := range errs {
+			paths = append(paths, FieldPath(fe))
+		}
+		Expect(paths).To(ConsistOf("page", "stages[0].title"))
+	})
+
+	It("should translate built-in tags", func() {
+		errs := fieldErrors(translatedRequest{})
+		Expect(Translate(errs[0])).To(Equal("name is a required field"))
+	})
+
+	It("should translate custom tags", func() {
+		errs := fieldErrors(translatedRequest{Name: "bad!"})
+		Expect(errs[0].Tag()).To(Equal("entity_name"))
+		Expect(Translate(errs[0])).To(Equal("name may only contain letters, numbers, spaces and . , ' -"))
+	})
+
+	It("should allow more than one validator to be registered", func() {
+		other := validator.New()
+		Register(other)
+
+		err := other.Struct(translatedRequest{})
+		Expect(Translate(err.(validator.ValidationErrors)[0])).To(Equal("name is a required field"))
+	})
+})
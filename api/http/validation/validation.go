@@ -9,11 +9,32 @@ import (
 
 var competitionNameRegex = regexp.MustCompile(`^[A-Za-z0-9 .,'-]+$`)
 
+var hexColourRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+var socialHandleRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,50}$`)
+
+var refKeyRegex = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
 func ValidateEntityName(fl validator.FieldLevel) bool {
 	name := fl.Field().String()
 	return competitionNameRegex.MatchString(name)
 }
 
+// ValidateHexColour accepts a six digit hex colour such as #000000.
+func ValidateHexColour(fl validator.FieldLevel) bool {
+	return hexColourRegex.MatchString(fl.Field().String())
+}
+
+// ValidateSocialHandle accepts a social media handle without its leading @.
+func ValidateSocialHandle(fl validator.FieldLevel) bool {
+	return socialHandleRegex.MatchString(fl.Field().String())
+}
+
+// ValidateRefKey accepts a lower case key such as world_rugby for naming another system.
+func ValidateRefKey(fl validator.FieldLevel) bool {
+	return refKeyRegex.MatchString(fl.Field().String())
+}
+
 func ValidateUniqueUUIDs(fl validator.FieldLevel) bool {
 	teams, ok := fl.Field().Interface().([]uuid.UUID)
 	if !ok {
@@ -21,6 +21,9 @@ var _ = Describe("field-level validators", func() {
 		validate = validator.New()
 		validate.RegisterValidation("entity_name", ValidateEntityName)
 		validate.RegisterValidation("unique_team_uuids", ValidateUniqueUUIDs)
+		validate.RegisterValidation("hex_colour", ValidateHexColour)
+		validate.RegisterValidation("social_handle", ValidateSocialHandle)
+		validate.RegisterValidation("ref_key", ValidateRefKey)
 	})
 
 	Describe("ValidateEntityName", func() {
@@ -38,6 +41,45 @@ var _ = Describe("field-level validators", func() {
 		})
 	})
 
+	Describe("ValidateHexColour", func() {
+		It("should pass with six digit hex colours", func() {
+			Expect(validate.Var("#000000", "hex_colour")).To(Succeed())
+			Expect(validate.Var("#E41B23", "hex_colour")).To(Succeed())
+			Expect(validate.Var("#ffcc00", "hex_colour")).To(Succeed())
+		})
+
+		It("should fail with short, named or unprefixed colours", func() {
+			for _, colour := range []string{"#fff", "red", "E41B23", "#E41B23FF"} {
+				Expect(validate.Var(colour, "hex_colour")).To(HaveOccurred(), colour)
+			}
+		})
+	})
+
+	Describe("ValidateSocialHandle", func() {
+		It("should pass with handles", func() {
+			Expect(validate.Var("ChiefsRugby", "social_handle")).To(Succeed())
+			Expect(validate.Var("bop.steamers_1", "social_handle")).To(Succeed())
+		})
+
+		It("should fail with an @, spaces or a URL", func() {
+			for _, handle := range []string{"@ChiefsRugby", "Chiefs Rugby", "https://x.com/ChiefsRugby", ""} {
+				Expect(validate.Var(handle, "social_handle")).To(HaveOccurred(), handle)
+			}
+		})
+	})
+
+	Describe("ValidateRefKey", func() {
+		It("should pass with lower case keys", func() {
+			Expect(validate.Var("world_rugby", "ref_key")).To(Succeed())
+		})
+
+		It("should fail with upper case, spaces or punctuation", func() {
+			for _, key := range []string{"WorldRugby", "world rugby", "espn-id", ""} {
+				Expect(validate.Var(key, "ref_key")).To(HaveOccurred(), key)
+			}
+		})
+	})
+
 	Describe("ValidateUniqueUUIDs", func() {
 		var team1, team2 uuid.UUID
 
@@ -15,6 +15,10 @@ import (
 	"github.com/bradley-adams/gainline/http/api"
 	"github.com/bradley-adams/gainline/http/handlers"
 	"github.com/bradley-adams/gainline/http/validation"
+	"github.com/bradley-adams/gainline/metrics"
+	"github.com/bradley-adams/gainline/service"
+	"github.com/bradley-adams/gainline/telemetry/prom"
+	"github.com/bradley-adams/gainline/telemetry/tracing"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 )
@@ -28,17 +32,14 @@ func main() {
 		panic(err)
 	}
 
-	writer := zerolog.ConsoleWriter{
-		Out:        os.Stderr,
-		TimeFormat: time.DateTime,
-	}
-
 	port := viper.GetString("PORT")
 
-	logger := zerolog.New(writer).With().Str("service", serviceName).Str("port", port).Timestamp().Logger()
+	logger := setUpLogger().With().Str("service", serviceName).Str("port", port).Timestamp().Logger()
 
 	logger.Info().Msgf("%s starting", serviceName)
 
+	shutdownTracing := tracing.Start(logger, serviceName)
+
 	dbWrapper := setupWrapperDB(logger)
 	validate, err := setUpValidator(logger)
 	if err != nil {
@@ -55,10 +56,15 @@ func main() {
 		GameStateClient: gameStateClient,
 		Auth0Domain:     viper.GetString("AUTH0_DOMAIN"),
 		Auth0Audience:   viper.GetString("AUTH0_AUDIENCE"),
+		FixtureRules:    setUpFixtureRules(),
 	})
 
+	go prom.Serve(logger)
+
 	logger.Info().Msg(serviceName + " started")
-	logger.Fatal().Err(r.Run(":8080")).Msg("failed to start server")
+	err = r.Run(":8080")
+	shutdownTracing()
+	logger.Fatal().Err(err).Msg("failed to start server")
 }
 
 func setUpEnvVars() error {
@@ -74,6 +80,37 @@ func setUpEnvVars() error {
 	return nil
 }
 
+// setUpLogger writes JSON when LOG_FORMAT=json (production) and human-readable
+// console output otherwise. LOG_LEVEL defaults to info.
+func setUpLogger() zerolog.Logger {
+	level, err := zerolog.ParseLevel(viper.GetString("LOG_LEVEL"))
+	if err != nil || level == zerolog.NoLevel {
+		level = zerolog.InfoLevel
+	}
+
+	if viper.GetString("LOG_FORMAT") == "json" {
+		return zerolog.New(os.Stderr).Level(level)
+	}
+
+	writer := zerolog.ConsoleWriter{
+		Out:        os.Stderr,
+		TimeFormat: time.DateTime,
+	}
+
+	return zerolog.New(writer).Level(level)
+}
+
+// setUpFixtureRules reads the scheduling limits applied to games. FIXTURE_MIN_REST_DAYS
+// defaults to api.DefaultMinRestDays and 0 turns the rest-day check off.
+func setUpFixtureRules() service.FixtureRules {
+	minRestDays := api.DefaultMinRestDays
+	if viper.IsSet("FIXTURE_MIN_REST_DAYS") {
+		minRestDays = viper.GetInt("FIXTURE_MIN_REST_DAYS")
+	}
+
+	return service.FixtureRules{MinRestDays: minRestDays}
+}
+
 func setupWrapperDB(logger zerolog.Logger) *db_handler.DBWrapper {
 	logger.Info().Msg("setting up DBWrapper using db.Open...")
 
@@ -100,6 +137,10 @@ func setupWrapperDB(logger zerolog.Logger) *db_handler.DBWrapper {
 
 	logger.Info().Msg("database connection established and migrations verified")
 
+	if err := metrics.RegisterDBStats(sqlDB, viper.GetString("DB_DATABASE")); err != nil {
+		logger.Fatal().Err(err).Msg("failed to register database metrics")
+	}
+
 	return &db_handler.DBWrapper{
 		DB: sqlDB,
 	}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "gainline_api"
+
+var (
+	// HTTPRequestsTotal counts handled requests by route template, method and status code.
+	HTTPRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests handled.",
+		},
+		[]string{"method", "route", "status"},
+	)
+
+	// HTTPRequestDuration observes request latency by route template, method and status code.
+	HTTPRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "route", "status"},
+	)
+
+	// LiveWatchers tracks the number of open SSE streams on the live game endpoint.
+	LiveWatchers = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "live",
+			Name:      "active_watchers",
+			Help:      "Number of clients currently watching live game state.",
+		},
+	)
+)
+
+// RegisterDBStats exposes connection pool statistics from sql.DB.Stats.
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, dbName))
+}
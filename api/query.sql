@@ -5,54 +5,139 @@ INSERT INTO competitions (
     name,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    sport,
+    gender,
+    level,
+    country,
+    region,
+    governing_body,
+    logo_url,
+    primary_colour,
+    secondary_colour,
+    website
 )
 VALUES (
     @id,
     @name,
     @created_at,
     @updated_at,
-    @deleted_at
+    @deleted_at,
+    @sport,
+    @gender,
+    @level,
+    @country,
+    @region,
+    @governing_body,
+    @logo_url,
+    @primary_colour,
+    @secondary_colour,
+    @website
 );
 
 -- name: GetCompetition :one
 -- Fetch a competition by id, excluding soft-deleted competitions
-SELECT 
+SELECT
 	id,
 	name,
 	created_at,
 	updated_at,
-	deleted_at 
-FROM 
+	deleted_at,
+	sport,
+	gender,
+	level,
+	country,
+	region,
+	governing_body,
+	logo_url,
+	primary_colour,
+	secondary_colour,
+	website
+FROM
 	competitions
-WHERE 
+WHERE
 	id = @id
 AND
 	deleted_at IS NULL;
 
+-- name: LockCompetition :one
+-- Lock a competition row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	competitions
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
 -- name: GetCompetitions :many
--- Fetch competitions with limit/offset, excluding soft-deleted
+-- Fetch competitions matching the optional filters with limit/offset, excluding soft-deleted
 SELECT
     id,
     name,
     created_at,
     updated_at,
-    deleted_at
-FROM competitions
-WHERE deleted_at IS NULL
+    deleted_at,
+    sport,
+    gender,
+    level,
+    country,
+    region,
+    governing_body,
+    logo_url,
+    primary_colour,
+    secondary_colour,
+    website
+FROM
+    competitions
+WHERE
+    deleted_at IS NULL
+AND
+    (sqlc.narg('sport')::sport_code IS NULL OR sport = sqlc.narg('sport'))
+AND
+    (sqlc.narg('gender')::competition_gender IS NULL OR gender = sqlc.narg('gender'))
+AND
+    (sqlc.narg('level')::competition_level IS NULL OR level = sqlc.narg('level'))
+AND
+    (sqlc.narg('country')::text IS NULL OR country = sqlc.narg('country'))
 ORDER BY created_at DESC, id DESC
 LIMIT @page_limit
 OFFSET @page_offset;
 
 -- name: CountCompetitions :one
--- Get total competitions (excluding soft-deleted)
-SELECT COUNT(*) FROM competitions WHERE deleted_at IS NULL;
+-- Get total competitions matching the optional filters (excluding soft-deleted)
+SELECT
+    COUNT(*)
+FROM
+    competitions
+WHERE
+    deleted_at IS NULL
+AND
+    (sqlc.narg('sport')::sport_code IS NULL OR sport = sqlc.narg('sport'))
+AND
+    (sqlc.narg('gender')::competition_gender IS NULL OR gender = sqlc.narg('gender'))
+AND
+    (sqlc.narg('level')::competition_level IS NULL OR level = sqlc.narg('level'))
+AND
+    (sqlc.narg('country')::text IS NULL OR country = sqlc.narg('country'));
 
 -- name: UpdateCompetition :exec
 -- Update an existing competition by id
 UPDATE competitions
 SET
-	name = @name
+	name = @name,
+	sport = @sport,
+	gender = @gender,
+	level = @level,
+	country = @country,
+	region = @region,
+	governing_body = @governing_body,
+	logo_url = @logo_url,
+	primary_colour = @primary_colour,
+	secondary_colour = @secondary_colour,
+	website = @website
 WHERE
 	id = @id
 AND
@@ -77,7 +162,9 @@ INSERT INTO seasons (
 	end_date,
 	created_at,
 	updated_at,
-	deleted_at
+	deleted_at,
+	name,
+	year
 )
 VALUES (
 	@id,
@@ -86,7 +173,9 @@ VALUES (
 	@end_date,
 	@created_at,
 	@updated_at,
-	@deleted_at
+	@deleted_at,
+	@name,
+	@year
 );
 
 -- name: GetSeason :one
@@ -98,7 +187,9 @@ SELECT
 	end_date,
 	created_at,
 	updated_at,
-	deleted_at
+	deleted_at,
+	name,
+	year
 FROM
 	seasons
 WHERE
@@ -106,6 +197,18 @@ WHERE
 AND
 	deleted_at IS NULL;
 
+-- name: LockSeason :one
+-- Lock a season row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	seasons
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
 -- name: GetSeasons :many
 -- Fetch all seasons for a competition, excluding soft-deleted seasons
 SELECT
@@ -115,7 +218,9 @@ SELECT
 	end_date,
 	created_at,
 	updated_at,
-	deleted_at
+	deleted_at,
+	name,
+	year
 FROM
 	seasons
 WHERE
@@ -135,12 +240,14 @@ SELECT COUNT(*) FROM seasons WHERE competition_id = @competition_id AND deleted_
 UPDATE seasons
 SET
 	competition_id = @competition_id,
+	name = @name,
+	year = @year,
 	start_date = @start_date,
 	end_date = @end_date,
 	updated_at = @updated_at
 WHERE
 	id = @id
-AND	
+AND
 	deleted_at IS NULL;
 
 -- name: DeleteSeason :exec
@@ -172,7 +279,16 @@ INSERT INTO teams (
 	location,
 	created_at,
 	updated_at,
-	deleted_at
+	deleted_at,
+	home_venue_id,
+	short_name,
+	logo_url,
+	crest_url,
+	primary_colour,
+	secondary_colour,
+	founded_year,
+	social_handles,
+	external_refs
 )
 VALUES (
 	@id,
@@ -181,7 +297,16 @@ VALUES (
 	@location,
 	@created_at,
 	@updated_at,
-	@deleted_at
+	@deleted_at,
+	@home_venue_id,
+	@short_name,
+	@logo_url,
+	@crest_url,
+	@primary_colour,
+	@secondary_colour,
+	@founded_year,
+	@social_handles,
+	@external_refs
 );
 
 -- name: GetTeam :one
@@ -193,7 +318,16 @@ SELECT
 	location,
 	created_at,
 	updated_at,
-	deleted_at
+	deleted_at,
+	home_venue_id,
+	short_name,
+	logo_url,
+	crest_url,
+	primary_colour,
+	secondary_colour,
+	founded_year,
+	social_handles,
+	external_refs
 FROM
 	teams
 WHERE
@@ -201,8 +335,20 @@ WHERE
 AND
 	deleted_at IS NULL;	
 
+-- name: LockTeam :one
+-- Lock a team row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	teams
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
 -- name: GetTeams :many
--- Fetch teams with pagination, excluding soft-deleted teams
+-- Fetch teams with pagination whose name, abbreviation or any alias matches the optional pattern, excluding soft-deleted teams
 SELECT
 	id,
 	name,
@@ -210,21 +356,57 @@ SELECT
 	location,
 	created_at,
 	updated_at,
-	deleted_at
+	deleted_at,
+	home_venue_id,
+	short_name,
+	logo_url,
+	crest_url,
+	primary_colour,
+	secondary_colour,
+	founded_year,
+	social_handles,
+	external_refs
 FROM
 	teams
 WHERE
 	deleted_at IS NULL
+AND
+	(
+		sqlc.narg('name_pattern')::text IS NULL
+		OR name ILIKE sqlc.narg('name_pattern')
+		OR abbreviation ILIKE sqlc.narg('name_pattern')
+		OR EXISTS (
+			SELECT 1
+			FROM team_aliases a
+			WHERE a.team_id = teams.id
+			AND a.deleted_at IS NULL
+			AND (a.name ILIKE sqlc.narg('name_pattern') OR a.abbreviation ILIKE sqlc.narg('name_pattern'))
+		)
+	)
 ORDER BY
 	name ASC
 LIMIT @page_limit
 OFFSET @page_offset;
 
 -- name: CountTeams :one
--- Get total teams (excluding soft-deleted)
+-- Get total teams matching the optional pattern (excluding soft-deleted)
 SELECT COUNT(*)
 FROM teams
-WHERE deleted_at IS NULL;
+WHERE
+	deleted_at IS NULL
+AND
+	(
+		sqlc.narg('name_pattern')::text IS NULL
+		OR name ILIKE sqlc.narg('name_pattern')
+		OR abbreviation ILIKE sqlc.narg('name_pattern')
+		OR EXISTS (
+			SELECT 1
+			FROM team_aliases a
+			WHERE a.team_id = teams.id
+			AND a.deleted_at IS NULL
+			AND (a.name ILIKE sqlc.narg('name_pattern') OR a.abbreviation ILIKE sqlc.narg('name_pattern'))
+		)
+	);
 
 -- name: UpdateTeam :exec
 -- Update an existing team by id
@@ -233,6 +415,15 @@ SET
 	name = @name,
 	abbreviation = @abbreviation,
 	location = @location,
+	home_venue_id = @home_venue_id,
+	short_name = @short_name,
+	logo_url = @logo_url,
+	crest_url = @crest_url,
+	primary_colour = @primary_colour,
+	secondary_colour = @secondary_colour,
+	founded_year = @founded_year,
+	social_handles = @social_handles,
+	external_refs = @external_refs,
 	updated_at = @updated_at
 WHERE
 	id = @id
@@ -276,7 +467,8 @@ SELECT
   season_id,
   created_at,
   updated_at,
-  deleted_at
+  deleted_at,
+  home_venue_id
 FROM
   season_teams
 WHERE
@@ -319,6 +511,60 @@ WHERE
 AND
     deleted_at IS NULL;
 
+-- name: UpdateSeasonTeamHomeVenue :exec
+-- Set or clear the home venue a team uses for one season
+UPDATE season_teams
+SET
+  home_venue_id = @home_venue_id,
+  updated_at = @updated_at
+WHERE
+  season_id = @season_id
+AND
+  team_id = @team_id
+AND
+  deleted_at IS NULL;
+
+-- name: UpsertSeasonFinals :exec
+-- Set the finals format for a season, replacing any existing one
+INSERT INTO season_finals (
+  season_id,
+  format,
+  round_interval_days,
+  created_at,
+  updated_at
+)
+VALUES (
+  @season_id,
+  @format,
+  @round_interval_days,
+  @created_at,
+  @updated_at
+)
+ON CONFLICT (season_id) DO UPDATE
+SET
+  format = EXCLUDED.format,
+  round_interval_days = EXCLUDED.round_interval_days,
+  updated_at = EXCLUDED.updated_at;
+
+-- name: GetSeasonFinals :one
+-- Fetch the finals format for a season
+SELECT
+  season_id,
+  format,
+  round_interval_days,
+  created_at,
+  updated_at
+FROM
+  season_finals
+WHERE
+  season_id = @season_id;
+
+-- name: DeleteSeasonFinals :exec
+-- Remove the finals format for a season
+DELETE FROM season_finals
+WHERE
+  season_id = @season_id;
+
 
 -- name: CreateStage :exec
 -- Insert a new stage into the database
@@ -424,7 +670,9 @@ INSERT INTO games (
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    venue_id,
+    neutral_venue
 )
 VALUES (
     @id,
@@ -438,7 +686,9 @@ VALUES (
     @status,
     @created_at,
     @updated_at,
-    @deleted_at
+    @deleted_at,
+    @venue_id,
+    @neutral_venue
 );
 
 -- name: GetGame :one
@@ -455,7 +705,9 @@ SELECT
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    venue_id,
+    neutral_venue
 FROM
     games
 WHERE
@@ -463,6 +715,43 @@ WHERE
 AND
     deleted_at IS NULL;
 
+-- name: LockGame :one
+-- Lock a game row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	games
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetAllGamesBySeasonID :many
+-- Fetch every game in a season for fixture checks, excluding soft-deleted games
+SELECT
+    id,
+    season_id,
+    stage_id,
+    date,
+    home_team_id,
+    away_team_id,
+    home_score,
+    away_score,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    venue_id,
+    neutral_venue
+FROM
+    games
+WHERE
+    season_id = @season_id
+AND
+    deleted_at IS NULL
+ORDER BY date ASC, id ASC;
+
 -- name: GetGamesByStageID :many
 -- Fetch all games for a stage, excluding soft-deleted games
 SELECT
@@ -477,7 +766,9 @@ SELECT
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    venue_id,
+    neutral_venue
 FROM
     games
 WHERE
@@ -488,9 +779,355 @@ AND
     deleted_at IS NULL
 ORDER BY date ASC, id ASC;
 
+-- name: GetGameDetails :many
+-- Fetch the teams as named on the game date, stage and venue timezone for each of the given games, used to expand and localize game responses
+SELECT
+    g.id,
+    ht.id AS home_team_id,
+    COALESCE(ha.name, ht.name)::text AS home_team_name,
+    COALESCE(ha.abbreviation, ht.abbreviation)::text AS home_team_abbreviation,
+    COALESCE(ha.logo_url, ht.logo_url) AS home_team_logo_url,
+    ht.short_name AS home_team_short_name,
+    ht.primary_colour AS home_team_primary_colour,
+    ht.secondary_colour AS home_team_secondary_colour,
+    awt.id AS away_team_id,
+    COALESCE(aa.name, awt.name)::text AS away_team_name,
+    COALESCE(aa.abbreviation, awt.abbreviation)::text AS away_team_abbreviation,
+    COALESCE(aa.logo_url, awt.logo_url) AS away_team_logo_url,
+    awt.short_name AS away_team_short_name,
+    awt.primary_colour AS away_team_primary_colour,
+    awt.secondary_colour AS away_team_secondary_colour,
+    st.id AS stage_id,
+    st.name AS stage_name,
+    st.stage_type,
+    st.order_index AS stage_order_index,
+    v.timezone AS venue_timezone
+FROM
+    games g
+JOIN
+    teams ht ON ht.id = g.home_team_id
+JOIN
+    teams awt ON awt.id = g.away_team_id
+JOIN
+    stages st ON st.id = g.stage_id
+LEFT JOIN
+    team_aliases ha ON ha.team_id = g.home_team_id
+    AND ha.deleted_at IS NULL
+    AND ha.valid_from <= g.date
+    AND (ha.valid_to IS NULL OR g.date < ha.valid_to + 1)
+LEFT JOIN
+    team_aliases aa ON aa.team_id = g.away_team_id
+    AND aa.deleted_at IS NULL
+    AND aa.valid_from <= g.date
+    AND (aa.valid_to IS NULL OR g.date < aa.valid_to + 1)
+LEFT JOIN
+    venues v ON v.id = g.venue_id
+WHERE
+    g.id = ANY(@game_ids::uuid[]);
+
+-- name: GetGamesBySeasonID :many
+-- Fetch a page of games for a season matching the optional filters, excluding soft-deleted games
+SELECT
+    id,
+    season_id,
+    stage_id,
+    date,
+    home_team_id,
+    away_team_id,
+    home_score,
+    away_score,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    venue_id,
+    neutral_venue
+FROM
+    games
+WHERE
+    season_id = @season_id
+AND
+    (sqlc.narg('stage_id')::uuid IS NULL OR stage_id = sqlc.narg('stage_id'))
+AND
+    (sqlc.narg('team_id')::uuid IS NULL OR home_team_id = sqlc.narg('team_id') OR away_team_id = sqlc.narg('team_id'))
+AND
+    (sqlc.narg('status')::game_status IS NULL OR status = sqlc.narg('status'))
+AND
+    (sqlc.narg('date_from')::timestamptz IS NULL OR date >= sqlc.narg('date_from'))
+AND
+    (sqlc.narg('date_to')::timestamptz IS NULL OR date <= sqlc.narg('date_to'))
+AND
+    deleted_at IS NULL
+ORDER BY
+    CASE WHEN @sort_desc::boolean THEN date END DESC,
+    date ASC,
+    id ASC
+LIMIT @page_limit
+OFFSET @page_offset;
+
 -- name: CountGames :one
--- Get total games for a season (excluding soft-deleted)
-SELECT COUNT(*) FROM games WHERE season_id = @season_id AND deleted_at IS NULL;
+-- Get total games for a season matching the optional filters (excluding soft-deleted)
+SELECT
+    COUNT(*)
+FROM
+    games
+WHERE
+    season_id = @season_id
+AND
+    (sqlc.narg('stage_id')::uuid IS NULL OR stage_id = sqlc.narg('stage_id'))
+AND
+    (sqlc.narg('team_id')::uuid IS NULL OR home_team_id = sqlc.narg('team_id') OR away_team_id = sqlc.narg('team_id'))
+AND
+    (sqlc.narg('status')::game_status IS NULL OR status = sqlc.narg('status'))
+AND
+    (sqlc.narg('date_from')::timestamptz IS NULL OR date >= sqlc.narg('date_from'))
+AND
+    (sqlc.narg('date_to')::timestamptz IS NULL OR date <= sqlc.narg('date_to'))
+AND
+    deleted_at IS NULL;
+
+-- name: GetGameFeed :many
+-- Fetch a page of games across competitions with competition, stage and team details as named on the game date, excluding soft-deleted games
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.venue_id,
+    g.neutral_venue,
+    v.timezone AS venue_timezone,
+    s.competition_id,
+    c.name AS competition_name,
+    st.name AS stage_name,
+    COALESCE(ha.name, ht.name)::text AS home_team_name,
+    COALESCE(ha.abbreviation, ht.abbreviation)::text AS home_team_abbreviation,
+    COALESCE(ha.logo_url, ht.logo_url) AS home_team_logo_url,
+    ht.short_name AS home_team_short_name,
+    ht.primary_colour AS home_team_primary_colour,
+    ht.secondary_colour AS home_team_secondary_colour,
+    COALESCE(aa.name, awt.name)::text AS away_team_name,
+    COALESCE(aa.abbreviation, awt.abbreviation)::text AS away_team_abbreviation,
+    COALESCE(aa.logo_url, awt.logo_url) AS away_team_logo_url,
+    awt.short_name AS away_team_short_name,
+    awt.primary_colour AS away_team_primary_colour,
+    awt.secondary_colour AS away_team_secondary_colour
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+JOIN
+    stages st ON st.id = g.stage_id
+JOIN
+    teams ht ON ht.id = g.home_team_id
+JOIN
+    teams awt ON awt.id = g.away_team_id
+LEFT JOIN
+    team_aliases ha ON ha.team_id = g.home_team_id
+    AND ha.deleted_at IS NULL
+    AND ha.valid_from <= g.date
+    AND (ha.valid_to IS NULL OR g.date < ha.valid_to + 1)
+LEFT JOIN
+    team_aliases aa ON aa.team_id = g.away_team_id
+    AND aa.deleted_at IS NULL
+    AND aa.valid_from <= g.date
+    AND (aa.valid_to IS NULL OR g.date < aa.valid_to + 1)
+LEFT JOIN
+    venues v ON v.id = g.venue_id
+WHERE
+    g.deleted_at IS NULL
+AND
+    (sqlc.narg('competition_id')::uuid IS NULL OR s.competition_id = sqlc.narg('competition_id'))
+AND
+    (sqlc.narg('stage_id')::uuid IS NULL OR g.stage_id = sqlc.narg('stage_id'))
+AND
+    (sqlc.narg('team_id')::uuid IS NULL OR g.home_team_id = sqlc.narg('team_id') OR g.away_team_id = sqlc.narg('team_id'))
+AND
+    (sqlc.narg('status')::game_status IS NULL OR g.status = sqlc.narg('status'))
+AND
+    (sqlc.narg('date_from')::timestamptz IS NULL OR g.date >= sqlc.narg('date_from'))
+AND
+    (sqlc.narg('date_to')::timestamptz IS NULL OR g.date <= sqlc.narg('date_to'))
+ORDER BY
+    CASE WHEN @sort_desc::boolean THEN g.date END DESC,
+    g.date ASC,
+    g.id ASC
+LIMIT @page_limit
+OFFSET @page_offset;
+
+-- name: CountGameFeed :one
+-- Get total games across competitions matching the optional filters (excluding soft-deleted)
+SELECT
+    COUNT(*)
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+JOIN
+    stages st ON st.id = g.stage_id
+JOIN
+    teams ht ON ht.id = g.home_team_id
+JOIN
+    teams awt ON awt.id = g.away_team_id
+WHERE
+    g.deleted_at IS NULL
+AND
+    (sqlc.narg('competition_id')::uuid IS NULL OR s.competition_id = sqlc.narg('competition_id'))
+AND
+    (sqlc.narg('stage_id')::uuid IS NULL OR g.stage_id = sqlc.narg('stage_id'))
+AND
+    (sqlc.narg('team_id')::uuid IS NULL OR g.home_team_id = sqlc.narg('team_id') OR g.away_team_id = sqlc.narg('team_id'))
+AND
+    (sqlc.narg('status')::game_status IS NULL OR g.status = sqlc.narg('status'))
+AND
+    (sqlc.narg('date_from')::timestamptz IS NULL OR g.date >= sqlc.narg('date_from'))
+AND
+    (sqlc.narg('date_to')::timestamptz IS NULL OR g.date <= sqlc.narg('date_to'));
+
+-- name: GetGamesByTeamID :many
+-- Fetch a page of games a team played in across all seasons matching the optional filters, excluding soft-deleted games
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.created_at,
+    g.updated_at,
+    g.deleted_at,
+    g.venue_id,
+    g.neutral_venue
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (g.home_team_id = @team_id OR g.away_team_id = @team_id)
+AND
+    (sqlc.narg('season_id')::uuid IS NULL OR g.season_id = sqlc.narg('season_id'))
+AND
+    (sqlc.narg('status')::game_status IS NULL OR g.status = sqlc.narg('status'))
+AND
+    g.deleted_at IS NULL
+ORDER BY
+    CASE WHEN @sort_desc::boolean THEN g.date END DESC,
+    g.date ASC,
+    g.id ASC
+LIMIT @page_limit
+OFFSET @page_offset;
+
+-- name: CountGamesByTeamID :one
+-- Get total games a team played in across all seasons matching the optional filters (excluding soft-deleted)
+SELECT
+    COUNT(*)
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (g.home_team_id = @team_id OR g.away_team_id = @team_id)
+AND
+    (sqlc.narg('season_id')::uuid IS NULL OR g.season_id = sqlc.narg('season_id'))
+AND
+    (sqlc.narg('status')::game_status IS NULL OR g.status = sqlc.narg('status'))
+AND
+    g.deleted_at IS NULL;
+
+-- name: GetTeamResults :many
+-- Fetch every finished game with a score for a team, newest first, optionally limited to a season
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.created_at,
+    g.updated_at,
+    g.deleted_at,
+    g.venue_id,
+    g.neutral_venue
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (g.home_team_id = @team_id OR g.away_team_id = @team_id)
+AND
+    (sqlc.narg('season_id')::uuid IS NULL OR g.season_id = sqlc.narg('season_id'))
+AND
+    g.status = 'finished'
+AND
+    g.home_score IS NOT NULL
+AND
+    g.away_score IS NOT NULL
+AND
+    g.deleted_at IS NULL
+ORDER BY
+    g.date DESC,
+    g.id DESC;
+
+-- name: GetHeadToHeadGames :many
+-- Fetch every finished game with a score between two teams, newest first
+SELECT
+    g.id,
+    g.season_id,
+    g.stage_id,
+    g.date,
+    g.home_team_id,
+    g.away_team_id,
+    g.home_score,
+    g.away_score,
+    g.status,
+    g.created_at,
+    g.updated_at,
+    g.deleted_at,
+    g.venue_id,
+    g.neutral_venue
+FROM
+    games g
+JOIN
+    seasons s ON s.id = g.season_id AND s.deleted_at IS NULL
+JOIN
+    competitions c ON c.id = s.competition_id AND c.deleted_at IS NULL
+WHERE
+    (
+        (g.home_team_id = @team_id AND g.away_team_id = @opponent_id)
+        OR
+        (g.home_team_id = @opponent_id AND g.away_team_id = @team_id)
+    )
+AND
+    g.status = 'finished'
+AND
+    g.home_score IS NOT NULL
+AND
+    g.away_score IS NOT NULL
+AND
+    g.deleted_at IS NULL
+ORDER BY
+    g.date DESC,
+    g.id DESC;
 
 -- name: UpdateGame :exec
 -- Update an existing game by id
@@ -503,6 +1140,8 @@ SET
     home_score = @home_score,
     away_score = @away_score,
     status = @status,
+    venue_id = @venue_id,
+    neutral_venue = @neutral_venue,
     updated_at = @updated_at
 WHERE
     id = @id
@@ -543,3 +1182,967 @@ WHERE
   season_id = @season_id
 AND
   deleted_at IS NULL;
+
+-- name: CreateVenue :exec
+-- Insert a new venue into the database
+INSERT INTO venues (
+	id,
+	name,
+	city,
+	country,
+	timezone,
+	capacity,
+	latitude,
+	longitude,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@name,
+	@city,
+	@country,
+	@timezone,
+	@capacity,
+	@latitude,
+	@longitude,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetVenue :one
+-- Fetch a venue by id, excluding soft-deleted venues
+SELECT
+	id,
+	name,
+	city,
+	country,
+	timezone,
+	capacity,
+	latitude,
+	longitude,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	venues
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: LockVenue :one
+-- Lock a venue row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	venues
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetVenues :many
+-- Fetch venues with pagination, excluding soft-deleted venues
+SELECT
+	id,
+	name,
+	city,
+	country,
+	timezone,
+	capacity,
+	latitude,
+	longitude,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	venues
+WHERE
+	deleted_at IS NULL
+ORDER BY
+	name ASC,
+	city ASC
+LIMIT @page_limit
+OFFSET @page_offset;
+
+-- name: CountVenues :one
+-- Get total venues (excluding soft-deleted)
+SELECT COUNT(*)
+FROM venues
+WHERE deleted_at IS NULL;
+
+-- name: UpdateVenue :exec
+-- Update an existing venue by id
+UPDATE venues
+SET
+	name = @name,
+	city = @city,
+	country = @country,
+	timezone = @timezone,
+	capacity = @capacity,
+	latitude = @latitude,
+	longitude = @longitude,
+	updated_at = @updated_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteVenue :exec
+-- Soft delete a venue
+UPDATE venues
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: CountGamesByVenueID :one
+-- Get total games played at a venue (excluding soft-deleted)
+SELECT COUNT(*)
+FROM games
+WHERE venue_id = @venue_id
+AND deleted_at IS NULL;
+
+-- name: ClearTeamHomeVenues :exec
+-- Clear the default home venue of every team using the given venue
+UPDATE teams
+SET
+	home_venue_id = NULL,
+	updated_at = @updated_at
+WHERE
+	home_venue_id = @venue_id;
+
+-- name: ClearSeasonTeamHomeVenues :exec
+-- Clear every season home venue override using the given venue
+UPDATE season_teams
+SET
+	home_venue_id = NULL,
+	updated_at = @updated_at
+WHERE
+	home_venue_id = @venue_id;
+
+-- name: CreatePlayer :exec
+-- Insert a new player into the database
+INSERT INTO players (
+	id,
+	team_id,
+	name,
+	date_of_birth,
+	position,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@team_id,
+	@name,
+	@date_of_birth,
+	@position,
+	@nationality,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetPlayer :one
+-- Fetch a player by id, excluding soft-deleted players
+SELECT
+	id,
+	team_id,
+	name,
+	date_of_birth,
+	position,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	players
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: LockPlayer :one
+-- Lock a player row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	players
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetPlayersByTeamID :many
+-- Fetch a team's players with pagination, excluding soft-deleted players
+SELECT
+	id,
+	team_id,
+	name,
+	date_of_birth,
+	position,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	players
+WHERE
+	team_id = @team_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	name ASC
+LIMIT @page_limit
+OFFSET @page_offset;
+
+-- name: CountPlayersByTeamID :one
+-- Get total players in a team (excluding soft-deleted)
+SELECT COUNT(*)
+FROM players
+WHERE team_id = @team_id
+AND deleted_at IS NULL;
+
+-- name: UpdatePlayer :exec
+-- Update an existing player by id
+UPDATE players
+SET
+	name = @name,
+	date_of_birth = @date_of_birth,
+	position = @position,
+	nationality = @nationality,
+	updated_at = @updated_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeletePlayer :exec
+-- Soft delete a player
+UPDATE players
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: CreateSeasonTeamPlayer :exec
+-- Name a player in a team's squad for a season
+INSERT INTO season_team_players (
+	id,
+	season_team_id,
+	player_id,
+	jersey_number,
+	active_from,
+	active_to,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@season_team_id,
+	@player_id,
+	@jersey_number,
+	@active_from,
+	@active_to,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetSeasonTeamPlayers :many
+-- Fetch a team's squad for a season, ordered by jersey number
+SELECT
+	id,
+	season_team_id,
+	player_id,
+	jersey_number,
+	active_from,
+	active_to,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	season_team_players
+WHERE
+	season_team_id = @season_team_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	jersey_number ASC,
+	active_from ASC NULLS FIRST;
+
+-- name: UpdateSeasonTeamPlayer :exec
+-- Update a squad member's jersey number and active dates
+UPDATE season_team_players
+SET
+	jersey_number = @jersey_number,
+	active_from = @active_from,
+	active_to = @active_to,
+	updated_at = @updated_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteSeasonTeamPlayer :exec
+-- Soft delete a squad member
+UPDATE season_team_players
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteSeasonTeamPlayersByPlayerID :exec
+-- Soft delete every squad entry for a player
+UPDATE season_team_players
+SET
+	deleted_at = @deleted_at
+WHERE
+	player_id = @player_id
+AND
+	deleted_at IS NULL;
+
+-- name: CreateGameLineupPlayer :exec
+-- Name a player on a side's team sheet for a game
+INSERT INTO game_lineup_players (
+	id,
+	game_id,
+	team_id,
+	player_id,
+	shirt_number,
+	captain,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@game_id,
+	@team_id,
+	@player_id,
+	@shirt_number,
+	@captain,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetGameLineupPlayers :many
+-- Fetch both team sheets for a game with their players, ordered by shirt number
+SELECT
+	glp.id,
+	glp.game_id,
+	glp.team_id,
+	glp.player_id,
+	glp.shirt_number,
+	glp.captain,
+	glp.created_at,
+	glp.updated_at,
+	glp.deleted_at,
+	p.team_id AS player_team_id,
+	p.name AS player_name,
+	p.date_of_birth AS player_date_of_birth,
+	p.position AS player_position,
+	p.nationality AS player_nationality,
+	p.created_at AS player_created_at,
+	p.updated_at AS player_updated_at,
+	p.deleted_at AS player_deleted_at
+FROM
+	game_lineup_players glp
+JOIN
+	players p ON p.id = glp.player_id
+WHERE
+	glp.game_id = @game_id
+AND
+	glp.deleted_at IS NULL
+ORDER BY
+	glp.team_id ASC,
+	glp.shirt_number ASC;
+
+-- name: DeleteGameLineupPlayers :exec
+-- Soft delete a side's team sheet for a game
+UPDATE game_lineup_players
+SET
+	deleted_at = @deleted_at
+WHERE
+	game_id = @game_id
+AND
+	team_id = @team_id
+AND
+	deleted_at IS NULL;
+
+-- name: CreateGameReplacement :exec
+-- Record a replacement made during a game
+INSERT INTO game_replacements (
+	id,
+	game_id,
+	team_id,
+	player_off_id,
+	player_on_id,
+	minute,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@game_id,
+	@team_id,
+	@player_off_id,
+	@player_on_id,
+	@minute,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetGameReplacements :many
+-- Fetch the replacements made in a game, in the order they were made
+SELECT
+	id,
+	game_id,
+	team_id,
+	player_off_id,
+	player_on_id,
+	minute,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	game_replacements
+WHERE
+	game_id = @game_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	minute ASC,
+	created_at ASC;
+
+-- name: DeleteGameReplacement :exec
+-- Soft delete a replacement
+UPDATE game_replacements
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: CreateGameEvent :exec
+-- Record a scoring or disciplinary event in a game
+INSERT INTO game_events (
+	id,
+	game_id,
+	team_id,
+	player_id,
+	event_type,
+	minute,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@game_id,
+	@team_id,
+	@player_id,
+	@event_type,
+	@minute,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetGameEvents :many
+-- Fetch the events in a game, in the order they happened
+SELECT
+	id,
+	game_id,
+	team_id,
+	player_id,
+	event_type,
+	minute,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	game_events
+WHERE
+	game_id = @game_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	minute ASC,
+	created_at ASC;
+
+-- name: DeleteGameEvent :exec
+-- Soft delete a game event
+UPDATE game_events
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: GetGamePlayerStats :many
+-- Fetch per-player stats for a finished game, top scorers first
+SELECT
+	game_id,
+	season_id,
+	team_id,
+	player_id,
+	appeared,
+	minutes,
+	tries,
+	conversions,
+	penalty_goals,
+	drop_goals,
+	yellow_cards,
+	red_cards,
+	points
+FROM
+	player_game_stats
+WHERE
+	game_id = @game_id
+ORDER BY
+	team_id ASC,
+	points DESC,
+	tries DESC;
+
+-- name: GetPlayerSeasonStats :many
+-- Sum a player's stats per season and team across every competition, oldest season first
+SELECT
+	pgs.season_id,
+	s.competition_id,
+	pgs.team_id,
+	COUNT(*) FILTER (WHERE pgs.appeared)::INT AS appearances,
+	SUM(pgs.minutes)::INT AS minutes,
+	SUM(pgs.tries)::INT AS tries,
+	SUM(pgs.conversions)::INT AS conversions,
+	SUM(pgs.penalty_goals)::INT AS penalty_goals,
+	SUM(pgs.drop_goals)::INT AS drop_goals,
+	SUM(pgs.yellow_cards)::INT AS yellow_cards,
+	SUM(pgs.red_cards)::INT AS red_cards,
+	SUM(pgs.points)::INT AS points
+FROM
+	player_game_stats pgs
+JOIN
+	seasons s ON s.id = pgs.season_id
+WHERE
+	pgs.player_id = @player_id
+GROUP BY
+	pgs.season_id,
+	s.competition_id,
+	s.start_date,
+	pgs.team_id
+ORDER BY
+	s.start_date ASC;
+
+-- name: GetSeasonLeaderboard :many
+-- Rank a season's players by tries or points
+SELECT
+	pgs.player_id,
+	p.name AS player_name,
+	pgs.team_id,
+	COUNT(*) FILTER (WHERE pgs.appeared)::INT AS appearances,
+	SUM(pgs.tries)::INT AS tries,
+	SUM(pgs.points)::INT AS points
+FROM
+	player_game_stats pgs
+JOIN
+	players p ON p.id = pgs.player_id
+WHERE
+	pgs.season_id = @season_id
+GROUP BY
+	pgs.player_id,
+	p.name,
+	pgs.team_id
+HAVING
+	CASE WHEN @stat::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END > 0
+ORDER BY
+	CASE WHEN @stat::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END DESC,
+	p.name ASC
+LIMIT @page_limit;
+
+-- name: GetCompetitionLeaderboard :many
+-- Rank a competition's players by tries or points across all of its seasons
+SELECT
+	pgs.player_id,
+	p.name AS player_name,
+	COUNT(*) FILTER (WHERE pgs.appeared)::INT AS appearances,
+	SUM(pgs.tries)::INT AS tries,
+	SUM(pgs.points)::INT AS points
+FROM
+	player_game_stats pgs
+JOIN
+	seasons s ON s.id = pgs.season_id
+JOIN
+	players p ON p.id = pgs.player_id
+WHERE
+	s.competition_id = @competition_id
+AND
+	s.deleted_at IS NULL
+GROUP BY
+	pgs.player_id,
+	p.name
+HAVING
+	CASE WHEN @stat::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END > 0
+ORDER BY
+	CASE WHEN @stat::text = 'tries' THEN SUM(pgs.tries) ELSE SUM(pgs.points) END DESC,
+	p.name ASC
+LIMIT @page_limit;
+
+-- name: CreateOfficial :exec
+-- Insert a new match official into the database
+INSERT INTO officials (
+	id,
+	name,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@name,
+	@nationality,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetOfficial :one
+-- Fetch an official by id, excluding soft-deleted officials
+SELECT
+	id,
+	name,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	officials
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: LockOfficial :one
+-- Lock an official row for the rest of the transaction and return its updated_at
+SELECT
+	updated_at
+FROM
+	officials
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetOfficials :many
+-- Fetch officials with pagination, excluding soft-deleted officials
+SELECT
+	id,
+	name,
+	nationality,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	officials
+WHERE
+	deleted_at IS NULL
+ORDER BY
+	name ASC
+LIMIT @page_limit
+OFFSET @page_offset;
+
+-- name: CountOfficials :one
+-- Get total officials (excluding soft-deleted)
+SELECT COUNT(*)
+FROM officials
+WHERE deleted_at IS NULL;
+
+-- name: UpdateOfficial :exec
+-- Update an existing official by id
+UPDATE officials
+SET
+	name = @name,
+	nationality = @nationality,
+	updated_at = @updated_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteOfficial :exec
+-- Soft delete an official
+UPDATE officials
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: CreateGameOfficial :exec
+-- Appoint an official to a game
+INSERT INTO game_officials (
+	id,
+	game_id,
+	official_id,
+	role,
+	created_at,
+	updated_at,
+	deleted_at
+)
+VALUES (
+	@id,
+	@game_id,
+	@official_id,
+	@role,
+	@created_at,
+	@updated_at,
+	@deleted_at
+);
+
+-- name: GetGameOfficials :many
+-- Fetch the officials appointed to a game, referee first
+SELECT
+	id,
+	game_id,
+	official_id,
+	role,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	game_officials
+WHERE
+	game_id = @game_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	role ASC,
+	created_at ASC;
+
+-- name: UpdateGameOfficialRole :exec
+-- Change the role an official has in a game
+UPDATE game_officials
+SET
+	role = @role,
+	updated_at = @updated_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteGameOfficial :exec
+-- Soft delete an official's appointment to a game
+UPDATE game_officials
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteGameOfficialsByOfficialID :exec
+-- Soft delete every appointment for an official
+UPDATE game_officials
+SET
+	deleted_at = @deleted_at
+WHERE
+	official_id = @official_id
+AND
+	deleted_at IS NULL;
+
+-- name: GetOfficialAppointments :many
+-- Fetch every game an official is appointed to, in kickoff order
+SELECT
+	gof.id,
+	gof.role,
+	g.id AS game_id,
+	s.competition_id,
+	g.season_id,
+	g.date,
+	g.home_team_id,
+	g.away_team_id,
+	g.status
+FROM
+	game_officials gof
+JOIN
+	games g ON g.id = gof.game_id
+JOIN
+	seasons s ON s.id = g.season_id
+WHERE
+	gof.official_id = @official_id
+AND
+	gof.deleted_at IS NULL
+AND
+	g.deleted_at IS NULL
+ORDER BY
+	g.date ASC;
+
+-- name: GetCurrentSeason :one
+-- Fetch the season in progress at a time, falling back to the next season to start and then the last to finish
+SELECT
+	id,
+	competition_id,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	deleted_at,
+	name,
+	year
+FROM
+	seasons
+WHERE
+	competition_id = @competition_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	CASE
+		WHEN start_date <= @at AND end_date >= @at THEN 0
+		WHEN start_date > @at THEN 1
+		ELSE 2
+	END,
+	CASE WHEN start_date > @at THEN start_date END ASC,
+	end_date DESC
+LIMIT 1;
+
+-- name: CreateTeamAlias :exec
+-- Insert a new team alias into the database
+INSERT INTO team_aliases (
+    id,
+    team_id,
+    name,
+    abbreviation,
+    logo_url,
+    valid_from,
+    valid_to,
+    created_at,
+    updated_at,
+    deleted_at
+)
+VALUES (
+    @id,
+    @team_id,
+    @name,
+    @abbreviation,
+    @logo_url,
+    @valid_from,
+    @valid_to,
+    @created_at,
+    @updated_at,
+    @deleted_at
+);
+
+-- name: GetTeamAlias :one
+-- Fetch a team alias by id, excluding soft-deleted aliases
+SELECT
+	id,
+	team_id,
+	name,
+	abbreviation,
+	logo_url,
+	valid_from,
+	valid_to,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	team_aliases
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: GetTeamAliasesByTeamID :many
+-- Fetch every alias of a team oldest first, excluding soft-deleted aliases
+SELECT
+	id,
+	team_id,
+	name,
+	abbreviation,
+	logo_url,
+	valid_from,
+	valid_to,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	team_aliases
+WHERE
+	team_id = @team_id
+AND
+	deleted_at IS NULL
+ORDER BY
+	valid_from ASC,
+	id ASC;
+
+-- name: GetTeamAliasesAt :many
+-- Fetch the aliases of the given teams that are valid on a date, excluding soft-deleted aliases
+SELECT
+	id,
+	team_id,
+	name,
+	abbreviation,
+	logo_url,
+	valid_from,
+	valid_to,
+	created_at,
+	updated_at,
+	deleted_at
+FROM
+	team_aliases
+WHERE
+	team_id = ANY(@team_ids::uuid[])
+AND
+	valid_from <= @at::date
+AND
+	(valid_to IS NULL OR valid_to >= @at::date)
+AND
+	deleted_at IS NULL;
+
+-- name: UpdateTeamAlias :exec
+-- Update an existing team alias by id
+UPDATE team_aliases
+SET
+	name = @name,
+	abbreviation = @abbreviation,
+	logo_url = @logo_url,
+	valid_from = @valid_from,
+	valid_to = @valid_to,
+	updated_at = @updated_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
+
+-- name: DeleteTeamAlias :exec
+-- Soft delete a team alias
+UPDATE team_aliases
+SET
+	deleted_at = @deleted_at
+WHERE
+	id = @id
+AND
+	deleted_at IS NULL;
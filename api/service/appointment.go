@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// AppointmentWindow is how far apart two kickoffs must be for one official to work
+// both games. Kickoffs closer together than this overlap.
+const AppointmentWindow = 3 * time.Hour
+
+// officialsPerRole is how many officials a game has in each role.
+var officialsPerRole = map[db.OfficialRole]int{
+	db.OfficialRoleReferee:          1,
+	db.OfficialRoleAssistantReferee: 2,
+	db.OfficialRoleTmo:              1,
+}
+
+// AppointmentService defines the contract for appointing officials to games.
+type AppointmentService interface {
+	GetAll(ctx context.Context, gameID uuid.UUID) ([]GameOfficial, error)
+	Set(ctx context.Context, req *api.GameOfficialRequest, gameID, officialID uuid.UUID) (GameOfficial, bool, error)
+	Remove(ctx context.Context, gameID, officialID uuid.UUID) error
+}
+
+// appointmentService is the concrete implementation backed by db_handler.DB.
+type appointmentService struct {
+	db db_handler.DB
+}
+
+// NewAppointmentService returns a new AppointmentService backed by db_handler.DB.
+func NewAppointmentService(db db_handler.DB) AppointmentService {
+	return &appointmentService{db: db}
+}
+
+// GameOfficial is an official's appointment to a game together with the official.
+type GameOfficial struct {
+	Appointment db.GameOfficial
+	Official    db.Official
+}
+
+func ToGameOfficialResponse(g GameOfficial) api.GameOfficialResponse {
+	return api.ToGameOfficialResponse(g.Appointment, g.Official)
+}
+
+func (s *appointmentService) GetAll(ctx context.Context, gameID uuid.UUID) ([]GameOfficial, error) {
+	var officials []GameOfficial
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetGame(ctx, gameID); err != nil {
+			return wrapDBError(err, "game", "unable to get game")
+		}
+
+		appointments, err := queries.GetGameOfficials(ctx, gameID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get game officials")
+		}
+
+		officials = make([]GameOfficial, 0, len(appointments))
+		for _, appointment := range appointments {
+			official, err := queries.GetOfficial(ctx, appointment.OfficialID)
+			if err != nil {
+				return errors.Wrap(err, "unable to get official")
+			}
+			officials = append(officials, GameOfficial{Appointment: appointment, Official: official})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return officials, nil
+}
+
+func (s *appointmentService) Set(
+	ctx context.Context,
+	req *api.GameOfficialRequest,
+	gameID, officialID uuid.UUID,
+) (GameOfficial, bool, error) {
+	var (
+		appointment GameOfficial
+		created     bool
+	)
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		appointment, created, txErr = setGameOfficial(ctx, queries, req, gameID, officialID)
+		return txErr
+	})
+	if err != nil {
+		return GameOfficial{}, false, err
+	}
+
+	return appointment, created, nil
+}
+
+func (s *appointmentService) Remove(ctx context.Context, gameID, officialID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return removeGameOfficial(ctx, queries, gameID, officialID)
+	})
+}
+
+// setGameOfficial appoints officialID to the game, or changes their role if they are
+// already appointed. The returned bool reports whether the official was newly
+// appointed. A role can only be filled as many times as officialsPerRole allows, and
+// an official cannot work two games whose kickoffs are within AppointmentWindow.
+func setGameOfficial(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.GameOfficialRequest,
+	gameID, officialID uuid.UUID,
+) (GameOfficial, bool, error) {
+	game, err := queries.GetGame(ctx, gameID)
+	if err != nil {
+		return GameOfficial{}, false, wrapDBError(err, "game", "unable to get game")
+	}
+
+	official, err := queries.GetOfficial(ctx, officialID)
+	if err != nil {
+		return GameOfficial{}, false, wrapDBError(err, "official", "unable to get official")
+	}
+
+	appointments, err := queries.GetGameOfficials(ctx, gameID)
+	if err != nil {
+		return GameOfficial{}, false, errors.Wrap(err, "unable to get game officials")
+	}
+
+	role := db.OfficialRole(req.Role)
+
+	var (
+		existing *db.GameOfficial
+		filled   int
+	)
+	for i, appointment := range appointments {
+		if appointment.OfficialID == officialID {
+			existing = &appointments[i]
+			continue
+		}
+		if appointment.Role == role {
+			filled++
+		}
+	}
+	if filled >= officialsPerRole[role] {
+		return GameOfficial{}, false, NewConflictError(
+			fmt.Sprintf("game already has %d %s", filled, pluralRole(role, filled)), nil)
+	}
+
+	if err := checkOfficialAvailable(ctx, queries, game, officialID); err != nil {
+		return GameOfficial{}, false, err
+	}
+
+	now := time.Now()
+
+	if existing != nil {
+		params := db.UpdateGameOfficialRoleParams{
+			Role:      role,
+			UpdatedAt: now,
+			ID:        existing.ID,
+		}
+		if err := queries.UpdateGameOfficialRole(ctx, params); err != nil {
+			return GameOfficial{}, false, wrapDBError(err, "game official", "unable to update game official")
+		}
+
+		appointment := *existing
+		appointment.Role = role
+		appointment.UpdatedAt = now
+
+		return GameOfficial{Appointment: appointment, Official: official}, false, nil
+	}
+
+	params := db.CreateGameOfficialParams{
+		ID:         uuid.New(),
+		GameID:     gameID,
+		OfficialID: officialID,
+		Role:       role,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		DeletedAt:  sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+	if err := queries.CreateGameOfficial(ctx, params); err != nil {
+		return GameOfficial{}, false, wrapDBError(err, "game official", "unable to appoint official")
+	}
+
+	return GameOfficial{
+		Appointment: db.GameOfficial{
+			ID:         params.ID,
+			GameID:     params.GameID,
+			OfficialID: params.OfficialID,
+			Role:       params.Role,
+			CreatedAt:  params.CreatedAt,
+			UpdatedAt:  params.UpdatedAt,
+			DeletedAt:  params.DeletedAt,
+		},
+		Official: official,
+	}, true, nil
+}
+
+// checkOfficialAvailable returns a conflict when the official is appointed to another
+// game kicking off within AppointmentWindow of game.
+func checkOfficialAvailable(ctx context.Context, queries db_handler.Queries, game db.Game, officialID uuid.UUID) error {
+	rows, err := queries.GetOfficialAppointments(ctx, officialID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get official appointments")
+	}
+
+	for _, row := range rows {
+		if row.GameID == game.ID {
+			continue
+		}
+
+		gap := row.Date.Sub(game.Date)
+		if gap < 0 {
+			gap = -gap
+		}
+		if gap < AppointmentWindow {
+			return NewConflictError(fmt.Sprintf(
+				"official is already appointed to game %s kicking off at %s",
+				row.GameID, row.Date.UTC().Format(time.RFC3339),
+			), nil)
+		}
+	}
+
+	return nil
+}
+
+// checkGameOfficialsAvailable returns a conflict when moving the game to date would put
+// one of its officials within AppointmentWindow of another of their games.
+func checkGameOfficialsAvailable(ctx context.Context, queries db_handler.Queries, gameID uuid.UUID, date time.Time) error {
+	appointments, err := queries.GetGameOfficials(ctx, gameID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get game officials")
+	}
+
+	moved := db.Game{ID: gameID, Date: date}
+	for _, appointment := range appointments {
+		if err := checkOfficialAvailable(ctx, queries, moved, appointment.OfficialID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeGameOfficial(ctx context.Context, queries db_handler.Queries, gameID, officialID uuid.UUID) error {
+	appointments, err := queries.GetGameOfficials(ctx, gameID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get game officials")
+	}
+
+	for _, appointment := range appointments {
+		if appointment.OfficialID != officialID {
+			continue
+		}
+
+		if err := queries.DeleteGameOfficial(ctx, db.DeleteGameOfficialParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:        appointment.ID,
+		}); err != nil {
+			return errors.Wrap(err, "unable to remove game official")
+		}
+		return nil
+	}
+
+	return NewNotFoundError("game official", nil)
+}
+
+func pluralRole(role db.OfficialRole, n int) string {
+	name := map[db.OfficialRole]string{
+		db.OfficialRoleReferee:          "referee",
+		db.OfficialRoleAssistantReferee: "assistant referee",
+		db.OfficialRoleTmo:              "TMO",
+	}[role]
+	if n == 1 {
+		return name
+	}
+	return name + "s"
+}
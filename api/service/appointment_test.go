@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("appointment", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc AppointmentService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewAppointmentService(mockDB)
+	})
+
+	validGameID := uuid.MustParse("4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01")
+	validOfficialID := uuid.MustParse("5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090")
+
+	kickoff := time.Date(2025, time.March, 1, 19, 35, 0, 0, time.UTC)
+
+	validGameFromDB := db.Game{ID: validGameID, Date: kickoff}
+	validOfficialFromDB := db.Official{ID: validOfficialID, Name: "Ben O'Keeffe"}
+
+	assistantRequest := &api.GameOfficialRequest{Role: api.OfficialRoleAssistantReferee}
+	refereeRequest := &api.GameOfficialRequest{Role: api.OfficialRoleReferee}
+
+	Describe("SetGameOfficial", func() {
+		It("should appoint an official who is free around kickoff", func() {
+			otherGame := db.GetOfficialAppointmentsRow{ID: uuid.New(), GameID: uuid.New(), Date: kickoff.Add(-AppointmentWindow)}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(validOfficialFromDB, nil)
+			mockQueries.EXPECT().GetGameOfficials(gomock.Any(), validGameID).Return(nil, nil)
+			mockQueries.EXPECT().GetOfficialAppointments(
+				gomock.Any(),
+				validOfficialID,
+			).Return([]db.GetOfficialAppointmentsRow{otherGame}, nil)
+			mockQueries.EXPECT().CreateGameOfficial(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameOfficialParams) error {
+				Expect(params.GameID).To(Equal(validGameID))
+				Expect(params.OfficialID).To(Equal(validOfficialID))
+				Expect(params.Role).To(Equal(db.OfficialRoleReferee))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			official, created, err := svc.Set(context.Background(), refereeRequest, validGameID, validOfficialID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(official.Official).To(Equal(validOfficialFromDB))
+			Expect(official.Appointment.Role).To(Equal(db.OfficialRoleReferee))
+		})
+
+		It("should change the role of an official already appointed", func() {
+			existing := db.GameOfficial{ID: uuid.New(), GameID: validGameID, OfficialID: validOfficialID, Role: db.OfficialRoleTmo}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(validOfficialFromDB, nil)
+			mockQueries.EXPECT().GetGameOfficials(gomock.Any(), validGameID).Return([]db.GameOfficial{existing}, nil)
+			mockQueries.EXPECT().GetOfficialAppointments(gomock.Any(), validOfficialID).Return([]db.GetOfficialAppointmentsRow{
+				{ID: existing.ID, GameID: validGameID, Date: kickoff},
+			}, nil)
+			mockQueries.EXPECT().UpdateGameOfficialRole(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.UpdateGameOfficialRoleParams) error {
+				Expect(params.ID).To(Equal(existing.ID))
+				Expect(params.Role).To(Equal(db.OfficialRoleReferee))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			official, created, err := svc.Set(context.Background(), refereeRequest, validGameID, validOfficialID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(official.Appointment.Role).To(Equal(db.OfficialRoleReferee))
+		})
+
+		It("should rollback with a conflict when both assistant referees are appointed", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(validOfficialFromDB, nil)
+			mockQueries.EXPECT().GetGameOfficials(gomock.Any(), validGameID).Return([]db.GameOfficial{
+				{ID: uuid.New(), OfficialID: uuid.New(), Role: db.OfficialRoleAssistantReferee},
+				{ID: uuid.New(), OfficialID: uuid.New(), Role: db.OfficialRoleAssistantReferee},
+			}, nil)
+			mockQueries.EXPECT().CreateGameOfficial(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, _, err := svc.Set(context.Background(), assistantRequest, validGameID, validOfficialID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("game already has 2 assistant referees"))
+		})
+
+		It("should rollback with a conflict when the official is appointed to an overlapping game", func() {
+			otherGameID := uuid.New()
+			overlapping := db.GetOfficialAppointmentsRow{ID: uuid.New(), GameID: otherGameID, Date: kickoff.Add(2 * time.Hour)}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(validOfficialFromDB, nil)
+			mockQueries.EXPECT().GetGameOfficials(gomock.Any(), validGameID).Return(nil, nil)
+			mockQueries.EXPECT().GetOfficialAppointments(
+				gomock.Any(),
+				validOfficialID,
+			).Return([]db.GetOfficialAppointmentsRow{overlapping}, nil)
+			mockQueries.EXPECT().CreateGameOfficial(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, _, err := svc.Set(context.Background(), refereeRequest, validGameID, validOfficialID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal(
+				"official is already appointed to game " + otherGameID.String() + " kicking off at 2025-03-01T21:35:00Z",
+			))
+		})
+	})
+
+	Describe("RemoveGameOfficial", func() {
+		It("should return a not found error when the official is not appointed to the game", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGameOfficials(gomock.Any(), validGameID).Return(nil, nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Remove(context.Background(), validGameID, validOfficialID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("game official"))
+		})
+	})
+})
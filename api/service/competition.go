@@ -16,12 +16,20 @@ import (
 // CompetitionService defines the contract for competition-related operations.
 type CompetitionService interface {
 	Create(ctx context.Context, req *api.CompetitionRequest) (db.Competition, error)
-	GetAll(ctx context.Context, limit, offset int) ([]db.Competition, int64, error)
+	GetAll(ctx context.Context, filter CompetitionFilter, limit, offset int) ([]db.Competition, int64, error)
 	Get(ctx context.Context, id uuid.UUID) (db.Competition, error)
-	Update(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest) (db.Competition, error)
+	Update(ctx context.Context, id uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// CompetitionFilter narrows the competitions list. Zero values do not filter.
+type CompetitionFilter struct {
+	Sport   api.SportCode
+	Gender  api.CompetitionGender
+	Level   api.CompetitionLevel
+	Country string
+}
+
 // competitionService is the concrete implementation backed by db_handler.DB.
 type competitionService struct {
 	db db_handler.DB
@@ -32,10 +40,10 @@ func NewCompetitionService(db db_handler.DB) CompetitionService {
 }
 
 func (s *competitionService) Create(ctx context.Context, req *api.CompetitionRequest) (db.Competition, error) {
-	req.Name = strings.TrimSpace(req.Name)
+	normaliseCompetitionRequest(req)
 
 	var competition db.Competition
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		competition, err = createCompetition(ctx, queries, req)
 		return err
@@ -49,6 +57,7 @@ func (s *competitionService) Create(ctx context.Context, req *api.CompetitionReq
 
 func (s *competitionService) GetAll(
 	ctx context.Context,
+	filter CompetitionFilter,
 	limit, offset int,
 ) ([]db.Competition, int64, error) {
 	var (
@@ -56,15 +65,26 @@ func (s *competitionService) GetAll(
 		total        int64
 	)
 
-	err := db_handler.Run(ctx, s.db, func(q db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
 		var err error
 
-		total, err = q.CountCompetitions(ctx)
+		sport, gender, level, country := filter.nullSport(), filter.nullGender(), filter.nullLevel(), toNullString(filter.Country)
+
+		total, err = q.CountCompetitions(ctx, db.CountCompetitionsParams{
+			Sport:   sport,
+			Gender:  gender,
+			Level:   level,
+			Country: country,
+		})
 		if err != nil {
 			return errors.Wrap(err, "count competitions")
 		}
 
 		competitions, err = q.GetCompetitions(ctx, db.GetCompetitionsParams{
+			Sport:      sport,
+			Gender:     gender,
+			Level:      level,
+			Country:    country,
 			PageOffset: int32(offset),
 			PageLimit:  int32(limit),
 		})
@@ -85,11 +105,11 @@ func (s *competitionService) GetAll(
 func (s *competitionService) Get(ctx context.Context, competitionID uuid.UUID) (db.Competition, error) {
 	var competition db.Competition
 
-	err := db_handler.Run(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		competition, err = queries.GetCompetition(ctx, competitionID)
 		if err != nil {
-			return errors.Wrap(err, "unable to get competition")
+			return wrapDBError(err, "competition", "unable to get competition")
 		}
 		return nil
 	})
@@ -100,11 +120,15 @@ func (s *competitionService) Get(ctx context.Context, competitionID uuid.UUID) (
 	return competition, nil
 }
 
-func (s *competitionService) Update(ctx context.Context, competitionID uuid.UUID, req *api.CompetitionRequest) (db.Competition, error) {
-	req.Name = strings.TrimSpace(req.Name)
+func (s *competitionService) Update(ctx context.Context, competitionID uuid.UUID, req *api.CompetitionRequest, version *time.Time) (db.Competition, error) {
+	normaliseCompetitionRequest(req)
 
 	var competition db.Competition
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := checkVersion(ctx, "competition", competitionID, version, queries.LockCompetition); err != nil {
+			return err
+		}
+
 		var txErr error
 		competition, txErr = updateCompetition(ctx, queries, competitionID, req)
 		return txErr
@@ -117,7 +141,7 @@ func (s *competitionService) Update(ctx context.Context, competitionID uuid.UUID
 }
 
 func (s *competitionService) Delete(ctx context.Context, competitionID uuid.UUID) error {
-	return db_handler.RunInTransaction(ctx, s.db, func(q db_handler.Queries) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
 		return deleteCompetition(ctx, q, competitionID)
 	})
 }
@@ -125,16 +149,26 @@ func (s *competitionService) Delete(ctx context.Context, competitionID uuid.UUID
 func createCompetition(ctx context.Context, queries db_handler.Queries, req *api.CompetitionRequest) (db.Competition, error) {
 	now := time.Now()
 	createCompetitionParams := db.CreateCompetitionParams{
-		ID:        uuid.New(),
-		Name:      req.Name,
-		CreatedAt: now,
-		UpdatedAt: now,
-		DeletedAt: sql.NullTime{Time: time.Time{}, Valid: false},
+		ID:              uuid.New(),
+		Name:            req.Name,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		DeletedAt:       sql.NullTime{Time: time.Time{}, Valid: false},
+		Sport:           db.SportCode(req.Sport),
+		Gender:          nullCompetitionGender(req.Gender),
+		Level:           nullCompetitionLevel(req.Level),
+		Country:         toNullString(req.Country),
+		Region:          toNullString(req.Region),
+		GoverningBody:   toNullString(req.GoverningBody),
+		LogoUrl:         toNullString(req.LogoURL),
+		PrimaryColour:   toNullString(req.PrimaryColour),
+		SecondaryColour: toNullString(req.SecondaryColour),
+		Website:         toNullString(req.Website),
 	}
 
 	err := queries.CreateCompetition(ctx, createCompetitionParams)
 	if err != nil {
-		return db.Competition{}, errors.Wrap(err, "unable to create new competition")
+		return db.Competition{}, wrapDBError(err, "competition", "unable to create new competition")
 	}
 
 	competition, err := queries.GetCompetition(ctx, createCompetitionParams.ID)
@@ -147,23 +181,64 @@ func createCompetition(ctx context.Context, queries db_handler.Queries, req *api
 
 func updateCompetition(ctx context.Context, queries db_handler.Queries, competitionID uuid.UUID, req *api.CompetitionRequest) (db.Competition, error) {
 	updateCompetitionParams := db.UpdateCompetitionParams{
-		Name: req.Name,
-		ID:   competitionID,
+		Name:            req.Name,
+		Sport:           db.SportCode(req.Sport),
+		Gender:          nullCompetitionGender(req.Gender),
+		Level:           nullCompetitionLevel(req.Level),
+		Country:         toNullString(req.Country),
+		Region:          toNullString(req.Region),
+		GoverningBody:   toNullString(req.GoverningBody),
+		LogoUrl:         toNullString(req.LogoURL),
+		PrimaryColour:   toNullString(req.PrimaryColour),
+		SecondaryColour: toNullString(req.SecondaryColour),
+		Website:         toNullString(req.Website),
+		ID:              competitionID,
 	}
 
 	err := queries.UpdateCompetition(ctx, updateCompetitionParams)
 	if err != nil {
-		return db.Competition{}, errors.Wrap(err, "unable to update competition")
+		return db.Competition{}, wrapDBError(err, "competition", "unable to update competition")
 	}
 
 	competition, err := queries.GetCompetition(ctx, competitionID)
 	if err != nil {
-		return db.Competition{}, errors.Wrap(err, "unable to get updated competition")
+		return db.Competition{}, wrapDBError(err, "competition", "unable to get updated competition")
 	}
 
 	return competition, nil
 }
 
+// normaliseCompetitionRequest trims the free text fields and defaults the sport to
+// rugby union.
+func normaliseCompetitionRequest(req *api.CompetitionRequest) {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Region = strings.TrimSpace(req.Region)
+	req.GoverningBody = strings.TrimSpace(req.GoverningBody)
+	if req.Sport == "" {
+		req.Sport = api.SportCodeRugbyUnion
+	}
+}
+
+func nullCompetitionGender(g api.CompetitionGender) db.NullCompetitionGender {
+	return db.NullCompetitionGender{CompetitionGender: db.CompetitionGender(g), Valid: g != ""}
+}
+
+func nullCompetitionLevel(l api.CompetitionLevel) db.NullCompetitionLevel {
+	return db.NullCompetitionLevel{CompetitionLevel: db.CompetitionLevel(l), Valid: l != ""}
+}
+
+func (f CompetitionFilter) nullSport() db.NullSportCode {
+	return db.NullSportCode{SportCode: db.SportCode(f.Sport), Valid: f.Sport != ""}
+}
+
+func (f CompetitionFilter) nullGender() db.NullCompetitionGender {
+	return nullCompetitionGender(f.Gender)
+}
+
+func (f CompetitionFilter) nullLevel() db.NullCompetitionLevel {
+	return nullCompetitionLevel(f.Level)
+}
+
 // deleteCompetition performs a soft-delete cascade in dependency order
 func deleteCompetition(ctx context.Context, queries db_handler.Queries, competitionID uuid.UUID) error {
 	now := time.Now()
@@ -120,6 +120,42 @@ var _ = Describe("competition", func() {
 			Expect(competition.Name).To(Equal("Trimmed Name"))
 		})
 
+		It("should default the sport and save the metadata when creating a competition", func() {
+			req := &api.CompetitionRequest{
+				Name:          "Farah Palmer Cup",
+				Gender:        api.CompetitionGenderWomen,
+				Level:         api.CompetitionLevelProvincial,
+				Country:       "NZ",
+				GoverningBody: "  New Zealand Rugby  ",
+				PrimaryColour: "#000000",
+				Website:       "https://www.provincial.rugby",
+			}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateCompetition(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, params db.CreateCompetitionParams) error {
+					Expect(params.Sport).To(Equal(db.SportCodeRugbyUnion))
+					Expect(params.Gender).To(Equal(db.NullCompetitionGender{CompetitionGender: db.CompetitionGenderWomen, Valid: true}))
+					Expect(params.Level).To(Equal(db.NullCompetitionLevel{CompetitionLevel: db.CompetitionLevelProvincial, Valid: true}))
+					Expect(params.Country).To(Equal(sql.NullString{String: "NZ", Valid: true}))
+					Expect(params.Region.Valid).To(BeFalse())
+					Expect(params.GoverningBody).To(Equal(sql.NullString{String: "New Zealand Rugby", Valid: true}))
+					Expect(params.PrimaryColour).To(Equal(sql.NullString{String: "#000000", Valid: true}))
+					Expect(params.SecondaryColour.Valid).To(BeFalse())
+					Expect(params.LogoUrl.Valid).To(BeFalse())
+					Expect(params.Website).To(Equal(sql.NullString{String: "https://www.provincial.rugby", Valid: true}))
+					return nil
+				})
+			mockQueries.EXPECT().GetCompetition(gomock.Any(), gomock.Any()).
+				Return(validCompetitionFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+			mockDB.EXPECT().Rollback(gomock.Any()).Times(0)
+
+			_, err := svc.Create(context.Background(), req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("should return formatted error if transaction begin fails", func() {
 			mockDB.EXPECT().BeginTx(
 				gomock.Any(),
@@ -216,7 +252,7 @@ var _ = Describe("competition", func() {
 	Describe("GetAll", func() {
 		It("should retrieve competitions without errors", func() {
 			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
-			mockQueries.EXPECT().CountCompetitions(gomock.Any()).Return(int64(len(validCompetitionsFromDB)), nil)
+			mockQueries.EXPECT().CountCompetitions(gomock.Any(), db.CountCompetitionsParams{}).Return(int64(len(validCompetitionsFromDB)), nil)
 			mockQueries.EXPECT().GetCompetitions(
 				gomock.Any(),
 				db.GetCompetitionsParams{
@@ -225,7 +261,7 @@ var _ = Describe("competition", func() {
 				},
 			).Return(validCompetitionsFromDB, nil)
 
-			competitions, total, err := svc.GetAll(context.Background(), 10, 0)
+			competitions, total, err := svc.GetAll(context.Background(), CompetitionFilter{}, 10, 0)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(total).To(Equal(int64(len(validCompetitionsFromDB))))
 			Expect(competitions).To(HaveLen(2))
@@ -233,11 +269,45 @@ var _ = Describe("competition", func() {
 			Expect(competitions[1].ID).To(Equal(validCompetitionsFromDB[1].ID))
 		})
 
+		It("should pass the filters to both queries", func() {
+			filter := CompetitionFilter{
+				Sport:   api.SportCodeRugbySevens,
+				Gender:  api.CompetitionGenderWomen,
+				Level:   api.CompetitionLevelInternational,
+				Country: "NZ",
+			}
+			sport := db.NullSportCode{SportCode: db.SportCodeRugbySevens, Valid: true}
+			gender := db.NullCompetitionGender{CompetitionGender: db.CompetitionGenderWomen, Valid: true}
+			level := db.NullCompetitionLevel{CompetitionLevel: db.CompetitionLevelInternational, Valid: true}
+			country := sql.NullString{String: "NZ", Valid: true}
+
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CountCompetitions(gomock.Any(), db.CountCompetitionsParams{
+				Sport:   sport,
+				Gender:  gender,
+				Level:   level,
+				Country: country,
+			}).Return(int64(1), nil)
+			mockQueries.EXPECT().GetCompetitions(gomock.Any(), db.GetCompetitionsParams{
+				Sport:      sport,
+				Gender:     gender,
+				Level:      level,
+				Country:    country,
+				PageLimit:  int32(10),
+				PageOffset: int32(0),
+			}).Return([]db.Competition{validCompetitionFromDB}, nil)
+
+			competitions, total, err := svc.GetAll(context.Background(), filter, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+			Expect(competitions).To(HaveLen(1))
+		})
+
 		It("should return an error if CountCompetitions fails", func() {
 			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
-			mockQueries.EXPECT().CountCompetitions(gomock.Any()).Return(int64(0), validTestError)
+			mockQueries.EXPECT().CountCompetitions(gomock.Any(), db.CountCompetitionsParams{}).Return(int64(0), validTestError)
 
-			competitions, total, err := svc.GetAll(context.Background(), 10, 0)
+			competitions, total, err := svc.GetAll(context.Background(), CompetitionFilter{}, 10, 0)
 			Expect(competitions).To(BeNil())
 			Expect(total).To(Equal(int64(0)))
 			Expect(err).To(HaveOccurred())
@@ -246,7 +316,7 @@ var _ = Describe("competition", func() {
 
 		It("should return an error if GetCompetitions fails", func() {
 			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
-			mockQueries.EXPECT().CountCompetitions(gomock.Any()).Return(int64(2), nil)
+			mockQueries.EXPECT().CountCompetitions(gomock.Any(), db.CountCompetitionsParams{}).Return(int64(2), nil)
 			mockQueries.EXPECT().GetCompetitions(
 				gomock.Any(),
 				db.GetCompetitionsParams{
@@ -255,7 +325,7 @@ var _ = Describe("competition", func() {
 				},
 			).Return(nil, validTestError)
 
-			competitions, total, err := svc.GetAll(context.Background(), 10, 0)
+			competitions, total, err := svc.GetAll(context.Background(), CompetitionFilter{}, 10, 0)
 			Expect(competitions).To(BeNil())
 			Expect(total).To(Equal(int64(0)))
 			Expect(err).To(HaveOccurred())
@@ -327,6 +397,7 @@ var _ = Describe("competition", func() {
 				context.Background(),
 				validCompetitionID,
 				validCompetitionRequest,
+				nil,
 			)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -354,7 +425,7 @@ var _ = Describe("competition", func() {
 			mockDB.EXPECT().Commit(gomock.Any())
 			mockDB.EXPECT().Rollback(gomock.Any()).Times(0)
 
-			competition, err := svc.Update(context.Background(), validCompetitionID, req)
+			competition, err := svc.Update(context.Background(), validCompetitionID, req, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(competition.Name).To(Equal("Updated Name"))
 		})
@@ -372,6 +443,7 @@ var _ = Describe("competition", func() {
 				context.Background(),
 				validCompetitionID,
 				validCompetitionRequest,
+				nil,
 			)
 
 			Expect(competition).To(Equal(validNilCompetition))
@@ -394,7 +466,7 @@ var _ = Describe("competition", func() {
 				gomock.Any(),
 			).AnyTimes()
 
-			competition, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest)
+			competition, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest, nil)
 
 			Expect(competition).To(Equal(validNilCompetition))
 			Expect(err.Error()).To(Equal("unable to update competition: a valid testing error"))
@@ -420,7 +492,7 @@ var _ = Describe("competition", func() {
 				gomock.Any(),
 			).AnyTimes()
 
-			competition, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest)
+			competition, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest, nil)
 
 			Expect(competition).To(Equal(validNilCompetition))
 			Expect(err.Error()).To(Equal("unable to get updated competition: a valid testing error"))
@@ -449,11 +521,40 @@ var _ = Describe("competition", func() {
 				gomock.Any(),
 			).Times(0)
 
-			competition, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest)
+			competition, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest, nil)
 
 			Expect(competition).To(Equal(validNilCompetition))
 			Expect(err.Error()).To(Equal(validTestError.Error()))
 		})
+
+		It("should rollback with a precondition failure when the competition has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().LockCompetition(
+				gomock.Any(),
+				validCompetitionID,
+			).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateCompetition(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Update(context.Background(), validCompetitionID, validCompetitionRequest, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("competition"))
+		})
 	})
 
 	Describe("DeleteCompetition", func() {
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// uniqueViolation is the Postgres error code raised when a unique constraint fails.
+const uniqueViolation = "23505"
+
+// NotFoundError reports that the requested resource does not exist.
+type NotFoundError struct {
+	Resource string
+	Err      error
+}
+
+func NewNotFoundError(resource string, err error) *NotFoundError {
+	return &NotFoundError{Resource: resource, Err: err}
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Resource + " not found"
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictError reports that a write clashes with existing state, such as a duplicate record.
+type ConflictError struct {
+	Message string
+	Err     error
+}
+
+func NewConflictError(message string, err error) *ConflictError {
+	return &ConflictError{Message: message, Err: err}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError describes why a single request field was rejected. Rule names the
+// domain rule that failed so clients can react to it without parsing Message.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// ValidationError reports a request that is well-formed but breaks a domain rule,
+// with one entry per offending field.
+type ValidationError struct {
+	Message string
+	Fields  []FieldError
+}
+
+func NewValidationError(message string, fields ...FieldError) *ValidationError {
+	return &ValidationError{Message: message, Fields: fields}
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+
+	details := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		details = append(details, f.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.Message, strings.Join(details, "; "))
+}
+
+// ForbiddenError reports an operation the caller is not allowed to perform on a resource,
+// such as reaching a game through a season it does not belong to.
+type ForbiddenError struct {
+	Message string
+}
+
+func NewForbiddenError(message string) *ForbiddenError {
+	return &ForbiddenError{Message: message}
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// PreconditionFailedError reports a write made against a version of a resource that is
+// no longer current, such as an If-Match header naming an old ETag.
+type PreconditionFailedError struct {
+	Resource string
+}
+
+func NewPreconditionFailedError(resource string) *PreconditionFailedError {
+	return &PreconditionFailedError{Resource: resource}
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return e.Resource + " has been modified since it was read"
+}
+
+// wrapDBError wraps err with message, classifying missing rows as NotFoundError and
+// unique constraint violations as ConflictError so callers can map them to a response.
+func wrapDBError(err error, resource, message string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(NewNotFoundError(resource, err), message)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return errors.Wrap(NewConflictError(resource+" already exists", err), message)
+	}
+
+	return errors.Wrap(err, message)
+}
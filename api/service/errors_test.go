@@ -0,0 +1,59 @@
+package service
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("errors", func() {
+	Describe("wrapDBError", func() {
+		It("should classify missing rows as not found", func() {
+			err := wrapDBError(errors.Wrap(sql.ErrNoRows, "query failed"), "game", "unable to get game")
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("game"))
+			Expect(err).To(MatchError("unable to get game: game not found"))
+			Expect(errors.Is(err, sql.ErrNoRows)).To(BeTrue())
+		})
+
+		It("should classify unique violations as conflicts", func() {
+			err := wrapDBError(&pq.Error{Code: "23505"}, "team", "unable to create new team")
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(err).To(MatchError("unable to create new team: team already exists"))
+		})
+
+		It("should wrap other errors unchanged", func() {
+			err := wrapDBError(errors.New("a valid testing error"), "team", "unable to get team")
+
+			var notFoundErr *NotFoundError
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &notFoundErr)).To(BeFalse())
+			Expect(errors.As(err, &conflictErr)).To(BeFalse())
+			Expect(err).To(MatchError("unable to get team: a valid testing error"))
+		})
+	})
+
+	Describe("PreconditionFailedError", func() {
+		It("should name the stale resource", func() {
+			Expect(NewPreconditionFailedError("game")).To(MatchError("game has been modified since it was read"))
+		})
+	})
+
+	Describe("ValidationError", func() {
+		It("should list every field message", func() {
+			err := NewValidationError("invalid game",
+				FieldError{Field: "home_team_id", Message: "home team not in season"},
+				FieldError{Field: "away_team_id", Message: "away team not in season"},
+			)
+
+			Expect(err).To(MatchError("invalid game: home team not in season; away team not in season"))
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// EventService defines the contract for the scoring and disciplinary events recorded
+// in a game. Events feed player statistics; they do not change the game's score.
+type EventService interface {
+	GetAll(ctx context.Context, gameID uuid.UUID) ([]db.GameEvent, error)
+	Create(ctx context.Context, req *api.GameEventRequest, gameID uuid.UUID) (db.GameEvent, error)
+	Delete(ctx context.Context, gameID, eventID uuid.UUID) error
+}
+
+// eventService is the concrete implementation backed by db_handler.DB.
+type eventService struct {
+	db db_handler.DB
+}
+
+// NewEventService returns a new EventService backed by db_handler.DB.
+func NewEventService(db db_handler.DB) EventService {
+	return &eventService{db: db}
+}
+
+func (s *eventService) GetAll(ctx context.Context, gameID uuid.UUID) ([]db.GameEvent, error) {
+	var events []db.GameEvent
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetGame(ctx, gameID); err != nil {
+			return wrapDBError(err, "game", "unable to get game")
+		}
+
+		var err error
+		events, err = queries.GetGameEvents(ctx, gameID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get game events")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (s *eventService) Create(ctx context.Context, req *api.GameEventRequest, gameID uuid.UUID) (db.GameEvent, error) {
+	var event db.GameEvent
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		event, txErr = createGameEvent(ctx, queries, req, gameID)
+		return txErr
+	})
+	if err != nil {
+		return db.GameEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (s *eventService) Delete(ctx context.Context, gameID, eventID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return deleteGameEvent(ctx, queries, gameID, eventID)
+	})
+}
+
+// createGameEvent records an event once the game has kicked off. The player involved
+// must be in the team's squad on the day of the game.
+func createGameEvent(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.GameEventRequest,
+	gameID uuid.UUID,
+) (db.GameEvent, error) {
+	game, err := getLineupGame(ctx, queries, gameID, req.TeamID)
+	if err != nil {
+		return db.GameEvent{}, err
+	}
+
+	if game.Status != db.GameStatusPlaying && game.Status != db.GameStatusFinished {
+		return db.GameEvent{}, NewConflictError("events can only be recorded once a game has kicked off", nil)
+	}
+
+	var playerID uuid.NullUUID
+	if req.PlayerID != nil {
+		if err := checkEventPlayer(ctx, queries, game, req.TeamID, *req.PlayerID); err != nil {
+			return db.GameEvent{}, err
+		}
+		playerID = uuid.NullUUID{UUID: *req.PlayerID, Valid: true}
+	}
+
+	now := time.Now()
+
+	params := db.CreateGameEventParams{
+		ID:        uuid.New(),
+		GameID:    gameID,
+		TeamID:    req.TeamID,
+		PlayerID:  playerID,
+		EventType: db.GameEventType(req.Type),
+		Minute:    req.Minute,
+		CreatedAt: now,
+		UpdatedAt: now,
+		DeletedAt: sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+	if err := queries.CreateGameEvent(ctx, params); err != nil {
+		return db.GameEvent{}, errors.Wrap(err, "unable to create game event")
+	}
+
+	return db.GameEvent{
+		ID:        params.ID,
+		GameID:    params.GameID,
+		TeamID:    params.TeamID,
+		PlayerID:  params.PlayerID,
+		EventType: params.EventType,
+		Minute:    params.Minute,
+		CreatedAt: params.CreatedAt,
+		UpdatedAt: params.UpdatedAt,
+		DeletedAt: params.DeletedAt,
+	}, nil
+}
+
+// checkEventPlayer reports a player who is not in the team's squad on the day of the game.
+func checkEventPlayer(ctx context.Context, queries db_handler.Queries, game db.Game, teamID, playerID uuid.UUID) error {
+	seasonTeamID, err := getSeasonTeamID(ctx, queries, game.SeasonID, teamID)
+	if err != nil {
+		return err
+	}
+
+	members, err := queries.GetSeasonTeamPlayers(ctx, seasonTeamID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get squad")
+	}
+
+	for _, member := range members {
+		if member.PlayerID == playerID && activeOn(member, game.Date) {
+			return nil
+		}
+	}
+
+	return NewValidationError("invalid event", FieldError{
+		Field:   "player_id",
+		Rule:    "in_squad",
+		Message: "player is not in the squad on the game date",
+	})
+}
+
+func deleteGameEvent(ctx context.Context, queries db_handler.Queries, gameID, eventID uuid.UUID) error {
+	events, err := queries.GetGameEvents(ctx, gameID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get game events")
+	}
+
+	for _, e := range events {
+		if e.ID != eventID {
+			continue
+		}
+
+		if err := queries.DeleteGameEvent(ctx, db.DeleteGameEventParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:        eventID,
+		}); err != nil {
+			return errors.Wrap(err, "unable to delete game event")
+		}
+		return nil
+	}
+
+	return NewNotFoundError("event", nil)
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("event", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc EventService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewEventService(mockDB)
+	})
+
+	validSeasonID := uuid.MustParse("9300778f-cce0-4efe-af6c-e399d8170315")
+	validGameID := uuid.MustParse("4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01")
+	validHomeTeamID := uuid.MustParse("013952a5-87e1-4d26-a312-09b2aff54241")
+	validAwayTeamID := uuid.MustParse("a2f1c3d4-5b6e-4f70-8a91-b2c3d4e5f607")
+	validSeasonTeamID := uuid.MustParse("b4a2c9e1-3f5d-4e7a-8c6b-0d1e2f3a4b5c")
+	validPlayerID := uuid.MustParse("7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10")
+
+	kickoff := time.Date(2025, time.April, 12, 7, 5, 0, 0, time.UTC)
+
+	validGame := func(status db.GameStatus) db.Game {
+		return db.Game{
+			ID:         validGameID,
+			SeasonID:   validSeasonID,
+			Date:       kickoff,
+			HomeTeamID: validHomeTeamID,
+			AwayTeamID: validAwayTeamID,
+			Status:     status,
+		}
+	}
+
+	validSeasonTeams := []db.GetSeasonTeamsRow{
+		{ID: validSeasonTeamID, SeasonID: validSeasonID, TeamID: validHomeTeamID},
+	}
+
+	tryBy := func(playerID uuid.UUID) *api.GameEventRequest {
+		return &api.GameEventRequest{TeamID: validHomeTeamID, PlayerID: &playerID, Type: api.GameEventTypeTry, Minute: 23}
+	}
+
+	Describe("CreateGameEvent", func() {
+		It("should record a try by a player in the squad", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{
+				{ID: uuid.New(), SeasonTeamID: validSeasonTeamID, PlayerID: validPlayerID, JerseyNumber: 8},
+			}, nil)
+			mockQueries.EXPECT().CreateGameEvent(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameEventParams) error {
+				Expect(params.PlayerID).To(Equal(uuid.NullUUID{UUID: validPlayerID, Valid: true}))
+				Expect(params.EventType).To(Equal(db.GameEventTypeTry))
+				Expect(params.Minute).To(Equal(int32(23)))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			event, err := svc.Create(context.Background(), tryBy(validPlayerID), validGameID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.TeamID).To(Equal(validHomeTeamID))
+		})
+
+		It("should award a penalty try to the team without checking the squad", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusFinished), nil)
+			mockQueries.EXPECT().CreateGameEvent(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameEventParams) error {
+				Expect(params.PlayerID.Valid).To(BeFalse())
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			req := &api.GameEventRequest{TeamID: validHomeTeamID, Type: api.GameEventTypePenaltyTry, Minute: 78}
+			_, err := svc.Create(context.Background(), req, validGameID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a validation error for a player not in the squad on the game date", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{
+				{
+					ID:           uuid.New(),
+					SeasonTeamID: validSeasonTeamID,
+					PlayerID:     validPlayerID,
+					JerseyNumber: 8,
+					ActiveTo:     sql.NullTime{Time: time.Date(2025, time.April, 11, 0, 0, 0, 0, time.UTC), Valid: true},
+				},
+			}, nil)
+			mockQueries.EXPECT().CreateGameEvent(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), tryBy(validPlayerID), validGameID)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(Equal([]FieldError{
+				{Field: "player_id", Rule: "in_squad", Message: "player is not in the squad on the game date"},
+			}))
+		})
+
+		It("should rollback with a conflict before kickoff", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusScheduled), nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), tryBy(validPlayerID), validGameID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("events can only be recorded once a game has kicked off"))
+		})
+
+		It("should rollback with a forbidden error for a team not playing in the game", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			req := tryBy(validPlayerID)
+			req.TeamID = uuid.New()
+			_, err := svc.Create(context.Background(), req, validGameID)
+
+			var forbiddenErr *ForbiddenError
+			Expect(errors.As(err, &forbiddenErr)).To(BeTrue())
+		})
+	})
+
+	Describe("DeleteGameEvent", func() {
+		It("should soft delete the event", func() {
+			event := db.GameEvent{ID: uuid.New(), GameID: validGameID, TeamID: validHomeTeamID}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGameEvents(gomock.Any(), validGameID).Return([]db.GameEvent{event}, nil)
+			mockQueries.EXPECT().DeleteGameEvent(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteGameEventParams) error {
+				Expect(params.ID).To(Equal(event.ID))
+				Expect(params.DeletedAt.Valid).To(BeTrue())
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			Expect(svc.Delete(context.Background(), validGameID, event.ID)).To(Succeed())
+		})
+
+		It("should rollback with a not found error for an event in another game", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGameEvents(gomock.Any(), validGameID).Return(nil, nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Delete(context.Background(), validGameID, uuid.New())
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("event"))
+		})
+	})
+})
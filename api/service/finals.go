@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// FinalsService defines the contract for a season's finals bracket.
+type FinalsService interface {
+	Get(ctx context.Context, season SeasonAggregate) (FinalsBracket, error)
+	Set(ctx context.Context, req *api.FinalsRequest, season SeasonAggregate) (FinalsBracket, error)
+	Delete(ctx context.Context, season SeasonAggregate) error
+}
+
+// finalsService is the concrete implementation backed by db_handler.DB.
+type finalsService struct {
+	db db_handler.DB
+}
+
+// NewFinalsService returns a new FinalsService backed by db_handler.DB.
+func NewFinalsService(db db_handler.DB) FinalsService {
+	return &finalsService{db: db}
+}
+
+// finalsSeeds is the number of teams that qualify for each format.
+var finalsSeeds = map[db.FinalsFormat]int{
+	db.FinalsFormatTop4: 4,
+	db.FinalsFormatTop6: 6,
+	db.FinalsFormatTop8: 8,
+}
+
+// FinalsBracket is a season's finals format, its qualifiers and the games in each
+// finals round. Seeds stay empty until every regular season game is finished.
+type FinalsBracket struct {
+	Finals db.SeasonFinal
+	Seeds  []LadderEntry
+	Rounds []FinalsRound
+
+	// latest is the date of the last game in the season, used to date the next round.
+	latest time.Time
+}
+
+// FinalsRound is the finals stage holding one knockout round and its games.
+type FinalsRound struct {
+	Stage db.Stage
+	Games []db.Game
+}
+
+type finalsPairing struct {
+	home uuid.UUID
+	away uuid.UUID
+}
+
+func (s *finalsService) Get(ctx context.Context, season SeasonAggregate) (FinalsBracket, error) {
+	var bracket FinalsBracket
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		bracket, err = getFinalsBracket(ctx, queries, season)
+		if err != nil {
+			return err
+		}
+
+		return nameSeeds(ctx, queries, &bracket, season)
+	})
+	if err != nil {
+		return FinalsBracket{}, err
+	}
+
+	return bracket, nil
+}
+
+// Set saves the season's finals format and draws the first finals round straight away
+// if the regular season is already over.
+func (s *finalsService) Set(ctx context.Context, req *api.FinalsRequest, season SeasonAggregate) (FinalsBracket, error) {
+	format := db.FinalsFormat(req.Format)
+	if err := validateFinalsFormat(format, season); err != nil {
+		return FinalsBracket{}, err
+	}
+
+	var bracket FinalsBracket
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		now := time.Now()
+
+		err := queries.UpsertSeasonFinals(ctx, db.UpsertSeasonFinalsParams{
+			SeasonID:          season.ID,
+			Format:            format,
+			RoundIntervalDays: int32(req.RoundIntervalDays),
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to save season finals")
+		}
+
+		if err := advanceFinals(ctx, queries, season); err != nil {
+			return err
+		}
+
+		bracket, err = getFinalsBracket(ctx, queries, season)
+		if err != nil {
+			return err
+		}
+
+		return nameSeeds(ctx, queries, &bracket, season)
+	})
+	if err != nil {
+		return FinalsBracket{}, err
+	}
+
+	return bracket, nil
+}
+
+// Delete removes the season's finals format. Finals games already drawn are kept.
+func (s *finalsService) Delete(ctx context.Context, season SeasonAggregate) error {
+	return db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := queries.DeleteSeasonFinals(ctx, season.ID); err != nil {
+			return errors.Wrap(err, "unable to delete season finals")
+		}
+		return nil
+	})
+}
+
+func validateFinalsFormat(format db.FinalsFormat, season SeasonAggregate) error {
+	seeds := finalsSeeds[format]
+	rounds := finalsRoundCount(seeds)
+
+	var fields []FieldError
+
+	if len(season.Teams) < seeds {
+		fields = append(fields, FieldError{
+			Field:   "format",
+			Rule:    "enough_teams",
+			Message: fmt.Sprintf("%s needs %d teams, season has %d", format, seeds, len(season.Teams)),
+		})
+	}
+
+	if stages := stagesOfType(season.Stages, db.StageTypeFinals); len(stages) < rounds {
+		fields = append(fields, FieldError{
+			Field:   "format",
+			Rule:    "enough_rounds",
+			Message: fmt.Sprintf("%s needs %d finals stages, season has %d", format, rounds, len(stages)),
+		})
+	}
+
+	if len(fields) > 0 {
+		return NewValidationError("invalid finals", fields...)
+	}
+
+	return nil
+}
+
+func getFinalsBracket(ctx context.Context, queries db_handler.Queries, season SeasonAggregate) (FinalsBracket, error) {
+	finals, err := queries.GetSeasonFinals(ctx, season.ID)
+	if err != nil {
+		return FinalsBracket{}, wrapDBError(err, "finals", "unable to get season finals")
+	}
+
+	games, err := queries.GetAllGamesBySeasonID(ctx, season.ID)
+	if err != nil {
+		return FinalsBracket{}, errors.Wrap(err, "unable to get season games")
+	}
+
+	return buildFinalsBracket(finals, games, season), nil
+}
+
+// nameSeeds names the seeded teams as they were at the end of the season.
+func nameSeeds(ctx context.Context, queries db_handler.Queries, bracket *FinalsBracket, season SeasonAggregate) error {
+	teams := make([]db.Team, 0, len(bracket.Seeds))
+	for _, entry := range bracket.Seeds {
+		teams = append(teams, entry.Team)
+	}
+
+	if err := nameTeamsAt(ctx, queries, teams, season.EndDate); err != nil {
+		return err
+	}
+
+	for i := range bracket.Seeds {
+		bracket.Seeds[i].Team = teams[i]
+	}
+
+	return nil
+}
+
+// advanceFinals draws the next finals round once the one before it is decided: the
+// first round once every regular season game is finished, and each later round once
+// every game in the previous one has a winner. It does nothing for seasons without a
+// finals format. The new games kick off RoundIntervalDays after the latest game in the
+// season, at the same time of day, and can be moved like any other game.
+func advanceFinals(ctx context.Context, queries db_handler.Queries, season SeasonAggregate) error {
+	bracket, err := getFinalsBracket(ctx, queries, season)
+
+	var notFoundErr *NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	round, pairings, ok := bracket.nextRound()
+	if !ok {
+		return nil
+	}
+
+	stage := bracket.Rounds[round].Stage
+	date := bracket.latest.AddDate(0, 0, int(bracket.Finals.RoundIntervalDays))
+
+	for _, p := range pairings {
+		_, err := insertGame(ctx, queries, &api.GameRequest{
+			StageID:    stage.ID,
+			Date:       date,
+			HomeTeamID: p.home,
+			AwayTeamID: p.away,
+		}, season)
+		if err != nil {
+			return errors.Wrapf(err, "unable to create finals game for stage %s", stage.Name)
+		}
+	}
+
+	return nil
+}
+
+// buildFinalsBracket places finals games into rounds, one per finals stage by
+// order_index, and seeds the ladder once the regular season is complete.
+func buildFinalsBracket(finals db.SeasonFinal, games []db.Game, season SeasonAggregate) FinalsBracket {
+	bracket := FinalsBracket{Finals: finals, Rounds: []FinalsRound{}}
+
+	seeds := finalsSeeds[finals.Format]
+
+	stages := stagesOfType(season.Stages, db.StageTypeFinals)
+	if rounds := finalsRoundCount(seeds); len(stages) > rounds {
+		stages = stages[:rounds]
+	}
+
+	roundIndex := make(map[uuid.UUID]int, len(stages))
+	for i, st := range stages {
+		roundIndex[st.ID] = i
+		bracket.Rounds = append(bracket.Rounds, FinalsRound{Stage: st, Games: []db.Game{}})
+	}
+
+	regular := make(map[uuid.UUID]struct{}, len(season.Stages))
+	for _, st := range stagesOfType(season.Stages, db.StageTypeRegular) {
+		regular[st.ID] = struct{}{}
+	}
+
+	var regularGames []db.Game
+	complete := true
+
+	for _, g := range games {
+		if g.Date.After(bracket.latest) {
+			bracket.latest = g.Date
+		}
+
+		if i, ok := roundIndex[g.StageID]; ok {
+			bracket.Rounds[i].Games = append(bracket.Rounds[i].Games, g)
+			continue
+		}
+
+		if _, ok := regular[g.StageID]; ok {
+			regularGames = append(regularGames, g)
+			if g.Status != db.GameStatusFinished {
+				complete = false
+			}
+		}
+	}
+
+	if complete && len(regularGames) > 0 {
+		if ladder := buildLadder(regularGames, season.Teams); len(ladder) >= seeds {
+			bracket.Seeds = ladder[:seeds]
+		}
+	}
+
+	return bracket
+}
+
+// nextRound works out which finals round can be drawn and who plays whom. The top
+// seeds get byes into the second round when the field is not a power of two, and every
+// round pairs the highest remaining seed with the lowest, with the higher seed at home.
+// It returns ok false until seeds are known, while a round is unfinished or has a drawn
+// game, and once every round has games.
+func (b FinalsBracket) nextRound() (int, []finalsPairing, bool) {
+	if len(b.Seeds) == 0 {
+		return 0, nil, false
+	}
+
+	seeds := make([]uuid.UUID, 0, len(b.Seeds))
+	rank := make(map[uuid.UUID]int, len(b.Seeds))
+	for i, entry := range b.Seeds {
+		seeds = append(seeds, entry.Team.ID)
+		rank[entry.Team.ID] = i
+	}
+
+	byes := 1<<finalsRoundCount(len(seeds)) - len(seeds)
+	entrants := seeds[byes:]
+
+	for r, round := range b.Rounds {
+		if len(round.Games) == 0 {
+			return r, pairSeeds(entrants, rank), true
+		}
+
+		winners, ok := finalsWinners(round.Games)
+		if !ok {
+			return 0, nil, false
+		}
+
+		entrants = winners
+		if r == 0 {
+			entrants = append(append([]uuid.UUID(nil), seeds[:byes]...), winners...)
+		}
+	}
+
+	return 0, nil, false
+}
+
+// pairSeeds matches the best ranked team with the worst, the second best with the
+// second worst and so on. Teams without a rank sort last.
+func pairSeeds(teams []uuid.UUID, rank map[uuid.UUID]int) []finalsPairing {
+	sorted := append([]uuid.UUID(nil), teams...)
+	seedOf := func(id uuid.UUID) int {
+		if r, ok := rank[id]; ok {
+			return r
+		}
+		return len(rank)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return seedOf(sorted[i]) < seedOf(sorted[j]) })
+
+	pairings := make([]finalsPairing, 0, len(sorted)/2)
+	for i := 0; i < len(sorted)/2; i++ {
+		pairings = append(pairings, finalsPairing{home: sorted[i], away: sorted[len(sorted)-1-i]})
+	}
+	return pairings
+}
+
+// finalsWinners returns the winner of each game, or ok false if any game is unfinished
+// or drawn.
+func finalsWinners(games []db.Game) ([]uuid.UUID, bool) {
+	winners := make([]uuid.UUID, 0, len(games))
+	for _, g := range games {
+		if g.Status != db.GameStatusFinished || !g.HomeScore.Valid || !g.AwayScore.Valid {
+			return nil, false
+		}
+
+		switch {
+		case g.HomeScore.Int32 > g.AwayScore.Int32:
+			winners = append(winners, g.HomeTeamID)
+		case g.AwayScore.Int32 > g.HomeScore.Int32:
+			winners = append(winners, g.AwayTeamID)
+		default:
+			return nil, false
+		}
+	}
+	return winners, true
+}
+
+// finalsRoundCount is the number of knockout rounds needed to whittle seeds teams down
+// to one.
+func finalsRoundCount(seeds int) int {
+	rounds := 0
+	for 1<<rounds < seeds {
+		rounds++
+	}
+	return rounds
+}
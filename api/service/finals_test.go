@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("finals", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc FinalsService
+
+	var season SeasonAggregate
+	var regular db.Stage
+	var qualifying, semis, final db.Stage
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewFinalsService(mockDB)
+
+		regular = db.Stage{ID: uuid.New(), Name: "Round 1", StageType: db.StageTypeRegular, OrderIndex: 1}
+		qualifying = db.Stage{ID: uuid.New(), Name: "Qualifying Finals", StageType: db.StageTypeFinals, OrderIndex: 2}
+		semis = db.Stage{ID: uuid.New(), Name: "Semi Finals", StageType: db.StageTypeFinals, OrderIndex: 3}
+		final = db.Stage{ID: uuid.New(), Name: "Final", StageType: db.StageTypeFinals, OrderIndex: 4}
+
+		season = SeasonAggregate{
+			ID:     uuid.New(),
+			Stages: []db.Stage{final, semis, qualifying, regular},
+		}
+		for i := 0; i < 7; i++ {
+			season.Teams = append(season.Teams, db.Team{ID: uuid.New(), Name: fmt.Sprintf("Team %d", i)})
+		}
+	})
+
+	kickoff := time.Date(2025, time.June, 7, 19, 5, 0, 0, time.UTC)
+
+	result := func(stage db.Stage, day int, home, away db.Team, homeScore, awayScore int32) db.Game {
+		return db.Game{
+			ID:         uuid.New(),
+			SeasonID:   season.ID,
+			StageID:    stage.ID,
+			Date:       kickoff.AddDate(0, 0, day),
+			HomeTeamID: home.ID,
+			AwayTeamID: away.ID,
+			HomeScore:  sql.NullInt32{Int32: homeScore, Valid: true},
+			AwayScore:  sql.NullInt32{Int32: awayScore, Valid: true},
+			Status:     db.GameStatusFinished,
+		}
+	}
+
+	// regularSeason has every team beat every team below it, so the ladder matches
+	// season.Teams order.
+	regularSeason := func() []db.Game {
+		var games []db.Game
+		for i, home := range season.Teams {
+			for _, away := range season.Teams[i+1:] {
+				games = append(games, result(regular, 0, home, away, 20, 10))
+			}
+		}
+		return games
+	}
+
+	top6 := func() db.SeasonFinal {
+		return db.SeasonFinal{SeasonID: season.ID, Format: db.FinalsFormatTop6, RoundIntervalDays: 7}
+	}
+
+	pairs := func(pairings []finalsPairing) [][2]uuid.UUID {
+		out := make([][2]uuid.UUID, 0, len(pairings))
+		for _, p := range pairings {
+			out = append(out, [2]uuid.UUID{p.home, p.away})
+		}
+		return out
+	}
+
+	Describe("buildLadder", func() {
+		It("should rank on points then points difference", func() {
+			a, b, c := season.Teams[0], season.Teams[1], season.Teams[2]
+			games := []db.Game{
+				result(regular, 0, a, b, 10, 10),
+				result(regular, 7, c, a, 30, 10),
+				result(regular, 14, b, c, 40, 10),
+			}
+			scheduled := result(regular, 21, a, c, 0, 0)
+			scheduled.Status = db.GameStatusScheduled
+			games = append(games, scheduled)
+
+			ladder := buildLadder(games, season.Teams[:3])
+
+			Expect(ladder).To(HaveLen(3))
+			Expect(ladder[0].Team).To(Equal(b))
+			Expect(ladder[0].Points).To(Equal(6))
+			Expect(ladder[0].Record).To(Equal(TeamRecord{Played: 2, Won: 1, Drawn: 1, PointsFor: 50, PointsAgainst: 20}))
+			Expect(ladder[1].Team).To(Equal(c))
+			Expect(ladder[1].Points).To(Equal(4))
+			Expect(ladder[2].Team).To(Equal(a))
+			Expect(ladder[2].Points).To(Equal(2))
+		})
+	})
+
+	Describe("buildFinalsBracket", func() {
+		It("should leave seeds empty until the regular season is finished", func() {
+			games := regularSeason()
+			games[0].Status = db.GameStatusPlaying
+
+			bracket := buildFinalsBracket(top6(), games, season)
+
+			Expect(bracket.Seeds).To(BeEmpty())
+			Expect(bracket.Rounds).To(HaveLen(3))
+			Expect(bracket.Rounds[0].Stage).To(Equal(qualifying))
+			_, _, ok := bracket.nextRound()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should seed the top of the ladder", func() {
+			bracket := buildFinalsBracket(top6(), regularSeason(), season)
+
+			Expect(bracket.Seeds).To(HaveLen(6))
+			for i, entry := range bracket.Seeds {
+				Expect(entry.Team).To(Equal(season.Teams[i]))
+			}
+		})
+
+		It("should only use as many finals stages as the format needs", func() {
+			finals := db.SeasonFinal{Format: db.FinalsFormatTop4}
+
+			bracket := buildFinalsBracket(finals, regularSeason(), season)
+
+			Expect(bracket.Rounds).To(HaveLen(2))
+			Expect(bracket.Rounds[1].Stage).To(Equal(semis))
+		})
+	})
+
+	Describe("nextRound", func() {
+		It("should give the top two seeds a bye in a top 6", func() {
+			bracket := buildFinalsBracket(top6(), regularSeason(), season)
+			t := season.Teams
+
+			round, pairings, ok := bracket.nextRound()
+
+			Expect(ok).To(BeTrue())
+			Expect(round).To(Equal(0))
+			Expect(pairs(pairings)).To(Equal([][2]uuid.UUID{{t[2].ID, t[5].ID}, {t[3].ID, t[4].ID}}))
+		})
+
+		It("should reseed survivors so the top seed meets the lowest", func() {
+			t := season.Teams
+			games := append(regularSeason(),
+				result(qualifying, 7, t[2], t[5], 10, 12),
+				result(qualifying, 7, t[3], t[4], 30, 3),
+			)
+			bracket := buildFinalsBracket(top6(), games, season)
+
+			round, pairings, ok := bracket.nextRound()
+
+			Expect(ok).To(BeTrue())
+			Expect(round).To(Equal(1))
+			Expect(pairs(pairings)).To(Equal([][2]uuid.UUID{{t[0].ID, t[5].ID}, {t[1].ID, t[3].ID}}))
+		})
+
+		It("should draw the final with the higher seed at home", func() {
+			t := season.Teams
+			games := append(regularSeason(),
+				result(qualifying, 7, t[2], t[5], 10, 12),
+				result(qualifying, 7, t[3], t[4], 30, 3),
+				result(semis, 14, t[0], t[5], 9, 15),
+				result(semis, 14, t[1], t[3], 22, 20),
+			)
+			bracket := buildFinalsBracket(top6(), games, season)
+
+			round, pairings, ok := bracket.nextRound()
+
+			Expect(ok).To(BeTrue())
+			Expect(round).To(Equal(2))
+			Expect(pairs(pairings)).To(Equal([][2]uuid.UUID{{t[1].ID, t[5].ID}}))
+		})
+
+		It("should wait while a round is unfinished or drawn", func() {
+			t := season.Teams
+			drawn := result(qualifying, 7, t[3], t[4], 10, 10)
+			playing := result(qualifying, 7, t[2], t[5], 10, 12)
+			playing.Status = db.GameStatusPlaying
+
+			for _, games := range [][]db.Game{
+				append(regularSeason(), drawn, result(qualifying, 7, t[2], t[5], 10, 12)),
+				append(regularSeason(), playing, result(qualifying, 7, t[3], t[4], 30, 3)),
+			} {
+				_, _, ok := buildFinalsBracket(top6(), games, season).nextRound()
+				Expect(ok).To(BeFalse())
+			}
+		})
+
+		It("should stop once the final has been drawn", func() {
+			t := season.Teams
+			// A top 4 plays its two rounds in the first two finals stages.
+			games := append(regularSeason(),
+				result(qualifying, 7, t[0], t[3], 20, 3),
+				result(qualifying, 7, t[1], t[2], 20, 3),
+				result(semis, 14, t[0], t[1], 20, 3),
+			)
+			finals := db.SeasonFinal{Format: db.FinalsFormatTop4}
+
+			_, _, ok := buildFinalsBracket(finals, games, season).nextRound()
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Set", func() {
+		It("should reject a format the season cannot hold", func() {
+			season.Stages = []db.Stage{regular, semis}
+
+			_, err := svc.Set(context.Background(), &api.FinalsRequest{Format: api.FinalsFormatTop8, RoundIntervalDays: 7}, season)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(HaveLen(2))
+			Expect(err).To(MatchError("invalid finals: top_8 needs 8 teams, season has 7; top_8 needs 3 finals stages, season has 1"))
+		})
+
+		It("should save the format and draw the first round once the regular season is over", func() {
+			games := regularSeason()
+			lastGame := games[len(games)-1].Date
+
+			var created []db.CreateGameParams
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				UpsertSeasonFinals(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.UpsertSeasonFinalsParams) error {
+					Expect(arg.SeasonID).To(Equal(season.ID))
+					Expect(arg.Format).To(Equal(db.FinalsFormatTop6))
+					Expect(arg.RoundIntervalDays).To(Equal(int32(7)))
+					return nil
+				})
+			mockQueries.EXPECT().
+				GetSeasonFinals(gomock.Any(), season.ID).
+				Return(top6(), nil).
+				Times(2)
+			gomock.InOrder(
+				mockQueries.EXPECT().
+					GetAllGamesBySeasonID(gomock.Any(), season.ID).
+					Return(games, nil),
+				mockQueries.EXPECT().
+					GetAllGamesBySeasonID(gomock.Any(), season.ID).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID) ([]db.Game, error) {
+						all := append([]db.Game(nil), games...)
+						for _, c := range created {
+							all = append(all, db.Game{ID: c.ID, StageID: c.StageID, Date: c.Date, HomeTeamID: c.HomeTeamID, AwayTeamID: c.AwayTeamID, Status: c.Status})
+						}
+						return all, nil
+					}),
+			)
+			mockQueries.EXPECT().
+				CreateGame(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateGameParams) error {
+					created = append(created, arg)
+					return nil
+				}).
+				Times(2)
+			mockQueries.EXPECT().
+				GetGame(gomock.Any(), gomock.Any()).
+				Return(db.Game{}, nil).
+				Times(2)
+			mockQueries.EXPECT().
+				GetTeamAliasesAt(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.GetTeamAliasesAtParams) ([]db.TeamAlias, error) {
+					Expect(arg.TeamIds).To(HaveLen(6))
+					Expect(arg.At).To(Equal(season.EndDate))
+					return []db.TeamAlias{{TeamID: arg.TeamIds[0], Name: "Old Name", Abbreviation: "OLD"}}, nil
+				})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			bracket, err := svc.Set(context.Background(), &api.FinalsRequest{Format: api.FinalsFormatTop6, RoundIntervalDays: 7}, season)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(HaveLen(2))
+			for _, c := range created {
+				Expect(c.StageID).To(Equal(qualifying.ID))
+				Expect(c.Date).To(Equal(lastGame.AddDate(0, 0, 7)))
+				Expect(c.Status).To(Equal(db.GameStatusScheduled))
+			}
+			Expect(bracket.Seeds).To(HaveLen(6))
+			Expect(bracket.Seeds[0].Team.Name).To(Equal("Old Name"))
+			Expect(bracket.Rounds[0].Games).To(HaveLen(2))
+		})
+
+		It("should roll back when saving the format fails", func() {
+			testErr := errors.New("a valid testing error")
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				UpsertSeasonFinals(gomock.Any(), gomock.Any()).
+				Return(testErr)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Set(context.Background(), &api.FinalsRequest{Format: api.FinalsFormatTop4, RoundIntervalDays: 7}, season)
+
+			Expect(err).To(MatchError("unable to save season finals: a valid testing error"))
+		})
+	})
+
+	Describe("Get", func() {
+		It("should return not found when the season has no finals format", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetSeasonFinals(gomock.Any(), season.ID).
+				Return(db.SeasonFinal{}, sql.ErrNoRows)
+
+			_, err := svc.Get(context.Background(), season)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(err).To(MatchError("unable to get season finals: finals not found"))
+		})
+	})
+
+	Describe("advanceFinals", func() {
+		It("should do nothing for a season without a finals format", func() {
+			mockQueries.EXPECT().
+				GetSeasonFinals(gomock.Any(), season.ID).
+				Return(db.SeasonFinal{}, sql.ErrNoRows)
+
+			Expect(advanceFinals(context.Background(), mockQueries, season)).To(Succeed())
+		})
+
+		It("should draw the semi finals once the qualifying finals are decided", func() {
+			t := season.Teams
+			games := append(regularSeason(),
+				result(qualifying, 7, t[2], t[5], 10, 12),
+				result(qualifying, 7, t[3], t[4], 30, 3),
+			)
+
+			mockQueries.EXPECT().
+				GetSeasonFinals(gomock.Any(), season.ID).
+				Return(top6(), nil)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), season.ID).
+				Return(games, nil)
+			mockQueries.EXPECT().
+				CreateGame(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateGameParams) error {
+					Expect(arg.StageID).To(Equal(semis.ID))
+					Expect(arg.Date).To(Equal(kickoff.AddDate(0, 0, 14)))
+					return nil
+				}).
+				Times(2)
+			mockQueries.EXPECT().
+				GetGame(gomock.Any(), gomock.Any()).
+				Return(db.Game{}, nil).
+				Times(2)
+
+			Expect(advanceFinals(context.Background(), mockQueries, season)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,509 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// FixtureService defines the contract for building a season's draw.
+type FixtureService interface {
+	Generate(ctx context.Context, req *api.FixtureGenerateRequest, season SeasonAggregate, dryRun bool) (FixturePlan, error)
+	Validate(ctx context.Context, season SeasonAggregate, rules FixtureRules) (FixtureReport, error)
+}
+
+// fixtureService is the concrete implementation backed by db_handler.DB.
+type fixtureService struct {
+	db    db_handler.DB
+	rules FixtureRules
+}
+
+// NewFixtureService returns a new FixtureService backed by db_handler.DB. Generated
+// draws are checked against rules.
+func NewFixtureService(db db_handler.DB, rules FixtureRules) FixtureService {
+	return &fixtureService{db: db, rules: rules}
+}
+
+// FixtureRules are the scheduling limits checked whenever games are created, moved or
+// generated.
+type FixtureRules struct {
+	// MinRestDays is the fewest full days a team must have off between games. 0 turns
+	// the check off.
+	MinRestDays int
+}
+
+// FixtureIssue is a single broken scheduling rule. GameIDs lists the games involved,
+// earliest first.
+type FixtureIssue struct {
+	Rule    api.FixtureRule
+	TeamID  uuid.UUID
+	GameIDs []uuid.UUID
+	Message string
+}
+
+func (i FixtureIssue) involves(gameID uuid.UUID) bool {
+	for _, id := range i.GameIDs {
+		if id == gameID {
+			return true
+		}
+	}
+	return false
+}
+
+// key identifies the issue by rule, team and games, whatever order the games are in.
+func (i FixtureIssue) key() string {
+	ids := make([]string, 0, len(i.GameIDs))
+	for _, id := range i.GameIDs {
+		ids = append(ids, id.String())
+	}
+	sort.Strings(ids)
+	return string(i.Rule) + "/" + i.TeamID.String() + "/" + strings.Join(ids, ",")
+}
+
+// FixtureReport is the result of checking every game in a season.
+type FixtureReport struct {
+	GameCount int
+	Rules     FixtureRules
+	Issues    []FixtureIssue
+}
+
+func (r FixtureReport) Valid() bool {
+	return len(r.Issues) == 0
+}
+
+// FixturePlan is a generated draw, one round per regular stage.
+type FixturePlan struct {
+	Rounds []FixtureRound
+}
+
+// GameCount returns the number of games across every round.
+func (p FixturePlan) GameCount() int {
+	count := 0
+	for _, r := range p.Rounds {
+		count += len(r.Games)
+	}
+	return count
+}
+
+type FixtureRound struct {
+	Stage db.Stage
+	Games []FixtureGame
+	Byes  []db.Team
+}
+
+// FixtureGame is a generated game. ID is only set once the game has been saved.
+type FixtureGame struct {
+	ID       uuid.UUID
+	Date     time.Time
+	HomeTeam db.Team
+	AwayTeam db.Team
+}
+
+func (s *fixtureService) Generate(
+	ctx context.Context,
+	req *api.FixtureGenerateRequest,
+	season SeasonAggregate,
+	dryRun bool,
+) (FixturePlan, error) {
+	plan, err := planRoundRobin(req, season)
+	if err != nil {
+		return FixturePlan{}, err
+	}
+
+	if dryRun {
+		err = db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+			if err := ensureRoundsEmpty(ctx, queries, season.ID, plan); err != nil {
+				return err
+			}
+			return checkPlanSchedule(ctx, queries, plan, season, s.rules)
+		})
+	} else {
+		err = db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+			if err := ensureRoundsEmpty(ctx, queries, season.ID, plan); err != nil {
+				return err
+			}
+			if err := checkPlanSchedule(ctx, queries, plan, season, s.rules); err != nil {
+				return err
+			}
+			return createFixtures(ctx, queries, &plan, season)
+		})
+	}
+	if err != nil {
+		return FixturePlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (s *fixtureService) Validate(ctx context.Context, season SeasonAggregate, rules FixtureRules) (FixtureReport, error) {
+	var games []db.Game
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		games, err = queries.GetAllGamesBySeasonID(ctx, season.ID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get season games")
+		}
+		return nil
+	})
+	if err != nil {
+		return FixtureReport{}, err
+	}
+
+	return FixtureReport{
+		GameCount: len(games),
+		Rules:     rules,
+		Issues:    checkFixtures(games, season, rules),
+	}, nil
+}
+
+// ensureRoundsEmpty refuses to generate into stages that already hold games, so a
+// draw is never merged with one created earlier.
+func ensureRoundsEmpty(ctx context.Context, queries db_handler.Queries, seasonID uuid.UUID, plan FixturePlan) error {
+	for _, round := range plan.Rounds {
+		count, err := queries.CountGames(ctx, db.CountGamesParams{
+			SeasonID: seasonID,
+			StageID:  uuid.NullUUID{UUID: round.Stage.ID, Valid: true},
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to count stage games")
+		}
+		if count > 0 {
+			return NewConflictError(fmt.Sprintf("stage %s already has games", round.Stage.Name), nil)
+		}
+	}
+	return nil
+}
+
+// checkPlanSchedule checks a draw against the games already in the season, so a
+// generated round cannot clash with a game scheduled by hand in another stage.
+func checkPlanSchedule(
+	ctx context.Context,
+	queries db_handler.Queries,
+	plan FixturePlan,
+	season SeasonAggregate,
+	rules FixtureRules,
+) error {
+	games, err := queries.GetAllGamesBySeasonID(ctx, season.ID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get season games")
+	}
+
+	// Planned games have no ID yet, so give each a placeholder to tell them apart.
+	planned := make(map[uuid.UUID]struct{}, plan.GameCount())
+	for _, round := range plan.Rounds {
+		for _, g := range round.Games {
+			id := uuid.New()
+			planned[id] = struct{}{}
+			games = append(games, db.Game{
+				ID:         id,
+				SeasonID:   season.ID,
+				StageID:    round.Stage.ID,
+				Date:       g.Date,
+				HomeTeamID: g.HomeTeam.ID,
+				AwayTeamID: g.AwayTeam.ID,
+			})
+		}
+	}
+
+	var fields []FieldError
+	for _, issue := range checkFixtures(games, season, rules) {
+		for _, id := range issue.GameIDs {
+			if _, ok := planned[id]; ok {
+				fields = append(fields, FieldError{Field: "fixtures", Rule: string(issue.Rule), Message: issue.Message})
+				break
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return NewValidationError("invalid fixtures", fields...)
+	}
+
+	return nil
+}
+
+func createFixtures(ctx context.Context, queries db_handler.Queries, plan *FixturePlan, season SeasonAggregate) error {
+	for i := range plan.Rounds {
+		round := &plan.Rounds[i]
+		for j := range round.Games {
+			fixture := &round.Games[j]
+
+			game, err := insertGame(ctx, queries, &api.GameRequest{
+				StageID:    round.Stage.ID,
+				Date:       fixture.Date,
+				HomeTeamID: fixture.HomeTeam.ID,
+				AwayTeamID: fixture.AwayTeam.ID,
+			}, season)
+			if err != nil {
+				return errors.Wrapf(err, "unable to create fixture for stage %s", round.Stage.Name)
+			}
+
+			fixture.ID = game.ID
+		}
+	}
+	return nil
+}
+
+// planRoundRobin builds a single or double round-robin over the season's teams, placing
+// one round in each regular stage. Teams are ordered by name so the same season always
+// produces the same draw.
+func planRoundRobin(req *api.FixtureGenerateRequest, season SeasonAggregate) (FixturePlan, error) {
+	teams := append([]db.Team(nil), season.Teams...)
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+
+	if len(teams) < 2 {
+		return FixturePlan{}, NewValidationError("invalid fixtures", FieldError{
+			Field:   "teams",
+			Rule:    "min_teams",
+			Message: "season needs at least two teams",
+		})
+	}
+
+	rounds := roundRobinRounds(len(teams))
+	if req.DoubleRoundRobin {
+		rounds = append(rounds, reverseFixtures(rounds)...)
+	}
+
+	stages := stagesOfType(season.Stages, db.StageTypeRegular)
+	if len(stages) < len(rounds) {
+		return FixturePlan{}, NewValidationError("invalid fixtures", FieldError{
+			Field:   "stages",
+			Rule:    "enough_rounds",
+			Message: fmt.Sprintf("draw needs %d regular stages, season has %d", len(rounds), len(stages)),
+		})
+	}
+
+	plan := FixturePlan{Rounds: make([]FixtureRound, 0, len(rounds))}
+	for r, pairings := range rounds {
+		roundDate := req.FirstRoundDate.AddDate(0, 0, r*req.RoundIntervalDays)
+		round := FixtureRound{Stage: stages[r]}
+
+		for _, p := range pairings {
+			switch {
+			case p.away >= len(teams):
+				round.Byes = append(round.Byes, teams[p.home])
+			case p.home >= len(teams):
+				round.Byes = append(round.Byes, teams[p.away])
+			default:
+				slot := req.KickoffSlots[len(round.Games)%len(req.KickoffSlots)]
+				date, err := kickoff(roundDate, slot)
+				if err != nil {
+					return FixturePlan{}, err
+				}
+
+				round.Games = append(round.Games, FixtureGame{
+					Date:     date,
+					HomeTeam: teams[p.home],
+					AwayTeam: teams[p.away],
+				})
+			}
+		}
+
+		plan.Rounds = append(plan.Rounds, round)
+	}
+
+	if err := ensurePlanWithinSeason(plan, season); err != nil {
+		return FixturePlan{}, err
+	}
+
+	return plan, nil
+}
+
+type pairing struct {
+	home int
+	away int
+}
+
+// roundRobinRounds pairs n teams using the circle method. An odd team count gets a
+// phantom team n, and whoever draws it has a bye. The fixed team alternates home and
+// away so every team's home and away counts differ by at most one.
+func roundRobinRounds(n int) [][]pairing {
+	size := n
+	if size%2 == 1 {
+		size++
+	}
+	fixed := size - 1
+
+	rounds := make([][]pairing, 0, fixed)
+	for r := 0; r < fixed; r++ {
+		round := make([]pairing, 0, size/2)
+
+		if r%2 == 0 {
+			round = append(round, pairing{home: r, away: fixed})
+		} else {
+			round = append(round, pairing{home: fixed, away: r})
+		}
+
+		for k := 1; k < size/2; k++ {
+			round = append(round, pairing{
+				home: (r + k) % fixed,
+				away: (r - k + fixed) % fixed,
+			})
+		}
+
+		rounds = append(rounds, round)
+	}
+
+	return rounds
+}
+
+// reverseFixtures returns the second leg of a double round-robin: the same rounds in
+// the same order with home and away swapped.
+func reverseFixtures(rounds [][]pairing) [][]pairing {
+	reversed := make([][]pairing, 0, len(rounds))
+	for _, round := range rounds {
+		leg := make([]pairing, 0, len(round))
+		for _, p := range round {
+			leg = append(leg, pairing{home: p.away, away: p.home})
+		}
+		reversed = append(reversed, leg)
+	}
+	return reversed
+}
+
+// stagesOfType returns the stages of stageType ordered by order_index.
+func stagesOfType(stages []db.Stage, stageType db.StageType) []db.Stage {
+	matched := make([]db.Stage, 0, len(stages))
+	for _, st := range stages {
+		if st.StageType == stageType {
+			matched = append(matched, st)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].OrderIndex < matched[j].OrderIndex })
+	return matched
+}
+
+func kickoff(roundDate time.Time, slot api.KickoffSlot) (time.Time, error) {
+	t, err := time.Parse("15:04", slot.Time)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid kickoff time %q", slot.Time)
+	}
+
+	year, month, day := roundDate.Date()
+	return time.Date(year, month, day+slot.DayOffset, t.Hour(), t.Minute(), 0, 0, roundDate.Location()), nil
+}
+
+func ensurePlanWithinSeason(plan FixturePlan, season SeasonAggregate) error {
+	for _, round := range plan.Rounds {
+		for _, g := range round.Games {
+			if g.Date.Before(season.StartDate) || g.Date.After(season.EndDate) {
+				return NewValidationError("invalid fixtures", FieldError{
+					Field: "first_round_date",
+					Rule:  "within_season",
+					Message: fmt.Sprintf("stage %s kicks off at %s, outside season bounds (%s - %s)",
+						round.Stage.Name, g.Date, season.StartDate, season.EndDate),
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// checkFixtures reports every scheduling rule broken by games: a team drawn against
+// itself, a team with two games in one stage or on one day, and a team with fewer than
+// rules.MinRestDays full days between consecutive games. Days are UTC calendar days.
+// Self matches come first, then each team's issues in order of its first game.
+func checkFixtures(games []db.Game, season SeasonAggregate, rules FixtureRules) []FixtureIssue {
+	sorted := append([]db.Game(nil), games...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	teamNames := make(map[uuid.UUID]string, len(season.Teams))
+	for _, t := range season.Teams {
+		teamNames[t.ID] = t.Name
+	}
+	teamName := func(id uuid.UUID) string {
+		if name, ok := teamNames[id]; ok {
+			return name
+		}
+		return id.String()
+	}
+
+	stageNames := make(map[uuid.UUID]string, len(season.Stages))
+	for _, st := range season.Stages {
+		stageNames[st.ID] = st.Name
+	}
+
+	var issues []FixtureIssue
+	var teamOrder []uuid.UUID
+	teamGames := map[uuid.UUID][]db.Game{}
+
+	for _, g := range sorted {
+		if g.HomeTeamID == g.AwayTeamID {
+			issues = append(issues, FixtureIssue{
+				Rule:    api.FixtureRuleSelfMatch,
+				TeamID:  g.HomeTeamID,
+				GameIDs: []uuid.UUID{g.ID},
+				Message: fmt.Sprintf("%s is drawn against itself on %s", teamName(g.HomeTeamID), g.Date.UTC().Format(time.DateOnly)),
+			})
+			continue
+		}
+
+		for _, teamID := range []uuid.UUID{g.HomeTeamID, g.AwayTeamID} {
+			if _, ok := teamGames[teamID]; !ok {
+				teamOrder = append(teamOrder, teamID)
+			}
+			teamGames[teamID] = append(teamGames[teamID], g)
+		}
+	}
+
+	for _, teamID := range teamOrder {
+		name := teamName(teamID)
+		firstInStage := map[uuid.UUID]db.Game{}
+
+		for i, g := range teamGames[teamID] {
+			if first, ok := firstInStage[g.StageID]; ok {
+				issues = append(issues, FixtureIssue{
+					Rule:    api.FixtureRuleDoubleBooked,
+					TeamID:  teamID,
+					GameIDs: []uuid.UUID{first.ID, g.ID},
+					Message: fmt.Sprintf("%s plays twice in %s", name, stageNames[g.StageID]),
+				})
+			} else {
+				firstInStage[g.StageID] = g
+			}
+
+			if i == 0 {
+				continue
+			}
+			prev := teamGames[teamID][i-1]
+			days := calendarDaysBetween(prev.Date, g.Date)
+
+			switch {
+			case days == 0 && prev.StageID != g.StageID:
+				issues = append(issues, FixtureIssue{
+					Rule:    api.FixtureRuleDoubleBooked,
+					TeamID:  teamID,
+					GameIDs: []uuid.UUID{prev.ID, g.ID},
+					Message: fmt.Sprintf("%s plays twice on %s", name, g.Date.UTC().Format(time.DateOnly)),
+				})
+			case days > 0 && days-1 < rules.MinRestDays:
+				issues = append(issues, FixtureIssue{
+					Rule:    api.FixtureRuleMinRestDays,
+					TeamID:  teamID,
+					GameIDs: []uuid.UUID{prev.ID, g.ID},
+					Message: fmt.Sprintf("%s has %d rest days between %s and %s, minimum is %d",
+						name, days-1, prev.Date.UTC().Format(time.DateOnly), g.Date.UTC().Format(time.DateOnly), rules.MinRestDays),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+func calendarDaysBetween(from, to time.Time) int {
+	fy, fm, fd := from.UTC().Date()
+	ty, tm, td := to.UTC().Date()
+	start := time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)
+	end := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours() / 24)
+}
@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("fixture", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc FixtureService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewFixtureService(mockDB, FixtureRules{MinRestDays: 3})
+	})
+
+	seasonStart := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	newSeason := func(teamCount, regularStages int) SeasonAggregate {
+		season := SeasonAggregate{
+			ID:        uuid.New(),
+			StartDate: seasonStart,
+			EndDate:   seasonStart.AddDate(0, 6, 0),
+		}
+		for i := 0; i < teamCount; i++ {
+			season.Teams = append(season.Teams, db.Team{ID: uuid.New(), Name: fmt.Sprintf("Team %02d", i)})
+		}
+		// Added in reverse to check stages are ordered by order_index.
+		for i := regularStages; i >= 1; i-- {
+			season.Stages = append(season.Stages, db.Stage{
+				ID:         uuid.New(),
+				Name:       fmt.Sprintf("Round %d", i),
+				StageType:  db.StageTypeRegular,
+				OrderIndex: int32(i),
+			})
+		}
+		season.Stages = append(season.Stages, db.Stage{
+			ID:         uuid.New(),
+			Name:       "Final",
+			StageType:  db.StageTypeFinals,
+			OrderIndex: int32(regularStages + 1),
+		})
+		return season
+	}
+
+	newRequest := func(double bool) *api.FixtureGenerateRequest {
+		req := &api.FixtureGenerateRequest{
+			DoubleRoundRobin: double,
+			FirstRoundDate:   seasonStart.Add(19*time.Hour + 5*time.Minute),
+		}
+		req.SetDefaults()
+		return req
+	}
+
+	type tally struct{ home, away int }
+
+	countVenues := func(plan FixturePlan) map[uuid.UUID]*tally {
+		counts := map[uuid.UUID]*tally{}
+		for _, r := range plan.Rounds {
+			for _, g := range r.Games {
+				if counts[g.HomeTeam.ID] == nil {
+					counts[g.HomeTeam.ID] = &tally{}
+				}
+				if counts[g.AwayTeam.ID] == nil {
+					counts[g.AwayTeam.ID] = &tally{}
+				}
+				counts[g.HomeTeam.ID].home++
+				counts[g.AwayTeam.ID].away++
+			}
+		}
+		return counts
+	}
+
+	Describe("planRoundRobin", func() {
+		It("should pair every team once with balanced home and away games", func() {
+			season := newSeason(6, 5)
+
+			plan, err := planRoundRobin(newRequest(false), season)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Rounds).To(HaveLen(5))
+			Expect(plan.GameCount()).To(Equal(15))
+
+			met := map[[2]uuid.UUID]int{}
+			for i, r := range plan.Rounds {
+				Expect(r.Stage.OrderIndex).To(Equal(int32(i + 1)))
+				Expect(r.Byes).To(BeEmpty())
+
+				playing := map[uuid.UUID]bool{}
+				for _, g := range r.Games {
+					Expect(playing).NotTo(HaveKey(g.HomeTeam.ID))
+					Expect(playing).NotTo(HaveKey(g.AwayTeam.ID))
+					playing[g.HomeTeam.ID], playing[g.AwayTeam.ID] = true, true
+
+					key := [2]uuid.UUID{g.HomeTeam.ID, g.AwayTeam.ID}
+					if key[0].String() > key[1].String() {
+						key[0], key[1] = key[1], key[0]
+					}
+					met[key]++
+				}
+			}
+			Expect(met).To(HaveLen(15))
+			for _, n := range met {
+				Expect(n).To(Equal(1))
+			}
+
+			for _, t := range countVenues(plan) {
+				Expect(t.home - t.away).To(BeNumerically("~", 0, 1))
+			}
+		})
+
+		It("should give each team one bye when the team count is odd", func() {
+			season := newSeason(5, 5)
+
+			plan, err := planRoundRobin(newRequest(false), season)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.GameCount()).To(Equal(10))
+
+			byes := map[uuid.UUID]int{}
+			for _, r := range plan.Rounds {
+				Expect(r.Games).To(HaveLen(2))
+				Expect(r.Byes).To(HaveLen(1))
+				byes[r.Byes[0].ID]++
+			}
+			Expect(byes).To(HaveLen(5))
+
+			for _, t := range countVenues(plan) {
+				Expect(t.home).To(Equal(t.away))
+			}
+		})
+
+		It("should mirror the first leg in a double round-robin", func() {
+			season := newSeason(4, 6)
+
+			plan, err := planRoundRobin(newRequest(true), season)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Rounds).To(HaveLen(6))
+			for i := 0; i < 3; i++ {
+				first, second := plan.Rounds[i].Games, plan.Rounds[i+3].Games
+				for j := range first {
+					Expect(second[j].HomeTeam).To(Equal(first[j].AwayTeam))
+					Expect(second[j].AwayTeam).To(Equal(first[j].HomeTeam))
+				}
+			}
+			for _, t := range countVenues(plan) {
+				Expect(t.home).To(Equal(3))
+				Expect(t.away).To(Equal(3))
+			}
+		})
+
+		It("should schedule rounds by interval and hand out kickoff slots in turn", func() {
+			season := newSeason(4, 3)
+			req := &api.FixtureGenerateRequest{
+				FirstRoundDate:    seasonStart,
+				RoundIntervalDays: 7,
+				KickoffSlots: []api.KickoffSlot{
+					{DayOffset: 0, Time: "19:05"},
+					{DayOffset: 1, Time: "14:35"},
+				},
+			}
+			req.SetDefaults()
+
+			plan, err := planRoundRobin(req, season)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Rounds[1].Games[0].Date).To(Equal(time.Date(2025, time.August, 8, 19, 5, 0, 0, time.UTC)))
+			Expect(plan.Rounds[1].Games[1].Date).To(Equal(time.Date(2025, time.August, 9, 14, 35, 0, 0, time.UTC)))
+		})
+
+		It("should default to the first round's kickoff time", func() {
+			plan, err := planRoundRobin(newRequest(false), newSeason(4, 3))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Rounds[2].Games[1].Date).To(Equal(time.Date(2025, time.August, 15, 19, 5, 0, 0, time.UTC)))
+		})
+
+		It("should reject a draw with too few regular stages", func() {
+			_, err := planRoundRobin(newRequest(true), newSeason(4, 5))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields[0].Rule).To(Equal("enough_rounds"))
+			Expect(err).To(MatchError("invalid fixtures: draw needs 6 regular stages, season has 5"))
+		})
+
+		It("should reject a draw that runs past the season", func() {
+			req := newRequest(false)
+			req.FirstRoundDate = seasonStart.AddDate(0, 5, 20)
+
+			_, err := planRoundRobin(req, newSeason(4, 3))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields[0].Rule).To(Equal("within_season"))
+		})
+
+		It("should reject a season with fewer than two teams", func() {
+			_, err := planRoundRobin(newRequest(false), newSeason(1, 3))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields[0].Rule).To(Equal("min_teams"))
+		})
+	})
+
+	Describe("Generate", func() {
+		It("should preview a draw without creating games", func() {
+			season := newSeason(4, 3)
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				CountGames(gomock.Any(), gomock.Any()).
+				Return(int64(0), nil).
+				Times(3)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), season.ID).
+				Return(nil, nil)
+
+			plan, err := svc.Generate(context.Background(), newRequest(false), season, true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.GameCount()).To(Equal(6))
+			Expect(plan.Rounds[0].Games[0].ID).To(Equal(uuid.Nil))
+		})
+
+		It("should create every game in one transaction", func() {
+			season := newSeason(4, 3)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				CountGames(gomock.Any(), gomock.Any()).
+				Return(int64(0), nil).
+				Times(3)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), season.ID).
+				Return(nil, nil)
+			mockQueries.EXPECT().
+				CreateGame(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(6)
+			mockQueries.EXPECT().
+				GetGame(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, id uuid.UUID) (db.Game, error) {
+					return db.Game{ID: id}, nil
+				}).
+				Times(6)
+			mockDB.EXPECT().Commit(gomock.Any())
+			mockDB.EXPECT().Rollback(gomock.Any()).Times(0)
+
+			plan, err := svc.Generate(context.Background(), newRequest(false), season, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			for _, r := range plan.Rounds {
+				for _, g := range r.Games {
+					Expect(g.ID).NotTo(Equal(uuid.Nil))
+				}
+			}
+		})
+
+		It("should refuse to generate into stages that already have games", func() {
+			season := newSeason(4, 3)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				CountGames(gomock.Any(), gomock.Any()).
+				Return(int64(2), nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Generate(context.Background(), newRequest(false), season, false)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(err).To(MatchError("stage Round 1 already has games"))
+		})
+
+		It("should roll back when creating a game fails", func() {
+			season := newSeason(4, 3)
+			testErr := errors.New("a valid testing error")
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				CountGames(gomock.Any(), gomock.Any()).
+				Return(int64(0), nil).
+				Times(3)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), season.ID).
+				Return(nil, nil)
+			mockQueries.EXPECT().
+				CreateGame(gomock.Any(), gomock.Any()).
+				Return(testErr)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Generate(context.Background(), newRequest(false), season, false)
+
+			Expect(err).To(MatchError("unable to create fixture for stage Round 1: unable to create new game: a valid testing error"))
+		})
+
+		It("should reject a draw that clashes with a game in another stage", func() {
+			season := newSeason(4, 3)
+			finals := season.Stages[len(season.Stages)-1]
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				CountGames(gomock.Any(), gomock.Any()).
+				Return(int64(0), nil).
+				Times(3)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), season.ID).
+				Return([]db.Game{{
+					ID:         uuid.New(),
+					StageID:    finals.ID,
+					Date:       seasonStart.Add(12 * time.Hour),
+					HomeTeamID: season.Teams[0].ID,
+					AwayTeamID: season.Teams[1].ID,
+				}}, nil)
+
+			_, err := svc.Generate(context.Background(), newRequest(false), season, true)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields[0].Field).To(Equal("fixtures"))
+			Expect(validationErr.Fields[0].Rule).To(Equal(string(api.FixtureRuleDoubleBooked)))
+			Expect(err.Error()).To(ContainSubstring("Team 00 plays twice on 2025-08-01"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("should check every game in the season with the given rules", func() {
+			season := newSeason(4, 3)
+			stage := season.Stages[0]
+			games := []db.Game{
+				{ID: uuid.New(), StageID: stage.ID, Date: seasonStart, HomeTeamID: season.Teams[0].ID, AwayTeamID: season.Teams[1].ID},
+				{ID: uuid.New(), StageID: season.Stages[1].ID, Date: seasonStart.AddDate(0, 0, 2), HomeTeamID: season.Teams[0].ID, AwayTeamID: season.Teams[2].ID},
+			}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), season.ID).
+				Return(games, nil)
+
+			report, err := svc.Validate(context.Background(), season, FixtureRules{MinRestDays: 2})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.GameCount).To(Equal(2))
+			Expect(report.Rules.MinRestDays).To(Equal(2))
+			Expect(report.Valid()).To(BeFalse())
+			Expect(report.Issues).To(HaveLen(1))
+			Expect(report.Issues[0].Rule).To(Equal(api.FixtureRuleMinRestDays))
+			Expect(report.Issues[0].Message).To(Equal("Team 00 has 1 rest days between 2025-08-01 and 2025-08-03, minimum is 2"))
+		})
+
+		It("should return an error when getting games fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetAllGamesBySeasonID(gomock.Any(), gomock.Any()).
+				Return(nil, errors.New("a valid testing error"))
+
+			_, err := svc.Validate(context.Background(), newSeason(4, 3), FixtureRules{})
+
+			Expect(err).To(MatchError("unable to get season games: a valid testing error"))
+		})
+	})
+
+	Describe("checkFixtures", func() {
+		var season SeasonAggregate
+		var a, b, c uuid.UUID
+		var round1, round2, final uuid.UUID
+
+		BeforeEach(func() {
+			season = newSeason(3, 2)
+			a, b, c = season.Teams[0].ID, season.Teams[1].ID, season.Teams[2].ID
+			round1, round2, final = season.Stages[1].ID, season.Stages[0].ID, season.Stages[2].ID
+		})
+
+		game := func(stageID uuid.UUID, day int, home, away uuid.UUID) db.Game {
+			return db.Game{
+				ID:         uuid.New(),
+				StageID:    stageID,
+				Date:       seasonStart.AddDate(0, 0, day).Add(19 * time.Hour),
+				HomeTeamID: home,
+				AwayTeamID: away,
+			}
+		}
+
+		rules := FixtureRules{MinRestDays: 3}
+
+		It("should pass a well spaced schedule", func() {
+			games := []db.Game{
+				game(round1, 0, a, b),
+				game(round2, 7, b, c),
+				game(final, 14, a, c),
+			}
+
+			Expect(checkFixtures(games, season, rules)).To(BeEmpty())
+		})
+
+		It("should flag a team drawn against itself", func() {
+			g := game(round1, 0, a, a)
+
+			issues := checkFixtures([]db.Game{g}, season, rules)
+
+			Expect(issues).To(ConsistOf(FixtureIssue{
+				Rule:    api.FixtureRuleSelfMatch,
+				TeamID:  a,
+				GameIDs: []uuid.UUID{g.ID},
+				Message: "Team 00 is drawn against itself on 2025-08-01",
+			}))
+		})
+
+		It("should flag a team with two games in one stage", func() {
+			first, second := game(round1, 0, a, b), game(round1, 4, c, a)
+
+			issues := checkFixtures([]db.Game{second, first}, season, rules)
+
+			Expect(issues).To(ConsistOf(FixtureIssue{
+				Rule:    api.FixtureRuleDoubleBooked,
+				TeamID:  a,
+				GameIDs: []uuid.UUID{first.ID, second.ID},
+				Message: "Team 00 plays twice in Round 1",
+			}))
+		})
+
+		It("should flag a team with two games on one day across stages", func() {
+			first, second := game(round1, 0, a, b), game(final, 0, a, c)
+
+			issues := checkFixtures([]db.Game{first, second}, season, rules)
+
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].Rule).To(Equal(api.FixtureRuleDoubleBooked))
+			Expect(issues[0].GameIDs).To(Equal([]uuid.UUID{first.ID, second.ID}))
+			Expect(issues[0].Message).To(Equal("Team 00 plays twice on 2025-08-01"))
+		})
+
+		It("should flag too few rest days for each team", func() {
+			games := []db.Game{
+				game(round1, 0, a, b),
+				game(round2, 3, b, a),
+			}
+
+			issues := checkFixtures(games, season, rules)
+
+			Expect(issues).To(HaveLen(2))
+			for _, issue := range issues {
+				Expect(issue.Rule).To(Equal(api.FixtureRuleMinRestDays))
+			}
+			Expect(issues[0].Message).To(Equal("Team 00 has 2 rest days between 2025-08-01 and 2025-08-04, minimum is 3"))
+		})
+
+		It("should skip the rest day check when the minimum is zero", func() {
+			games := []db.Game{
+				game(round1, 0, a, b),
+				game(round2, 1, b, a),
+			}
+
+			Expect(checkFixtures(games, season, FixtureRules{})).To(BeEmpty())
+		})
+	})
+})
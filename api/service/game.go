@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
@@ -16,26 +17,52 @@ import (
 type GameService interface {
 	Create(ctx context.Context, req *api.GameRequest, season SeasonAggregate) (db.Game, error)
 	GetAll(ctx context.Context, seasonID, stageID uuid.UUID) ([]db.Game, error)
+	GetAllBySeason(ctx context.Context, seasonID uuid.UUID, filter GameFilter, limit, offset int) ([]db.Game, int64, error)
+	GetFeed(ctx context.Context, filter GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error)
 	Get(ctx context.Context, gameID uuid.UUID) (db.Game, error)
-	Update(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season SeasonAggregate) (db.Game, error)
+	GetDetails(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error)
+	Update(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season SeasonAggregate, version *time.Time) (db.Game, error)
+	Batch(ctx context.Context, reqs []api.GameRequest, season SeasonAggregate) (GameBatchResult, error)
 	Delete(ctx context.Context, gameID uuid.UUID) error
 }
 
+// GameFilter narrows a game listing. Null or zero-valued fields are not applied.
+// CompetitionID only applies to the cross-competition feed.
+type GameFilter struct {
+	CompetitionID uuid.NullUUID
+	StageID       uuid.NullUUID
+	TeamID        uuid.NullUUID
+	Status        api.GameStatus
+	From          time.Time
+	To            time.Time
+	Descending    bool
+}
+
+// GameBatchResult holds the games saved by a batch, in request order.
+type GameBatchResult struct {
+	Games   []db.Game
+	Created int
+	Updated int
+}
+
 // gameService is the concrete implementation backed by db_handler.DB.
 type gameService struct {
-	db db_handler.DB
+	db    db_handler.DB
+	rules FixtureRules
 }
 
-func NewGameService(db db_handler.DB) GameService {
-	return &gameService{db: db}
+// NewGameService returns a new GameService backed by db_handler.DB. Created and updated
+// games are checked against rules.
+func NewGameService(db db_handler.DB, rules FixtureRules) GameService {
+	return &gameService{db: db, rules: rules}
 }
 
 func (s *gameService) Create(ctx context.Context, req *api.GameRequest, season SeasonAggregate) (db.Game, error) {
 	var game db.Game
 
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var txErr error
-		game, txErr = createGame(ctx, queries, req, season)
+		game, txErr = createGame(ctx, queries, req, season, s.rules)
 		return txErr
 	})
 	if err != nil {
@@ -48,7 +75,7 @@ func (s *gameService) Create(ctx context.Context, req *api.GameRequest, season S
 func (s *gameService) GetAll(ctx context.Context, seasonID, stageID uuid.UUID) ([]db.Game, error) {
 	var games []db.Game
 
-	err := db_handler.Run(ctx, s.db, func(q db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
 		var err error
 		games, err = q.GetGamesByStageID(ctx, db.GetGamesByStageIDParams{
 			SeasonID: seasonID,
@@ -63,28 +90,97 @@ func (s *gameService) GetAll(ctx context.Context, seasonID, stageID uuid.UUID) (
 	return games, nil
 }
 
+func (s *gameService) GetAllBySeason(ctx context.Context, seasonID uuid.UUID, filter GameFilter, limit, offset int) ([]db.Game, int64, error) {
+	var games []db.Game
+	var total int64
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		games, total, err = getSeasonGames(ctx, queries, seasonID, filter, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return games, total, nil
+}
+
+func (s *gameService) GetFeed(ctx context.Context, filter GameFilter, limit, offset int) ([]db.GetGameFeedRow, int64, error) {
+	var games []db.GetGameFeedRow
+	var total int64
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		games, total, err = getGameFeed(ctx, queries, filter, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return games, total, nil
+}
+
 func (s *gameService) Get(ctx context.Context, gameID uuid.UUID) (db.Game, error) {
 	var game db.Game
 
-	err := db_handler.Run(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		game, err = queries.GetGame(ctx, gameID)
 		return err
 	})
 	if err != nil {
-		return db.Game{}, errors.Wrap(err, "unable to get game")
+		return db.Game{}, wrapDBError(err, "game", "unable to get game")
 	}
 
 	return game, nil
 }
 
-func (s *gameService) Update(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season SeasonAggregate) (db.Game, error) {
+func (s *gameService) GetDetails(ctx context.Context, gameIDs []uuid.UUID) (map[uuid.UUID]db.GetGameDetailsRow, error) {
+	var rows []db.GetGameDetailsRow
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		rows, err = queries.GetGameDetails(ctx, gameIDs)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get game details")
+	}
+
+	details := make(map[uuid.UUID]db.GetGameDetailsRow, len(rows))
+	for _, row := range rows {
+		details[row.ID] = row
+	}
+
+	return details, nil
+}
+
+func (s *gameService) Update(ctx context.Context, req *api.GameRequest, gameID uuid.UUID, season SeasonAggregate, version *time.Time) (db.Game, error) {
 	var game db.Game
 
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := checkVersion(ctx, "game", gameID, version, queries.LockGame); err != nil {
+			return err
+		}
+
+		current, err := queries.GetGame(ctx, gameID)
+		if err != nil {
+			return wrapDBError(err, "game", "unable to get game")
+		}
+
 		var txErr error
-		game, txErr = updateGame(ctx, queries, req, gameID, season)
-		return txErr
+		game, txErr = updateGame(ctx, queries, req, current, season, s.rules)
+		if txErr != nil {
+			return txErr
+		}
+
+		// A finished game may complete the regular season or a finals round.
+		if game.Status != db.GameStatusFinished {
+			return nil
+		}
+		return advanceFinals(ctx, queries, season)
 	})
 	if err != nil {
 		return db.Game{}, err
@@ -93,8 +189,61 @@ func (s *gameService) Update(ctx context.Context, req *api.GameRequest, gameID u
 	return game, nil
 }
 
+// Batch creates and updates games in one transaction. Every game is checked before any
+// error is returned, so a failed batch reports all of its problems at once, each field
+// prefixed with the game's position, e.g. "games[2].home_team_id". Nothing is saved
+// unless every game is valid.
+func (s *gameService) Batch(ctx context.Context, reqs []api.GameRequest, season SeasonAggregate) (GameBatchResult, error) {
+	if err := validateBatchIDs(reqs); err != nil {
+		return GameBatchResult{}, err
+	}
+
+	var result GameBatchResult
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		result = GameBatchResult{Games: make([]db.Game, 0, len(reqs))}
+
+		var fields []FieldError
+		finished := false
+
+		for i := range reqs {
+			game, err := saveBatchGame(ctx, queries, &reqs[i], season, s.rules)
+			if err != nil {
+				itemFields, ok := batchFieldErrors(i, err)
+				if !ok {
+					return errors.Wrapf(err, "unable to save games[%d]", i)
+				}
+				fields = append(fields, itemFields...)
+				continue
+			}
+
+			if reqs[i].ID == nil {
+				result.Created++
+			} else {
+				result.Updated++
+			}
+			finished = finished || game.Status == db.GameStatusFinished
+			result.Games = append(result.Games, game)
+		}
+
+		if len(fields) > 0 {
+			return NewValidationError("invalid games", fields...)
+		}
+
+		if !finished {
+			return nil
+		}
+		return advanceFinals(ctx, queries, season)
+	})
+	if err != nil {
+		return GameBatchResult{}, err
+	}
+
+	return result, nil
+}
+
 func (s *gameService) Delete(ctx context.Context, gameID uuid.UUID) error {
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		return deleteGame(ctx, queries, gameID)
 	})
 	if err != nil {
@@ -103,16 +252,126 @@ func (s *gameService) Delete(ctx context.Context, gameID uuid.UUID) error {
 	return nil
 }
 
+func getSeasonGames(
+	ctx context.Context,
+	queries db_handler.Queries,
+	seasonID uuid.UUID,
+	filter GameFilter,
+	limit int,
+	offset int,
+) ([]db.Game, int64, error) {
+	status, from, to := filter.nullStatus(), filter.nullFrom(), filter.nullTo()
+
+	games, err := queries.GetGamesBySeasonID(ctx, db.GetGamesBySeasonIDParams{
+		SeasonID:   seasonID,
+		StageID:    filter.StageID,
+		TeamID:     filter.TeamID,
+		Status:     status,
+		DateFrom:   from,
+		DateTo:     to,
+		SortDesc:   filter.Descending,
+		PageLimit:  int32(limit),
+		PageOffset: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to get games")
+	}
+
+	total, err := queries.CountGames(ctx, db.CountGamesParams{
+		SeasonID: seasonID,
+		StageID:  filter.StageID,
+		TeamID:   filter.TeamID,
+		Status:   status,
+		DateFrom: from,
+		DateTo:   to,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to count games")
+	}
+
+	return games, total, nil
+}
+
+func getGameFeed(
+	ctx context.Context,
+	queries db_handler.Queries,
+	filter GameFilter,
+	limit int,
+	offset int,
+) ([]db.GetGameFeedRow, int64, error) {
+	status, from, to := filter.nullStatus(), filter.nullFrom(), filter.nullTo()
+
+	games, err := queries.GetGameFeed(ctx, db.GetGameFeedParams{
+		CompetitionID: filter.CompetitionID,
+		StageID:       filter.StageID,
+		TeamID:        filter.TeamID,
+		Status:        status,
+		DateFrom:      from,
+		DateTo:        to,
+		SortDesc:      filter.Descending,
+		PageLimit:     int32(limit),
+		PageOffset:    int32(offset),
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to get game feed")
+	}
+
+	total, err := queries.CountGameFeed(ctx, db.CountGameFeedParams{
+		CompetitionID: filter.CompetitionID,
+		StageID:       filter.StageID,
+		TeamID:        filter.TeamID,
+		Status:        status,
+		DateFrom:      from,
+		DateTo:        to,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to count game feed")
+	}
+
+	return games, total, nil
+}
+
+func (f GameFilter) nullStatus() db.NullGameStatus {
+	return db.NullGameStatus{GameStatus: db.GameStatus(f.Status), Valid: f.Status != ""}
+}
+
+func (f GameFilter) nullFrom() sql.NullTime {
+	return sql.NullTime{Time: f.From, Valid: !f.From.IsZero()}
+}
+
+func (f GameFilter) nullTo() sql.NullTime {
+	return sql.NullTime{Time: f.To, Valid: !f.To.IsZero()}
+}
+
 func createGame(
 	ctx context.Context,
 	queries db_handler.Queries,
 	req *api.GameRequest,
 	season SeasonAggregate,
+	rules FixtureRules,
 ) (db.Game, error) {
 	if err := validateGameRequest(req, season); err != nil {
 		return db.Game{}, err
 	}
 
+	if err := checkGameSchedule(ctx, queries, req, uuid.Nil, season, rules); err != nil {
+		return db.Game{}, err
+	}
+
+	if err := checkGameVenue(ctx, queries, req); err != nil {
+		return db.Game{}, err
+	}
+
+	return insertGame(ctx, queries, req, season)
+}
+
+// insertGame saves a game without validating it. Callers check the request first.
+func insertGame(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.GameRequest,
+	season SeasonAggregate,
+) (db.Game, error) {
 	now := time.Now()
 
 	// default status if not provided
@@ -122,22 +381,24 @@ func createGame(
 	}
 
 	createParams := db.CreateGameParams{
-		ID:         uuid.New(),
-		SeasonID:   season.ID,
-		StageID:    req.StageID,
-		Date:       req.Date,
-		HomeTeamID: req.HomeTeamID,
-		AwayTeamID: req.AwayTeamID,
-		HomeScore:  toNullInt32(req.HomeScore),
-		AwayScore:  toNullInt32(req.AwayScore),
-		Status:     db.GameStatus(status),
-		CreatedAt:  now,
-		UpdatedAt:  now,
-		DeletedAt:  sql.NullTime{Time: time.Time{}, Valid: false},
+		ID:           uuid.New(),
+		SeasonID:     season.ID,
+		StageID:      req.StageID,
+		Date:         req.Date,
+		HomeTeamID:   req.HomeTeamID,
+		AwayTeamID:   req.AwayTeamID,
+		HomeScore:    toNullInt32(req.HomeScore),
+		AwayScore:    toNullInt32(req.AwayScore),
+		Status:       db.GameStatus(status),
+		VenueID:      gameVenue(req, season),
+		NeutralVenue: req.Neutral,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		DeletedAt:    sql.NullTime{Time: time.Time{}, Valid: false},
 	}
 
 	if err := queries.CreateGame(ctx, createParams); err != nil {
-		return db.Game{}, errors.Wrap(err, "unable to create new game")
+		return db.Game{}, wrapDBError(err, "game", "unable to create new game")
 	}
 
 	game, err := queries.GetGame(ctx, createParams.ID)
@@ -148,17 +409,39 @@ func createGame(
 	return game, nil
 }
 
+// updateGame saves req over current. The fixture rules are only checked when the game
+// moves, so score and status changes are never blocked by the schedule, and the
+// appointed officials are checked again when the kickoff changes.
 func updateGame(
 	ctx context.Context,
 	queries db_handler.Queries,
 	req *api.GameRequest,
-	gameID uuid.UUID,
+	current db.Game,
 	season SeasonAggregate,
+	rules FixtureRules,
 ) (db.Game, error) {
+	gameID := current.ID
+
 	if err := validateGameRequest(req, season); err != nil {
 		return db.Game{}, err
 	}
 
+	if scheduleChanged(current, req) {
+		if err := checkGameSchedule(ctx, queries, req, gameID, season, rules); err != nil {
+			return db.Game{}, err
+		}
+	}
+
+	if !current.Date.Equal(req.Date) {
+		if err := checkGameOfficialsAvailable(ctx, queries, gameID, req.Date); err != nil {
+			return db.Game{}, err
+		}
+	}
+
+	if err := checkGameVenue(ctx, queries, req); err != nil {
+		return db.Game{}, err
+	}
+
 	now := time.Now()
 
 	// default status if not provided
@@ -168,29 +451,109 @@ func updateGame(
 	}
 
 	updateParams := db.UpdateGameParams{
-		StageID:    req.StageID,
-		Date:       req.Date,
-		HomeTeamID: req.HomeTeamID,
-		AwayTeamID: req.AwayTeamID,
-		HomeScore:  toNullInt32(req.HomeScore),
-		AwayScore:  toNullInt32(req.AwayScore),
-		Status:     db.GameStatus(status),
-		UpdatedAt:  now,
-		ID:         gameID,
+		StageID:      req.StageID,
+		Date:         req.Date,
+		HomeTeamID:   req.HomeTeamID,
+		AwayTeamID:   req.AwayTeamID,
+		HomeScore:    toNullInt32(req.HomeScore),
+		AwayScore:    toNullInt32(req.AwayScore),
+		Status:       db.GameStatus(status),
+		VenueID:      toNullUUID(req.VenueID),
+		NeutralVenue: req.Neutral,
+		UpdatedAt:    now,
+		ID:           gameID,
 	}
 
 	if err := queries.UpdateGame(ctx, updateParams); err != nil {
-		return db.Game{}, errors.Wrap(err, "unable to update game")
+		return db.Game{}, wrapDBError(err, "game", "unable to update game")
 	}
 
 	updatedGame, err := queries.GetGame(ctx, gameID)
 	if err != nil {
-		return db.Game{}, errors.Wrap(err, "unable to get updated game")
+		return db.Game{}, wrapDBError(err, "game", "unable to get updated game")
 	}
 
 	return updatedGame, nil
 }
 
+// saveBatchGame creates req, or updates the game it names after checking that the game
+// belongs to season.
+func saveBatchGame(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.GameRequest,
+	season SeasonAggregate,
+	rules FixtureRules,
+) (db.Game, error) {
+	if req.ID == nil {
+		return createGame(ctx, queries, req, season, rules)
+	}
+
+	existing, err := queries.GetGame(ctx, *req.ID)
+	if err != nil {
+		return db.Game{}, wrapDBError(err, "game", "unable to get game")
+	}
+	if existing.SeasonID != season.ID {
+		return db.Game{}, NewForbiddenError("game does not belong to season")
+	}
+
+	return updateGame(ctx, queries, req, existing, season, rules)
+}
+
+// validateBatchIDs rejects a batch that updates the same game twice.
+func validateBatchIDs(reqs []api.GameRequest) error {
+	seen := make(map[uuid.UUID]int, len(reqs))
+
+	var fields []FieldError
+	for i, req := range reqs {
+		if req.ID == nil {
+			continue
+		}
+		if first, ok := seen[*req.ID]; ok {
+			fields = append(fields, FieldError{
+				Field:   fmt.Sprintf("games[%d].id", i),
+				Rule:    "unique",
+				Message: fmt.Sprintf("game %s is already updated by games[%d]", req.ID, first),
+			})
+			continue
+		}
+		seen[*req.ID] = i
+	}
+
+	if len(fields) > 0 {
+		return NewValidationError("invalid games", fields...)
+	}
+
+	return nil
+}
+
+// batchFieldErrors reports a rejected batch game as field errors under games[i]. It
+// returns ok false for errors that are not the game's fault, which abort the batch.
+func batchFieldErrors(i int, err error) ([]FieldError, bool) {
+	var (
+		validationErr *ValidationError
+		notFoundErr   *NotFoundError
+		forbiddenErr  *ForbiddenError
+	)
+
+	prefix := fmt.Sprintf("games[%d]", i)
+
+	switch {
+	case errors.As(err, &validationErr):
+		fields := make([]FieldError, 0, len(validationErr.Fields))
+		for _, f := range validationErr.Fields {
+			fields = append(fields, FieldError{Field: prefix + "." + f.Field, Rule: f.Rule, Message: f.Message})
+		}
+		return fields, true
+	case errors.As(err, &notFoundErr):
+		return []FieldError{{Field: prefix + ".id", Rule: "exists", Message: notFoundErr.Error()}}, true
+	case errors.As(err, &forbiddenErr):
+		return []FieldError{{Field: prefix + ".id", Rule: "game_in_season", Message: forbiddenErr.Error()}}, true
+	default:
+		return nil, false
+	}
+}
+
 func deleteGame(
 	ctx context.Context,
 	queries db_handler.Queries,
@@ -214,25 +577,128 @@ func validateGameRequest(req *api.GameRequest, season SeasonAggregate) error {
 		teamIDs[t.ID] = struct{}{}
 	}
 
+	var fields []FieldError
+
 	// Team in season
 	if _, ok := teamIDs[req.HomeTeamID]; !ok {
-		return errors.New("home team not in season")
+		fields = append(fields, FieldError{Field: "home_team_id", Rule: "team_in_season", Message: "home team not in season"})
 	}
 	if _, ok := teamIDs[req.AwayTeamID]; !ok {
-		return errors.New("away team not in season")
+		fields = append(fields, FieldError{Field: "away_team_id", Rule: "team_in_season", Message: "away team not in season"})
 	}
 
 	// Date in season bounds
 	if req.Date.Before(season.StartDate) || req.Date.After(season.EndDate) {
-		return errors.Errorf("game date %s outside season bounds (%s - %s)", req.Date, season.StartDate, season.EndDate)
+		fields = append(fields, FieldError{
+			Field:   "date",
+			Rule:    "within_season",
+			Message: fmt.Sprintf("game date %s outside season bounds (%s - %s)", req.Date, season.StartDate, season.EndDate),
+		})
+	}
+
+	if len(fields) > 0 {
+		return NewValidationError("invalid game", fields...)
+	}
+
+	return nil
+}
+
+// scheduleChanged reports whether req moves current to another date, stage or pairing.
+func scheduleChanged(current db.Game, req *api.GameRequest) bool {
+	return !current.Date.Equal(req.Date) ||
+		current.StageID != req.StageID ||
+		current.HomeTeamID != req.HomeTeamID ||
+		current.AwayTeamID != req.AwayTeamID
+}
+
+// checkGameSchedule rejects a game that would break the season's fixture rules, such as
+// a team already playing that day. gameID is the game being updated, or uuid.Nil for a
+// new game. Only new issues involving this game are reported; ones the game already had
+// before the change are left to the fixture report.
+func checkGameSchedule(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.GameRequest,
+	gameID uuid.UUID,
+	season SeasonAggregate,
+	rules FixtureRules,
+) error {
+	existing, err := queries.GetAllGamesBySeasonID(ctx, season.ID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get season games")
+	}
+
+	known := make(map[string]struct{})
+	if gameID != uuid.Nil {
+		for _, issue := range checkFixtures(existing, season, rules) {
+			if issue.involves(gameID) {
+				known[issue.key()] = struct{}{}
+			}
+		}
+	}
+
+	games := make([]db.Game, 0, len(existing)+1)
+	for _, g := range existing {
+		if g.ID != gameID {
+			games = append(games, g)
+		}
+	}
+	games = append(games, db.Game{
+		ID:         gameID,
+		SeasonID:   season.ID,
+		StageID:    req.StageID,
+		Date:       req.Date,
+		HomeTeamID: req.HomeTeamID,
+		AwayTeamID: req.AwayTeamID,
+	})
+
+	var fields []FieldError
+	for _, issue := range checkFixtures(games, season, rules) {
+		if !issue.involves(gameID) {
+			continue
+		}
+		if _, ok := known[issue.key()]; ok {
+			continue
+		}
+
+		field := "away_team_id"
+		if issue.TeamID == req.HomeTeamID {
+			field = "home_team_id"
+		}
+		fields = append(fields, FieldError{Field: field, Rule: string(issue.Rule), Message: issue.Message})
+	}
+
+	if len(fields) > 0 {
+		return NewValidationError("invalid game", fields...)
 	}
 
 	return nil
 }
 
+// checkGameVenue rejects a game whose venue does not exist. Games without a venue pass.
+func checkGameVenue(ctx context.Context, queries db_handler.Queries, req *api.GameRequest) error {
+	return ensureVenueExists(ctx, queries, toNullUUID(req.VenueID), "invalid game", "venue_id")
+}
+
+// gameVenue returns the venue a new game is played at: the requested venue, or the home
+// team's home venue for the season when none is given. Neutral games always name one.
+func gameVenue(req *api.GameRequest, season SeasonAggregate) uuid.NullUUID {
+	if req.VenueID != nil || req.Neutral {
+		return toNullUUID(req.VenueID)
+	}
+	return season.homeVenue(req.HomeTeamID)
+}
+
 func toNullInt32(i *int32) sql.NullInt32 {
 	if i == nil {
 		return sql.NullInt32{}
 	}
 	return sql.NullInt32{Int32: *i, Valid: true}
 }
+
+func toNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
@@ -26,7 +26,7 @@ var _ = Describe("game", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockDB = mock_db.NewMockDB(ctrl)
 		mockQueries = mock_db.NewMockQueries(ctrl)
-		svc = NewGameService(mockDB)
+		svc = NewGameService(mockDB, FixtureRules{})
 	})
 
 	validGameID := uuid.MustParse("bbbbbbbb-bbbb-4bbb-8bbb-bbbbbbbbbbbb")
@@ -151,6 +151,10 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().CreateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -187,6 +191,129 @@ var _ = Describe("game", func() {
 			Expect(game.DeletedAt.Time).To(Equal(validGameResponse.DeletedAt.Time))
 		})
 
+		It("should save the venue of a game played at a known venue", func() {
+			venueID := uuid.New()
+			req := *validGameRequest
+			req.VenueID = &venueID
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), validSeasonID).Return(nil, nil)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{ID: venueID}, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameParams) error {
+				Expect(params.VenueID).To(Equal(uuid.NullUUID{UUID: venueID, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().GetGame(gomock.Any(), gomock.Any()).Return(validGameFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &req, validSeasonWithTeams)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should play a game without a venue at the home team's home venue", func() {
+			homeVenueID := uuid.New()
+			homeTeam := validTeamFromDB
+			homeTeam.HomeVenueID = uuid.NullUUID{UUID: homeVenueID, Valid: true}
+			season := validSeasonWithTeams
+			season.Teams = []db.Team{homeTeam, validTeamFromDB2}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), validSeasonID).Return(nil, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameParams) error {
+				Expect(params.VenueID).To(Equal(uuid.NullUUID{UUID: homeVenueID, Valid: true}))
+				Expect(params.NeutralVenue).To(BeFalse())
+				return nil
+			})
+			mockQueries.EXPECT().GetGame(gomock.Any(), gomock.Any()).Return(validGameFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), validGameRequest, season)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should prefer the season's home venue over the team's own", func() {
+			seasonVenueID := uuid.New()
+			homeTeam := validTeamFromDB
+			homeTeam.HomeVenueID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+			season := validSeasonWithTeams
+			season.Teams = []db.Team{homeTeam, validTeamFromDB2}
+			season.HomeVenues = map[uuid.UUID]uuid.UUID{validHomeTeamID: seasonVenueID}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), validSeasonID).Return(nil, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameParams) error {
+				Expect(params.VenueID).To(Equal(uuid.NullUUID{UUID: seasonVenueID, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().GetGame(gomock.Any(), gomock.Any()).Return(validGameFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), validGameRequest, season)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should keep the requested venue of a neutral game", func() {
+			venueID := uuid.New()
+			req := *validGameRequest
+			req.VenueID = &venueID
+			req.Neutral = true
+			season := validSeasonWithTeams
+			season.HomeVenues = map[uuid.UUID]uuid.UUID{validHomeTeamID: uuid.New()}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), validSeasonID).Return(nil, nil)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{ID: venueID}, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameParams) error {
+				Expect(params.VenueID).To(Equal(uuid.NullUUID{UUID: venueID, Valid: true}))
+				Expect(params.NeutralVenue).To(BeTrue())
+				return nil
+			})
+			mockQueries.EXPECT().GetGame(gomock.Any(), gomock.Any()).Return(validGameFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &req, season)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a validation error when the venue does not exist", func() {
+			venueID := uuid.New()
+			req := *validGameRequest
+			req.VenueID = &venueID
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), validSeasonID).Return(nil, nil)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{}, sql.ErrNoRows)
+			mockQueries.EXPECT().CreateGame(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &req, validSeasonWithTeams)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(ConsistOf(FieldError{Field: "venue_id", Rule: "exists", Message: "venue not found"}))
+		})
+
 		It("should return formatted error if transaction begin fails", func() {
 			mockDB.EXPECT().BeginTx(
 				gomock.Any(),
@@ -202,6 +329,59 @@ var _ = Describe("game", func() {
 			Expect(err.Error()).To(Equal(validTestError.Error()))
 		})
 
+		It("should rollback and reject a game that double-books a team", func() {
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return([]db.Game{validGameFromDB}, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			game, err := svc.Create(context.Background(), validGameRequest, validSeasonWithTeams)
+
+			Expect(game).To(Equal(validNilGame))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(HaveLen(2))
+			Expect(validationErr.Fields[0].Field).To(Equal("home_team_id"))
+			Expect(validationErr.Fields[0].Rule).To(Equal("team_double_booked"))
+			Expect(validationErr.Fields[1].Field).To(Equal("away_team_id"))
+		})
+
+		It("should rollback and return formatted error when getting season games fails", func() {
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, validTestError)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Create(context.Background(), validGameRequest, validSeasonWithTeams)
+
+			Expect(err.Error()).To(Equal("unable to get season games: " + validTestError.Error()))
+		})
+
 		It("should rollback and return formatted error on insert failure", func() {
 			mockDB.EXPECT().BeginTx(
 				gomock.Any(),
@@ -210,6 +390,10 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().CreateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -232,6 +416,10 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().CreateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -258,6 +446,10 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().CreateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -348,6 +540,195 @@ var _ = Describe("game", func() {
 		})
 	})
 
+	Describe("GetAllBySeason", func() {
+		It("should pass filters to the paged and count queries", func() {
+			filter := GameFilter{
+				TeamID:     uuid.NullUUID{UUID: validHomeTeamID, Valid: true},
+				Status:     api.GameStatusFinished,
+				From:       validTimeNow,
+				Descending: true,
+			}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGamesBySeasonID(
+					gomock.Any(),
+					db.GetGamesBySeasonIDParams{
+						SeasonID:   validSeasonID,
+						TeamID:     uuid.NullUUID{UUID: validHomeTeamID, Valid: true},
+						Status:     db.NullGameStatus{GameStatus: db.GameStatus(api.GameStatusFinished), Valid: true},
+						DateFrom:   sql.NullTime{Time: validTimeNow, Valid: true},
+						SortDesc:   true,
+						PageLimit:  20,
+						PageOffset: 40,
+					},
+				).
+				Return(validGamesFromDB, nil)
+			mockQueries.EXPECT().
+				CountGames(
+					gomock.Any(),
+					db.CountGamesParams{
+						SeasonID: validSeasonID,
+						TeamID:   uuid.NullUUID{UUID: validHomeTeamID, Valid: true},
+						Status:   db.NullGameStatus{GameStatus: db.GameStatus(api.GameStatusFinished), Valid: true},
+						DateFrom: sql.NullTime{Time: validTimeNow, Valid: true},
+					},
+				).
+				Return(int64(42), nil)
+
+			games, total, err := svc.GetAllBySeason(context.Background(), validSeasonID, filter, 20, 40)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(games).To(Equal(validGamesFromDB))
+			Expect(total).To(Equal(int64(42)))
+		})
+
+		It("should return an error when getting games fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGamesBySeasonID(gomock.Any(), gomock.Any()).
+				Return(nil, validTestError)
+
+			games, total, err := svc.GetAllBySeason(context.Background(), validSeasonID, GameFilter{}, 20, 0)
+
+			Expect(games).To(BeNil())
+			Expect(total).To(BeZero())
+			Expect(err).To(MatchError("unable to get games: a valid testing error"))
+		})
+
+		It("should return an error when counting games fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGamesBySeasonID(gomock.Any(), gomock.Any()).
+				Return(validGamesFromDB, nil)
+			mockQueries.EXPECT().
+				CountGames(gomock.Any(), gomock.Any()).
+				Return(int64(0), validTestError)
+
+			_, _, err := svc.GetAllBySeason(context.Background(), validSeasonID, GameFilter{}, 20, 0)
+
+			Expect(err).To(MatchError("unable to count games: a valid testing error"))
+		})
+	})
+
+	Describe("GetFeed", func() {
+		It("should pass filters to the feed and count queries", func() {
+			competitionID := uuid.New()
+			filter := GameFilter{
+				CompetitionID: uuid.NullUUID{UUID: competitionID, Valid: true},
+				Status:        api.GameStatusScheduled,
+				To:            validTimeNow,
+			}
+			rows := []db.GetGameFeedRow{{ID: validGameID, CompetitionID: competitionID}}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGameFeed(
+					gomock.Any(),
+					db.GetGameFeedParams{
+						CompetitionID: uuid.NullUUID{UUID: competitionID, Valid: true},
+						Status:        db.NullGameStatus{GameStatus: db.GameStatus(api.GameStatusScheduled), Valid: true},
+						DateTo:        sql.NullTime{Time: validTimeNow, Valid: true},
+						PageLimit:     20,
+						PageOffset:    0,
+					},
+				).
+				Return(rows, nil)
+			mockQueries.EXPECT().
+				CountGameFeed(
+					gomock.Any(),
+					db.CountGameFeedParams{
+						CompetitionID: uuid.NullUUID{UUID: competitionID, Valid: true},
+						Status:        db.NullGameStatus{GameStatus: db.GameStatus(api.GameStatusScheduled), Valid: true},
+						DateTo:        sql.NullTime{Time: validTimeNow, Valid: true},
+					},
+				).
+				Return(int64(1), nil)
+
+			games, total, err := svc.GetFeed(context.Background(), filter, 20, 0)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(games).To(Equal(rows))
+			Expect(total).To(Equal(int64(1)))
+		})
+
+		It("should return an error when getting the feed fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGameFeed(gomock.Any(), gomock.Any()).
+				Return(nil, validTestError)
+
+			games, total, err := svc.GetFeed(context.Background(), GameFilter{}, 20, 0)
+
+			Expect(games).To(BeNil())
+			Expect(total).To(BeZero())
+			Expect(err).To(MatchError("unable to get game feed: a valid testing error"))
+		})
+
+		It("should return an error when counting the feed fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGameFeed(gomock.Any(), gomock.Any()).
+				Return(nil, nil)
+			mockQueries.EXPECT().
+				CountGameFeed(gomock.Any(), gomock.Any()).
+				Return(int64(0), validTestError)
+
+			_, _, err := svc.GetFeed(context.Background(), GameFilter{}, 20, 0)
+
+			Expect(err).To(MatchError("unable to count game feed: a valid testing error"))
+		})
+	})
+
+	Describe("GetDetails", func() {
+		It("should key details by game ID", func() {
+			otherGameID := uuid.New()
+			rows := []db.GetGameDetailsRow{
+				{ID: validGameID, HomeTeamName: "Home"},
+				{ID: otherGameID, HomeTeamName: "Other"},
+			}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGameDetails(gomock.Any(), []uuid.UUID{validGameID, otherGameID}).
+				Return(rows, nil)
+
+			details, err := svc.GetDetails(context.Background(), []uuid.UUID{validGameID, otherGameID})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(details).To(HaveLen(2))
+			Expect(details[otherGameID].HomeTeamName).To(Equal("Other"))
+		})
+
+		It("should return an error when the lookup fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetGameDetails(gomock.Any(), gomock.Any()).
+				Return(nil, validTestError)
+
+			details, err := svc.GetDetails(context.Background(), []uuid.UUID{validGameID})
+
+			Expect(details).To(BeNil())
+			Expect(err).To(MatchError("unable to get game details: a valid testing error"))
+		})
+	})
+
 	Describe("GetGame", func() {
 		It("should retrieve a game without errors", func() {
 			mockDB.EXPECT().New(
@@ -396,6 +777,9 @@ var _ = Describe("game", func() {
 	})
 
 	Describe("UpdateGame", func() {
+		validGameBeforeMove := validGameFromDB
+		validGameBeforeMove.Date = validTimeNow.AddDate(0, 0, -1)
+
 		It("should update a game without errors", func() {
 			mockDB.EXPECT().BeginTx(
 				gomock.Any(),
@@ -404,6 +788,18 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().GetGameOfficials(
+				gomock.Any(),
+				validGameID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().UpdateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -412,6 +808,10 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 				gomock.Any(),
 			).Return(validUpdatedGameFromDB, nil)
+			mockQueries.EXPECT().GetSeasonFinals(
+				gomock.Any(),
+				validSeasonID,
+			).Return(db.SeasonFinal{}, sql.ErrNoRows)
 			mockDB.EXPECT().Commit(
 				gomock.Any(),
 			)
@@ -419,7 +819,7 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 			).Times(0)
 
-			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams)
+			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(game.ID).To(Equal(validUpdatedGameResponse.ID))
@@ -449,12 +849,163 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 			).Times(0)
 
-			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams)
+			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
 
 			Expect(game).To(Equal(validNilGame))
 			Expect(err.Error()).To(Equal(validTestError.Error()))
 		})
 
+		It("should not treat the game being moved as a clash with itself", func() {
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return([]db.Game{validGameFromDB}, nil)
+			mockQueries.EXPECT().GetGameOfficials(
+				gomock.Any(),
+				validGameID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().UpdateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validUpdatedGameFromDB, nil)
+			mockQueries.EXPECT().GetSeasonFinals(
+				gomock.Any(),
+				validSeasonID,
+			).Return(db.SeasonFinal{}, sql.ErrNoRows)
+			mockDB.EXPECT().Commit(
+				gomock.Any(),
+			)
+
+			_, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check the schedule when the game has not moved", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), gomock.Any()).Times(0)
+			mockQueries.EXPECT().GetGameOfficials(gomock.Any(), gomock.Any()).Times(0)
+			mockQueries.EXPECT().UpdateGame(gomock.Any(), gomock.Any()).Return(nil)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validUpdatedGameFromDB, nil)
+			mockQueries.EXPECT().GetSeasonFinals(gomock.Any(), validSeasonID).Return(db.SeasonFinal{}, sql.ErrNoRows)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a conflict when the move double-books an official", func() {
+			officialID := uuid.New()
+			otherGameID := uuid.New()
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(gomock.Any(), validSeasonID).Return(nil, nil)
+			mockQueries.EXPECT().
+				GetGameOfficials(gomock.Any(), validGameID).
+				Return([]db.GameOfficial{{GameID: validGameID, OfficialID: officialID, Role: db.OfficialRoleReferee}}, nil)
+			mockQueries.EXPECT().
+				GetOfficialAppointments(gomock.Any(), officialID).
+				Return([]db.GetOfficialAppointmentsRow{
+					{GameID: validGameID, Date: validGameBeforeMove.Date},
+					{GameID: otherGameID, Date: validTimeNow.Add(time.Hour)},
+				}, nil)
+			mockQueries.EXPECT().UpdateGame(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(otherGameID.String()))
+		})
+
+		Describe("when the season already breaks the rest days rule", func() {
+			var restSvc GameService
+
+			// The home team already plays the day before this game.
+			earlierGame := db.Game{
+				ID:         uuid.MustParse("55555555-5555-4555-8555-555555555555"),
+				SeasonID:   validSeasonID,
+				StageID:    uuid.New(),
+				Date:       validTimeNow.AddDate(0, 0, -1),
+				HomeTeamID: validHomeTeamID,
+				AwayTeamID: uuid.MustParse("33333333-3333-4333-8333-333333333333"),
+			}
+			// The away team has enough rest before this game.
+			laterGame := db.Game{
+				ID:         uuid.MustParse("66666666-6666-4666-8666-666666666666"),
+				SeasonID:   validSeasonID,
+				StageID:    uuid.New(),
+				Date:       validTimeNow.AddDate(0, 0, 5),
+				HomeTeamID: uuid.MustParse("44444444-4444-4444-8444-444444444444"),
+				AwayTeamID: validAwayTeamID,
+			}
+
+			BeforeEach(func() {
+				restSvc = NewGameService(mockDB, FixtureRules{MinRestDays: 3})
+			})
+
+			It("should not block a move that keeps the existing issue", func() {
+				req := *validGameRequest
+				req.Date = validTimeNow.Add(time.Hour)
+
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+				mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+				mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+				mockQueries.EXPECT().
+					GetAllGamesBySeasonID(gomock.Any(), validSeasonID).
+					Return([]db.Game{earlierGame, validGameFromDB, laterGame}, nil)
+				mockQueries.EXPECT().GetGameOfficials(gomock.Any(), validGameID).Return(nil, nil)
+				mockQueries.EXPECT().UpdateGame(gomock.Any(), gomock.Any()).Return(nil)
+				mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validUpdatedGameFromDB, nil)
+				mockQueries.EXPECT().GetSeasonFinals(gomock.Any(), validSeasonID).Return(db.SeasonFinal{}, sql.ErrNoRows)
+				mockDB.EXPECT().Commit(gomock.Any())
+
+				_, err := restSvc.Update(context.Background(), &req, validGameID, validSeasonWithTeams, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should rollback and reject a move that adds a new issue", func() {
+				req := *validGameRequest
+				req.Date = validTimeNow.AddDate(0, 0, 3)
+
+				mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+				mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+				mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGameFromDB, nil)
+				mockQueries.EXPECT().
+					GetAllGamesBySeasonID(gomock.Any(), validSeasonID).
+					Return([]db.Game{earlierGame, validGameFromDB, laterGame}, nil)
+				mockQueries.EXPECT().UpdateGame(gomock.Any(), gomock.Any()).Times(0)
+				mockDB.EXPECT().Rollback(gomock.Any())
+
+				_, err := restSvc.Update(context.Background(), &req, validGameID, validSeasonWithTeams, nil)
+
+				var validationErr *ValidationError
+				Expect(errors.As(err, &validationErr)).To(BeTrue())
+				Expect(validationErr.Fields).To(HaveLen(1))
+				Expect(validationErr.Fields[0].Field).To(Equal("away_team_id"))
+				Expect(validationErr.Fields[0].Rule).To(Equal("min_rest_days"))
+			})
+		})
+
 		It("should rollback and return formatted error on update failure", func() {
 			mockDB.EXPECT().BeginTx(
 				gomock.Any(),
@@ -463,6 +1014,18 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().GetGameOfficials(
+				gomock.Any(),
+				validGameID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().UpdateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -471,7 +1034,7 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 			).AnyTimes()
 
-			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams)
+			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
 
 			Expect(game).To(Equal(validNilGame))
 			Expect(err.Error()).To(Equal("unable to update game: a valid testing error"))
@@ -485,6 +1048,18 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().GetGameOfficials(
+				gomock.Any(),
+				validGameID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().UpdateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -497,7 +1072,7 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 			).AnyTimes()
 
-			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams)
+			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
 
 			Expect(game).To(Equal(validNilGame))
 			Expect(err.Error()).To(Equal("unable to get updated game: a valid testing error"))
@@ -511,6 +1086,18 @@ var _ = Describe("game", func() {
 			mockDB.EXPECT().New(
 				gomock.Any(),
 			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().GetGameOfficials(
+				gomock.Any(),
+				validGameID,
+			).Return(nil, nil)
 			mockQueries.EXPECT().UpdateGame(
 				gomock.Any(),
 				gomock.Any(),
@@ -519,6 +1106,10 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 				gomock.Any(),
 			).Return(validUpdatedGameFromDB, nil)
+			mockQueries.EXPECT().GetSeasonFinals(
+				gomock.Any(),
+				validSeasonID,
+			).Return(db.SeasonFinal{}, sql.ErrNoRows)
 			mockDB.EXPECT().Commit(
 				gomock.Any(),
 			).Return(validTestError)
@@ -526,11 +1117,295 @@ var _ = Describe("game", func() {
 				gomock.Any(),
 			).Times(0)
 
-			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams)
+			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, nil)
 
 			Expect(game).To(Equal(validNilGame))
 			Expect(err.Error()).To(Equal(validTestError.Error()))
 		})
+
+		It("should update a game whose version still matches", func() {
+			readAt := validGameFromDB.UpdatedAt
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().LockGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validTimeNow, nil)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameBeforeMove, nil)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().GetGameOfficials(
+				gomock.Any(),
+				validGameID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().UpdateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validUpdatedGameFromDB, nil)
+			mockQueries.EXPECT().GetSeasonFinals(
+				gomock.Any(),
+				validSeasonID,
+			).Return(db.SeasonFinal{}, sql.ErrNoRows)
+			mockDB.EXPECT().Commit(
+				gomock.Any(),
+			)
+
+			game, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, &readAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(game).To(Equal(validUpdatedGameFromDB))
+		})
+
+		It("should rollback with not found when the game to lock is gone", func() {
+			readAt := validGameFromDB.UpdatedAt
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().LockGame(
+				gomock.Any(),
+				validGameID,
+			).Return(time.Time{}, sql.ErrNoRows)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, &readAt)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+
+		It("should rollback with a precondition failure when the game has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().LockGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Update(context.Background(), validGameRequest, validGameID, validSeasonWithTeams, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("game"))
+		})
+	})
+
+	Describe("BatchGames", func() {
+		newGameReq := func(status api.GameStatus) api.GameRequest {
+			req := *validGameRequest
+			req.Status = status
+			return req
+		}
+
+		It("should create and update games in one transaction", func() {
+			createReq := newGameReq(api.GameStatusScheduled)
+			updateReq := newGameReq(api.GameStatusFinished)
+			updateReq.ID = &validGameID
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				gomock.Not(validGameID),
+			).Return(validGamesFromDB[1], nil)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validGameFromDB, nil)
+			mockQueries.EXPECT().UpdateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(nil)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(validUpdatedGameFromDB, nil)
+			mockQueries.EXPECT().GetSeasonFinals(
+				gomock.Any(),
+				validSeasonID,
+			).Return(db.SeasonFinal{}, sql.ErrNoRows)
+			mockDB.EXPECT().Commit(
+				gomock.Any(),
+			)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			).Times(0)
+
+			result, err := svc.Batch(context.Background(), []api.GameRequest{createReq, updateReq}, validSeasonWithTeams)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result.Created).To(Equal(1))
+			Expect(result.Updated).To(Equal(1))
+			Expect(result.Games).To(Equal([]db.Game{validGamesFromDB[1], validUpdatedGameFromDB}))
+		})
+
+		It("should rollback and report every invalid game by index", func() {
+			missingID := uuid.New()
+			missingReq := newGameReq(api.GameStatusScheduled)
+			missingReq.ID = &missingID
+
+			badTeamReq := newGameReq(api.GameStatusScheduled)
+			badTeamReq.HomeTeamID = uuid.New()
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				missingID,
+			).Return(db.Game{}, sql.ErrNoRows)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Commit(
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			result, err := svc.Batch(context.Background(), []api.GameRequest{missingReq, badTeamReq}, validSeasonWithTeams)
+			Expect(result).To(Equal(GameBatchResult{}))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(HaveLen(2))
+			Expect(validationErr.Fields[0].Field).To(Equal("games[0].id"))
+			Expect(validationErr.Fields[0].Rule).To(Equal("exists"))
+			Expect(validationErr.Fields[1].Field).To(Equal("games[1].home_team_id"))
+		})
+
+		It("should reject a game from another season", func() {
+			updateReq := newGameReq(api.GameStatusScheduled)
+			updateReq.ID = &validGameID
+
+			otherSeasonGame := validGameFromDB
+			otherSeasonGame.SeasonID = uuid.New()
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(
+				gomock.Any(),
+				validGameID,
+			).Return(otherSeasonGame, nil)
+			mockQueries.EXPECT().UpdateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Batch(context.Background(), []api.GameRequest{updateReq}, validSeasonWithTeams)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(ConsistOf(HaveField("Field", "games[0].id")))
+			Expect(validationErr.Fields[0].Rule).To(Equal("game_in_season"))
+		})
+
+		It("should reject a game listed twice before starting a transaction", func() {
+			first := newGameReq(api.GameStatusScheduled)
+			first.ID = &validGameID
+			second := newGameReq(api.GameStatusFinished)
+			second.ID = &validGameID
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+
+			_, err := svc.Batch(context.Background(), []api.GameRequest{first, second}, validSeasonWithTeams)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(HaveLen(1))
+			Expect(validationErr.Fields[0].Field).To(Equal("games[1].id"))
+			Expect(validationErr.Fields[0].Rule).To(Equal("unique"))
+		})
+
+		It("should rollback and return formatted error on insert failure", func() {
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().GetAllGamesBySeasonID(
+				gomock.Any(),
+				validSeasonID,
+			).Return(nil, nil)
+			mockQueries.EXPECT().CreateGame(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(validTestError)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Batch(context.Background(), []api.GameRequest{newGameReq(api.GameStatusScheduled)}, validSeasonWithTeams)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to save games[0]"))
+			Expect(err.Error()).To(ContainSubstring(validTestError.Error()))
+		})
 	})
 
 	Describe("DeleteGame", func() {
@@ -625,14 +1500,31 @@ var _ = Describe("game", func() {
 			badReq := *validGameRequest
 			badReq.HomeTeamID = uuid.MustParse("33333333-3333-4333-8333-333333333333")
 			err := validateGameRequest(&badReq, validSeasonWithTeams)
-			Expect(err).To(MatchError("home team not in season"))
+			Expect(err).To(MatchError("invalid game: home team not in season"))
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(ConsistOf(FieldError{Field: "home_team_id", Rule: "team_in_season", Message: "home team not in season"}))
 		})
 
 		It("should reject if away team not in season", func() {
 			badReq := *validGameRequest
 			badReq.AwayTeamID = uuid.MustParse("44444444-4444-4444-8444-444444444444")
 			err := validateGameRequest(&badReq, validSeasonWithTeams)
-			Expect(err).To(MatchError("away team not in season"))
+			Expect(err).To(MatchError("invalid game: away team not in season"))
+		})
+
+		It("should report every invalid field", func() {
+			badReq := *validGameRequest
+			badReq.HomeTeamID = uuid.MustParse("33333333-3333-4333-8333-333333333333")
+			badReq.AwayTeamID = uuid.MustParse("44444444-4444-4444-8444-444444444444")
+			badReq.Date = validSeasonWithTeams.EndDate.Add(24 * time.Hour)
+			err := validateGameRequest(&badReq, validSeasonWithTeams)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(HaveLen(3))
+			Expect(validationErr.Fields[2].Field).To(Equal("date"))
 		})
 
 		It("should reject if date is before season start", func() {
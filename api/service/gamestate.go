@@ -12,9 +12,18 @@ import (
 // GameStateService defines the contract for live game state operations.
 type GameStateService interface {
 	UpdateGameState(ctx context.Context, gameID uuid.UUID, homeScore, awayScore int32, status string) error
+	UpdateGameStates(ctx context.Context, updates []GameStateUpdate) error
 	WatchGameState(ctx context.Context, gameID uuid.UUID) (<-chan *gamestatev1.GameState, error)
 }
 
+// GameStateUpdate is the score and status broadcast for one game.
+type GameStateUpdate struct {
+	GameID    uuid.UUID
+	HomeScore int32
+	AwayScore int32
+	Status    string
+}
+
 // gameStateService is the concrete implementation backed by the gamestate gRPC client.
 type gameStateService struct {
 	client *gamestateclient.Client
@@ -34,6 +43,21 @@ func (s *gameStateService) UpdateGameState(ctx context.Context, gameID uuid.UUID
 	})
 }
 
+// UpdateGameStates broadcasts several games in one call, such as a round of results
+// saved together.
+func (s *gameStateService) UpdateGameStates(ctx context.Context, updates []GameStateUpdate) error {
+	states := make([]*gamestatev1.GameState, 0, len(updates))
+	for _, u := range updates {
+		states = append(states, &gamestatev1.GameState{
+			GameId:    u.GameID.String(),
+			HomeScore: u.HomeScore,
+			AwayScore: u.AwayScore,
+			Status:    u.Status,
+		})
+	}
+	return s.client.UpdateGameStates(ctx, states)
+}
+
 // WatchGameState returns a channel of live state updates for a game.
 func (s *gameStateService) WatchGameState(ctx context.Context, gameID uuid.UUID) (<-chan *gamestatev1.GameState, error) {
 	return s.client.WatchGameState(ctx, gameID.String())
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/google/uuid"
+)
+
+const (
+	ladderPointsWin  = 4
+	ladderPointsDraw = 2
+)
+
+// LadderEntry is a team's standing built from finished games.
+type LadderEntry struct {
+	Team   db.Team
+	Record TeamRecord
+	Points int
+}
+
+// buildLadder ranks teams on their finished, scored games. Ties are broken by points
+// difference, then points scored, then name. Teams without games are still listed and
+// games involving teams outside teams are ignored.
+func buildLadder(games []db.Game, teams []db.Team) []LadderEntry {
+	entries := make(map[uuid.UUID]*LadderEntry, len(teams))
+	for _, t := range teams {
+		entries[t.ID] = &LadderEntry{Team: t}
+	}
+
+	for _, g := range games {
+		if g.Status != db.GameStatusFinished || !g.HomeScore.Valid || !g.AwayScore.Valid {
+			continue
+		}
+		for _, teamID := range []uuid.UUID{g.HomeTeamID, g.AwayTeamID} {
+			if entry, ok := entries[teamID]; ok {
+				entry.Record.add(newTeamResult(teamID, g))
+			}
+		}
+	}
+
+	ladder := make([]LadderEntry, 0, len(entries))
+	for _, entry := range entries {
+		entry.Points = entry.Record.Won*ladderPointsWin + entry.Record.Drawn*ladderPointsDraw
+		ladder = append(ladder, *entry)
+	}
+
+	sort.Slice(ladder, func(i, j int) bool {
+		a, b := ladder[i], ladder[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		aDiff, bDiff := a.Record.PointsFor-a.Record.PointsAgainst, b.Record.PointsFor-b.Record.PointsAgainst
+		if aDiff != bDiff {
+			return aDiff > bDiff
+		}
+		if a.Record.PointsFor != b.Record.PointsFor {
+			return a.Record.PointsFor > b.Record.PointsFor
+		}
+		return a.Team.Name < b.Team.Name
+	})
+
+	return ladder
+}
@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// LineupService defines the contract for matchday team sheets. A side's sheet can be
+// changed until kickoff; replacements are recorded while the game is playing.
+type LineupService interface {
+	Get(ctx context.Context, gameID uuid.UUID) (GameLineups, error)
+	Set(ctx context.Context, req *api.LineupRequest, gameID, teamID uuid.UUID) (TeamLineup, error)
+	Clear(ctx context.Context, gameID, teamID uuid.UUID) error
+	AddReplacement(ctx context.Context, req *api.ReplacementRequest, gameID, teamID uuid.UUID) (db.GameReplacement, error)
+	RemoveReplacement(ctx context.Context, gameID, teamID, replacementID uuid.UUID) error
+}
+
+// lineupService is the concrete implementation backed by db_handler.DB.
+type lineupService struct {
+	db db_handler.DB
+}
+
+// NewLineupService returns a new LineupService backed by db_handler.DB.
+func NewLineupService(db db_handler.DB) LineupService {
+	return &lineupService{db: db}
+}
+
+// GameLineups holds both sides' team sheets for a game.
+type GameLineups struct {
+	GameID uuid.UUID
+	Home   TeamLineup
+	Away   TeamLineup
+}
+
+// TeamLineup is one side's team sheet, ordered by shirt number, and the replacements
+// it has made.
+type TeamLineup struct {
+	TeamID       uuid.UUID
+	Players      []LineupPlayer
+	Replacements []db.GameReplacement
+}
+
+// LineupPlayer is a player's place on a team sheet together with the player.
+type LineupPlayer struct {
+	Selection db.GameLineupPlayer
+	Player    db.Player
+}
+
+func (l GameLineups) team(teamID uuid.UUID) TeamLineup {
+	if l.Away.TeamID == teamID {
+		return l.Away
+	}
+	return l.Home
+}
+
+func ToGameLineupsResponse(l GameLineups) api.GameLineupsResponse {
+	return api.GameLineupsResponse{
+		GameID: l.GameID,
+		Home:   ToTeamLineupResponse(l.Home),
+		Away:   ToTeamLineupResponse(l.Away),
+	}
+}
+
+func ToTeamLineupResponse(t TeamLineup) api.TeamLineupResponse {
+	resp := api.TeamLineupResponse{
+		TeamID:       t.TeamID,
+		Starters:     make([]api.LineupPlayerResponse, 0, api.StartingShirts),
+		Bench:        make([]api.LineupPlayerResponse, 0, len(t.Players)),
+		Replacements: make([]api.ReplacementResponse, 0, len(t.Replacements)),
+	}
+
+	for _, p := range t.Players {
+		if p.Selection.Captain {
+			captainID := p.Player.ID
+			resp.CaptainID = &captainID
+		}
+
+		player := api.ToLineupPlayerResponse(p.Selection, p.Player)
+		if p.Selection.ShirtNumber <= api.StartingShirts {
+			resp.Starters = append(resp.Starters, player)
+		} else {
+			resp.Bench = append(resp.Bench, player)
+		}
+	}
+
+	for _, r := range t.Replacements {
+		resp.Replacements = append(resp.Replacements, api.ToReplacementResponse(r))
+	}
+
+	return resp
+}
+
+func (s *lineupService) Get(ctx context.Context, gameID uuid.UUID) (GameLineups, error) {
+	var lineups GameLineups
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		game, err := queries.GetGame(ctx, gameID)
+		if err != nil {
+			return wrapDBError(err, "game", "unable to get game")
+		}
+
+		lineups, err = getGameLineups(ctx, queries, game)
+		return err
+	})
+	if err != nil {
+		return GameLineups{}, err
+	}
+
+	return lineups, nil
+}
+
+func (s *lineupService) Set(ctx context.Context, req *api.LineupRequest, gameID, teamID uuid.UUID) (TeamLineup, error) {
+	var lineup TeamLineup
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		lineup, txErr = setLineup(ctx, queries, req, gameID, teamID)
+		return txErr
+	})
+	if err != nil {
+		return TeamLineup{}, err
+	}
+
+	return lineup, nil
+}
+
+func (s *lineupService) Clear(ctx context.Context, gameID, teamID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return clearLineup(ctx, queries, gameID, teamID)
+	})
+}
+
+func (s *lineupService) AddReplacement(
+	ctx context.Context,
+	req *api.ReplacementRequest,
+	gameID, teamID uuid.UUID,
+) (db.GameReplacement, error) {
+	var replacement db.GameReplacement
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		replacement, txErr = addReplacement(ctx, queries, req, gameID, teamID)
+		return txErr
+	})
+	if err != nil {
+		return db.GameReplacement{}, err
+	}
+
+	return replacement, nil
+}
+
+func (s *lineupService) RemoveReplacement(ctx context.Context, gameID, teamID, replacementID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return removeReplacement(ctx, queries, gameID, teamID, replacementID)
+	})
+}
+
+// getLineupGame returns the game teamID is playing in. A team that is not playing in
+// the game has no lineup for it.
+func getLineupGame(ctx context.Context, queries db_handler.Queries, gameID, teamID uuid.UUID) (db.Game, error) {
+	game, err := queries.GetGame(ctx, gameID)
+	if err != nil {
+		return db.Game{}, wrapDBError(err, "game", "unable to get game")
+	}
+
+	if teamID != game.HomeTeamID && teamID != game.AwayTeamID {
+		return db.Game{}, NewForbiddenError("team is not playing in this game")
+	}
+
+	return game, nil
+}
+
+func getGameLineups(ctx context.Context, queries db_handler.Queries, game db.Game) (GameLineups, error) {
+	selections, err := queries.GetGameLineupPlayers(ctx, game.ID)
+	if err != nil {
+		return GameLineups{}, errors.Wrap(err, "unable to get lineups")
+	}
+
+	replacements, err := queries.GetGameReplacements(ctx, game.ID)
+	if err != nil {
+		return GameLineups{}, errors.Wrap(err, "unable to get replacements")
+	}
+
+	home := buildTeamLineup(game.HomeTeamID, selections, replacements)
+	away := buildTeamLineup(game.AwayTeamID, selections, replacements)
+
+	return GameLineups{GameID: game.ID, Home: home, Away: away}, nil
+}
+
+func buildTeamLineup(
+	teamID uuid.UUID,
+	selections []db.GetGameLineupPlayersRow,
+	replacements []db.GameReplacement,
+) TeamLineup {
+	lineup := TeamLineup{TeamID: teamID}
+
+	for _, row := range selections {
+		if row.TeamID != teamID {
+			continue
+		}
+
+		lineup.Players = append(lineup.Players, LineupPlayer{
+			Selection: db.GameLineupPlayer{
+				ID:          row.ID,
+				GameID:      row.GameID,
+				TeamID:      row.TeamID,
+				PlayerID:    row.PlayerID,
+				ShirtNumber: row.ShirtNumber,
+				Captain:     row.Captain,
+				CreatedAt:   row.CreatedAt,
+				UpdatedAt:   row.UpdatedAt,
+				DeletedAt:   row.DeletedAt,
+			},
+			Player: db.Player{
+				ID:          row.PlayerID,
+				TeamID:      row.PlayerTeamID,
+				Name:        row.PlayerName,
+				DateOfBirth: row.PlayerDateOfBirth,
+				Position:    row.PlayerPosition,
+				Nationality: row.PlayerNationality,
+				CreatedAt:   row.PlayerCreatedAt,
+				UpdatedAt:   row.PlayerUpdatedAt,
+				DeletedAt:   row.PlayerDeletedAt,
+			},
+		})
+	}
+
+	for _, replacement := range replacements {
+		if replacement.TeamID == teamID {
+			lineup.Replacements = append(lineup.Replacements, replacement)
+		}
+	}
+
+	return lineup
+}
+
+// setLineup replaces a side's team sheet. Every player must be in the team's season
+// squad on the day of the game.
+func setLineup(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.LineupRequest,
+	gameID, teamID uuid.UUID,
+) (TeamLineup, error) {
+	game, err := getLineupGame(ctx, queries, gameID, teamID)
+	if err != nil {
+		return TeamLineup{}, err
+	}
+
+	if lineupLocked(game, time.Now()) {
+		return TeamLineup{}, NewConflictError("lineups are locked once a game has kicked off", nil)
+	}
+
+	if err := checkLineupSquad(ctx, queries, req, game, teamID); err != nil {
+		return TeamLineup{}, err
+	}
+
+	now := time.Now()
+
+	if err := queries.DeleteGameLineupPlayers(ctx, db.DeleteGameLineupPlayersParams{
+		DeletedAt: sql.NullTime{Time: now, Valid: true},
+		GameID:    gameID,
+		TeamID:    teamID,
+	}); err != nil {
+		return TeamLineup{}, errors.Wrap(err, "unable to clear lineup")
+	}
+
+	for _, p := range req.Players {
+		params := db.CreateGameLineupPlayerParams{
+			ID:          uuid.New(),
+			GameID:      gameID,
+			TeamID:      teamID,
+			PlayerID:    p.PlayerID,
+			ShirtNumber: p.ShirtNumber,
+			Captain:     p.PlayerID == req.CaptainID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			DeletedAt:   sql.NullTime{Time: time.Time{}, Valid: false},
+		}
+		if err := queries.CreateGameLineupPlayer(ctx, params); err != nil {
+			return TeamLineup{}, errors.Wrap(err, "unable to add lineup player")
+		}
+	}
+
+	lineups, err := getGameLineups(ctx, queries, game)
+	if err != nil {
+		return TeamLineup{}, err
+	}
+
+	return lineups.team(teamID), nil
+}
+
+// checkLineupSquad reports every player on the sheet who is not in the team's squad
+// on the day of the game.
+func checkLineupSquad(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.LineupRequest,
+	game db.Game,
+	teamID uuid.UUID,
+) error {
+	seasonTeamID, err := getSeasonTeamID(ctx, queries, game.SeasonID, teamID)
+	if err != nil {
+		return err
+	}
+
+	members, err := queries.GetSeasonTeamPlayers(ctx, seasonTeamID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get squad")
+	}
+
+	active := make(map[uuid.UUID]bool, len(members))
+	for _, member := range members {
+		if activeOn(member, game.Date) {
+			active[member.PlayerID] = true
+		}
+	}
+
+	var fields []FieldError
+	for i, p := range req.Players {
+		if !active[p.PlayerID] {
+			fields = append(fields, FieldError{
+				Field:   fmt.Sprintf("players[%d].player_id", i),
+				Rule:    "in_squad",
+				Message: "player is not in the squad on the game date",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return NewValidationError("invalid lineup", fields...)
+	}
+
+	return nil
+}
+
+// lineupLocked reports whether a game's team sheets can no longer change: once it has
+// left scheduled, or once its kickoff time has passed even if its status has not moved on.
+func lineupLocked(game db.Game, now time.Time) bool {
+	return game.Status != db.GameStatusScheduled || !now.Before(game.Date)
+}
+
+// activeOn reports whether a squad spell covers the day of t. Active dates have no
+// time of day, so they are compared against the UTC calendar day.
+func activeOn(member db.SeasonTeamPlayer, t time.Time) bool {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	if member.ActiveFrom.Valid && day.Before(member.ActiveFrom.Time) {
+		return false
+	}
+	if member.ActiveTo.Valid && day.After(member.ActiveTo.Time) {
+		return false
+	}
+	return true
+}
+
+func clearLineup(ctx context.Context, queries db_handler.Queries, gameID, teamID uuid.UUID) error {
+	game, err := getLineupGame(ctx, queries, gameID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if lineupLocked(game, time.Now()) {
+		return NewConflictError("lineups are locked once a game has kicked off", nil)
+	}
+
+	if err := queries.DeleteGameLineupPlayers(ctx, db.DeleteGameLineupPlayersParams{
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		GameID:    gameID,
+		TeamID:    teamID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to clear lineup")
+	}
+
+	return nil
+}
+
+// addReplacement records a bench player coming on for a player on the field. A player
+// who has been replaced cannot come back on, and each bench player comes on once.
+func addReplacement(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.ReplacementRequest,
+	gameID, teamID uuid.UUID,
+) (db.GameReplacement, error) {
+	game, err := getLineupGame(ctx, queries, gameID, teamID)
+	if err != nil {
+		return db.GameReplacement{}, err
+	}
+
+	if game.Status != db.GameStatusPlaying {
+		return db.GameReplacement{}, NewConflictError("replacements can only be made while a game is playing", nil)
+	}
+
+	lineups, err := getGameLineups(ctx, queries, game)
+	if err != nil {
+		return db.GameReplacement{}, err
+	}
+	lineup := lineups.team(teamID)
+
+	onField := make(map[uuid.UUID]bool, api.StartingShirts)
+	onBench := make(map[uuid.UUID]bool)
+	for _, p := range lineup.Players {
+		if p.Selection.ShirtNumber <= api.StartingShirts {
+			onField[p.Player.ID] = true
+		} else {
+			onBench[p.Player.ID] = true
+		}
+	}
+	for _, r := range lineup.Replacements {
+		delete(onField, r.PlayerOffID)
+		delete(onBench, r.PlayerOnID)
+		onField[r.PlayerOnID] = true
+	}
+
+	var fields []FieldError
+	if !onField[req.PlayerOffID] {
+		fields = append(fields, FieldError{
+			Field:   "player_off_id",
+			Rule:    "on_field",
+			Message: "player is not on the field",
+		})
+	}
+	if !onBench[req.PlayerOnID] {
+		fields = append(fields, FieldError{
+			Field:   "player_on_id",
+			Rule:    "on_bench",
+			Message: "player is not on the bench",
+		})
+	}
+	if len(fields) > 0 {
+		return db.GameReplacement{}, NewValidationError("invalid replacement", fields...)
+	}
+
+	now := time.Now()
+	params := db.CreateGameReplacementParams{
+		ID:          uuid.New(),
+		GameID:      gameID,
+		TeamID:      teamID,
+		PlayerOffID: req.PlayerOffID,
+		PlayerOnID:  req.PlayerOnID,
+		Minute:      req.Minute,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+	if err := queries.CreateGameReplacement(ctx, params); err != nil {
+		return db.GameReplacement{}, errors.Wrap(err, "unable to add replacement")
+	}
+
+	return db.GameReplacement{
+		ID:          params.ID,
+		GameID:      params.GameID,
+		TeamID:      params.TeamID,
+		PlayerOffID: params.PlayerOffID,
+		PlayerOnID:  params.PlayerOnID,
+		Minute:      params.Minute,
+		CreatedAt:   params.CreatedAt,
+		UpdatedAt:   params.UpdatedAt,
+		DeletedAt:   params.DeletedAt,
+	}, nil
+}
+
+func removeReplacement(
+	ctx context.Context,
+	queries db_handler.Queries,
+	gameID, teamID, replacementID uuid.UUID,
+) error {
+	game, err := getLineupGame(ctx, queries, gameID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if game.Status != db.GameStatusPlaying {
+		return NewConflictError("replacements can only be made while a game is playing", nil)
+	}
+
+	replacements, err := queries.GetGameReplacements(ctx, gameID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get replacements")
+	}
+
+	for _, r := range replacements {
+		if r.ID != replacementID || r.TeamID != teamID {
+			continue
+		}
+
+		if err := queries.DeleteGameReplacement(ctx, db.DeleteGameReplacementParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:        replacementID,
+		}); err != nil {
+			return errors.Wrap(err, "unable to remove replacement")
+		}
+		return nil
+	}
+
+	return NewNotFoundError("replacement", nil)
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("lineup", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc LineupService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewLineupService(mockDB)
+	})
+
+	validSeasonID := uuid.MustParse("9300778f-cce0-4efe-af6c-e399d8170315")
+	validGameID := uuid.MustParse("4019a7f3-7741-4d8f-b3e0-1c7f3a0a1a01")
+	validHomeTeamID := uuid.MustParse("013952a5-87e1-4d26-a312-09b2aff54241")
+	validAwayTeamID := uuid.MustParse("a2f1c3d4-5b6e-4f70-8a91-b2c3d4e5f607")
+	validSeasonTeamID := uuid.MustParse("b4a2c9e1-3f5d-4e7a-8c6b-0d1e2f3a4b5c")
+
+	// Lineups lock at kickoff, so the game kicks off a week from now.
+	kickoffDay := time.Now().UTC().AddDate(0, 0, 7).Truncate(24 * time.Hour)
+	kickoff := kickoffDay.Add(7*time.Hour + 5*time.Minute)
+
+	validGame := func(status db.GameStatus) db.Game {
+		return db.Game{
+			ID:         validGameID,
+			SeasonID:   validSeasonID,
+			Date:       kickoff,
+			HomeTeamID: validHomeTeamID,
+			AwayTeamID: validAwayTeamID,
+			Status:     status,
+		}
+	}
+
+	// A full matchday 23, with shirt number i+1 worn by playerIDs[i]
+	playerIDs := make([]uuid.UUID, 23)
+	for i := range playerIDs {
+		playerIDs[i] = uuid.MustParse(fmt.Sprintf("00000000-0000-4000-8000-%012d", i+1))
+	}
+
+	validLineupRequest := func() *api.LineupRequest {
+		req := &api.LineupRequest{CaptainID: playerIDs[7]}
+		for i, id := range playerIDs {
+			req.Players = append(req.Players, api.LineupPlayerRequest{PlayerID: id, ShirtNumber: int32(i + 1)})
+		}
+		return req
+	}
+
+	squadFor := func(ids []uuid.UUID) []db.SeasonTeamPlayer {
+		members := make([]db.SeasonTeamPlayer, 0, len(ids))
+		for i, id := range ids {
+			members = append(members, db.SeasonTeamPlayer{ID: uuid.New(), SeasonTeamID: validSeasonTeamID, PlayerID: id, JerseyNumber: int32(i + 1)})
+		}
+		return members
+	}
+
+	sheetFor := func(teamID uuid.UUID, ids []uuid.UUID) []db.GetGameLineupPlayersRow {
+		sheet := make([]db.GetGameLineupPlayersRow, 0, len(ids))
+		for i, id := range ids {
+			sheet = append(sheet, db.GetGameLineupPlayersRow{
+				ID:           uuid.New(),
+				GameID:       validGameID,
+				TeamID:       teamID,
+				PlayerID:     id,
+				ShirtNumber:  int32(i + 1),
+				Captain:      i == 7,
+				PlayerTeamID: teamID,
+				PlayerName:   fmt.Sprintf("Player %d", i+1),
+			})
+		}
+		return sheet
+	}
+
+	Describe("GetLineups", func() {
+		It("should split the team sheets and replacements by side", func() {
+			replacement := db.GameReplacement{ID: uuid.New(), TeamID: validHomeTeamID, PlayerOffID: playerIDs[0], PlayerOnID: playerIDs[15], Minute: 50}
+
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			mockQueries.EXPECT().GetGameLineupPlayers(gomock.Any(), validGameID).Return(sheetFor(validHomeTeamID, playerIDs), nil)
+			mockQueries.EXPECT().GetGameReplacements(gomock.Any(), validGameID).Return([]db.GameReplacement{replacement}, nil)
+
+			lineups, err := svc.Get(context.Background(), validGameID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lineups.Home.Players).To(HaveLen(23))
+			Expect(lineups.Home.Players[0].Player.ID).To(Equal(playerIDs[0]))
+			Expect(lineups.Home.Players[0].Player.Name).To(Equal("Player 1"))
+			Expect(lineups.Home.Replacements).To(Equal([]db.GameReplacement{replacement}))
+			Expect(lineups.Away.TeamID).To(Equal(validAwayTeamID))
+			Expect(lineups.Away.Players).To(BeEmpty())
+
+			resp := ToGameLineupsResponse(lineups)
+			Expect(resp.Home.Starters).To(HaveLen(15))
+			Expect(resp.Home.Bench).To(HaveLen(8))
+			Expect(*resp.Home.CaptainID).To(Equal(playerIDs[7]))
+			Expect(resp.Away.Starters).To(BeEmpty())
+		})
+	})
+
+	Describe("SetLineup", func() {
+		It("should replace the team sheet when every player is in the squad", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusScheduled), nil)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return([]db.GetSeasonTeamsRow{
+				{ID: validSeasonTeamID, SeasonID: validSeasonID, TeamID: validHomeTeamID},
+			}, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return(squadFor(playerIDs), nil)
+			mockQueries.EXPECT().DeleteGameLineupPlayers(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteGameLineupPlayersParams) error {
+				Expect(params.GameID).To(Equal(validGameID))
+				Expect(params.TeamID).To(Equal(validHomeTeamID))
+				return nil
+			})
+
+			var captains int
+			mockQueries.EXPECT().CreateGameLineupPlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameLineupPlayerParams) error {
+				if params.Captain {
+					captains++
+					Expect(params.PlayerID).To(Equal(playerIDs[7]))
+				}
+				return nil
+			}).Times(23)
+			mockQueries.EXPECT().GetGameLineupPlayers(gomock.Any(), validGameID).Return(sheetFor(validHomeTeamID, playerIDs), nil)
+			mockQueries.EXPECT().GetGameReplacements(gomock.Any(), validGameID).Return(nil, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			lineup, err := svc.Set(context.Background(), validLineupRequest(), validGameID, validHomeTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(captains).To(Equal(1))
+			Expect(lineup.TeamID).To(Equal(validHomeTeamID))
+			Expect(lineup.Players).To(HaveLen(23))
+		})
+
+		It("should rollback with a validation error for players not in the squad on the game date", func() {
+			squad := squadFor(playerIDs)
+			squad[3].ActiveTo = sql.NullTime{Time: kickoffDay.AddDate(0, 0, -1), Valid: true}
+			squad[4].ActiveFrom = sql.NullTime{Time: kickoffDay, Valid: true}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusScheduled), nil)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return([]db.GetSeasonTeamsRow{
+				{ID: validSeasonTeamID, SeasonID: validSeasonID, TeamID: validHomeTeamID},
+			}, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return(squad[:22], nil)
+			mockQueries.EXPECT().DeleteGameLineupPlayers(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Set(context.Background(), validLineupRequest(), validGameID, validHomeTeamID)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(Equal([]FieldError{
+				{Field: "players[3].player_id", Rule: "in_squad", Message: "player is not in the squad on the game date"},
+				{Field: "players[22].player_id", Rule: "in_squad", Message: "player is not in the squad on the game date"},
+			}))
+		})
+
+		It("should rollback with a conflict once the game has kicked off", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Set(context.Background(), validLineupRequest(), validGameID, validHomeTeamID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("lineups are locked once a game has kicked off"))
+		})
+
+		It("should rollback with a conflict once the kickoff time has passed", func() {
+			game := validGame(db.GameStatusScheduled)
+			game.Date = time.Now().Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(game, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Set(context.Background(), validLineupRequest(), validGameID, validHomeTeamID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("lineups are locked once a game has kicked off"))
+		})
+
+		It("should rollback with a forbidden error for a team not playing in the game", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusScheduled), nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Set(context.Background(), validLineupRequest(), validGameID, uuid.New())
+
+			var forbiddenErr *ForbiddenError
+			Expect(errors.As(err, &forbiddenErr)).To(BeTrue())
+		})
+	})
+
+	Describe("ClearLineup", func() {
+		It("should soft delete the side's team sheet before kickoff", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusScheduled), nil)
+			mockQueries.EXPECT().DeleteGameLineupPlayers(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteGameLineupPlayersParams) error {
+				Expect(params.TeamID).To(Equal(validAwayTeamID))
+				Expect(params.DeletedAt.Valid).To(BeTrue())
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			err := svc.Clear(context.Background(), validGameID, validAwayTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("AddReplacement", func() {
+		expectLineup := func(replacements []db.GameReplacement) {
+			mockQueries.EXPECT().GetGameLineupPlayers(gomock.Any(), validGameID).Return(sheetFor(validHomeTeamID, playerIDs), nil)
+			mockQueries.EXPECT().GetGameReplacements(gomock.Any(), validGameID).Return(replacements, nil)
+		}
+
+		It("should bring a bench player on for a player on the field", func() {
+			req := &api.ReplacementRequest{PlayerOffID: playerIDs[0], PlayerOnID: playerIDs[16], Minute: 50}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			expectLineup(nil)
+			mockQueries.EXPECT().CreateGameReplacement(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateGameReplacementParams) error {
+				Expect(params.TeamID).To(Equal(validHomeTeamID))
+				Expect(params.PlayerOffID).To(Equal(playerIDs[0]))
+				Expect(params.PlayerOnID).To(Equal(playerIDs[16]))
+				Expect(params.Minute).To(Equal(int32(50)))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			replacement, err := svc.AddReplacement(context.Background(), req, validGameID, validHomeTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replacement.PlayerOnID).To(Equal(playerIDs[16]))
+		})
+
+		It("should let a player who came on be replaced in turn", func() {
+			earlier := db.GameReplacement{ID: uuid.New(), TeamID: validHomeTeamID, PlayerOffID: playerIDs[0], PlayerOnID: playerIDs[16], Minute: 50}
+			req := &api.ReplacementRequest{PlayerOffID: playerIDs[16], PlayerOnID: playerIDs[17], Minute: 70}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			expectLineup([]db.GameReplacement{earlier})
+			mockQueries.EXPECT().CreateGameReplacement(gomock.Any(), gomock.Any()).Return(nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.AddReplacement(context.Background(), req, validGameID, validHomeTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a validation error when the players are not where they need to be", func() {
+			earlier := db.GameReplacement{ID: uuid.New(), TeamID: validHomeTeamID, PlayerOffID: playerIDs[0], PlayerOnID: playerIDs[16], Minute: 50}
+			req := &api.ReplacementRequest{PlayerOffID: playerIDs[0], PlayerOnID: playerIDs[16], Minute: 60}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			expectLineup([]db.GameReplacement{earlier})
+			mockQueries.EXPECT().CreateGameReplacement(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.AddReplacement(context.Background(), req, validGameID, validHomeTeamID)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(HaveLen(2))
+			Expect(validationErr.Fields[0].Rule).To(Equal("on_field"))
+			Expect(validationErr.Fields[1].Rule).To(Equal("on_bench"))
+		})
+
+		It("should rollback with a conflict before kickoff", func() {
+			req := &api.ReplacementRequest{PlayerOffID: playerIDs[0], PlayerOnID: playerIDs[16], Minute: 50}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusScheduled), nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.AddReplacement(context.Background(), req, validGameID, validHomeTeamID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+		})
+	})
+
+	Describe("RemoveReplacement", func() {
+		It("should return a not found error for another side's replacement", func() {
+			replacement := db.GameReplacement{ID: uuid.New(), TeamID: validAwayTeamID}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetGame(gomock.Any(), validGameID).Return(validGame(db.GameStatusPlaying), nil)
+			mockQueries.EXPECT().GetGameReplacements(gomock.Any(), validGameID).Return([]db.GameReplacement{replacement}, nil)
+			mockQueries.EXPECT().DeleteGameReplacement(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.RemoveReplacement(context.Background(), validGameID, validHomeTeamID, replacement.ID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("replacement"))
+		})
+	})
+})
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// OfficialService defines the contract for match official operations.
+type OfficialService interface {
+	Create(ctx context.Context, req *api.OfficialRequest) (db.Official, error)
+	GetAll(ctx context.Context, limit, offset int) ([]db.Official, int64, error)
+	Get(ctx context.Context, officialID uuid.UUID) (db.Official, error)
+	Update(ctx context.Context, req *api.OfficialRequest, officialID uuid.UUID, version *time.Time) (db.Official, error)
+	Delete(ctx context.Context, officialID uuid.UUID) error
+	GetAppointments(ctx context.Context, officialID uuid.UUID) (OfficialAppointments, error)
+}
+
+// officialService is the concrete implementation backed by db_handler.DB.
+type officialService struct {
+	db db_handler.DB
+}
+
+// NewOfficialService returns a new OfficialService backed by db_handler.DB.
+func NewOfficialService(db db_handler.DB) OfficialService {
+	return &officialService{db: db}
+}
+
+// OfficialAppointments splits an official's appointments by kickoff. Upcoming games
+// are soonest first and past games most recent first.
+type OfficialAppointments struct {
+	OfficialID uuid.UUID
+	Upcoming   []db.GetOfficialAppointmentsRow
+	Past       []db.GetOfficialAppointmentsRow
+}
+
+func ToOfficialAppointmentsResponse(a OfficialAppointments) api.OfficialAppointmentsResponse {
+	resp := api.OfficialAppointmentsResponse{
+		OfficialID: a.OfficialID,
+		Upcoming:   make([]api.AppointmentResponse, 0, len(a.Upcoming)),
+		Past:       make([]api.AppointmentResponse, 0, len(a.Past)),
+	}
+
+	for _, row := range a.Upcoming {
+		resp.Upcoming = append(resp.Upcoming, api.ToAppointmentResponse(row))
+	}
+	for _, row := range a.Past {
+		resp.Past = append(resp.Past, api.ToAppointmentResponse(row))
+	}
+
+	return resp
+}
+
+func (s *officialService) Create(ctx context.Context, req *api.OfficialRequest) (db.Official, error) {
+	var official db.Official
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		official, txErr = createOfficial(ctx, queries, req)
+		return txErr
+	})
+	if err != nil {
+		return db.Official{}, err
+	}
+
+	return official, nil
+}
+
+func (s *officialService) GetAll(ctx context.Context, limit, offset int) ([]db.Official, int64, error) {
+	var (
+		officials []db.Official
+		total     int64
+	)
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
+		var err error
+
+		total, err = q.CountOfficials(ctx)
+		if err != nil {
+			return errors.Wrap(err, "count officials")
+		}
+
+		officials, err = q.GetOfficials(ctx, db.GetOfficialsParams{
+			PageLimit:  int32(limit),
+			PageOffset: int32(offset),
+		})
+		if err != nil {
+			return errors.Wrap(err, "get officials")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return officials, total, nil
+}
+
+func (s *officialService) Get(ctx context.Context, officialID uuid.UUID) (db.Official, error) {
+	var official db.Official
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		official, err = queries.GetOfficial(ctx, officialID)
+		return err
+	})
+	if err != nil {
+		return db.Official{}, wrapDBError(err, "official", "unable to get official")
+	}
+
+	return official, nil
+}
+
+func (s *officialService) Update(
+	ctx context.Context,
+	req *api.OfficialRequest,
+	officialID uuid.UUID,
+	version *time.Time,
+) (db.Official, error) {
+	var official db.Official
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := checkVersion(ctx, "official", officialID, version, queries.LockOfficial); err != nil {
+			return err
+		}
+
+		var txErr error
+		official, txErr = updateOfficial(ctx, queries, req, officialID)
+		return txErr
+	})
+	if err != nil {
+		return db.Official{}, err
+	}
+
+	return official, nil
+}
+
+func (s *officialService) Delete(ctx context.Context, officialID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return deleteOfficial(ctx, queries, officialID)
+	})
+}
+
+// GetAppointments returns every game the official is appointed to, split into games
+// yet to kick off and games that already have.
+func (s *officialService) GetAppointments(ctx context.Context, officialID uuid.UUID) (OfficialAppointments, error) {
+	appointments := OfficialAppointments{OfficialID: officialID}
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetOfficial(ctx, officialID); err != nil {
+			return wrapDBError(err, "official", "unable to get official")
+		}
+
+		rows, err := queries.GetOfficialAppointments(ctx, officialID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get official appointments")
+		}
+
+		now := time.Now()
+
+		// Rows are in kickoff order, so past games are prepended to list the most
+		// recent first.
+		for _, row := range rows {
+			if row.Date.After(now) {
+				appointments.Upcoming = append(appointments.Upcoming, row)
+			} else {
+				appointments.Past = append([]db.GetOfficialAppointmentsRow{row}, appointments.Past...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return OfficialAppointments{}, err
+	}
+
+	return appointments, nil
+}
+
+func createOfficial(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.OfficialRequest,
+) (db.Official, error) {
+	now := time.Now()
+	params := db.CreateOfficialParams{
+		ID:          uuid.New(),
+		Name:        req.Name,
+		Nationality: toNullString(req.Nationality),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+
+	if err := queries.CreateOfficial(ctx, params); err != nil {
+		return db.Official{}, wrapDBError(err, "official", "unable to create new official")
+	}
+
+	official, err := queries.GetOfficial(ctx, params.ID)
+	if err != nil {
+		return db.Official{}, errors.Wrap(err, "unable to get new official")
+	}
+
+	return official, nil
+}
+
+func updateOfficial(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.OfficialRequest,
+	officialID uuid.UUID,
+) (db.Official, error) {
+	params := db.UpdateOfficialParams{
+		Name:        req.Name,
+		Nationality: toNullString(req.Nationality),
+		UpdatedAt:   time.Now(),
+		ID:          officialID,
+	}
+
+	if err := queries.UpdateOfficial(ctx, params); err != nil {
+		return db.Official{}, wrapDBError(err, "official", "unable to update official")
+	}
+
+	official, err := queries.GetOfficial(ctx, officialID)
+	if err != nil {
+		return db.Official{}, wrapDBError(err, "official", "unable to get updated official")
+	}
+
+	return official, nil
+}
+
+// deleteOfficial soft deletes an official along with every game they are appointed to.
+func deleteOfficial(
+	ctx context.Context,
+	queries db_handler.Queries,
+	officialID uuid.UUID,
+) error {
+	if _, err := queries.GetOfficial(ctx, officialID); err != nil {
+		return wrapDBError(err, "official", "unable to get official")
+	}
+
+	deletedAt := sql.NullTime{Time: time.Now(), Valid: true}
+
+	if err := queries.DeleteGameOfficialsByOfficialID(ctx, db.DeleteGameOfficialsByOfficialIDParams{
+		DeletedAt:  deletedAt,
+		OfficialID: officialID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to delete official appointments")
+	}
+
+	if err := queries.DeleteOfficial(ctx, db.DeleteOfficialParams{
+		DeletedAt: deletedAt,
+		ID:        officialID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to delete official")
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("official", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc OfficialService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewOfficialService(mockDB)
+	})
+
+	validOfficialID := uuid.MustParse("5f3e2d1c-9b8a-4c7d-a6e5-f4d3c2b1a090")
+
+	validTimeNow := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	nationality := "New Zealand"
+
+	validOfficialRequest := &api.OfficialRequest{
+		Name:        "Ben O'Keeffe",
+		Nationality: nationality,
+	}
+
+	validOfficialFromDB := db.Official{
+		ID:          validOfficialID,
+		Name:        "Ben O'Keeffe",
+		Nationality: sql.NullString{String: nationality, Valid: true},
+		CreatedAt:   validTimeNow,
+		UpdatedAt:   validTimeNow,
+	}
+
+	Describe("CreateOfficial", func() {
+		It("should create a new official", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateOfficial(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateOfficialParams) error {
+				Expect(params.Name).To(Equal("Ben O'Keeffe"))
+				Expect(params.Nationality).To(Equal(sql.NullString{String: nationality, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), gomock.Any()).Return(validOfficialFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			official, err := svc.Create(context.Background(), validOfficialRequest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(official).To(Equal(validOfficialFromDB))
+		})
+	})
+
+	Describe("GetOfficials", func() {
+		It("should retrieve paginated officials with total count", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CountOfficials(gomock.Any()).Return(int64(1), nil)
+			mockQueries.EXPECT().GetOfficials(
+				gomock.Any(),
+				db.GetOfficialsParams{PageOffset: 20, PageLimit: 10},
+			).Return([]db.Official{validOfficialFromDB}, nil)
+
+			officials, total, err := svc.GetAll(context.Background(), 10, 20)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+			Expect(officials).To(Equal([]db.Official{validOfficialFromDB}))
+		})
+	})
+
+	Describe("GetOfficial", func() {
+		It("should return a not found error when the official does not exist", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(db.Official{}, sql.ErrNoRows)
+
+			_, err := svc.Get(context.Background(), validOfficialID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("official"))
+		})
+	})
+
+	Describe("UpdateOfficial", func() {
+		It("should rollback with a precondition failure when the official has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().LockOfficial(gomock.Any(), validOfficialID).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateOfficial(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Update(context.Background(), validOfficialRequest, validOfficialID, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("official"))
+		})
+	})
+
+	Describe("DeleteOfficial", func() {
+		It("should soft delete an official along with their appointments", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(validOfficialFromDB, nil)
+			mockQueries.EXPECT().DeleteGameOfficialsByOfficialID(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteGameOfficialsByOfficialIDParams) error {
+				Expect(params.OfficialID).To(Equal(validOfficialID))
+				Expect(params.DeletedAt.Valid).To(BeTrue())
+				return nil
+			})
+			mockQueries.EXPECT().DeleteOfficial(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteOfficialParams) error {
+				Expect(params.ID).To(Equal(validOfficialID))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			err := svc.Delete(context.Background(), validOfficialID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("GetAppointments", func() {
+		It("should split appointments into upcoming and past games", func() {
+			lastMonth := db.GetOfficialAppointmentsRow{ID: uuid.New(), GameID: uuid.New(), Date: time.Now().AddDate(0, -1, 0)}
+			lastWeek := db.GetOfficialAppointmentsRow{ID: uuid.New(), GameID: uuid.New(), Date: time.Now().AddDate(0, 0, -7)}
+			nextWeek := db.GetOfficialAppointmentsRow{ID: uuid.New(), GameID: uuid.New(), Date: time.Now().AddDate(0, 0, 7)}
+
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetOfficial(gomock.Any(), validOfficialID).Return(validOfficialFromDB, nil)
+			mockQueries.EXPECT().GetOfficialAppointments(
+				gomock.Any(),
+				validOfficialID,
+			).Return([]db.GetOfficialAppointmentsRow{lastMonth, lastWeek, nextWeek}, nil)
+
+			appointments, err := svc.GetAppointments(context.Background(), validOfficialID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(appointments.Upcoming).To(Equal([]db.GetOfficialAppointmentsRow{nextWeek}))
+			Expect(appointments.Past).To(Equal([]db.GetOfficialAppointmentsRow{lastWeek, lastMonth}))
+		})
+	})
+})
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// PlayerService defines the contract for player-related operations. Players belong to
+// a team, so every method takes the team the player is looked up through.
+type PlayerService interface {
+	Create(ctx context.Context, req *api.PlayerRequest, teamID uuid.UUID) (db.Player, error)
+	GetAll(ctx context.Context, teamID uuid.UUID, limit, offset int) ([]db.Player, int64, error)
+	Get(ctx context.Context, teamID, playerID uuid.UUID) (db.Player, error)
+	Update(ctx context.Context, req *api.PlayerRequest, teamID, playerID uuid.UUID, version *time.Time) (db.Player, error)
+	Delete(ctx context.Context, teamID, playerID uuid.UUID) error
+}
+
+// playerService is the concrete implementation backed by db_handler.DB.
+type playerService struct {
+	db db_handler.DB
+}
+
+// NewPlayerService returns a new PlayerService backed by db_handler.DB.
+func NewPlayerService(db db_handler.DB) PlayerService {
+	return &playerService{db: db}
+}
+
+func (s *playerService) Create(ctx context.Context, req *api.PlayerRequest, teamID uuid.UUID) (db.Player, error) {
+	var player db.Player
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		player, txErr = createPlayer(ctx, queries, req, teamID)
+		return txErr
+	})
+	if err != nil {
+		return db.Player{}, err
+	}
+
+	return player, nil
+}
+
+func (s *playerService) GetAll(ctx context.Context, teamID uuid.UUID, limit, offset int) ([]db.Player, int64, error) {
+	var (
+		players []db.Player
+		total   int64
+	)
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
+		if _, err := q.GetTeam(ctx, teamID); err != nil {
+			return wrapDBError(err, "team", "unable to get team")
+		}
+
+		var err error
+
+		total, err = q.CountPlayersByTeamID(ctx, teamID)
+		if err != nil {
+			return errors.Wrap(err, "count players")
+		}
+
+		players, err = q.GetPlayersByTeamID(ctx, db.GetPlayersByTeamIDParams{
+			TeamID:     teamID,
+			PageLimit:  int32(limit),
+			PageOffset: int32(offset),
+		})
+		if err != nil {
+			return errors.Wrap(err, "get players")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return players, total, nil
+}
+
+func (s *playerService) Get(ctx context.Context, teamID, playerID uuid.UUID) (db.Player, error) {
+	var player db.Player
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		player, err = getTeamPlayer(ctx, queries, teamID, playerID)
+		return err
+	})
+	if err != nil {
+		return db.Player{}, err
+	}
+
+	return player, nil
+}
+
+func (s *playerService) Update(ctx context.Context, req *api.PlayerRequest, teamID, playerID uuid.UUID, version *time.Time) (db.Player, error) {
+	var player db.Player
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := getTeamPlayer(ctx, queries, teamID, playerID); err != nil {
+			return err
+		}
+
+		if err := checkVersion(ctx, "player", playerID, version, queries.LockPlayer); err != nil {
+			return err
+		}
+
+		var txErr error
+		player, txErr = updatePlayer(ctx, queries, req, playerID)
+		return txErr
+	})
+	if err != nil {
+		return db.Player{}, err
+	}
+
+	return player, nil
+}
+
+func (s *playerService) Delete(ctx context.Context, teamID, playerID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return deletePlayer(ctx, queries, teamID, playerID)
+	})
+}
+
+// getTeamPlayer returns a player of teamID. Players of other teams are not found.
+func getTeamPlayer(ctx context.Context, queries db_handler.Queries, teamID, playerID uuid.UUID) (db.Player, error) {
+	player, err := queries.GetPlayer(ctx, playerID)
+	if err != nil {
+		return db.Player{}, wrapDBError(err, "player", "unable to get player")
+	}
+
+	if player.TeamID != teamID {
+		return db.Player{}, NewNotFoundError("player", nil)
+	}
+
+	return player, nil
+}
+
+func createPlayer(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.PlayerRequest,
+	teamID uuid.UUID,
+) (db.Player, error) {
+	if _, err := queries.GetTeam(ctx, teamID); err != nil {
+		return db.Player{}, wrapDBError(err, "team", "unable to get team")
+	}
+
+	now := time.Now()
+	params := db.CreatePlayerParams{
+		ID:          uuid.New(),
+		TeamID:      teamID,
+		Name:        req.Name,
+		DateOfBirth: toNullTime(req.DateOfBirth),
+		Position:    db.PlayerPosition(req.Position),
+		Nationality: toNullString(req.Nationality),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+
+	if err := queries.CreatePlayer(ctx, params); err != nil {
+		return db.Player{}, wrapDBError(err, "player", "unable to create new player")
+	}
+
+	player, err := queries.GetPlayer(ctx, params.ID)
+	if err != nil {
+		return db.Player{}, errors.Wrap(err, "unable to get new player")
+	}
+
+	return player, nil
+}
+
+func updatePlayer(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.PlayerRequest,
+	playerID uuid.UUID,
+) (db.Player, error) {
+	params := db.UpdatePlayerParams{
+		Name:        req.Name,
+		DateOfBirth: toNullTime(req.DateOfBirth),
+		Position:    db.PlayerPosition(req.Position),
+		Nationality: toNullString(req.Nationality),
+		UpdatedAt:   time.Now(),
+		ID:          playerID,
+	}
+
+	if err := queries.UpdatePlayer(ctx, params); err != nil {
+		return db.Player{}, wrapDBError(err, "player", "unable to update player")
+	}
+
+	player, err := queries.GetPlayer(ctx, playerID)
+	if err != nil {
+		return db.Player{}, wrapDBError(err, "player", "unable to get updated player")
+	}
+
+	return player, nil
+}
+
+// deletePlayer soft deletes a player along with every squad they are named in.
+func deletePlayer(
+	ctx context.Context,
+	queries db_handler.Queries,
+	teamID, playerID uuid.UUID,
+) error {
+	if _, err := getTeamPlayer(ctx, queries, teamID, playerID); err != nil {
+		return err
+	}
+
+	deletedAt := sql.NullTime{Time: time.Now(), Valid: true}
+
+	if err := queries.DeleteSeasonTeamPlayersByPlayerID(ctx, db.DeleteSeasonTeamPlayersByPlayerIDParams{
+		DeletedAt: deletedAt,
+		PlayerID:  playerID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to delete player squad entries")
+	}
+
+	if err := queries.DeletePlayer(ctx, db.DeletePlayerParams{
+		DeletedAt: deletedAt,
+		ID:        playerID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to delete player")
+	}
+
+	return nil
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("player", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc PlayerService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewPlayerService(mockDB)
+	})
+
+	validTeamID := uuid.MustParse("013952a5-87e1-4d26-a312-09b2aff54241")
+	validPlayerID := uuid.MustParse("7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10")
+
+	validTimeNow := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	dateOfBirth := time.Date(1993, time.October, 14, 0, 0, 0, 0, time.UTC)
+
+	validPlayerRequest := &api.PlayerRequest{
+		Name:        "Ardie Savea",
+		DateOfBirth: &dateOfBirth,
+		Position:    api.PlayerPositionNumberEight,
+		Nationality: "New Zealand",
+	}
+
+	validPlayerFromDB := db.Player{
+		ID:          validPlayerID,
+		TeamID:      validTeamID,
+		Name:        "Ardie Savea",
+		DateOfBirth: sql.NullTime{Time: dateOfBirth, Valid: true},
+		Position:    db.PlayerPositionNumberEight,
+		Nationality: sql.NullString{String: "New Zealand", Valid: true},
+		CreatedAt:   validTimeNow,
+		UpdatedAt:   validTimeNow,
+	}
+
+	validTestError := errors.New("a valid testing error")
+
+	Describe("CreatePlayer", func() {
+		It("should create a new player for the team", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(db.Team{ID: validTeamID}, nil)
+			mockQueries.EXPECT().CreatePlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreatePlayerParams) error {
+				Expect(params.TeamID).To(Equal(validTeamID))
+				Expect(params.Position).To(Equal(db.PlayerPositionNumberEight))
+				Expect(params.DateOfBirth).To(Equal(sql.NullTime{Time: dateOfBirth, Valid: true}))
+				Expect(params.Nationality).To(Equal(sql.NullString{String: "New Zealand", Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), gomock.Any()).Return(validPlayerFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			player, err := svc.Create(context.Background(), validPlayerRequest, validTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(player).To(Equal(validPlayerFromDB))
+		})
+
+		It("should store missing optional details as null", func() {
+			req := &api.PlayerRequest{Name: "Beauden Barrett", Position: api.PlayerPositionFlyHalf}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(db.Team{ID: validTeamID}, nil)
+			mockQueries.EXPECT().CreatePlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreatePlayerParams) error {
+				Expect(params.DateOfBirth.Valid).To(BeFalse())
+				Expect(params.Nationality.Valid).To(BeFalse())
+				return nil
+			})
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), gomock.Any()).Return(db.Player{}, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), req, validTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a not found error when the team does not exist", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(db.Team{}, sql.ErrNoRows)
+			mockQueries.EXPECT().CreatePlayer(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), validPlayerRequest, validTeamID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("team"))
+		})
+	})
+
+	Describe("GetPlayers", func() {
+		It("should retrieve the team's paginated players with total count", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(db.Team{ID: validTeamID}, nil)
+			mockQueries.EXPECT().CountPlayersByTeamID(gomock.Any(), validTeamID).Return(int64(1), nil)
+			mockQueries.EXPECT().GetPlayersByTeamID(
+				gomock.Any(),
+				db.GetPlayersByTeamIDParams{TeamID: validTeamID, PageOffset: 20, PageLimit: 10},
+			).Return([]db.Player{validPlayerFromDB}, nil)
+
+			players, total, err := svc.GetAll(context.Background(), validTeamID, 10, 20)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+			Expect(players).To(Equal([]db.Player{validPlayerFromDB}))
+		})
+
+		It("should return error when count fails", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(db.Team{ID: validTeamID}, nil)
+			mockQueries.EXPECT().CountPlayersByTeamID(gomock.Any(), validTeamID).Return(int64(0), validTestError)
+
+			players, total, err := svc.GetAll(context.Background(), validTeamID, 10, 0)
+
+			Expect(players).To(BeNil())
+			Expect(total).To(Equal(int64(0)))
+			Expect(err.Error()).To(Equal("count players: a valid testing error"))
+		})
+	})
+
+	Describe("GetPlayer", func() {
+		It("should return a not found error when the player belongs to another team", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+
+			_, err := svc.Get(context.Background(), uuid.New(), validPlayerID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("player"))
+		})
+	})
+
+	Describe("UpdatePlayer", func() {
+		It("should update a player when the version matches", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().LockPlayer(gomock.Any(), validPlayerID).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdatePlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.UpdatePlayerParams) error {
+				Expect(params.ID).To(Equal(validPlayerID))
+				Expect(params.Position).To(Equal(db.PlayerPositionNumberEight))
+				return nil
+			})
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			player, err := svc.Update(context.Background(), validPlayerRequest, validTeamID, validPlayerID, &validTimeNow)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(player).To(Equal(validPlayerFromDB))
+		})
+
+		It("should rollback with a precondition failure when the player has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().LockPlayer(gomock.Any(), validPlayerID).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdatePlayer(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Update(context.Background(), validPlayerRequest, validTeamID, validPlayerID, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("player"))
+		})
+	})
+
+	Describe("DeletePlayer", func() {
+		It("should soft delete the player and remove them from every squad", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().DeleteSeasonTeamPlayersByPlayerID(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteSeasonTeamPlayersByPlayerIDParams) error {
+				Expect(params.PlayerID).To(Equal(validPlayerID))
+				Expect(params.DeletedAt.Valid).To(BeTrue())
+				return nil
+			})
+			mockQueries.EXPECT().DeletePlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeletePlayerParams) error {
+				Expect(params.ID).To(Equal(validPlayerID))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			err := svc.Delete(context.Background(), validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return a not found error when the player does not exist", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(db.Player{}, sql.ErrNoRows)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Delete(context.Background(), validTeamID, validPlayerID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+	})
+})
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
@@ -18,8 +20,10 @@ type SeasonService interface {
 	Create(ctx context.Context, req *api.SeasonRequest, competitionID uuid.UUID) (SeasonAggregate, error)
 	GetAll(ctx context.Context, competitionID uuid.UUID, limit, offset int) ([]SeasonAggregate, int64, error)
 	Get(ctx context.Context, competitionID, seasonID uuid.UUID) (SeasonAggregate, error)
-	Update(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID) (SeasonAggregate, error)
+	Update(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID, version *time.Time) (SeasonAggregate, error)
 	Delete(ctx context.Context, seasonID uuid.UUID) error
+	SetTeamHomeVenue(ctx context.Context, seasonID, teamID uuid.UUID, venueID uuid.NullUUID) (SeasonAggregate, error)
+	GetCurrent(ctx context.Context, competitionID uuid.UUID) (CurrentSeason, error)
 }
 
 // seasonService is the concrete implementation backed by db_handler.DB.
@@ -33,7 +37,7 @@ func NewSeasonService(db db_handler.DB) SeasonService {
 
 func (s *seasonService) Create(ctx context.Context, req *api.SeasonRequest, competitionID uuid.UUID) (SeasonAggregate, error) {
 	var season SeasonAggregate
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		season, err = createSeason(ctx, queries, req, competitionID)
 		return err
@@ -47,7 +51,7 @@ func (s *seasonService) Create(ctx context.Context, req *api.SeasonRequest, comp
 func (s *seasonService) GetAll(ctx context.Context, competitionID uuid.UUID, limit, offset int) ([]SeasonAggregate, int64, error) {
 	var seasons []SeasonAggregate
 	var total int64
-	err := db_handler.Run(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		seasons, total, err = getSeasons(ctx, queries, competitionID, limit, offset)
 		return err
@@ -60,7 +64,7 @@ func (s *seasonService) GetAll(ctx context.Context, competitionID uuid.UUID, lim
 
 func (s *seasonService) Get(ctx context.Context, competitionID, seasonID uuid.UUID) (SeasonAggregate, error) {
 	var season SeasonAggregate
-	err := db_handler.Run(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		season, err = getSeason(ctx, queries, seasonID)
 		return err
@@ -71,9 +75,13 @@ func (s *seasonService) Get(ctx context.Context, competitionID, seasonID uuid.UU
 	return season, nil
 }
 
-func (s *seasonService) Update(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID) (SeasonAggregate, error) {
+func (s *seasonService) Update(ctx context.Context, req *api.SeasonRequest, competitionID, seasonID uuid.UUID, version *time.Time) (SeasonAggregate, error) {
 	var season SeasonAggregate
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := checkVersion(ctx, "season", seasonID, version, queries.LockSeason); err != nil {
+			return err
+		}
+
 		var txErr error
 		season, txErr = updateSeason(ctx, queries, req, competitionID, seasonID)
 		return txErr
@@ -85,7 +93,7 @@ func (s *seasonService) Update(ctx context.Context, req *api.SeasonRequest, comp
 }
 
 func (s *seasonService) Delete(ctx context.Context, seasonID uuid.UUID) error {
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		return deleteSeason(ctx, queries, seasonID)
 	})
 	if err != nil {
@@ -94,9 +102,39 @@ func (s *seasonService) Delete(ctx context.Context, seasonID uuid.UUID) error {
 	return nil
 }
 
+func (s *seasonService) SetTeamHomeVenue(ctx context.Context, seasonID, teamID uuid.UUID, venueID uuid.NullUUID) (SeasonAggregate, error) {
+	var season SeasonAggregate
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		season, err = setSeasonTeamHomeVenue(ctx, queries, seasonID, teamID, venueID)
+		return err
+	})
+	if err != nil {
+		return SeasonAggregate{}, err
+	}
+	return season, nil
+}
+
+// GetCurrent returns the competition's season in progress. When none is in progress it
+// falls back to the next season to start, then to the last season to finish.
+func (s *seasonService) GetCurrent(ctx context.Context, competitionID uuid.UUID) (CurrentSeason, error) {
+	var current CurrentSeason
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		current, err = getCurrentSeason(ctx, queries, competitionID, time.Now())
+		return err
+	})
+	if err != nil {
+		return CurrentSeason{}, err
+	}
+	return current, nil
+}
+
 type SeasonAggregate struct {
 	ID            uuid.UUID
 	CompetitionID uuid.UUID
+	Name          string
+	Year          int32
 	StartDate     time.Time
 	EndDate       time.Time
 	Stages        []db.Stage
@@ -104,6 +142,38 @@ type SeasonAggregate struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	DeletedAt     zero.Time
+
+	// HomeVenues maps a team ID to the venue that replaces the team's own home venue
+	// for this season. Teams without an override are absent.
+	HomeVenues map[uuid.UUID]uuid.UUID
+}
+
+// homeVenue returns the venue teamID plays its home games at this season: the season's
+// override when there is one, otherwise the team's own home venue.
+func (s SeasonAggregate) homeVenue(teamID uuid.UUID) uuid.NullUUID {
+	if venueID, ok := s.HomeVenues[teamID]; ok {
+		return uuid.NullUUID{UUID: venueID, Valid: true}
+	}
+	for _, team := range s.Teams {
+		if team.ID == teamID {
+			return team.HomeVenueID
+		}
+	}
+	return uuid.NullUUID{}
+}
+
+// CurrentSeason is the season resolved as a competition's current season and how it
+// was resolved.
+type CurrentSeason struct {
+	Season     SeasonAggregate
+	Resolution api.SeasonResolution
+}
+
+func ToCurrentSeasonResponse(c CurrentSeason) api.CurrentSeasonResponse {
+	return api.CurrentSeasonResponse{
+		Resolution: c.Resolution,
+		Season:     ToSeasonResponse(c.Season),
+	}
 }
 
 func ToSeasonResponse(s SeasonAggregate) api.SeasonResponse {
@@ -120,16 +190,47 @@ func ToSeasonResponse(s SeasonAggregate) api.SeasonResponse {
 	return api.SeasonResponse{
 		ID:            s.ID,
 		CompetitionID: s.CompetitionID,
+		Name:          s.Name,
+		Year:          s.Year,
 		StartDate:     s.StartDate,
 		EndDate:       s.EndDate,
 		Stages:        stages,
 		Teams:         teams,
+		HomeVenues:    s.HomeVenues,
 		CreatedAt:     s.CreatedAt,
 		UpdatedAt:     s.UpdatedAt,
 		DeletedAt:     zero.TimeFrom(s.DeletedAt.Time),
 	}
 }
 
+// ToSeasonRequest returns the request that would save s as it is. Stages keep their
+// IDs so a merge patch that leaves them alone does not recreate them.
+func ToSeasonRequest(s SeasonAggregate) api.SeasonRequest {
+	stages := make([]api.StageRequest, 0, len(s.Stages))
+	for _, stage := range s.Stages {
+		stages = append(stages, api.StageRequest{
+			ID:         &stage.ID,
+			Name:       stage.Name,
+			StageType:  api.StageType(stage.StageType),
+			OrderIndex: stage.OrderIndex,
+		})
+	}
+
+	teams := make([]uuid.UUID, 0, len(s.Teams))
+	for _, team := range s.Teams {
+		teams = append(teams, team.ID)
+	}
+
+	return api.SeasonRequest{
+		Name:      s.Name,
+		Year:      s.Year,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+		Stages:    stages,
+		Teams:     teams,
+	}
+}
+
 func createSeason(ctx context.Context, queries db_handler.Queries, req *api.SeasonRequest, competitionID uuid.UUID) (SeasonAggregate, error) {
 	now := time.Now()
 	seasonID := uuid.New()
@@ -155,9 +256,16 @@ func createSeason(ctx context.Context, queries db_handler.Queries, req *api.Seas
 }
 
 func insertSeason(ctx context.Context, queries db_handler.Queries, seasonID, competitionID uuid.UUID, req *api.SeasonRequest, now time.Time) error {
+	name, year, err := seasonLabel(req)
+	if err != nil {
+		return err
+	}
+
 	params := db.CreateSeasonParams{
 		ID:            seasonID,
 		CompetitionID: competitionID,
+		Name:          name,
+		Year:          year,
 		StartDate:     req.StartDate,
 		EndDate:       req.EndDate,
 		CreatedAt:     now,
@@ -166,14 +274,46 @@ func insertSeason(ctx context.Context, queries db_handler.Queries, seasonID, com
 	}
 
 	if err := queries.CreateSeason(ctx, params); err != nil {
-		return errors.Wrap(err, "unable to create season")
+		return wrapDBError(err, "season", "unable to create season")
 	}
 	return nil
 }
 
+// seasonLabel returns the name and year to store for req. The year defaults to the year
+// the season starts and must otherwise be the year it starts or ends. The name defaults
+// to the year.
+func seasonLabel(req *api.SeasonRequest) (string, int32, error) {
+	year := req.Year
+	if year == 0 {
+		year = int32(req.StartDate.Year())
+	}
+
+	if int(year) < req.StartDate.Year() || int(year) > req.EndDate.Year() {
+		return "", 0, NewValidationError("invalid season", FieldError{
+			Field:   "year",
+			Rule:    "season_year",
+			Message: fmt.Sprintf("year must be between %d and %d", req.StartDate.Year(), req.EndDate.Year()),
+		})
+	}
+
+	name := req.Name
+	if name == "" {
+		name = strconv.Itoa(int(year))
+	}
+
+	return name, year, nil
+}
+
 func ensureTeamsExist(ctx context.Context, queries db_handler.Queries, teamIDs []uuid.UUID) error {
 	for _, id := range teamIDs {
 		if _, err := queries.GetTeam(ctx, id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return NewValidationError("invalid season", FieldError{
+					Field:   "teams",
+					Rule:    "team_exists",
+					Message: fmt.Sprintf("team %s does not exist", id),
+				})
+			}
 			return errors.Wrapf(err, "unable to get team %s", id.String())
 		}
 	}
@@ -221,7 +361,7 @@ func createStages(
 func getSeason(ctx context.Context, queries db_handler.Queries, seasonID uuid.UUID) (SeasonAggregate, error) {
 	season, err := queries.GetSeason(ctx, seasonID)
 	if err != nil {
-		return SeasonAggregate{}, errors.Wrap(err, "unable to get season")
+		return SeasonAggregate{}, wrapDBError(err, "season", "unable to get season")
 	}
 
 	return buildSeasonAggregate(ctx, queries, season)
@@ -233,7 +373,7 @@ func buildSeasonAggregate(
 	season db.Season,
 ) (SeasonAggregate, error) {
 
-	teams, err := getSeasonTeams(ctx, queries, season.ID)
+	teams, homeVenues, err := getSeasonTeams(ctx, queries, season.ID)
 	if err != nil {
 		return SeasonAggregate{}, err
 	}
@@ -246,9 +386,12 @@ func buildSeasonAggregate(
 	return SeasonAggregate{
 		ID:            season.ID,
 		CompetitionID: season.CompetitionID,
+		Name:          season.Name,
+		Year:          season.Year,
 		StartDate:     season.StartDate,
 		EndDate:       season.EndDate,
 		Teams:         teams,
+		HomeVenues:    homeVenues,
 		Stages:        stages,
 		CreatedAt:     season.CreatedAt,
 		UpdatedAt:     season.UpdatedAt,
@@ -256,36 +399,122 @@ func buildSeasonAggregate(
 	}, nil
 }
 
+// getCurrentSeason resolves the competition's season at the given time: the season in
+// progress, otherwise the next to start, otherwise the last to finish.
+func getCurrentSeason(
+	ctx context.Context,
+	queries db_handler.Queries,
+	competitionID uuid.UUID,
+	at time.Time,
+) (CurrentSeason, error) {
+	if _, err := queries.GetCompetition(ctx, competitionID); err != nil {
+		return CurrentSeason{}, wrapDBError(err, "competition", "unable to get competition")
+	}
+
+	season, err := queries.GetCurrentSeason(ctx, db.GetCurrentSeasonParams{
+		CompetitionID: competitionID,
+		At:            at,
+	})
+	if err != nil {
+		return CurrentSeason{}, wrapDBError(err, "season", "unable to get current season")
+	}
+
+	resolution := api.SeasonResolutionCurrent
+	switch {
+	case season.StartDate.After(at):
+		resolution = api.SeasonResolutionNext
+	case season.EndDate.Before(at):
+		resolution = api.SeasonResolutionPrevious
+	}
+
+	aggregate, err := buildSeasonAggregate(ctx, queries, season)
+	if err != nil {
+		return CurrentSeason{}, err
+	}
+
+	return CurrentSeason{Season: aggregate, Resolution: resolution}, nil
+}
+
+// getSeasonTeams returns the season's teams along with any home venue overrides, keyed
+// by team ID.
 func getSeasonTeams(
 	ctx context.Context,
 	queries db_handler.Queries,
 	seasonID uuid.UUID,
-) ([]db.Team, error) {
+) ([]db.Team, map[uuid.UUID]uuid.UUID, error) {
 
 	rows, err := queries.GetSeasonTeams(ctx, seasonID)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to get season teams")
+		return nil, nil, errors.Wrap(err, "unable to get season teams")
 	}
 
 	teams := make([]db.Team, 0, len(rows))
+	homeVenues := make(map[uuid.UUID]uuid.UUID)
 	for _, row := range rows {
 		team, err := queries.GetTeam(ctx, row.TeamID)
 		if err != nil {
-			return nil, errors.Wrap(err, "unable to get team")
+			return nil, nil, errors.Wrap(err, "unable to get team")
 		}
 		teams = append(teams, team)
+
+		if row.HomeVenueID.Valid {
+			homeVenues[row.TeamID] = row.HomeVenueID.UUID
+		}
+	}
+
+	return teams, homeVenues, nil
+}
+
+// setSeasonTeamHomeVenue overrides where teamID plays its home games this season. A null
+// venueID removes the override so the team's own home venue applies again.
+func setSeasonTeamHomeVenue(
+	ctx context.Context,
+	queries db_handler.Queries,
+	seasonID, teamID uuid.UUID,
+	venueID uuid.NullUUID,
+) (SeasonAggregate, error) {
+	season, err := getSeason(ctx, queries, seasonID)
+	if err != nil {
+		return SeasonAggregate{}, err
 	}
 
-	return teams, nil
+	inSeason := false
+	for _, team := range season.Teams {
+		if team.ID == teamID {
+			inSeason = true
+			break
+		}
+	}
+	if !inSeason {
+		return SeasonAggregate{}, NewNotFoundError("team", nil)
+	}
+
+	if err := ensureVenueExists(ctx, queries, venueID, "invalid home venue", "venue_id"); err != nil {
+		return SeasonAggregate{}, err
+	}
+
+	params := db.UpdateSeasonTeamHomeVenueParams{
+		HomeVenueID: venueID,
+		UpdatedAt:   time.Now(),
+		SeasonID:    seasonID,
+		TeamID:      teamID,
+	}
+	if err := queries.UpdateSeasonTeamHomeVenue(ctx, params); err != nil {
+		return SeasonAggregate{}, errors.Wrap(err, "unable to update season team home venue")
+	}
+
+	return getSeason(ctx, queries, seasonID)
 }
 
+// dedupeUUIDs drops repeated IDs, keeping the first of each in its original order.
 func dedupeUUIDs(ids []uuid.UUID) []uuid.UUID {
-	set := make(map[uuid.UUID]struct{}, len(ids))
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
 	for _, id := range ids {
-		set[id] = struct{}{}
-	}
-	out := make([]uuid.UUID, 0, len(set))
-	for id := range set {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
 		out = append(out, id)
 	}
 	return out
@@ -345,15 +574,22 @@ func updateSeason(ctx context.Context, queries db_handler.Queries, req *api.Seas
 }
 
 func updateSeasonFields(ctx context.Context, queries db_handler.Queries, req *api.SeasonRequest, competitionID, seasonID uuid.UUID, now time.Time) error {
+	name, year, err := seasonLabel(req)
+	if err != nil {
+		return err
+	}
+
 	params := db.UpdateSeasonParams{
 		CompetitionID: competitionID,
+		Name:          name,
+		Year:          year,
 		StartDate:     req.StartDate,
 		EndDate:       req.EndDate,
 		UpdatedAt:     now,
 		ID:            seasonID,
 	}
 	if err := queries.UpdateSeason(ctx, params); err != nil {
-		return errors.Wrap(err, "unable to update season")
+		return wrapDBError(err, "season", "unable to update season")
 	}
 	return nil
 }
@@ -389,7 +625,11 @@ func syncSeasonStages(
 		requestedSet[stageID] = struct{}{}
 
 		if _, exists := existingMap[stageID]; !exists {
-			return errors.Errorf("unable to update stage %s for season %s", stageID, seasonID)
+			return NewValidationError("invalid season", FieldError{
+				Field:   "stages",
+				Rule:    "stage_in_season",
+				Message: fmt.Sprintf("stage %s does not belong to season %s", stageID, seasonID),
+			})
 		}
 
 		if err := updateStage(ctx, queries, stageID, stage, now); err != nil {
@@ -419,7 +659,7 @@ func createStage(
 	}
 
 	if err := queries.CreateStage(ctx, params); err != nil {
-		return errors.Wrapf(err, "unable to create stage %q", stage.Name)
+		return wrapDBError(err, "stage", fmt.Sprintf("unable to create stage %q", stage.Name))
 	}
 
 	return nil
@@ -441,7 +681,7 @@ func updateStage(
 	}
 
 	if err := queries.UpdateStage(ctx, params); err != nil {
-		return errors.Wrapf(err, "unable to update stage %s", stageID)
+		return wrapDBError(err, "stage", fmt.Sprintf("unable to update stage %s", stageID))
 	}
 
 	return nil
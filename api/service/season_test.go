@@ -10,6 +10,7 @@ import (
 	"github.com/bradley-adams/gainline/http/api"
 	"github.com/google/uuid"
 	"github.com/guregu/null/zero"
+	"github.com/lib/pq"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -658,6 +659,66 @@ var _ = Describe("season", func() {
 		})
 	})
 
+	Describe("CreateSeason labels", func() {
+		start := time.Date(2025, time.July, 31, 9, 10, 0, 0, time.UTC)
+
+		labelled := func(name string, year int32) *api.SeasonRequest {
+			return &api.SeasonRequest{
+				Name:      name,
+				Year:      year,
+				StartDate: start,
+				EndDate:   start.AddDate(0, 3, 0),
+				Stages:    []api.StageRequest{validStage},
+				Teams:     validTeamIDs,
+			}
+		}
+
+		It("should default the name and year to the year the season starts", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateSeason(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateSeasonParams) error {
+				Expect(params.Name).To(Equal("2025"))
+				Expect(params.Year).To(Equal(int32(2025)))
+				return validTestError
+			})
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), labelled("", 0), validCompetitionID)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should rollback with a conflict when the name is taken in the competition", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateSeason(gomock.Any(), gomock.Any()).Return(&pq.Error{Code: uniqueViolation})
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), labelled("Bunnings NPC 2025", 2025), validCompetitionID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("season already exists"))
+		})
+
+		It("should rollback with a validation error when the year is outside the season dates", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateSeason(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), labelled("", 2024), validCompetitionID)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields[0].Field).To(Equal("year"))
+			Expect(validationErr.Fields[0].Message).To(Equal("year must be between 2025 and 2025"))
+		})
+	})
+
 	Describe("GetSeasons", func() {
 		It("should get all seasons without errors", func() {
 			mockDB.EXPECT().
@@ -886,6 +947,52 @@ var _ = Describe("season", func() {
 		})
 	})
 
+	Describe("GetCurrentSeason", func() {
+		expectSeason := func(season db.Season) {
+			mockQueries.EXPECT().GetCompetition(gomock.Any(), validCompetitionID).Return(db.Competition{ID: validCompetitionID}, nil)
+			mockQueries.EXPECT().GetCurrentSeason(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.GetCurrentSeasonParams) (db.Season, error) {
+				Expect(params.CompetitionID).To(Equal(validCompetitionID))
+				return season, nil
+			})
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), season.ID).Return(nil, nil)
+			mockQueries.EXPECT().GetStagesBySeasonID(gomock.Any(), season.ID).Return(nil, nil)
+		}
+
+		DescribeTable("should say how the season was resolved",
+			func(start, end time.Time, resolution api.SeasonResolution) {
+				season := validSeasonFromDB
+				season.StartDate, season.EndDate = start, end
+
+				mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+				expectSeason(season)
+
+				current, err := svc.GetCurrent(context.Background(), validCompetitionID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(current.Season.ID).To(Equal(validSeasonID))
+				Expect(current.Resolution).To(Equal(resolution))
+			},
+			Entry("a season in progress", time.Now().AddDate(0, -1, 0), time.Now().AddDate(0, 1, 0), api.SeasonResolutionCurrent),
+			Entry("the next season to start", time.Now().AddDate(0, 1, 0), time.Now().AddDate(0, 4, 0), api.SeasonResolutionNext),
+			Entry("the last season to finish", time.Now().AddDate(0, -4, 0), time.Now().AddDate(0, -1, 0), api.SeasonResolutionPrevious),
+		)
+
+		It("should return a not found error when the competition has no seasons", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetCompetition(gomock.Any(), validCompetitionID).Return(db.Competition{ID: validCompetitionID}, nil)
+			mockQueries.EXPECT().GetCurrentSeason(gomock.Any(), gomock.Any()).Return(db.Season{}, sql.ErrNoRows)
+
+			_, err := svc.GetCurrent(context.Background(), validCompetitionID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("season"))
+		})
+	})
+
 	Describe("UpdateSeason", func() {
 		It("should update a season without errors", func() {
 			mockDB.EXPECT().BeginTx(
@@ -968,6 +1075,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -1004,6 +1112,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1031,6 +1140,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1066,6 +1176,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1105,6 +1216,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1152,6 +1264,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1206,11 +1319,12 @@ var _ = Describe("season", func() {
 				invalidUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
 			Expect(err).To(MatchError(
-				"unable to sync season stages: unable to update stage 66666666-6666-4666-8666-666666666666 for season aaaaaaaa-aaaa-4aaa-8aaa-aaaaaaaaaaaa",
+				"unable to sync season stages: invalid season: stage 66666666-6666-4666-8666-666666666666 does not belong to season aaaaaaaa-aaaa-4aaa-8aaa-aaaaaaaaaaaa",
 			))
 		})
 
@@ -1259,6 +1373,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1314,6 +1429,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1373,6 +1489,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1436,6 +1553,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1503,6 +1621,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1574,6 +1693,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 			Expect(season).To(Equal(validNilSeasonWithTeams))
 			Expect(err.Error()).To(Equal("unable to get season teams: a valid testing error"))
@@ -1652,6 +1772,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1735,6 +1856,7 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
@@ -1821,11 +1943,41 @@ var _ = Describe("season", func() {
 				validUpdateSeasonRequest,
 				validCompetitionID,
 				validSeasonID,
+				nil,
 			)
 
 			Expect(season).To(Equal(validNilSeasonWithTeams))
 			Expect(err.Error()).To(Equal("a valid testing error"))
 		})
+
+		It("should rollback with a precondition failure when the season has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().LockSeason(
+				gomock.Any(),
+				validSeasonID,
+			).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateSeason(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Update(context.Background(), validUpdateSeasonRequest, validCompetitionID, validSeasonID, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("season"))
+		})
 	})
 
 	Describe("DeleteSeason", func() {
@@ -2021,4 +2173,92 @@ var _ = Describe("season", func() {
 			Expect(err.Error()).To(Equal("a valid testing error"))
 		})
 	})
+
+	Describe("SetTeamHomeVenue", func() {
+		venueID := uuid.MustParse("5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01")
+
+		expectSeason := func(rows []db.GetSeasonTeamsRow) {
+			mockQueries.EXPECT().GetSeason(gomock.Any(), validSeasonID).Return(validSeasonFromDB, nil)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonFromDB.ID).Return(rows, nil)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID2).Return(validTeamFromDB2, nil)
+			mockQueries.EXPECT().GetStagesBySeasonID(gomock.Any(), validSeasonFromDB.ID).Return(validStagesFromDB, nil)
+		}
+
+		It("should override the team's home venue for the season", func() {
+			overridden := validSeasonTeamFromDB
+			overridden.HomeVenueID = uuid.NullUUID{UUID: venueID, Valid: true}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			expectSeason(validSeasonTeamsFromDB)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{ID: venueID}, nil)
+			mockQueries.EXPECT().UpdateSeasonTeamHomeVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.UpdateSeasonTeamHomeVenueParams) error {
+				Expect(params.SeasonID).To(Equal(validSeasonID))
+				Expect(params.TeamID).To(Equal(validTeamID))
+				Expect(params.HomeVenueID).To(Equal(uuid.NullUUID{UUID: venueID, Valid: true}))
+				return nil
+			})
+			expectSeason([]db.GetSeasonTeamsRow{overridden, validSeasonTeamFromDB2})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			season, err := svc.SetTeamHomeVenue(context.Background(), validSeasonID, validTeamID, uuid.NullUUID{UUID: venueID, Valid: true})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.HomeVenues).To(Equal(map[uuid.UUID]uuid.UUID{validTeamID: venueID}))
+		})
+
+		It("should clear the override without looking up a venue", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			expectSeason(validSeasonTeamsFromDB)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), gomock.Any()).Times(0)
+			mockQueries.EXPECT().UpdateSeasonTeamHomeVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.UpdateSeasonTeamHomeVenueParams) error {
+				Expect(params.HomeVenueID.Valid).To(BeFalse())
+				return nil
+			})
+			expectSeason(validSeasonTeamsFromDB)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			season, err := svc.SetTeamHomeVenue(context.Background(), validSeasonID, validTeamID, uuid.NullUUID{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(season.HomeVenues).To(BeEmpty())
+		})
+
+		It("should rollback with not found when the team is not in the season", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			expectSeason(validSeasonTeamsFromDB)
+			mockQueries.EXPECT().UpdateSeasonTeamHomeVenue(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.SetTeamHomeVenue(context.Background(), validSeasonID, validTeamID3, uuid.NullUUID{UUID: venueID, Valid: true})
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("team"))
+		})
+
+		It("should rollback with a validation error when the venue does not exist", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			expectSeason(validSeasonTeamsFromDB)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{}, sql.ErrNoRows)
+			mockQueries.EXPECT().UpdateSeasonTeamHomeVenue(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.SetTeamHomeVenue(context.Background(), validSeasonID, validTeamID, uuid.NullUUID{UUID: venueID, Valid: true})
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(ConsistOf(FieldError{Field: "venue_id", Rule: "exists", Message: "venue not found"}))
+		})
+	})
 })
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// SquadService defines the contract for managing the players a team names for a season.
+type SquadService interface {
+	GetAll(ctx context.Context, seasonID, teamID uuid.UUID) ([]SquadPlayer, error)
+	Set(ctx context.Context, req *api.SquadPlayerRequest, seasonID, teamID, playerID uuid.UUID) (SquadPlayer, bool, error)
+	Remove(ctx context.Context, seasonID, teamID, playerID uuid.UUID) error
+}
+
+// squadService is the concrete implementation backed by db_handler.DB.
+type squadService struct {
+	db db_handler.DB
+}
+
+// NewSquadService returns a new SquadService backed by db_handler.DB.
+func NewSquadService(db db_handler.DB) SquadService {
+	return &squadService{db: db}
+}
+
+// SquadPlayer is a player's place in a season squad together with the player.
+type SquadPlayer struct {
+	Membership db.SeasonTeamPlayer
+	Player     db.Player
+}
+
+func ToSquadPlayerResponse(s SquadPlayer) api.SquadPlayerResponse {
+	return api.ToSquadPlayerResponse(s.Membership, s.Player)
+}
+
+func (s *squadService) GetAll(ctx context.Context, seasonID, teamID uuid.UUID) ([]SquadPlayer, error) {
+	var squad []SquadPlayer
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		seasonTeamID, err := getSeasonTeamID(ctx, queries, seasonID, teamID)
+		if err != nil {
+			return err
+		}
+
+		squad, err = getSquad(ctx, queries, seasonTeamID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return squad, nil
+}
+
+func (s *squadService) Set(
+	ctx context.Context,
+	req *api.SquadPlayerRequest,
+	seasonID, teamID, playerID uuid.UUID,
+) (SquadPlayer, bool, error) {
+	var (
+		member  SquadPlayer
+		created bool
+	)
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		member, created, txErr = setSquadPlayer(ctx, queries, req, seasonID, teamID, playerID)
+		return txErr
+	})
+	if err != nil {
+		return SquadPlayer{}, false, err
+	}
+
+	return member, created, nil
+}
+
+func (s *squadService) Remove(ctx context.Context, seasonID, teamID, playerID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return removeSquadPlayer(ctx, queries, seasonID, teamID, playerID)
+	})
+}
+
+// getSeasonTeamID returns the season_teams row linking teamID to seasonID.
+func getSeasonTeamID(ctx context.Context, queries db_handler.Queries, seasonID, teamID uuid.UUID) (uuid.UUID, error) {
+	rows, err := queries.GetSeasonTeams(ctx, seasonID)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "unable to get season teams")
+	}
+
+	for _, row := range rows {
+		if row.TeamID == teamID {
+			return row.ID, nil
+		}
+	}
+
+	return uuid.Nil, NewNotFoundError("team", nil)
+}
+
+func getSquad(ctx context.Context, queries db_handler.Queries, seasonTeamID uuid.UUID) ([]SquadPlayer, error) {
+	members, err := queries.GetSeasonTeamPlayers(ctx, seasonTeamID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get squad")
+	}
+
+	squad := make([]SquadPlayer, 0, len(members))
+	for _, member := range members {
+		player, err := queries.GetPlayer(ctx, member.PlayerID)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get player")
+		}
+		squad = append(squad, SquadPlayer{Membership: member, Player: player})
+	}
+
+	return squad, nil
+}
+
+// setSquadPlayer names playerID in the squad, or updates their jersey number and
+// active dates if they are already in it. The player need not be registered with
+// the team, so a provincial player can be named in a Super Rugby squad. The returned
+// bool reports whether the player was newly added.
+func setSquadPlayer(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.SquadPlayerRequest,
+	seasonID, teamID, playerID uuid.UUID,
+) (SquadPlayer, bool, error) {
+	seasonTeamID, err := getSeasonTeamID(ctx, queries, seasonID, teamID)
+	if err != nil {
+		return SquadPlayer{}, false, err
+	}
+
+	player, err := queries.GetPlayer(ctx, playerID)
+	if err != nil {
+		return SquadPlayer{}, false, wrapDBError(err, "player", "unable to get player")
+	}
+
+	members, err := queries.GetSeasonTeamPlayers(ctx, seasonTeamID)
+	if err != nil {
+		return SquadPlayer{}, false, errors.Wrap(err, "unable to get squad")
+	}
+
+	activeFrom := toNullTime(req.ActiveFrom)
+	activeTo := toNullTime(req.ActiveTo)
+
+	var existing *db.SeasonTeamPlayer
+	for i, member := range members {
+		if member.PlayerID == playerID {
+			existing = &members[i]
+			continue
+		}
+		if member.JerseyNumber == req.JerseyNumber &&
+			activeRangesOverlap(member.ActiveFrom, member.ActiveTo, activeFrom, activeTo) {
+			return SquadPlayer{}, false, NewConflictError(
+				fmt.Sprintf("jersey number %d is already taken", req.JerseyNumber), nil)
+		}
+	}
+
+	now := time.Now()
+
+	if existing != nil {
+		params := db.UpdateSeasonTeamPlayerParams{
+			JerseyNumber: req.JerseyNumber,
+			ActiveFrom:   activeFrom,
+			ActiveTo:     activeTo,
+			UpdatedAt:    now,
+			ID:           existing.ID,
+		}
+		if err := queries.UpdateSeasonTeamPlayer(ctx, params); err != nil {
+			return SquadPlayer{}, false, errors.Wrap(err, "unable to update squad player")
+		}
+
+		member := *existing
+		member.JerseyNumber = params.JerseyNumber
+		member.ActiveFrom = params.ActiveFrom
+		member.ActiveTo = params.ActiveTo
+		member.UpdatedAt = now
+
+		return SquadPlayer{Membership: member, Player: player}, false, nil
+	}
+
+	params := db.CreateSeasonTeamPlayerParams{
+		ID:           uuid.New(),
+		SeasonTeamID: seasonTeamID,
+		PlayerID:     playerID,
+		JerseyNumber: req.JerseyNumber,
+		ActiveFrom:   activeFrom,
+		ActiveTo:     activeTo,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		DeletedAt:    sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+	if err := queries.CreateSeasonTeamPlayer(ctx, params); err != nil {
+		return SquadPlayer{}, false, wrapDBError(err, "squad player", "unable to add squad player")
+	}
+
+	member := db.SeasonTeamPlayer{
+		ID:           params.ID,
+		SeasonTeamID: params.SeasonTeamID,
+		PlayerID:     params.PlayerID,
+		JerseyNumber: params.JerseyNumber,
+		ActiveFrom:   params.ActiveFrom,
+		ActiveTo:     params.ActiveTo,
+		CreatedAt:    params.CreatedAt,
+		UpdatedAt:    params.UpdatedAt,
+		DeletedAt:    params.DeletedAt,
+	}
+
+	return SquadPlayer{Membership: member, Player: player}, true, nil
+}
+
+func removeSquadPlayer(
+	ctx context.Context,
+	queries db_handler.Queries,
+	seasonID, teamID, playerID uuid.UUID,
+) error {
+	seasonTeamID, err := getSeasonTeamID(ctx, queries, seasonID, teamID)
+	if err != nil {
+		return err
+	}
+
+	members, err := queries.GetSeasonTeamPlayers(ctx, seasonTeamID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get squad")
+	}
+
+	for _, member := range members {
+		if member.PlayerID != playerID {
+			continue
+		}
+
+		if err := queries.DeleteSeasonTeamPlayer(ctx, db.DeleteSeasonTeamPlayerParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:        member.ID,
+		}); err != nil {
+			return errors.Wrap(err, "unable to remove squad player")
+		}
+		return nil
+	}
+
+	return NewNotFoundError("squad player", nil)
+}
+
+// activeRangesOverlap reports whether two squad spells overlap. A missing bound is
+// open-ended, so a spell with neither date covers the whole season.
+func activeRangesOverlap(aFrom, aTo, bFrom, bTo sql.NullTime) bool {
+	if aTo.Valid && bFrom.Valid && aTo.Time.Before(bFrom.Time) {
+		return false
+	}
+	if bTo.Valid && aFrom.Valid && bTo.Time.Before(aFrom.Time) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("squad", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc SquadService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewSquadService(mockDB)
+	})
+
+	validSeasonID := uuid.MustParse("9300778f-cce0-4efe-af6c-e399d8170315")
+	validTeamID := uuid.MustParse("013952a5-87e1-4d26-a312-09b2aff54241")
+	validSeasonTeamID := uuid.MustParse("b4a2c9e1-3f5d-4e7a-8c6b-0d1e2f3a4b5c")
+	validPlayerID := uuid.MustParse("7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10")
+	otherPlayerID := uuid.MustParse("8d2a4b63-7f1c-4e9b-a3c5-2b0f6e4d8c21")
+
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	validSeasonTeams := []db.GetSeasonTeamsRow{
+		{ID: validSeasonTeamID, SeasonID: validSeasonID, TeamID: validTeamID},
+	}
+
+	validPlayerFromDB := db.Player{
+		ID:       validPlayerID,
+		TeamID:   validTeamID,
+		Name:     "Ardie Savea",
+		Position: db.PlayerPositionNumberEight,
+	}
+
+	Describe("GetSquad", func() {
+		It("should return each squad member with their player", func() {
+			member := db.SeasonTeamPlayer{ID: uuid.New(), SeasonTeamID: validSeasonTeamID, PlayerID: validPlayerID, JerseyNumber: 8}
+
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{member}, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+
+			squad, err := svc.GetAll(context.Background(), validSeasonID, validTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(squad).To(Equal([]SquadPlayer{{Membership: member, Player: validPlayerFromDB}}))
+		})
+
+		It("should return a not found error when the team is not in the season", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+
+			_, err := svc.GetAll(context.Background(), validSeasonID, uuid.New())
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("team"))
+		})
+	})
+
+	Describe("SetSquadPlayer", func() {
+		It("should add a player who is not yet in the squad", func() {
+			req := &api.SquadPlayerRequest{JerseyNumber: 8, ActiveFrom: &march}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return(nil, nil)
+			mockQueries.EXPECT().CreateSeasonTeamPlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateSeasonTeamPlayerParams) error {
+				Expect(params.SeasonTeamID).To(Equal(validSeasonTeamID))
+				Expect(params.PlayerID).To(Equal(validPlayerID))
+				Expect(params.JerseyNumber).To(Equal(int32(8)))
+				Expect(params.ActiveFrom).To(Equal(sql.NullTime{Time: march, Valid: true}))
+				Expect(params.ActiveTo.Valid).To(BeFalse())
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			member, created, err := svc.Set(context.Background(), req, validSeasonID, validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(member.Player).To(Equal(validPlayerFromDB))
+			Expect(member.Membership.JerseyNumber).To(Equal(int32(8)))
+		})
+
+		It("should update a player already in the squad", func() {
+			existing := db.SeasonTeamPlayer{ID: uuid.New(), SeasonTeamID: validSeasonTeamID, PlayerID: validPlayerID, JerseyNumber: 8}
+			req := &api.SquadPlayerRequest{JerseyNumber: 20}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{existing}, nil)
+			mockQueries.EXPECT().UpdateSeasonTeamPlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.UpdateSeasonTeamPlayerParams) error {
+				Expect(params.ID).To(Equal(existing.ID))
+				Expect(params.JerseyNumber).To(Equal(int32(20)))
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			member, created, err := svc.Set(context.Background(), req, validSeasonID, validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(member.Membership.ID).To(Equal(existing.ID))
+			Expect(member.Membership.JerseyNumber).To(Equal(int32(20)))
+		})
+
+		It("should rollback with a conflict when another player wears the number at the same time", func() {
+			other := db.SeasonTeamPlayer{ID: uuid.New(), PlayerID: otherPlayerID, JerseyNumber: 8, ActiveTo: sql.NullTime{Time: april, Valid: true}}
+			req := &api.SquadPlayerRequest{JerseyNumber: 8, ActiveFrom: &march}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{other}, nil)
+			mockQueries.EXPECT().CreateSeasonTeamPlayer(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, _, err := svc.Set(context.Background(), req, validSeasonID, validTeamID, validPlayerID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("jersey number 8 is already taken"))
+		})
+
+		It("should allow the number once the other player has left the squad", func() {
+			other := db.SeasonTeamPlayer{ID: uuid.New(), PlayerID: otherPlayerID, JerseyNumber: 8, ActiveTo: sql.NullTime{Time: march, Valid: true}}
+			req := &api.SquadPlayerRequest{JerseyNumber: 8, ActiveFrom: &april}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(validPlayerFromDB, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{other}, nil)
+			mockQueries.EXPECT().CreateSeasonTeamPlayer(gomock.Any(), gomock.Any()).Return(nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, created, err := svc.Set(context.Background(), req, validSeasonID, validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		It("should name a player registered with another team", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(db.Player{ID: validPlayerID, TeamID: uuid.New()}, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return(nil, nil)
+			mockQueries.EXPECT().CreateSeasonTeamPlayer(gomock.Any(), gomock.Any()).Return(nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, created, err := svc.Set(context.Background(), &api.SquadPlayerRequest{JerseyNumber: 8}, validSeasonID, validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		It("should rollback with a not found error when the player does not exist", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(db.Player{}, sql.ErrNoRows)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, _, err := svc.Set(context.Background(), &api.SquadPlayerRequest{JerseyNumber: 8}, validSeasonID, validTeamID, validPlayerID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("player"))
+		})
+	})
+
+	Describe("RemoveSquadPlayer", func() {
+		It("should soft delete the player's squad entry", func() {
+			member := db.SeasonTeamPlayer{ID: uuid.New(), PlayerID: validPlayerID, JerseyNumber: 8}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return([]db.SeasonTeamPlayer{member}, nil)
+			mockQueries.EXPECT().DeleteSeasonTeamPlayer(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteSeasonTeamPlayerParams) error {
+				Expect(params.ID).To(Equal(member.ID))
+				Expect(params.DeletedAt.Valid).To(BeTrue())
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			err := svc.Remove(context.Background(), validSeasonID, validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return a not found error when the player is not in the squad", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonTeams(gomock.Any(), validSeasonID).Return(validSeasonTeams, nil)
+			mockQueries.EXPECT().GetSeasonTeamPlayers(gomock.Any(), validSeasonTeamID).Return(nil, nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Remove(context.Background(), validSeasonID, validTeamID, validPlayerID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("squad player"))
+		})
+	})
+})
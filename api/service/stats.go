@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// StatsService defines the contract for player statistics. Stats are aggregated from
+// the lineups, replacements and events of finished games.
+type StatsService interface {
+	GetGameStats(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error)
+	GetPlayerCareer(ctx context.Context, teamID, playerID uuid.UUID) (PlayerCareer, error)
+	GetSeasonLeaderboard(ctx context.Context, seasonID uuid.UUID, stat string, limit int) ([]LeaderboardEntry, error)
+	GetCompetitionLeaderboard(ctx context.Context, competitionID uuid.UUID, stat string, limit int) ([]LeaderboardEntry, error)
+}
+
+// statsService is the concrete implementation backed by db_handler.DB.
+type statsService struct {
+	db db_handler.DB
+}
+
+// NewStatsService returns a new StatsService backed by db_handler.DB.
+func NewStatsService(db db_handler.DB) StatsService {
+	return &statsService{db: db}
+}
+
+// PlayerCareer is a player together with their stats for every season they have
+// played in, oldest first.
+type PlayerCareer struct {
+	Player  db.Player
+	Seasons []db.GetPlayerSeasonStatsRow
+}
+
+// LeaderboardEntry is a player's totals on a leaderboard. TeamID is only set on
+// season leaderboards, where a player plays for one team.
+type LeaderboardEntry struct {
+	PlayerID    uuid.UUID
+	PlayerName  string
+	TeamID      uuid.NullUUID
+	Appearances int32
+	Tries       int32
+	Points      int32
+}
+
+func ToPlayerCareerResponse(c PlayerCareer) api.PlayerCareerResponse {
+	resp := api.PlayerCareerResponse{
+		Player:  api.ToPlayerSummary(c.Player),
+		Seasons: make([]api.PlayerSeasonStatsResponse, 0, len(c.Seasons)),
+	}
+
+	for _, s := range c.Seasons {
+		season := api.ToPlayerSeasonStatsResponse(s)
+		resp.Totals.Add(season.PlayerStatsResponse)
+		resp.Seasons = append(resp.Seasons, season)
+	}
+
+	return resp
+}
+
+// ToLeaderboardResponse ranks entries, which are already ordered by stat. Players
+// level on the stat share a rank and the next player's rank skips past them.
+func ToLeaderboardResponse(stat string, entries []LeaderboardEntry) api.LeaderboardResponse {
+	resp := api.LeaderboardResponse{
+		Stat:    stat,
+		Entries: make([]api.LeaderboardEntryResponse, 0, len(entries)),
+	}
+
+	value := func(e LeaderboardEntry) int32 {
+		if stat == api.LeaderboardStatTries {
+			return e.Tries
+		}
+		return e.Points
+	}
+
+	rank := 0
+	for i, e := range entries {
+		if i == 0 || value(e) != value(entries[i-1]) {
+			rank = i + 1
+		}
+
+		var teamID *uuid.UUID
+		if e.TeamID.Valid {
+			id := e.TeamID.UUID
+			teamID = &id
+		}
+
+		resp.Entries = append(resp.Entries, api.LeaderboardEntryResponse{
+			Rank:        rank,
+			PlayerID:    e.PlayerID,
+			PlayerName:  e.PlayerName,
+			TeamID:      teamID,
+			Appearances: e.Appearances,
+			Tries:       e.Tries,
+			Points:      e.Points,
+		})
+	}
+
+	return resp
+}
+
+func (s *statsService) GetGameStats(ctx context.Context, gameID uuid.UUID) ([]db.PlayerGameStat, error) {
+	var stats []db.PlayerGameStat
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetGame(ctx, gameID); err != nil {
+			return wrapDBError(err, "game", "unable to get game")
+		}
+
+		var err error
+		stats, err = queries.GetGamePlayerStats(ctx, gameID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get game stats")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (s *statsService) GetPlayerCareer(ctx context.Context, teamID, playerID uuid.UUID) (PlayerCareer, error) {
+	var career PlayerCareer
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		player, err := getTeamPlayer(ctx, queries, teamID, playerID)
+		if err != nil {
+			return err
+		}
+
+		seasons, err := queries.GetPlayerSeasonStats(ctx, playerID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get player stats")
+		}
+
+		career = PlayerCareer{Player: player, Seasons: seasons}
+		return nil
+	})
+	if err != nil {
+		return PlayerCareer{}, err
+	}
+
+	return career, nil
+}
+
+func (s *statsService) GetSeasonLeaderboard(ctx context.Context, seasonID uuid.UUID, stat string, limit int) ([]LeaderboardEntry, error) {
+	var entries []LeaderboardEntry
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		rows, err := queries.GetSeasonLeaderboard(ctx, db.GetSeasonLeaderboardParams{
+			SeasonID:  seasonID,
+			Stat:      stat,
+			PageLimit: int32(limit),
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to get season leaderboard")
+		}
+
+		entries = make([]LeaderboardEntry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, LeaderboardEntry{
+				PlayerID:    row.PlayerID,
+				PlayerName:  row.PlayerName,
+				TeamID:      uuid.NullUUID{UUID: row.TeamID, Valid: true},
+				Appearances: row.Appearances,
+				Tries:       row.Tries,
+				Points:      row.Points,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (s *statsService) GetCompetitionLeaderboard(
+	ctx context.Context,
+	competitionID uuid.UUID,
+	stat string,
+	limit int,
+) ([]LeaderboardEntry, error) {
+	var entries []LeaderboardEntry
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetCompetition(ctx, competitionID); err != nil {
+			return wrapDBError(err, "competition", "unable to get competition")
+		}
+
+		rows, err := queries.GetCompetitionLeaderboard(ctx, db.GetCompetitionLeaderboardParams{
+			CompetitionID: competitionID,
+			Stat:          stat,
+			PageLimit:     int32(limit),
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to get competition leaderboard")
+		}
+
+		entries = make([]LeaderboardEntry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, LeaderboardEntry{
+				PlayerID:    row.PlayerID,
+				PlayerName:  row.PlayerName,
+				Appearances: row.Appearances,
+				Tries:       row.Tries,
+				Points:      row.Points,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("stats", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc StatsService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewStatsService(mockDB)
+	})
+
+	validCompetitionID := uuid.MustParse("44dd315c-1abc-43aa-9843-642f920190d1")
+	validSeasonID := uuid.MustParse("9300778f-cce0-4efe-af6c-e399d8170315")
+	validTeamID := uuid.MustParse("013952a5-87e1-4d26-a312-09b2aff54241")
+	validPlayerID := uuid.MustParse("7c1f3a52-6e0b-4d8a-b2f4-1a9e5d3c7b10")
+
+	Describe("GetPlayerCareer", func() {
+		It("should total the player's seasons across competitions and teams", func() {
+			npc := db.GetPlayerSeasonStatsRow{
+				SeasonID:      uuid.New(),
+				CompetitionID: uuid.New(),
+				TeamID:        validTeamID,
+				Appearances:   10,
+				Minutes:       760,
+				Tries:         4,
+				Points:        20,
+			}
+			superRugby := db.GetPlayerSeasonStatsRow{
+				SeasonID:      validSeasonID,
+				CompetitionID: validCompetitionID,
+				TeamID:        uuid.New(),
+				Appearances:   14,
+				Minutes:       1020,
+				Tries:         6,
+				Conversions:   1,
+				YellowCards:   1,
+				Points:        32,
+			}
+
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(db.Player{ID: validPlayerID, TeamID: validTeamID, Name: "Ardie Savea"}, nil)
+			mockQueries.EXPECT().GetPlayerSeasonStats(gomock.Any(), validPlayerID).Return([]db.GetPlayerSeasonStatsRow{npc, superRugby}, nil)
+
+			career, err := svc.GetPlayerCareer(context.Background(), validTeamID, validPlayerID)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := ToPlayerCareerResponse(career)
+			Expect(resp.Player.Name).To(Equal("Ardie Savea"))
+			Expect(resp.Seasons).To(HaveLen(2))
+			Expect(resp.Seasons[1].TeamID).To(Equal(superRugby.TeamID))
+			Expect(resp.Totals).To(Equal(api.PlayerStatsResponse{
+				Appearances: 24,
+				Minutes:     1780,
+				Tries:       10,
+				Conversions: 1,
+				YellowCards: 1,
+				Points:      52,
+			}))
+		})
+
+		It("should return a not found error for a player of another team", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetPlayer(gomock.Any(), validPlayerID).Return(db.Player{ID: validPlayerID, TeamID: uuid.New()}, nil)
+
+			_, err := svc.GetPlayerCareer(context.Background(), validTeamID, validPlayerID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("player"))
+		})
+	})
+
+	Describe("GetSeasonLeaderboard", func() {
+		It("should rank players and share ranks between players level on the stat", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetSeasonLeaderboard(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.GetSeasonLeaderboardParams) ([]db.GetSeasonLeaderboardRow, error) {
+				Expect(params.SeasonID).To(Equal(validSeasonID))
+				Expect(params.Stat).To(Equal(api.LeaderboardStatTries))
+				Expect(params.PageLimit).To(Equal(int32(3)))
+				return []db.GetSeasonLeaderboardRow{
+					{PlayerID: uuid.New(), PlayerName: "Will Jordan", TeamID: validTeamID, Tries: 9, Points: 45},
+					{PlayerID: uuid.New(), PlayerName: "Mark Telea", TeamID: validTeamID, Tries: 7, Points: 35},
+					{PlayerID: uuid.New(), PlayerName: "Leicester Fainga'anuku", TeamID: uuid.New(), Tries: 7, Points: 35},
+				}, nil
+			})
+
+			entries, err := svc.GetSeasonLeaderboard(context.Background(), validSeasonID, api.LeaderboardStatTries, 3)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := ToLeaderboardResponse(api.LeaderboardStatTries, entries)
+			Expect(resp.Entries).To(HaveLen(3))
+			Expect(resp.Entries[0].Rank).To(Equal(1))
+			Expect(resp.Entries[1].Rank).To(Equal(2))
+			Expect(resp.Entries[2].Rank).To(Equal(2))
+			Expect(*resp.Entries[0].TeamID).To(Equal(validTeamID))
+		})
+	})
+
+	Describe("GetCompetitionLeaderboard", func() {
+		It("should return a not found error for an unknown competition", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetCompetition(gomock.Any(), validCompetitionID).Return(db.Competition{}, sql.ErrNoRows)
+
+			_, err := svc.GetCompetitionLeaderboard(context.Background(), validCompetitionID, api.LeaderboardStatPoints, 10)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("competition"))
+		})
+	})
+})
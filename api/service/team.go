@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/bradley-adams/gainline/db/db"
@@ -15,10 +17,84 @@ import (
 // TeamService defines the contract for team-related operations.
 type TeamService interface {
 	Create(ctx context.Context, req *api.TeamRequest) (db.Team, error)
-	GetAll(ctx context.Context, limit, offset int) ([]db.Team, int64, error)
+	GetAll(ctx context.Context, filter TeamFilter, limit, offset int) ([]db.Team, int64, error)
 	Get(ctx context.Context, teamID uuid.UUID) (db.Team, error)
-	Update(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID) (db.Team, error)
+	Update(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID, version *time.Time) (db.Team, error)
 	Delete(ctx context.Context, teamID uuid.UUID) error
+	GetGames(ctx context.Context, teamID uuid.UUID, filter TeamGameFilter, limit, offset int) ([]db.Game, int64, error)
+	GetForm(ctx context.Context, teamID uuid.UUID, seasonID uuid.NullUUID, last int) (TeamForm, error)
+	GetHeadToHead(ctx context.Context, teamID, opponentID uuid.UUID) (HeadToHead, error)
+}
+
+// TeamFilter narrows the teams list. A blank search does not filter.
+type TeamFilter struct {
+	Search string
+}
+
+// TeamGameFilter narrows a team's game listing. Null or zero-valued fields are not applied.
+type TeamGameFilter struct {
+	SeasonID   uuid.NullUUID
+	Status     api.GameStatus
+	Descending bool
+}
+
+// TeamRecord tallies a team's results and points over a set of games.
+type TeamRecord struct {
+	Played        int
+	Won           int
+	Drawn         int
+	Lost          int
+	PointsFor     int32
+	PointsAgainst int32
+}
+
+func (r *TeamRecord) add(result TeamResult) {
+	r.Played++
+	r.PointsFor += result.PointsFor
+	r.PointsAgainst += result.PointsAgainst
+
+	switch result.Result {
+	case api.GameResultWin:
+		r.Won++
+	case api.GameResultDraw:
+		r.Drawn++
+	case api.GameResultLoss:
+		r.Lost++
+	}
+}
+
+// TeamResult is a finished game seen from one team's side.
+type TeamResult struct {
+	Game          db.Game
+	OpponentID    uuid.UUID
+	Home          bool
+	PointsFor     int32
+	PointsAgainst int32
+	Result        api.GameResult
+}
+
+// TeamForm summarises a team's finished games. Recent holds the latest results newest
+// first; the streak is the run of identical results ending with the latest game.
+type TeamForm struct {
+	TeamID       uuid.UUID
+	Recent       []TeamResult
+	StreakResult api.GameResult
+	StreakCount  int
+	Overall      TeamRecord
+	Home         TeamRecord
+	Away         TeamRecord
+}
+
+// HeadToHead summarises every finished meeting between two teams from Team's side.
+// Meetings are newest first; BiggestWin and BiggestLoss are nil when Team has never
+// won or lost the fixture.
+type HeadToHead struct {
+	Team        db.Team
+	Opponent    db.Team
+	Meetings    []TeamResult
+	Record      TeamRecord
+	BiggestWin  *TeamResult
+	BiggestLoss *TeamResult
 }
 
 // teamService is the concrete implementation backed by db_handler.DB.
@@ -34,7 +110,7 @@ func NewTeamService(db db_handler.DB) TeamService {
 func (s *teamService) Create(ctx context.Context, req *api.TeamRequest) (db.Team, error) {
 	var team db.Team
 
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var txErr error
 		team, txErr = createTeam(ctx, queries, req)
 		return txErr
@@ -48,6 +124,7 @@ func (s *teamService) Create(ctx context.Context, req *api.TeamRequest) (db.Team
 
 func (s *teamService) GetAll(
 	ctx context.Context,
+	filter TeamFilter,
 	limit, offset int,
 ) ([]db.Team, int64, error) {
 	var (
@@ -55,17 +132,20 @@ func (s *teamService) GetAll(
 		total int64
 	)
 
-	err := db_handler.Run(ctx, s.db, func(q db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
 		var err error
 
-		total, err = q.CountTeams(ctx)
+		pattern := filter.namePattern()
+
+		total, err = q.CountTeams(ctx, pattern)
 		if err != nil {
 			return errors.Wrap(err, "count teams")
 		}
 
 		teams, err = q.GetTeams(ctx, db.GetTeamsParams{
-			PageOffset: int32(offset),
-			PageLimit:  int32(limit),
+			NamePattern: pattern,
+			PageOffset:  int32(offset),
+			PageLimit:   int32(limit),
 		})
 		if err != nil {
 			return errors.Wrap(err, "get teams")
@@ -84,7 +164,7 @@ func (s *teamService) GetAll(
 func (s *teamService) Get(ctx context.Context, teamID uuid.UUID) (db.Team, error) {
 	var team db.Team
 
-	err := db_handler.Run(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		var err error
 		team, err = queries.GetTeam(ctx, teamID)
 		if err != nil {
@@ -93,16 +173,20 @@ func (s *teamService) Get(ctx context.Context, teamID uuid.UUID) (db.Team, error
 		return nil
 	})
 	if err != nil {
-		return db.Team{}, errors.Wrap(err, "unable to get team")
+		return db.Team{}, wrapDBError(err, "team", "unable to get team")
 	}
 
 	return team, nil
 }
 
-func (s *teamService) Update(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID) (db.Team, error) {
+func (s *teamService) Update(ctx context.Context, req *api.TeamRequest, teamID uuid.UUID, version *time.Time) (db.Team, error) {
 	var team db.Team
 
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := checkVersion(ctx, "team", teamID, version, queries.LockTeam); err != nil {
+			return err
+		}
+
 		var txErr error
 		team, txErr = updateTeam(ctx, queries, req, teamID)
 		return txErr
@@ -115,7 +199,7 @@ func (s *teamService) Update(ctx context.Context, req *api.TeamRequest, teamID u
 }
 
 func (s *teamService) Delete(ctx context.Context, teamID uuid.UUID) error {
-	err := db_handler.RunInTransaction(ctx, s.db, func(queries db_handler.Queries) error {
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
 		return deleteTeam(ctx, queries, teamID)
 	})
 	if err != nil {
@@ -124,24 +208,142 @@ func (s *teamService) Delete(ctx context.Context, teamID uuid.UUID) error {
 	return nil
 }
 
+func (s *teamService) GetGames(
+	ctx context.Context,
+	teamID uuid.UUID,
+	filter TeamGameFilter,
+	limit, offset int,
+) ([]db.Game, int64, error) {
+	var (
+		games []db.Game
+		total int64
+	)
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		games, total, err = getTeamGames(ctx, queries, teamID, filter, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return games, total, nil
+}
+
+func (s *teamService) GetForm(ctx context.Context, teamID uuid.UUID, seasonID uuid.NullUUID, last int) (TeamForm, error) {
+	var form TeamForm
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetTeam(ctx, teamID); err != nil {
+			return wrapDBError(err, "team", "unable to get team")
+		}
+
+		games, err := queries.GetTeamResults(ctx, db.GetTeamResultsParams{
+			TeamID:   teamID,
+			SeasonID: seasonID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to get team results")
+		}
+
+		form = buildTeamForm(teamID, games, last)
+		return nil
+	})
+	if err != nil {
+		return TeamForm{}, err
+	}
+
+	return form, nil
+}
+
+func (s *teamService) GetHeadToHead(ctx context.Context, teamID, opponentID uuid.UUID) (HeadToHead, error) {
+	if teamID == opponentID {
+		return HeadToHead{}, NewValidationError("invalid head-to-head", FieldError{
+			Field:   "opponent_id",
+			Rule:    "different_team",
+			Message: "opponent must be a different team",
+		})
+	}
+
+	var h2h HeadToHead
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		team, err := queries.GetTeam(ctx, teamID)
+		if err != nil {
+			return wrapDBError(err, "team", "unable to get team")
+		}
+
+		opponent, err := queries.GetTeam(ctx, opponentID)
+		if err != nil {
+			return wrapDBError(err, "team", "unable to get opponent")
+		}
+
+		games, err := queries.GetHeadToHeadGames(ctx, db.GetHeadToHeadGamesParams{
+			TeamID:     teamID,
+			OpponentID: opponentID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to get head-to-head games")
+		}
+
+		h2h = buildHeadToHead(team, opponent, games)
+		return nil
+	})
+	if err != nil {
+		return HeadToHead{}, err
+	}
+
+	return h2h, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// namePattern returns an ILIKE pattern matching names that contain the search text.
+func (f TeamFilter) namePattern() sql.NullString {
+	search := strings.TrimSpace(f.Search)
+	if search == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: "%" + likeEscaper.Replace(search) + "%", Valid: true}
+}
+
 func createTeam(
 	ctx context.Context,
 	queries db_handler.Queries,
 	req *api.TeamRequest,
 ) (db.Team, error) {
+	if err := ensureVenueExists(ctx, queries, toNullUUID(req.HomeVenueID), "invalid team", "home_venue_id"); err != nil {
+		return db.Team{}, err
+	}
+
+	profile, err := newTeamProfile(req)
+	if err != nil {
+		return db.Team{}, err
+	}
+
 	now := time.Now()
 	params := db.CreateTeamParams{
-		ID:           uuid.New(),
-		Name:         req.Name,
-		Abbreviation: req.Abbreviation,
-		Location:     req.Location,
-		CreatedAt:    now,
-		UpdatedAt:    now,
-		DeletedAt:    sql.NullTime{Time: time.Time{}, Valid: false},
+		ID:              uuid.New(),
+		Name:            req.Name,
+		Abbreviation:    req.Abbreviation,
+		Location:        req.Location,
+		HomeVenueID:     toNullUUID(req.HomeVenueID),
+		ShortName:       profile.ShortName,
+		LogoUrl:         profile.LogoUrl,
+		CrestUrl:        profile.CrestUrl,
+		PrimaryColour:   profile.PrimaryColour,
+		SecondaryColour: profile.SecondaryColour,
+		FoundedYear:     profile.FoundedYear,
+		SocialHandles:   profile.SocialHandles,
+		ExternalRefs:    profile.ExternalRefs,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		DeletedAt:       sql.NullTime{Time: time.Time{}, Valid: false},
 	}
 
 	if err := queries.CreateTeam(ctx, params); err != nil {
-		return db.Team{}, errors.Wrap(err, "unable to create new team")
+		return db.Team{}, wrapDBError(err, "team", "unable to create new team")
 	}
 
 	team, err := queries.GetTeam(ctx, params.ID)
@@ -158,27 +360,88 @@ func updateTeam(
 	req *api.TeamRequest,
 	teamID uuid.UUID,
 ) (db.Team, error) {
+	if err := ensureVenueExists(ctx, queries, toNullUUID(req.HomeVenueID), "invalid team", "home_venue_id"); err != nil {
+		return db.Team{}, err
+	}
+
+	profile, err := newTeamProfile(req)
+	if err != nil {
+		return db.Team{}, err
+	}
+
 	now := time.Now()
 	params := db.UpdateTeamParams{
-		Name:         req.Name,
-		Abbreviation: req.Abbreviation,
-		Location:     req.Location,
-		UpdatedAt:    now,
-		ID:           teamID,
+		Name:            req.Name,
+		Abbreviation:    req.Abbreviation,
+		Location:        req.Location,
+		HomeVenueID:     toNullUUID(req.HomeVenueID),
+		ShortName:       profile.ShortName,
+		LogoUrl:         profile.LogoUrl,
+		CrestUrl:        profile.CrestUrl,
+		PrimaryColour:   profile.PrimaryColour,
+		SecondaryColour: profile.SecondaryColour,
+		FoundedYear:     profile.FoundedYear,
+		SocialHandles:   profile.SocialHandles,
+		ExternalRefs:    profile.ExternalRefs,
+		UpdatedAt:       now,
+		ID:              teamID,
 	}
 
 	if err := queries.UpdateTeam(ctx, params); err != nil {
-		return db.Team{}, errors.Wrap(err, "unable to update team")
+		return db.Team{}, wrapDBError(err, "team", "unable to update team")
 	}
 
 	updatedTeam, err := queries.GetTeam(ctx, teamID)
 	if err != nil {
-		return db.Team{}, errors.Wrap(err, "unable to get updated team")
+		return db.Team{}, wrapDBError(err, "team", "unable to get updated team")
 	}
 
 	return updatedTeam, nil
 }
 
+// teamProfile holds the branding and profile columns of a team as they are stored.
+type teamProfile struct {
+	ShortName       sql.NullString
+	LogoUrl         sql.NullString
+	CrestUrl        sql.NullString
+	PrimaryColour   sql.NullString
+	SecondaryColour sql.NullString
+	FoundedYear     sql.NullInt32
+	SocialHandles   json.RawMessage
+	ExternalRefs    json.RawMessage
+}
+
+func newTeamProfile(req *api.TeamRequest) (teamProfile, error) {
+	socialHandles, err := toJSONObject(req.SocialHandles)
+	if err != nil {
+		return teamProfile{}, errors.Wrap(err, "unable to encode social handles")
+	}
+
+	externalRefs, err := toJSONObject(req.ExternalRefs)
+	if err != nil {
+		return teamProfile{}, errors.Wrap(err, "unable to encode external refs")
+	}
+
+	return teamProfile{
+		ShortName:       toNullString(req.ShortName),
+		LogoUrl:         toNullString(req.LogoURL),
+		CrestUrl:        toNullString(req.CrestURL),
+		PrimaryColour:   toNullString(req.PrimaryColour),
+		SecondaryColour: toNullString(req.SecondaryColour),
+		FoundedYear:     toNullInt32(req.FoundedYear),
+		SocialHandles:   socialHandles,
+		ExternalRefs:    externalRefs,
+	}, nil
+}
+
+// toJSONObject encodes m for a JSON object column, storing a nil map as {}.
+func toJSONObject[K ~string](m map[K]string) (json.RawMessage, error) {
+	if m == nil {
+		return json.RawMessage("{}"), nil
+	}
+	return json.Marshal(m)
+}
+
 func deleteTeam(
 	ctx context.Context,
 	queries db_handler.Queries,
@@ -195,3 +458,142 @@ func deleteTeam(
 
 	return nil
 }
+
+func getTeamGames(
+	ctx context.Context,
+	queries db_handler.Queries,
+	teamID uuid.UUID,
+	filter TeamGameFilter,
+	limit int,
+	offset int,
+) ([]db.Game, int64, error) {
+	if _, err := queries.GetTeam(ctx, teamID); err != nil {
+		return nil, 0, wrapDBError(err, "team", "unable to get team")
+	}
+
+	status := db.NullGameStatus{GameStatus: db.GameStatus(filter.Status), Valid: filter.Status != ""}
+
+	games, err := queries.GetGamesByTeamID(ctx, db.GetGamesByTeamIDParams{
+		TeamID:     teamID,
+		SeasonID:   filter.SeasonID,
+		Status:     status,
+		SortDesc:   filter.Descending,
+		PageLimit:  int32(limit),
+		PageOffset: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to get team games")
+	}
+
+	total, err := queries.CountGamesByTeamID(ctx, db.CountGamesByTeamIDParams{
+		TeamID:   teamID,
+		SeasonID: filter.SeasonID,
+		Status:   status,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "unable to count team games")
+	}
+
+	return games, total, nil
+}
+
+// buildTeamForm summarises games, which must be finished, scored and ordered newest first.
+func buildTeamForm(teamID uuid.UUID, games []db.Game, last int) TeamForm {
+	form := TeamForm{
+		TeamID: teamID,
+		Recent: make([]TeamResult, 0, min(last, len(games))),
+	}
+
+	streakOpen := true
+	for i, g := range games {
+		result := newTeamResult(teamID, g)
+
+		if i < last {
+			form.Recent = append(form.Recent, result)
+		}
+
+		if streakOpen {
+			switch form.StreakResult {
+			case "", result.Result:
+				form.StreakResult = result.Result
+				form.StreakCount++
+			default:
+				streakOpen = false
+			}
+		}
+
+		form.Overall.add(result)
+		if result.Home {
+			form.Home.add(result)
+		} else {
+			form.Away.add(result)
+		}
+	}
+
+	return form
+}
+
+// buildHeadToHead summarises games, which must be finished, scored and ordered newest first.
+// When two meetings share the biggest margin the most recent is kept.
+func buildHeadToHead(team, opponent db.Team, games []db.Game) HeadToHead {
+	h2h := HeadToHead{
+		Team:     team,
+		Opponent: opponent,
+		Meetings: make([]TeamResult, 0, len(games)),
+	}
+
+	for _, g := range games {
+		h2h.Meetings = append(h2h.Meetings, newTeamResult(team.ID, g))
+	}
+
+	for i := range h2h.Meetings {
+		result := &h2h.Meetings[i]
+		h2h.Record.add(*result)
+
+		switch result.Result {
+		case api.GameResultWin:
+			if h2h.BiggestWin == nil || result.margin() > h2h.BiggestWin.margin() {
+				h2h.BiggestWin = result
+			}
+		case api.GameResultLoss:
+			if h2h.BiggestLoss == nil || result.margin() > h2h.BiggestLoss.margin() {
+				h2h.BiggestLoss = result
+			}
+		}
+	}
+
+	return h2h
+}
+
+func newTeamResult(teamID uuid.UUID, g db.Game) TeamResult {
+	result := TeamResult{
+		Game:          g,
+		OpponentID:    g.AwayTeamID,
+		Home:          g.HomeTeamID == teamID,
+		PointsFor:     g.HomeScore.Int32,
+		PointsAgainst: g.AwayScore.Int32,
+	}
+	if !result.Home {
+		result.OpponentID = g.HomeTeamID
+		result.PointsFor, result.PointsAgainst = result.PointsAgainst, result.PointsFor
+	}
+
+	switch {
+	case result.PointsFor > result.PointsAgainst:
+		result.Result = api.GameResultWin
+	case result.PointsFor < result.PointsAgainst:
+		result.Result = api.GameResultLoss
+	default:
+		result.Result = api.GameResultDraw
+	}
+
+	return result
+}
+
+// margin is the absolute points difference of the game.
+func (r TeamResult) margin() int32 {
+	if r.PointsFor > r.PointsAgainst {
+		return r.PointsFor - r.PointsAgainst
+	}
+	return r.PointsAgainst - r.PointsFor
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// TeamAliasService defines the contract for the names a team has played under. A team
+// keeps its ID through renames; the alias covering a game's date names it in that game.
+type TeamAliasService interface {
+	GetAll(ctx context.Context, teamID uuid.UUID) ([]db.TeamAlias, error)
+	Create(ctx context.Context, req *api.TeamAliasRequest, teamID uuid.UUID) (db.TeamAlias, error)
+	Update(ctx context.Context, req *api.TeamAliasRequest, teamID, aliasID uuid.UUID) (db.TeamAlias, error)
+	Delete(ctx context.Context, teamID, aliasID uuid.UUID) error
+}
+
+// teamAliasService is the concrete implementation backed by db_handler.DB.
+type teamAliasService struct {
+	db db_handler.DB
+}
+
+// NewTeamAliasService returns a new TeamAliasService backed by db_handler.DB.
+func NewTeamAliasService(db db_handler.DB) TeamAliasService {
+	return &teamAliasService{db: db}
+}
+
+func (s *teamAliasService) GetAll(ctx context.Context, teamID uuid.UUID) ([]db.TeamAlias, error) {
+	var aliases []db.TeamAlias
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := queries.GetTeam(ctx, teamID); err != nil {
+			return wrapDBError(err, "team", "unable to get team")
+		}
+
+		var err error
+		aliases, err = queries.GetTeamAliasesByTeamID(ctx, teamID)
+		if err != nil {
+			return errors.Wrap(err, "unable to get team aliases")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+func (s *teamAliasService) Create(ctx context.Context, req *api.TeamAliasRequest, teamID uuid.UUID) (db.TeamAlias, error) {
+	var alias db.TeamAlias
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		alias, txErr = createTeamAlias(ctx, queries, req, teamID)
+		return txErr
+	})
+	if err != nil {
+		return db.TeamAlias{}, err
+	}
+
+	return alias, nil
+}
+
+func (s *teamAliasService) Update(ctx context.Context, req *api.TeamAliasRequest, teamID, aliasID uuid.UUID) (db.TeamAlias, error) {
+	var alias db.TeamAlias
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		alias, txErr = updateTeamAlias(ctx, queries, req, teamID, aliasID)
+		return txErr
+	})
+	if err != nil {
+		return db.TeamAlias{}, err
+	}
+
+	return alias, nil
+}
+
+func (s *teamAliasService) Delete(ctx context.Context, teamID, aliasID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if _, err := getTeamAlias(ctx, queries, teamID, aliasID); err != nil {
+			return err
+		}
+
+		if err := queries.DeleteTeamAlias(ctx, db.DeleteTeamAliasParams{
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:        aliasID,
+		}); err != nil {
+			return errors.Wrap(err, "unable to delete team alias")
+		}
+		return nil
+	})
+}
+
+// getTeamAlias returns an alias of teamID. Aliases of other teams are not found.
+func getTeamAlias(ctx context.Context, queries db_handler.Queries, teamID, aliasID uuid.UUID) (db.TeamAlias, error) {
+	alias, err := queries.GetTeamAlias(ctx, aliasID)
+	if err != nil {
+		return db.TeamAlias{}, wrapDBError(err, "team alias", "unable to get team alias")
+	}
+
+	if alias.TeamID != teamID {
+		return db.TeamAlias{}, NewNotFoundError("team alias", nil)
+	}
+
+	return alias, nil
+}
+
+func createTeamAlias(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.TeamAliasRequest,
+	teamID uuid.UUID,
+) (db.TeamAlias, error) {
+	if _, err := queries.GetTeam(ctx, teamID); err != nil {
+		return db.TeamAlias{}, wrapDBError(err, "team", "unable to get team")
+	}
+
+	if err := checkTeamAliasOverlap(ctx, queries, req, teamID, uuid.Nil); err != nil {
+		return db.TeamAlias{}, err
+	}
+
+	now := time.Now()
+	params := db.CreateTeamAliasParams{
+		ID:           uuid.New(),
+		TeamID:       teamID,
+		Name:         req.Name,
+		Abbreviation: req.Abbreviation,
+		LogoUrl:      toNullString(req.LogoURL),
+		ValidFrom:    req.ValidFrom,
+		ValidTo:      toNullTime(req.ValidTo),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		DeletedAt:    sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+
+	if err := queries.CreateTeamAlias(ctx, params); err != nil {
+		return db.TeamAlias{}, wrapDBError(err, "team alias", "unable to create new team alias")
+	}
+
+	alias, err := queries.GetTeamAlias(ctx, params.ID)
+	if err != nil {
+		return db.TeamAlias{}, errors.Wrap(err, "unable to get new team alias")
+	}
+
+	return alias, nil
+}
+
+func updateTeamAlias(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.TeamAliasRequest,
+	teamID, aliasID uuid.UUID,
+) (db.TeamAlias, error) {
+	if _, err := getTeamAlias(ctx, queries, teamID, aliasID); err != nil {
+		return db.TeamAlias{}, err
+	}
+
+	if err := checkTeamAliasOverlap(ctx, queries, req, teamID, aliasID); err != nil {
+		return db.TeamAlias{}, err
+	}
+
+	params := db.UpdateTeamAliasParams{
+		Name:         req.Name,
+		Abbreviation: req.Abbreviation,
+		LogoUrl:      toNullString(req.LogoURL),
+		ValidFrom:    req.ValidFrom,
+		ValidTo:      toNullTime(req.ValidTo),
+		UpdatedAt:    time.Now(),
+		ID:           aliasID,
+	}
+
+	if err := queries.UpdateTeamAlias(ctx, params); err != nil {
+		return db.TeamAlias{}, wrapDBError(err, "team alias", "unable to update team alias")
+	}
+
+	alias, err := queries.GetTeamAlias(ctx, aliasID)
+	if err != nil {
+		return db.TeamAlias{}, wrapDBError(err, "team alias", "unable to get updated team alias")
+	}
+
+	return alias, nil
+}
+
+// checkTeamAliasOverlap rejects an alias whose dates overlap another alias of the team,
+// so at most one alias names the team on any date. aliasID is the alias being updated,
+// which is ignored.
+func checkTeamAliasOverlap(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.TeamAliasRequest,
+	teamID, aliasID uuid.UUID,
+) error {
+	aliases, err := queries.GetTeamAliasesByTeamID(ctx, teamID)
+	if err != nil {
+		return errors.Wrap(err, "unable to get team aliases")
+	}
+
+	validFrom := sql.NullTime{Time: req.ValidFrom, Valid: true}
+	validTo := toNullTime(req.ValidTo)
+
+	for _, alias := range aliases {
+		if alias.ID == aliasID {
+			continue
+		}
+		existingFrom := sql.NullTime{Time: alias.ValidFrom, Valid: true}
+		if activeRangesOverlap(existingFrom, alias.ValidTo, validFrom, validTo) {
+			return NewConflictError("dates overlap the team alias "+alias.Name, nil)
+		}
+	}
+
+	return nil
+}
+
+// nameTeamsAt renames teams to the alias each was using on date, along with its logo
+// when it has one. Teams without an alias covering date keep their current name.
+func nameTeamsAt(ctx context.Context, queries db_handler.Queries, teams []db.Team, date time.Time) error {
+	if len(teams) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(teams))
+	for _, t := range teams {
+		ids = append(ids, t.ID)
+	}
+
+	aliases, err := queries.GetTeamAliasesAt(ctx, db.GetTeamAliasesAtParams{
+		TeamIds: ids,
+		At:      date,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get team aliases")
+	}
+
+	byTeam := make(map[uuid.UUID]db.TeamAlias, len(aliases))
+	for _, alias := range aliases {
+		byTeam[alias.TeamID] = alias
+	}
+
+	for i := range teams {
+		if alias, ok := byTeam[teams[i].ID]; ok {
+			teams[i].Name = alias.Name
+			teams[i].Abbreviation = alias.Abbreviation
+			if alias.LogoUrl.Valid {
+				teams[i].LogoUrl = alias.LogoUrl
+			}
+		}
+	}
+
+	return nil
+}
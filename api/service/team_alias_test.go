@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("team alias", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc TeamAliasService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewTeamAliasService(mockDB)
+	})
+
+	validTeamID := uuid.MustParse("b5c6e9d7-8f11-4ef2-acc6-2e5a97839532")
+	validAliasID := uuid.MustParse("2c8f1e4a-7b3d-4a6e-9f5c-1d2e3f4a5b6c")
+
+	validTimeNow := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	from2010 := time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to2015 := time.Date(2015, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	validTeamFromDB := db.Team{
+		ID:           validTeamID,
+		Name:         "Bay of Plenty",
+		Abbreviation: "BOP",
+		Location:     "Tauranga",
+	}
+
+	validAliasFromDB := db.TeamAlias{
+		ID:           validAliasID,
+		TeamID:       validTeamID,
+		Name:         "Bay of Plenty Steamers",
+		Abbreviation: "BOPS",
+		ValidFrom:    from2010,
+		ValidTo:      sql.NullTime{Time: to2015, Valid: true},
+		CreatedAt:    validTimeNow,
+		UpdatedAt:    validTimeNow,
+	}
+
+	Describe("Create", func() {
+		It("should create a new team alias", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().GetTeamAliasesByTeamID(gomock.Any(), validTeamID).Return(nil, nil)
+			mockQueries.EXPECT().CreateTeamAlias(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateTeamAliasParams) error {
+				Expect(params.TeamID).To(Equal(validTeamID))
+				Expect(params.Name).To(Equal("Bay of Plenty Steamers"))
+				Expect(params.ValidFrom).To(Equal(from2010))
+				Expect(params.ValidTo).To(Equal(sql.NullTime{Time: to2015, Valid: true}))
+				Expect(params.LogoUrl.Valid).To(BeFalse())
+				return nil
+			})
+			mockQueries.EXPECT().GetTeamAlias(gomock.Any(), gomock.Any()).Return(validAliasFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			alias, err := svc.Create(context.Background(), &api.TeamAliasRequest{
+				Name:         "Bay of Plenty Steamers",
+				Abbreviation: "BOPS",
+				ValidFrom:    from2010,
+				ValidTo:      &to2015,
+			}, validTeamID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(alias).To(Equal(validAliasFromDB))
+		})
+
+		It("should reject dates that overlap another alias", func() {
+			from2015 := time.Date(2015, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetTeamAliasesByTeamID(gomock.Any(), validTeamID).
+				Return([]db.TeamAlias{validAliasFromDB}, nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &api.TeamAliasRequest{
+				Name:         "Steamers",
+				Abbreviation: "STM",
+				ValidFrom:    from2015,
+			}, validTeamID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(err).To(MatchError("dates overlap the team alias Bay of Plenty Steamers"))
+		})
+
+		It("should return not found when the team does not exist", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeam(gomock.Any(), validTeamID).Return(db.Team{}, sql.ErrNoRows)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &api.TeamAliasRequest{
+				Name:         "Steamers",
+				Abbreviation: "STM",
+				ValidFrom:    from2010,
+			}, validTeamID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+	})
+
+	Describe("Update", func() {
+		It("should not count the alias being updated as an overlap", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeamAlias(gomock.Any(), validAliasID).Return(validAliasFromDB, nil).Times(2)
+			mockQueries.EXPECT().
+				GetTeamAliasesByTeamID(gomock.Any(), validTeamID).
+				Return([]db.TeamAlias{validAliasFromDB}, nil)
+			mockQueries.EXPECT().
+				UpdateTeamAlias(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, params db.UpdateTeamAliasParams) error {
+					Expect(params.ID).To(Equal(validAliasID))
+					Expect(params.ValidTo.Valid).To(BeFalse())
+					return nil
+				})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Update(context.Background(), &api.TeamAliasRequest{
+				Name:         "Bay of Plenty Steamers",
+				Abbreviation: "BOPS",
+				ValidFrom:    from2010,
+			}, validTeamID, validAliasID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should return not found for an alias of another team", func() {
+			otherTeamID := uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d")
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetTeamAlias(gomock.Any(), validAliasID).Return(validAliasFromDB, nil)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Delete(context.Background(), otherTeamID, validAliasID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(err).To(MatchError("team alias not found"))
+		})
+	})
+
+	Describe("nameTeamsAt", func() {
+		It("should rename only the teams with an alias on the date", func() {
+			otherTeam := db.Team{ID: uuid.New(), Name: "Waikato", Abbreviation: "WAI"}
+			teams := []db.Team{validTeamFromDB, otherTeam}
+			at := time.Date(2012, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+			mockQueries.EXPECT().
+				GetTeamAliasesAt(gomock.Any(), db.GetTeamAliasesAtParams{
+					TeamIds: []uuid.UUID{validTeamID, otherTeam.ID},
+					At:      at,
+				}).
+				Return([]db.TeamAlias{validAliasFromDB}, nil)
+
+			err := nameTeamsAt(context.Background(), mockQueries, teams, at)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(teams[0].Name).To(Equal("Bay of Plenty Steamers"))
+			Expect(teams[0].Abbreviation).To(Equal("BOPS"))
+			Expect(teams[0].LogoUrl.Valid).To(BeFalse())
+			Expect(teams[1]).To(Equal(otherTeam))
+		})
+
+		It("should use the alias logo when it has one", func() {
+			team := validTeamFromDB
+			team.LogoUrl = sql.NullString{String: "https://example.com/bop.png", Valid: true}
+			alias := validAliasFromDB
+			alias.LogoUrl = sql.NullString{String: "https://example.com/steamers.png", Valid: true}
+			teams := []db.Team{team}
+
+			mockQueries.EXPECT().GetTeamAliasesAt(gomock.Any(), gomock.Any()).Return([]db.TeamAlias{alias}, nil)
+
+			err := nameTeamsAt(context.Background(), mockQueries, teams, from2010)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(teams[0].LogoUrl).To(Equal(alias.LogoUrl))
+		})
+
+		It("should not query without teams", func() {
+			err := nameTeamsAt(context.Background(), mockQueries, nil, time.Now())
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
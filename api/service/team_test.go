@@ -119,6 +119,78 @@ var _ = Describe("team", func() {
 			Expect(team.DeletedAt.Time).To(Equal(validTeamResponse.DeletedAt.Time))
 		})
 
+		It("should save a home venue that exists", func() {
+			venueID := uuid.New()
+			req := *validTeamRequest
+			req.HomeVenueID = &venueID
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{ID: venueID}, nil)
+			mockQueries.EXPECT().CreateTeam(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateTeamParams) error {
+				Expect(params.HomeVenueID).To(Equal(uuid.NullUUID{UUID: venueID, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().GetTeam(gomock.Any(), gomock.Any()).Return(validTeamFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &req)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should save branding and store missing maps as empty objects", func() {
+			founded := int32(1996)
+			req := *validTeamRequest
+			req.ShortName = "Chiefs"
+			req.PrimaryColour = "#FFD700"
+			req.FoundedYear = &founded
+			req.SocialHandles = map[api.SocialPlatform]string{api.SocialPlatformX: "ChiefsRugby"}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateTeam(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateTeamParams) error {
+				Expect(params.ShortName).To(Equal(sql.NullString{String: "Chiefs", Valid: true}))
+				Expect(params.PrimaryColour).To(Equal(sql.NullString{String: "#FFD700", Valid: true}))
+				Expect(params.SecondaryColour.Valid).To(BeFalse())
+				Expect(params.LogoUrl.Valid).To(BeFalse())
+				Expect(params.FoundedYear).To(Equal(sql.NullInt32{Int32: 1996, Valid: true}))
+				Expect(params.SocialHandles).To(MatchJSON(`{"x":"ChiefsRugby"}`))
+				Expect(params.ExternalRefs).To(MatchJSON(`{}`))
+				return nil
+			})
+			mockQueries.EXPECT().GetTeam(gomock.Any(), gomock.Any()).Return(validTeamFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &req)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a validation error when the home venue does not exist", func() {
+			venueID := uuid.New()
+			req := *validTeamRequest
+			req.HomeVenueID = &venueID
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), venueID).Return(db.Venue{}, sql.ErrNoRows)
+			mockQueries.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), &req)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields).To(ConsistOf(FieldError{Field: "home_venue_id", Rule: "exists", Message: "venue not found"}))
+		})
+
 		It("should return formatted error if transaction begin fails", func() {
 			mockDB.EXPECT().BeginTx(
 				gomock.Any(),
@@ -222,7 +294,7 @@ var _ = Describe("team", func() {
 				Return(mockQueries)
 
 			mockQueries.EXPECT().
-				CountTeams(gomock.Any()).
+				CountTeams(gomock.Any(), sql.NullString{}).
 				Return(int64(len(validTeamsFromDB)), nil)
 
 			mockQueries.EXPECT().
@@ -235,7 +307,7 @@ var _ = Describe("team", func() {
 				).
 				Return(validTeamsFromDB, nil)
 
-			teams, total, err := svc.GetAll(context.Background(), limit, offset)
+			teams, total, err := svc.GetAll(context.Background(), TeamFilter{}, limit, offset)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(total).To(Equal(int64(len(validTeamsFromDB))))
@@ -249,6 +321,33 @@ var _ = Describe("team", func() {
 			Expect(teams[0].DeletedAt.Time).To(Equal(validTeamsResponse[0].DeletedAt.Time))
 		})
 
+		It("should match the search anywhere in a name, escaping wildcards", func() {
+			pattern := sql.NullString{String: `%100\% Steamers%`, Valid: true}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+
+			mockQueries.EXPECT().
+				CountTeams(gomock.Any(), pattern).
+				Return(int64(0), nil)
+
+			mockQueries.EXPECT().
+				GetTeams(
+					gomock.Any(),
+					db.GetTeamsParams{
+						NamePattern: pattern,
+						PageOffset:  int32(0),
+						PageLimit:   int32(10),
+					},
+				).
+				Return(nil, nil)
+
+			_, _, err := svc.GetAll(context.Background(), TeamFilter{Search: "  100% Steamers "}, 10, 0)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("should return error when count fails", func() {
 			limit := 10
 			offset := 0
@@ -258,10 +357,10 @@ var _ = Describe("team", func() {
 				Return(mockQueries)
 
 			mockQueries.EXPECT().
-				CountTeams(gomock.Any()).
+				CountTeams(gomock.Any(), sql.NullString{}).
 				Return(int64(0), validTestError)
 
-			teams, total, err := svc.GetAll(context.Background(), limit, offset)
+			teams, total, err := svc.GetAll(context.Background(), TeamFilter{}, limit, offset)
 
 			Expect(teams).To(BeNil())
 			Expect(total).To(Equal(int64(0)))
@@ -277,7 +376,7 @@ var _ = Describe("team", func() {
 				Return(mockQueries)
 
 			mockQueries.EXPECT().
-				CountTeams(gomock.Any()).
+				CountTeams(gomock.Any(), sql.NullString{}).
 				Return(int64(2), nil)
 
 			mockQueries.EXPECT().
@@ -287,7 +386,7 @@ var _ = Describe("team", func() {
 				).
 				Return(nil, validTestError)
 
-			teams, total, err := svc.GetAll(context.Background(), limit, offset)
+			teams, total, err := svc.GetAll(context.Background(), TeamFilter{}, limit, offset)
 
 			Expect(teams).To(BeNil())
 			Expect(total).To(Equal(int64(0)))
@@ -357,7 +456,7 @@ var _ = Describe("team", func() {
 				gomock.Any(),
 			).Times(0)
 
-			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID)
+			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(team.ID).To(Equal(validUpdatedTeamResponse.ID))
@@ -378,7 +477,7 @@ var _ = Describe("team", func() {
 				gomock.Any(),
 			).Times(0)
 
-			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID)
+			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID, nil)
 
 			Expect(team).To(Equal(validNilTeam))
 			Expect(err.Error()).To(Equal(validTestError.Error()))
@@ -400,7 +499,7 @@ var _ = Describe("team", func() {
 				gomock.Any(),
 			).AnyTimes()
 
-			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID)
+			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID, nil)
 
 			Expect(team).To(Equal(validNilTeam))
 			Expect(err.Error()).To(Equal("unable to update team: a valid testing error"))
@@ -426,7 +525,7 @@ var _ = Describe("team", func() {
 				gomock.Any(),
 			).AnyTimes()
 
-			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID)
+			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID, nil)
 
 			Expect(team).To(Equal(validNilTeam))
 			Expect(err.Error()).To(Equal("unable to get updated team: a valid testing error"))
@@ -455,11 +554,40 @@ var _ = Describe("team", func() {
 				gomock.Any(),
 			).Times(0)
 
-			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID)
+			team, err := svc.Update(context.Background(), validTeamRequest, validTeamID, nil)
 
 			Expect(team).To(Equal(validNilTeam))
 			Expect(err.Error()).To(Equal(validTestError.Error()))
 		})
+
+		It("should rollback with a precondition failure when the team has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(
+				gomock.Any(),
+				gomock.Any(),
+			)
+			mockDB.EXPECT().New(
+				gomock.Any(),
+			).Return(mockQueries)
+			mockQueries.EXPECT().LockTeam(
+				gomock.Any(),
+				validTeamID,
+			).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateTeam(
+				gomock.Any(),
+				gomock.Any(),
+			).Times(0)
+			mockDB.EXPECT().Rollback(
+				gomock.Any(),
+			)
+
+			_, err := svc.Update(context.Background(), validTeamRequest, validTeamID, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("team"))
+		})
 	})
 
 	Describe("DeleteTeam", func() {
@@ -545,4 +673,251 @@ var _ = Describe("team", func() {
 			Expect(err.Error()).To(Equal(validTestError.Error()))
 		})
 	})
+
+	Describe("GetGames", func() {
+		It("should pass filters to the paged and count queries", func() {
+			seasonID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+			games := []db.Game{{ID: uuid.New(), HomeTeamID: validTeamID}}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetGamesByTeamID(
+					gomock.Any(),
+					db.GetGamesByTeamIDParams{
+						TeamID:     validTeamID,
+						SeasonID:   seasonID,
+						Status:     db.NullGameStatus{GameStatus: db.GameStatusFinished, Valid: true},
+						SortDesc:   true,
+						PageLimit:  10,
+						PageOffset: 20,
+					},
+				).
+				Return(games, nil)
+			mockQueries.EXPECT().
+				CountGamesByTeamID(
+					gomock.Any(),
+					db.CountGamesByTeamIDParams{
+						TeamID:   validTeamID,
+						SeasonID: seasonID,
+						Status:   db.NullGameStatus{GameStatus: db.GameStatusFinished, Valid: true},
+					},
+				).
+				Return(int64(21), nil)
+
+			filter := TeamGameFilter{SeasonID: seasonID, Status: api.GameStatusFinished, Descending: true}
+			result, total, err := svc.GetGames(context.Background(), validTeamID, filter, 10, 20)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(games))
+			Expect(total).To(Equal(int64(21)))
+		})
+
+		It("should return a not found error when the team does not exist", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(db.Team{}, sql.ErrNoRows)
+
+			_, _, err := svc.GetGames(context.Background(), validTeamID, TeamGameFilter{}, 20, 0)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(err).To(MatchError("unable to get team: team not found"))
+		})
+
+		It("should return an error when counting games fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetGamesByTeamID(gomock.Any(), gomock.Any()).
+				Return(nil, nil)
+			mockQueries.EXPECT().
+				CountGamesByTeamID(gomock.Any(), gomock.Any()).
+				Return(int64(0), validTestError)
+
+			_, _, err := svc.GetGames(context.Background(), validTeamID, TeamGameFilter{}, 20, 0)
+
+			Expect(err).To(MatchError("unable to count team games: a valid testing error"))
+		})
+	})
+
+	Describe("GetForm", func() {
+		score := func(home, away int32) (sql.NullInt32, sql.NullInt32) {
+			return sql.NullInt32{Int32: home, Valid: true}, sql.NullInt32{Int32: away, Valid: true}
+		}
+		game := func(home, away uuid.UUID, homeScore, awayScore int32) db.Game {
+			hs, as := score(homeScore, awayScore)
+			return db.Game{ID: uuid.New(), HomeTeamID: home, AwayTeamID: away, HomeScore: hs, AwayScore: as}
+		}
+
+		It("should summarise results newest first", func() {
+			results := []db.Game{
+				game(validTeamID, validTeamID2, 30, 10),
+				game(validTeamID2, validTeamID, 12, 20),
+				game(validTeamID, validTeamID2, 17, 17),
+				game(validTeamID2, validTeamID, 25, 3),
+			}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetTeamResults(gomock.Any(), db.GetTeamResultsParams{TeamID: validTeamID}).
+				Return(results, nil)
+
+			form, err := svc.GetForm(context.Background(), validTeamID, uuid.NullUUID{}, 3)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(form.Recent).To(HaveLen(3))
+			Expect([]api.GameResult{form.Recent[0].Result, form.Recent[1].Result, form.Recent[2].Result}).
+				To(Equal([]api.GameResult{api.GameResultWin, api.GameResultWin, api.GameResultDraw}))
+			Expect(form.Recent[1].Home).To(BeFalse())
+			Expect(form.Recent[1].OpponentID).To(Equal(validTeamID2))
+			Expect(form.Recent[1].PointsFor).To(Equal(int32(20)))
+			Expect(form.StreakResult).To(Equal(api.GameResultWin))
+			Expect(form.StreakCount).To(Equal(2))
+			Expect(form.Overall).To(Equal(TeamRecord{Played: 4, Won: 2, Drawn: 1, Lost: 1, PointsFor: 70, PointsAgainst: 64}))
+			Expect(form.Home).To(Equal(TeamRecord{Played: 2, Won: 1, Drawn: 1, PointsFor: 47, PointsAgainst: 27}))
+			Expect(form.Away).To(Equal(TeamRecord{Played: 2, Won: 1, Lost: 1, PointsFor: 23, PointsAgainst: 37}))
+		})
+
+		It("should return an empty form when the team has no results", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetTeamResults(gomock.Any(), gomock.Any()).
+				Return(nil, nil)
+
+			form, err := svc.GetForm(context.Background(), validTeamID, uuid.NullUUID{}, 5)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(form.Recent).To(BeEmpty())
+			Expect(form.StreakCount).To(BeZero())
+			Expect(form.Overall).To(Equal(TeamRecord{}))
+		})
+
+		It("should return an error when getting results fails", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetTeamResults(gomock.Any(), gomock.Any()).
+				Return(nil, validTestError)
+
+			_, err := svc.GetForm(context.Background(), validTeamID, uuid.NullUUID{}, 5)
+
+			Expect(err).To(MatchError("unable to get team results: a valid testing error"))
+		})
+	})
+
+	Describe("GetHeadToHead", func() {
+		game := func(home, away uuid.UUID, homeScore, awayScore int32) db.Game {
+			return db.Game{
+				ID:         uuid.New(),
+				HomeTeamID: home,
+				AwayTeamID: away,
+				HomeScore:  sql.NullInt32{Int32: homeScore, Valid: true},
+				AwayScore:  sql.NullInt32{Int32: awayScore, Valid: true},
+			}
+		}
+
+		It("should summarise meetings from the team's side", func() {
+			games := []db.Game{
+				game(validTeamID2, validTeamID, 14, 21),
+				game(validTeamID, validTeamID2, 40, 12),
+				game(validTeamID, validTeamID2, 10, 35),
+				game(validTeamID2, validTeamID, 18, 18),
+			}
+
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID2).
+				Return(validTeamsFromDB[1], nil)
+			mockQueries.EXPECT().
+				GetHeadToHeadGames(gomock.Any(), db.GetHeadToHeadGamesParams{TeamID: validTeamID, OpponentID: validTeamID2}).
+				Return(games, nil)
+
+			h2h, err := svc.GetHeadToHead(context.Background(), validTeamID, validTeamID2)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h2h.Opponent).To(Equal(validTeamsFromDB[1]))
+			Expect(h2h.Meetings).To(HaveLen(4))
+			Expect(h2h.Meetings[0].Result).To(Equal(api.GameResultWin))
+			Expect(h2h.Record).To(Equal(TeamRecord{Played: 4, Won: 2, Drawn: 1, Lost: 1, PointsFor: 89, PointsAgainst: 79}))
+			Expect(h2h.BiggestWin.Game.ID).To(Equal(games[1].ID))
+			Expect(h2h.BiggestLoss.Game.ID).To(Equal(games[2].ID))
+		})
+
+		It("should leave margins empty when the teams have never met", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), gomock.Any()).
+				Return(validTeamFromDB, nil).
+				Times(2)
+			mockQueries.EXPECT().
+				GetHeadToHeadGames(gomock.Any(), gomock.Any()).
+				Return(nil, nil)
+
+			h2h, err := svc.GetHeadToHead(context.Background(), validTeamID, validTeamID2)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h2h.Meetings).To(BeEmpty())
+			Expect(h2h.BiggestWin).To(BeNil())
+			Expect(h2h.BiggestLoss).To(BeNil())
+		})
+
+		It("should reject a team compared with itself", func() {
+			_, err := svc.GetHeadToHead(context.Background(), validTeamID, validTeamID)
+
+			var validationErr *ValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Fields[0].Rule).To(Equal("different_team"))
+		})
+
+		It("should return a not found error when the opponent does not exist", func() {
+			mockDB.EXPECT().
+				New(gomock.Any()).
+				Return(mockQueries)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID).
+				Return(validTeamFromDB, nil)
+			mockQueries.EXPECT().
+				GetTeam(gomock.Any(), validTeamID2).
+				Return(db.Team{}, sql.ErrNoRows)
+
+			_, err := svc.GetHeadToHead(context.Background(), validTeamID, validTeamID2)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(err).To(MatchError("unable to get opponent: team not found"))
+		})
+	})
 })
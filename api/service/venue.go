@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	"github.com/bradley-adams/gainline/db/db_handler"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// VenueService defines the contract for venue-related operations.
+type VenueService interface {
+	Create(ctx context.Context, req *api.VenueRequest) (db.Venue, error)
+	GetAll(ctx context.Context, limit, offset int) ([]db.Venue, int64, error)
+	Get(ctx context.Context, venueID uuid.UUID) (db.Venue, error)
+	Update(ctx context.Context, req *api.VenueRequest, venueID uuid.UUID, version *time.Time) (db.Venue, error)
+	Delete(ctx context.Context, venueID uuid.UUID) error
+}
+
+// venueService is the concrete implementation backed by db_handler.DB.
+type venueService struct {
+	db db_handler.DB
+}
+
+// NewVenueService returns a new VenueService backed by db_handler.DB.
+func NewVenueService(db db_handler.DB) VenueService {
+	return &venueService{db: db}
+}
+
+func (s *venueService) Create(ctx context.Context, req *api.VenueRequest) (db.Venue, error) {
+	var venue db.Venue
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var txErr error
+		venue, txErr = createVenue(ctx, queries, req)
+		return txErr
+	})
+	if err != nil {
+		return db.Venue{}, err
+	}
+
+	return venue, nil
+}
+
+func (s *venueService) GetAll(ctx context.Context, limit, offset int) ([]db.Venue, int64, error) {
+	var (
+		venues []db.Venue
+		total  int64
+	)
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, q db_handler.Queries) error {
+		var err error
+
+		total, err = q.CountVenues(ctx)
+		if err != nil {
+			return errors.Wrap(err, "count venues")
+		}
+
+		venues, err = q.GetVenues(ctx, db.GetVenuesParams{
+			PageOffset: int32(offset),
+			PageLimit:  int32(limit),
+		})
+		if err != nil {
+			return errors.Wrap(err, "get venues")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return venues, total, nil
+}
+
+func (s *venueService) Get(ctx context.Context, venueID uuid.UUID) (db.Venue, error) {
+	var venue db.Venue
+
+	err := db_handler.Run(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		var err error
+		venue, err = queries.GetVenue(ctx, venueID)
+		return err
+	})
+	if err != nil {
+		return db.Venue{}, wrapDBError(err, "venue", "unable to get venue")
+	}
+
+	return venue, nil
+}
+
+func (s *venueService) Update(ctx context.Context, req *api.VenueRequest, venueID uuid.UUID, version *time.Time) (db.Venue, error) {
+	var venue db.Venue
+
+	err := db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		if err := checkVersion(ctx, "venue", venueID, version, queries.LockVenue); err != nil {
+			return err
+		}
+
+		var txErr error
+		venue, txErr = updateVenue(ctx, queries, req, venueID)
+		return txErr
+	})
+	if err != nil {
+		return db.Venue{}, err
+	}
+
+	return venue, nil
+}
+
+func (s *venueService) Delete(ctx context.Context, venueID uuid.UUID) error {
+	return db_handler.RunInTransaction(ctx, s.db, func(ctx context.Context, queries db_handler.Queries) error {
+		return deleteVenue(ctx, queries, venueID)
+	})
+}
+
+func createVenue(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.VenueRequest,
+) (db.Venue, error) {
+	now := time.Now()
+	params := db.CreateVenueParams{
+		ID:        uuid.New(),
+		Name:      req.Name,
+		City:      req.City,
+		Country:   req.Country,
+		Timezone:  req.Timezone,
+		Capacity:  toNullInt32(req.Capacity),
+		Latitude:  toNullFloat64(req.Latitude),
+		Longitude: toNullFloat64(req.Longitude),
+		CreatedAt: now,
+		UpdatedAt: now,
+		DeletedAt: sql.NullTime{Time: time.Time{}, Valid: false},
+	}
+
+	if err := queries.CreateVenue(ctx, params); err != nil {
+		return db.Venue{}, wrapDBError(err, "venue", "unable to create new venue")
+	}
+
+	venue, err := queries.GetVenue(ctx, params.ID)
+	if err != nil {
+		return db.Venue{}, errors.Wrap(err, "unable to get new venue")
+	}
+
+	return venue, nil
+}
+
+func updateVenue(
+	ctx context.Context,
+	queries db_handler.Queries,
+	req *api.VenueRequest,
+	venueID uuid.UUID,
+) (db.Venue, error) {
+	params := db.UpdateVenueParams{
+		Name:      req.Name,
+		City:      req.City,
+		Country:   req.Country,
+		Timezone:  req.Timezone,
+		Capacity:  toNullInt32(req.Capacity),
+		Latitude:  toNullFloat64(req.Latitude),
+		Longitude: toNullFloat64(req.Longitude),
+		UpdatedAt: time.Now(),
+		ID:        venueID,
+	}
+
+	if err := queries.UpdateVenue(ctx, params); err != nil {
+		return db.Venue{}, wrapDBError(err, "venue", "unable to update venue")
+	}
+
+	venue, err := queries.GetVenue(ctx, venueID)
+	if err != nil {
+		return db.Venue{}, wrapDBError(err, "venue", "unable to get updated venue")
+	}
+
+	return venue, nil
+}
+
+// deleteVenue soft deletes a venue that no game is played at. Games must be moved or
+// cleared first so their local kickoff times do not silently change; teams using it as
+// their home venue simply lose the default.
+func deleteVenue(
+	ctx context.Context,
+	queries db_handler.Queries,
+	venueID uuid.UUID,
+) error {
+	if _, err := queries.GetVenue(ctx, venueID); err != nil {
+		return wrapDBError(err, "venue", "unable to get venue")
+	}
+
+	games, err := queries.CountGamesByVenueID(ctx, uuid.NullUUID{UUID: venueID, Valid: true})
+	if err != nil {
+		return errors.Wrap(err, "unable to count venue games")
+	}
+	if games > 0 {
+		return NewConflictError(fmt.Sprintf("venue has %d games", games), nil)
+	}
+
+	now := time.Now()
+	nullVenueID := uuid.NullUUID{UUID: venueID, Valid: true}
+
+	// Home venues are only defaults, so teams fall back to having none.
+	if err := queries.ClearTeamHomeVenues(ctx, db.ClearTeamHomeVenuesParams{
+		UpdatedAt: now,
+		VenueID:   nullVenueID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to clear team home venues")
+	}
+
+	if err := queries.ClearSeasonTeamHomeVenues(ctx, db.ClearSeasonTeamHomeVenuesParams{
+		UpdatedAt: now,
+		VenueID:   nullVenueID,
+	}); err != nil {
+		return errors.Wrap(err, "unable to clear season home venues")
+	}
+
+	params := db.DeleteVenueParams{
+		ID:        venueID,
+		DeletedAt: sql.NullTime{Time: now, Valid: true},
+	}
+
+	if err := queries.DeleteVenue(ctx, params); err != nil {
+		return errors.Wrap(err, "unable to delete venue")
+	}
+
+	return nil
+}
+
+// ensureVenueExists returns a validation error against field when venueID names a venue
+// that does not exist. A null venueID passes.
+func ensureVenueExists(ctx context.Context, queries db_handler.Queries, venueID uuid.NullUUID, message, field string) error {
+	if !venueID.Valid {
+		return nil
+	}
+
+	_, err := queries.GetVenue(ctx, venueID.UUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NewValidationError(message, FieldError{Field: field, Rule: "exists", Message: "venue not found"})
+	}
+	if err != nil {
+		return errors.Wrap(err, "unable to get venue")
+	}
+
+	return nil
+}
+
+func toNullFloat64(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bradley-adams/gainline/db/db"
+	mock_db "github.com/bradley-adams/gainline/db/db_handler/mock"
+	"github.com/bradley-adams/gainline/http/api"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("venue", func() {
+	var ctrl *gomock.Controller
+	var mockDB *mock_db.MockDB
+	var mockQueries *mock_db.MockQueries
+	var svc VenueService
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockDB = mock_db.NewMockDB(ctrl)
+		mockQueries = mock_db.NewMockQueries(ctrl)
+		svc = NewVenueService(mockDB)
+	})
+
+	validVenueID := uuid.MustParse("5b0c8f1e-2a7d-4c3e-9f61-8d2e4a6b1c01")
+
+	validTimeNow := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	capacity := int32(50000)
+	latitude := -36.8751
+	longitude := 174.7447
+
+	validVenueRequest := &api.VenueRequest{
+		Name:      "Eden Park",
+		City:      "Auckland",
+		Country:   "New Zealand",
+		Timezone:  "Pacific/Auckland",
+		Capacity:  &capacity,
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}
+
+	validVenueFromDB := db.Venue{
+		ID:        validVenueID,
+		Name:      "Eden Park",
+		City:      "Auckland",
+		Country:   "New Zealand",
+		Timezone:  "Pacific/Auckland",
+		Capacity:  sql.NullInt32{Int32: capacity, Valid: true},
+		Latitude:  sql.NullFloat64{Float64: latitude, Valid: true},
+		Longitude: sql.NullFloat64{Float64: longitude, Valid: true},
+		CreatedAt: validTimeNow,
+		UpdatedAt: validTimeNow,
+	}
+
+	validTestError := errors.New("a valid testing error")
+
+	Describe("CreateVenue", func() {
+		It("should create a new venue with its optional details", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateVenueParams) error {
+				Expect(params.Timezone).To(Equal("Pacific/Auckland"))
+				Expect(params.Capacity).To(Equal(sql.NullInt32{Int32: capacity, Valid: true}))
+				Expect(params.Latitude).To(Equal(sql.NullFloat64{Float64: latitude, Valid: true}))
+				Expect(params.Longitude).To(Equal(sql.NullFloat64{Float64: longitude, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().GetVenue(gomock.Any(), gomock.Any()).Return(validVenueFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			venue, err := svc.Create(context.Background(), validVenueRequest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(venue).To(Equal(validVenueFromDB))
+		})
+
+		It("should store missing optional details as null", func() {
+			req := &api.VenueRequest{
+				Name:     "Sky Stadium",
+				City:     "Wellington",
+				Country:  "New Zealand",
+				Timezone: "Pacific/Auckland",
+			}
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.CreateVenueParams) error {
+				Expect(params.Capacity.Valid).To(BeFalse())
+				Expect(params.Latitude.Valid).To(BeFalse())
+				Expect(params.Longitude.Valid).To(BeFalse())
+				return nil
+			})
+			mockQueries.EXPECT().GetVenue(gomock.Any(), gomock.Any()).Return(db.Venue{}, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			_, err := svc.Create(context.Background(), req)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a conflict when the venue already exists in the city", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CreateVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).Return(&pq.Error{Code: uniqueViolation})
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Create(context.Background(), validVenueRequest)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("venue already exists"))
+		})
+	})
+
+	Describe("GetVenues", func() {
+		It("should retrieve paginated venues with total count", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CountVenues(gomock.Any()).Return(int64(1), nil)
+			mockQueries.EXPECT().GetVenues(
+				gomock.Any(),
+				db.GetVenuesParams{PageOffset: 20, PageLimit: 10},
+			).Return([]db.Venue{validVenueFromDB}, nil)
+
+			venues, total, err := svc.GetAll(context.Background(), 10, 20)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+			Expect(venues).To(Equal([]db.Venue{validVenueFromDB}))
+		})
+
+		It("should return error when count fails", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().CountVenues(gomock.Any()).Return(int64(0), validTestError)
+
+			venues, total, err := svc.GetAll(context.Background(), 10, 0)
+
+			Expect(venues).To(BeNil())
+			Expect(total).To(Equal(int64(0)))
+			Expect(err.Error()).To(Equal("count venues: a valid testing error"))
+		})
+	})
+
+	Describe("GetVenue", func() {
+		It("should return a not found error when the venue does not exist", func() {
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), validVenueID).Return(db.Venue{}, sql.ErrNoRows)
+
+			_, err := svc.Get(context.Background(), validVenueID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Resource).To(Equal("venue"))
+		})
+	})
+
+	Describe("UpdateVenue", func() {
+		It("should update a venue when the version matches", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().LockVenue(gomock.Any(), validVenueID).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.UpdateVenueParams) error {
+				Expect(params.ID).To(Equal(validVenueID))
+				Expect(params.Timezone).To(Equal("Pacific/Auckland"))
+				return nil
+			})
+			mockQueries.EXPECT().GetVenue(gomock.Any(), validVenueID).Return(validVenueFromDB, nil)
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			venue, err := svc.Update(context.Background(), validVenueRequest, validVenueID, &validTimeNow)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(venue).To(Equal(validVenueFromDB))
+		})
+
+		It("should rollback with a precondition failure when the venue has changed", func() {
+			readAt := validTimeNow.Add(-time.Minute)
+
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().LockVenue(gomock.Any(), validVenueID).Return(validTimeNow, nil)
+			mockQueries.EXPECT().UpdateVenue(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			_, err := svc.Update(context.Background(), validVenueRequest, validVenueID, &readAt)
+
+			var preconditionErr *PreconditionFailedError
+			Expect(errors.As(err, &preconditionErr)).To(BeTrue())
+			Expect(preconditionErr.Resource).To(Equal("venue"))
+		})
+	})
+
+	Describe("DeleteVenue", func() {
+		It("should soft delete a venue no game is played at and clear it as a home venue", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), validVenueID).Return(validVenueFromDB, nil)
+			mockQueries.EXPECT().CountGamesByVenueID(
+				gomock.Any(),
+				uuid.NullUUID{UUID: validVenueID, Valid: true},
+			).Return(int64(0), nil)
+			mockQueries.EXPECT().ClearTeamHomeVenues(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.ClearTeamHomeVenuesParams) error {
+				Expect(params.VenueID).To(Equal(uuid.NullUUID{UUID: validVenueID, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().ClearSeasonTeamHomeVenues(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.ClearSeasonTeamHomeVenuesParams) error {
+				Expect(params.VenueID).To(Equal(uuid.NullUUID{UUID: validVenueID, Valid: true}))
+				return nil
+			})
+			mockQueries.EXPECT().DeleteVenue(
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(func(_ context.Context, params db.DeleteVenueParams) error {
+				Expect(params.ID).To(Equal(validVenueID))
+				Expect(params.DeletedAt.Valid).To(BeTrue())
+				return nil
+			})
+			mockDB.EXPECT().Commit(gomock.Any())
+
+			err := svc.Delete(context.Background(), validVenueID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should rollback with a conflict when games are played at the venue", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), validVenueID).Return(validVenueFromDB, nil)
+			mockQueries.EXPECT().CountGamesByVenueID(gomock.Any(), gomock.Any()).Return(int64(3), nil)
+			mockQueries.EXPECT().DeleteVenue(gomock.Any(), gomock.Any()).Times(0)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Delete(context.Background(), validVenueID)
+
+			var conflictErr *ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Message).To(Equal("venue has 3 games"))
+		})
+
+		It("should return a not found error when the venue does not exist", func() {
+			mockDB.EXPECT().BeginTx(gomock.Any(), gomock.Any())
+			mockDB.EXPECT().New(gomock.Any()).Return(mockQueries)
+			mockQueries.EXPECT().GetVenue(gomock.Any(), validVenueID).Return(db.Venue{}, sql.ErrNoRows)
+			mockDB.EXPECT().Rollback(gomock.Any())
+
+			err := svc.Delete(context.Background(), validVenueID)
+
+			var notFoundErr *NotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// checkVersion locks a resource for the rest of the transaction and fails with
+// PreconditionFailedError when its updated_at is no longer version. A nil version
+// skips the check, leaving the last write to win.
+func checkVersion(
+	ctx context.Context,
+	resource string,
+	id uuid.UUID,
+	version *time.Time,
+	lock func(ctx context.Context, id uuid.UUID) (time.Time, error),
+) error {
+	if version == nil {
+		return nil
+	}
+
+	updatedAt, err := lock(ctx, id)
+	if err != nil {
+		return wrapDBError(err, resource, "unable to lock "+resource)
+	}
+
+	if !updatedAt.Equal(*version) {
+		return NewPreconditionFailedError(resource)
+	}
+
+	return nil
+}
@@ -0,0 +1,3 @@
+-- Drop the game date index used by the fixtures and results feed
+
+DROP INDEX IF EXISTS idx_games_date;
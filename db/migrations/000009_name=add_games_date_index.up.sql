@@ -0,0 +1,5 @@
+-- Index game dates for the cross-competition fixtures and results feed
+
+CREATE INDEX idx_games_date
+ON games (date)
+WHERE deleted_at IS NULL;
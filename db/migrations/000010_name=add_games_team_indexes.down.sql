@@ -0,0 +1,4 @@
+-- Drop the team indexes used to list a team's games
+
+DROP INDEX IF EXISTS idx_games_away_team_id;
+DROP INDEX IF EXISTS idx_games_home_team_id;
@@ -0,0 +1,9 @@
+-- Index home and away teams so a team's games can be listed across seasons
+
+CREATE INDEX idx_games_home_team_id
+ON games (home_team_id, date)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_games_away_team_id
+ON games (away_team_id, date)
+WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- Drop the season finals table and format type
+
+DROP TABLE IF EXISTS season_finals;
+
+DROP TYPE IF EXISTS finals_format;
@@ -0,0 +1,12 @@
+-- Store the finals format used to build each season's finals bracket from the ladder
+
+CREATE TYPE finals_format AS ENUM ('top_4', 'top_6', 'top_8');
+
+CREATE TABLE season_finals (
+    season_id UUID PRIMARY KEY,
+    format finals_format NOT NULL,
+    round_interval_days INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT fk_season_finals_season FOREIGN KEY (season_id) REFERENCES seasons(id) ON DELETE CASCADE
+);
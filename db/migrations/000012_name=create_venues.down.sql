@@ -0,0 +1,7 @@
+-- Drop the game venue column and the venues table
+
+DROP INDEX IF EXISTS idx_games_venue_id;
+
+ALTER TABLE games DROP COLUMN IF EXISTS venue_id;
+
+DROP TABLE IF EXISTS venues;
@@ -0,0 +1,29 @@
+-- Store venues with their IANA timezone so games can report a local kickoff time
+
+CREATE TABLE venues (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    city TEXT NOT NULL,
+    country TEXT NOT NULL,
+    timezone TEXT NOT NULL,
+    capacity INT,
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT chk_venues_capacity CHECK (capacity IS NULL OR capacity >= 0),
+    CONSTRAINT chk_venues_coordinates CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+CREATE UNIQUE INDEX unique_venue_name_city_ci
+ON venues (LOWER(name), LOWER(city))
+WHERE deleted_at IS NULL;
+
+ALTER TABLE games
+ADD COLUMN venue_id UUID,
+ADD CONSTRAINT fk_games_venue FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_games_venue_id
+ON games (venue_id)
+WHERE deleted_at IS NULL;
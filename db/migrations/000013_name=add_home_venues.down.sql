@@ -0,0 +1,7 @@
+-- Drop home venues and the neutral venue flag
+
+ALTER TABLE games DROP COLUMN IF EXISTS neutral_venue;
+
+ALTER TABLE season_teams DROP COLUMN IF EXISTS home_venue_id;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS home_venue_id;
@@ -0,0 +1,12 @@
+-- Give teams a default home venue, optionally overridden per season, and flag games played at a neutral venue
+
+ALTER TABLE teams
+ADD COLUMN home_venue_id UUID,
+ADD CONSTRAINT fk_teams_home_venue FOREIGN KEY (home_venue_id) REFERENCES venues(id) ON DELETE SET NULL;
+
+ALTER TABLE season_teams
+ADD COLUMN home_venue_id UUID,
+ADD CONSTRAINT fk_season_teams_home_venue FOREIGN KEY (home_venue_id) REFERENCES venues(id) ON DELETE SET NULL;
+
+ALTER TABLE games
+ADD COLUMN neutral_venue BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,7 @@
+-- Drop players and season squads
+
+DROP TABLE IF EXISTS season_team_players;
+
+DROP TABLE IF EXISTS players;
+
+DROP TYPE IF EXISTS player_position;
@@ -0,0 +1,55 @@
+-- Store players and the squads they are named in for each season
+
+CREATE TYPE player_position AS ENUM (
+    'prop',
+    'hooker',
+    'lock',
+    'flanker',
+    'number_eight',
+    'scrum_half',
+    'fly_half',
+    'centre',
+    'wing',
+    'fullback'
+);
+
+CREATE TABLE players (
+    id UUID PRIMARY KEY,
+    team_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    date_of_birth DATE,
+    position player_position NOT NULL,
+    nationality TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_players_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_players_team_id
+ON players (team_id)
+WHERE deleted_at IS NULL;
+
+CREATE TABLE season_team_players (
+    id UUID PRIMARY KEY,
+    season_team_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    jersey_number INT NOT NULL,
+    active_from DATE,
+    active_to DATE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_season_team_players_season_team FOREIGN KEY (season_team_id) REFERENCES season_teams(id) ON DELETE CASCADE,
+    CONSTRAINT fk_season_team_players_player FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    CONSTRAINT chk_season_team_players_jersey_number CHECK (jersey_number BETWEEN 1 AND 99),
+    CONSTRAINT chk_season_team_players_active_dates CHECK (active_from IS NULL OR active_to IS NULL OR active_from <= active_to)
+);
+
+CREATE UNIQUE INDEX unique_season_team_player
+ON season_team_players (season_team_id, player_id)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_season_team_players_player_id
+ON season_team_players (player_id)
+WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- Drop game lineups and replacements
+
+DROP TABLE IF EXISTS game_replacements;
+
+DROP TABLE IF EXISTS game_lineup_players;
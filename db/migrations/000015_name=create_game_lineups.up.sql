@@ -0,0 +1,47 @@
+-- Store each side's matchday team sheet and the replacements made during a game
+
+CREATE TABLE game_lineup_players (
+    id UUID PRIMARY KEY,
+    game_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_id UUID NOT NULL,
+    shirt_number INT NOT NULL,
+    captain BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_game_lineup_players_game FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_lineup_players_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_lineup_players_player FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    CONSTRAINT chk_game_lineup_players_shirt_number CHECK (shirt_number BETWEEN 1 AND 23)
+);
+
+CREATE UNIQUE INDEX unique_game_lineup_shirt_number
+ON game_lineup_players (game_id, team_id, shirt_number)
+WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX unique_game_lineup_player
+ON game_lineup_players (game_id, player_id)
+WHERE deleted_at IS NULL;
+
+CREATE TABLE game_replacements (
+    id UUID PRIMARY KEY,
+    game_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_off_id UUID NOT NULL,
+    player_on_id UUID NOT NULL,
+    minute INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_game_replacements_game FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_replacements_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_replacements_player_off FOREIGN KEY (player_off_id) REFERENCES players(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_replacements_player_on FOREIGN KEY (player_on_id) REFERENCES players(id) ON DELETE CASCADE,
+    CONSTRAINT chk_game_replacements_players CHECK (player_off_id <> player_on_id),
+    CONSTRAINT chk_game_replacements_minute CHECK (minute BETWEEN 1 AND 120)
+);
+
+CREATE INDEX idx_game_replacements_game_id
+ON game_replacements (game_id)
+WHERE deleted_at IS NULL;
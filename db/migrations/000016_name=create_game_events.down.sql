@@ -0,0 +1,7 @@
+-- Drop game events and player game stats
+
+DROP VIEW IF EXISTS player_game_stats;
+
+DROP TABLE IF EXISTS game_events;
+
+DROP TYPE IF EXISTS game_event_type;
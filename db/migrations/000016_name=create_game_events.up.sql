@@ -0,0 +1,106 @@
+-- Store scoring and disciplinary events and aggregate them into per-player game stats
+
+CREATE TYPE game_event_type AS ENUM (
+    'try',
+    'conversion',
+    'penalty_goal',
+    'drop_goal',
+    'penalty_try',
+    'yellow_card',
+    'red_card'
+);
+
+CREATE TABLE game_events (
+    id UUID PRIMARY KEY,
+    game_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_id UUID,
+    event_type game_event_type NOT NULL,
+    minute INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_game_events_game FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_events_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_events_player FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    CONSTRAINT chk_game_events_player CHECK ((event_type = 'penalty_try') = (player_id IS NULL)),
+    CONSTRAINT chk_game_events_minute CHECK (minute BETWEEN 1 AND 120)
+);
+
+CREATE INDEX idx_game_events_game_id
+ON game_events (game_id)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_game_events_player_id
+ON game_events (player_id)
+WHERE deleted_at IS NULL;
+
+-- One row per player per finished game, for everyone who took the field or scored.
+-- Starters play from minute 0 and replacements from the minute they came on, until
+-- they are replaced, sent off or the 80 minutes are up.
+CREATE VIEW player_game_stats AS
+WITH appearances AS (
+    SELECT
+        l.game_id,
+        l.team_id,
+        l.player_id,
+        CASE WHEN l.shirt_number <= 15 THEN 0 ELSE r_on.minute END AS minute_on,
+        COALESCE(r_off.minute, 80) AS minute_off
+    FROM
+        game_lineup_players l
+    LEFT JOIN
+        game_replacements r_on ON r_on.game_id = l.game_id AND r_on.player_on_id = l.player_id AND r_on.deleted_at IS NULL
+    LEFT JOIN
+        game_replacements r_off ON r_off.game_id = l.game_id AND r_off.player_off_id = l.player_id AND r_off.deleted_at IS NULL
+    WHERE
+        l.deleted_at IS NULL
+    AND
+        (l.shirt_number <= 15 OR r_on.id IS NOT NULL)
+),
+events AS (
+    SELECT
+        game_id,
+        team_id,
+        player_id,
+        COUNT(*) FILTER (WHERE event_type = 'try') AS tries,
+        COUNT(*) FILTER (WHERE event_type = 'conversion') AS conversions,
+        COUNT(*) FILTER (WHERE event_type = 'penalty_goal') AS penalty_goals,
+        COUNT(*) FILTER (WHERE event_type = 'drop_goal') AS drop_goals,
+        COUNT(*) FILTER (WHERE event_type = 'yellow_card') AS yellow_cards,
+        COUNT(*) FILTER (WHERE event_type = 'red_card') AS red_cards,
+        MIN(minute) FILTER (WHERE event_type = 'red_card') AS sent_off_at
+    FROM
+        game_events
+    WHERE
+        deleted_at IS NULL
+    AND
+        player_id IS NOT NULL
+    GROUP BY
+        game_id,
+        team_id,
+        player_id
+)
+SELECT
+    g.id AS game_id,
+    g.season_id,
+    COALESCE(a.team_id, e.team_id) AS team_id,
+    COALESCE(a.player_id, e.player_id) AS player_id,
+    (a.player_id IS NOT NULL) AS appeared,
+    COALESCE(GREATEST(LEAST(a.minute_off, COALESCE(e.sent_off_at, 80)) - a.minute_on, 0), 0)::INT AS minutes,
+    COALESCE(e.tries, 0)::INT AS tries,
+    COALESCE(e.conversions, 0)::INT AS conversions,
+    COALESCE(e.penalty_goals, 0)::INT AS penalty_goals,
+    COALESCE(e.drop_goals, 0)::INT AS drop_goals,
+    COALESCE(e.yellow_cards, 0)::INT AS yellow_cards,
+    COALESCE(e.red_cards, 0)::INT AS red_cards,
+    (COALESCE(e.tries, 0) * 5 + COALESCE(e.conversions, 0) * 2 + (COALESCE(e.penalty_goals, 0) + COALESCE(e.drop_goals, 0)) * 3)::INT AS points
+FROM
+    appearances a
+FULL OUTER JOIN
+    events e ON e.game_id = a.game_id AND e.player_id = a.player_id
+JOIN
+    games g ON g.id = COALESCE(a.game_id, e.game_id)
+WHERE
+    g.deleted_at IS NULL
+AND
+    g.status = 'finished';
@@ -0,0 +1,7 @@
+-- Drop match officials and their appointments
+
+DROP TABLE IF EXISTS game_officials;
+
+DROP TABLE IF EXISTS officials;
+
+DROP TYPE IF EXISTS official_role;
@@ -0,0 +1,41 @@
+-- Store match officials and their appointments to games
+
+CREATE TYPE official_role AS ENUM (
+    'referee',
+    'assistant_referee',
+    'tmo'
+);
+
+CREATE TABLE officials (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    nationality TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE game_officials (
+    id UUID PRIMARY KEY,
+    game_id UUID NOT NULL,
+    official_id UUID NOT NULL,
+    role official_role NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_game_officials_game FOREIGN KEY (game_id) REFERENCES games(id) ON DELETE CASCADE,
+    CONSTRAINT fk_game_officials_official FOREIGN KEY (official_id) REFERENCES officials(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX unique_game_officials_official
+ON game_officials (game_id, official_id)
+WHERE deleted_at IS NULL;
+
+-- A game has one referee and one TMO; assistant referees are limited in the service
+CREATE UNIQUE INDEX unique_game_officials_role
+ON game_officials (game_id, role)
+WHERE deleted_at IS NULL AND role <> 'assistant_referee';
+
+CREATE INDEX idx_game_officials_official_id
+ON game_officials (official_id)
+WHERE deleted_at IS NULL;
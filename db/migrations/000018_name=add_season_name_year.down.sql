@@ -0,0 +1,11 @@
+-- Drop season names and years
+
+DROP INDEX IF EXISTS idx_seasons_competition_dates;
+
+DROP INDEX IF EXISTS unique_season_competition_year;
+
+DROP INDEX IF EXISTS unique_season_competition_name_ci;
+
+ALTER TABLE seasons
+DROP COLUMN IF EXISTS year,
+DROP COLUMN IF EXISTS name;
@@ -0,0 +1,26 @@
+-- Label seasons with a name and year that are unique within their competition
+
+ALTER TABLE seasons
+ADD COLUMN name VARCHAR(100),
+ADD COLUMN year INTEGER;
+
+UPDATE seasons
+SET
+    year = EXTRACT(YEAR FROM start_date),
+    name = EXTRACT(YEAR FROM start_date)::TEXT;
+
+ALTER TABLE seasons
+ALTER COLUMN name SET NOT NULL,
+ALTER COLUMN year SET NOT NULL;
+
+CREATE UNIQUE INDEX unique_season_competition_name_ci
+ON seasons (competition_id, LOWER(name))
+WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX unique_season_competition_year
+ON seasons (competition_id, year)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_seasons_competition_dates
+ON seasons (competition_id, start_date, end_date)
+WHERE deleted_at IS NULL;
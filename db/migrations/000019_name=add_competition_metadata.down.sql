@@ -0,0 +1,24 @@
+-- Drop competition metadata
+
+DROP INDEX IF EXISTS idx_competitions_sport_gender_level;
+
+ALTER TABLE competitions
+DROP CONSTRAINT IF EXISTS chk_competitions_secondary_colour,
+DROP CONSTRAINT IF EXISTS chk_competitions_primary_colour,
+DROP CONSTRAINT IF EXISTS chk_competitions_country,
+DROP COLUMN IF EXISTS website,
+DROP COLUMN IF EXISTS secondary_colour,
+DROP COLUMN IF EXISTS primary_colour,
+DROP COLUMN IF EXISTS logo_url,
+DROP COLUMN IF EXISTS governing_body,
+DROP COLUMN IF EXISTS region,
+DROP COLUMN IF EXISTS country,
+DROP COLUMN IF EXISTS level,
+DROP COLUMN IF EXISTS gender,
+DROP COLUMN IF EXISTS sport;
+
+DROP TYPE IF EXISTS competition_level;
+
+DROP TYPE IF EXISTS competition_gender;
+
+DROP TYPE IF EXISTS sport_code;
@@ -0,0 +1,58 @@
+-- Describe competitions by code, gender, level and region, and give them branding
+
+CREATE TYPE sport_code AS ENUM (
+    'rugby_union',
+    'rugby_sevens',
+    'rugby_league'
+);
+
+CREATE TYPE competition_gender AS ENUM (
+    'men',
+    'women',
+    'mixed'
+);
+
+CREATE TYPE competition_level AS ENUM (
+    'international',
+    'professional',
+    'provincial',
+    'club',
+    'school'
+);
+
+ALTER TABLE competitions
+ADD COLUMN sport sport_code NOT NULL DEFAULT 'rugby_union',
+ADD COLUMN gender competition_gender,
+ADD COLUMN level competition_level,
+ADD COLUMN country CHAR(2),
+ADD COLUMN region VARCHAR(100),
+ADD COLUMN governing_body VARCHAR(100),
+ADD COLUMN logo_url TEXT,
+ADD COLUMN primary_colour VARCHAR(7),
+ADD COLUMN secondary_colour VARCHAR(7),
+ADD COLUMN website TEXT,
+ADD CONSTRAINT chk_competitions_country CHECK (country IS NULL OR country ~ '^[A-Z]{2}$'),
+ADD CONSTRAINT chk_competitions_primary_colour CHECK (primary_colour IS NULL OR primary_colour ~ '^#[0-9A-Fa-f]{6}$'),
+ADD CONSTRAINT chk_competitions_secondary_colour CHECK (secondary_colour IS NULL OR secondary_colour ~ '^#[0-9A-Fa-f]{6}$');
+
+UPDATE competitions
+SET
+    gender = 'men',
+    level = 'provincial',
+    country = 'NZ',
+    governing_body = 'New Zealand Rugby'
+WHERE
+    id = '44dd315c-1abc-43aa-9843-642f920190d1';
+
+UPDATE competitions
+SET
+    gender = 'men',
+    level = 'professional',
+    region = 'Pacific',
+    governing_body = 'Super Rugby Pacific'
+WHERE
+    id = 'b3f77b8d-25e5-4817-aed4-d023160cd7ed';
+
+CREATE INDEX idx_competitions_sport_gender_level
+ON competitions (sport, gender, level)
+WHERE deleted_at IS NULL;
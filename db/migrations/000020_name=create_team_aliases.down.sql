@@ -0,0 +1,3 @@
+-- Drop team aliases
+
+DROP TABLE IF EXISTS team_aliases;
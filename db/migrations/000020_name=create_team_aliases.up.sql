@@ -0,0 +1,26 @@
+-- Record the names, abbreviations and logos a team has played under, so a team keeps
+-- one ID across renames and rebrands. Seeded teams are already shared by ID across the
+-- 2024, 2025 and 2026 seasons, so no teams need merging.
+
+CREATE TABLE team_aliases (
+    id UUID PRIMARY KEY,
+    team_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    abbreviation TEXT NOT NULL,
+    logo_url TEXT,
+    valid_from DATE NOT NULL,
+    valid_to DATE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT fk_team_aliases_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    CONSTRAINT chk_team_aliases_valid_range CHECK (valid_to IS NULL OR valid_to >= valid_from)
+);
+
+CREATE INDEX idx_team_aliases_team_id_valid_from
+ON team_aliases (team_id, valid_from)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_team_aliases_lower_name
+ON team_aliases (LOWER(name))
+WHERE deleted_at IS NULL;
@@ -0,0 +1,16 @@
+-- Drop team branding and profile details
+
+ALTER TABLE teams
+DROP CONSTRAINT IF EXISTS chk_teams_external_refs,
+DROP CONSTRAINT IF EXISTS chk_teams_social_handles,
+DROP CONSTRAINT IF EXISTS chk_teams_founded_year,
+DROP CONSTRAINT IF EXISTS chk_teams_secondary_colour,
+DROP CONSTRAINT IF EXISTS chk_teams_primary_colour,
+DROP COLUMN IF EXISTS external_refs,
+DROP COLUMN IF EXISTS social_handles,
+DROP COLUMN IF EXISTS founded_year,
+DROP COLUMN IF EXISTS secondary_colour,
+DROP COLUMN IF EXISTS primary_colour,
+DROP COLUMN IF EXISTS crest_url,
+DROP COLUMN IF EXISTS logo_url,
+DROP COLUMN IF EXISTS short_name;
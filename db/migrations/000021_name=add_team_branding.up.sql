@@ -0,0 +1,16 @@
+-- Give teams the branding and profile details scoreboards and overlays need
+
+ALTER TABLE teams
+ADD COLUMN short_name VARCHAR(30),
+ADD COLUMN logo_url TEXT,
+ADD COLUMN crest_url TEXT,
+ADD COLUMN primary_colour VARCHAR(7),
+ADD COLUMN secondary_colour VARCHAR(7),
+ADD COLUMN founded_year INTEGER,
+ADD COLUMN social_handles JSONB NOT NULL DEFAULT '{}',
+ADD COLUMN external_refs JSONB NOT NULL DEFAULT '{}',
+ADD CONSTRAINT chk_teams_primary_colour CHECK (primary_colour IS NULL OR primary_colour ~ '^#[0-9A-Fa-f]{6}$'),
+ADD CONSTRAINT chk_teams_secondary_colour CHECK (secondary_colour IS NULL OR secondary_colour ~ '^#[0-9A-Fa-f]{6}$'),
+ADD CONSTRAINT chk_teams_founded_year CHECK (founded_year IS NULL OR founded_year >= 1800),
+ADD CONSTRAINT chk_teams_social_handles CHECK (jsonb_typeof(social_handles) = 'object'),
+ADD CONSTRAINT chk_teams_external_refs CHECK (jsonb_typeof(external_refs) = 'object');
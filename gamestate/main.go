@@ -5,12 +5,17 @@ import (
 	"os"
 	"time"
 
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/bradley-adams/gainline/gamestate/redis"
 	"github.com/bradley-adams/gainline/gamestate/server"
 	gamestatev1 "github.com/bradley-adams/gainline/proto/gen/gamestate/v1"
+	"github.com/bradley-adams/gainline/telemetry/prom"
+	"github.com/bradley-adams/gainline/telemetry/tracing"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
 )
@@ -35,6 +40,8 @@ func main() {
 
 	logger.Info().Msgf("%s starting", serviceName)
 
+	shutdownTracing := tracing.Start(logger, serviceName)
+
 	redisClient := setupRedisClient(logger)
 	gameStateServer := server.New(redisClient)
 
@@ -43,12 +50,26 @@ func main() {
 		logger.Fatal().Err(err).Msg("failed to listen")
 	}
 
-	grpcServer := grpc.NewServer()
+	serverMetrics := grpcprom.NewServerMetrics(
+		grpcprom.WithServerHandlingTimeHistogram(),
+	)
+	prometheus.MustRegister(serverMetrics)
+
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(serverMetrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(serverMetrics.StreamServerInterceptor()),
+	)
 	gamestatev1.RegisterGameStateServiceServer(grpcServer, gameStateServer)
 	reflection.Register(grpcServer)
+	serverMetrics.InitializeMetrics(grpcServer)
+
+	go prom.Serve(logger)
 
 	logger.Info().Msg(serviceName + " started")
-	logger.Fatal().Err(grpcServer.Serve(lis)).Msg("failed to start server")
+	err = grpcServer.Serve(lis)
+	shutdownTracing()
+	logger.Fatal().Err(err).Msg("failed to start server")
 }
 
 func setUpEnvVars() error {
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "gainline_gamestate"
+
+var (
+	// ActiveStreams tracks the number of open WatchGameState streams.
+	ActiveStreams = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "watch",
+			Name:      "active_streams",
+			Help:      "Number of open WatchGameState streams.",
+		},
+	)
+
+	// RedisPublishDuration observes how long it takes to store and publish a game state.
+	RedisPublishDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "publish_duration_seconds",
+			Help:      "Latency of publishing game state updates to redis in seconds.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		},
+		[]string{"result"},
+	)
+
+	// DecodeFailures counts pub/sub payloads that could not be decoded into a GameState.
+	DecodeFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "decode_failures_total",
+			Help:      "Total number of game state messages that failed to decode.",
+		},
+	)
+)
@@ -4,12 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/bradley-adams/gainline/gamestate/metrics"
 	gamestatev1 "github.com/bradley-adams/gainline/proto/gen/gamestate/v1"
 )
 
+var tracer = otel.Tracer("github.com/bradley-adams/gainline/gamestate/redis")
+
 type Client struct {
 	rdb *redis.Client
 }
@@ -35,7 +44,19 @@ func gameChannel(gameID string) string {
 }
 
 // SetGameState stores state as JSON and publishes it to the game's channel.
-func (c *Client) SetGameState(ctx context.Context, state *gamestatev1.GameState) error {
+func (c *Client) SetGameState(ctx context.Context, state *gamestatev1.GameState) (err error) {
+	ctx, span := tracer.Start(ctx, "redis.SetGameState", trace.WithAttributes(
+		semconv.DBSystemNameRedis,
+		attribute.String("game.id", state.GetGameId()),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	data, err := json.Marshal(state)
 	if err != nil {
 		return fmt.Errorf("marshal game state: %w", err)
@@ -45,9 +66,12 @@ func (c *Client) SetGameState(ctx context.Context, state *gamestatev1.GameState)
 		return fmt.Errorf("set game state: %w", err)
 	}
 
+	start := time.Now()
 	if err := c.rdb.Publish(ctx, gameChannel(state.GetGameId()), data).Err(); err != nil {
+		metrics.RedisPublishDuration.WithLabelValues("error").Observe(time.Since(start).Seconds())
 		return fmt.Errorf("publish game state: %w", err)
 	}
+	metrics.RedisPublishDuration.WithLabelValues("ok").Observe(time.Since(start).Seconds())
 
 	return nil
 }
@@ -55,7 +79,12 @@ func (c *Client) SetGameState(ctx context.Context, state *gamestatev1.GameState)
 // SubscribeGameState returns a channel of state updates for gameID.
 // The channel closes when ctx is cancelled.
 func (c *Client) SubscribeGameState(ctx context.Context, gameID string) (<-chan *gamestatev1.GameState, error) {
+	_, span := tracer.Start(ctx, "redis.SubscribeGameState", trace.WithAttributes(
+		semconv.DBSystemNameRedis,
+		attribute.String("game.id", gameID),
+	))
 	sub := c.rdb.Subscribe(ctx, gameChannel(gameID))
+	span.End()
 
 	out := make(chan *gamestatev1.GameState)
 
@@ -74,6 +103,7 @@ func (c *Client) SubscribeGameState(ctx context.Context, gameID string) (<-chan
 				}
 				var state gamestatev1.GameState
 				if err := json.Unmarshal([]byte(msg.Payload), &state); err != nil {
+					metrics.DecodeFailures.Inc()
 					continue
 				}
 				select {
@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"errors"
 
+	"github.com/bradley-adams/gainline/gamestate/metrics"
 	gamestatev1 "github.com/bradley-adams/gainline/proto/gen/gamestate/v1"
 )
 
@@ -29,6 +31,21 @@ func (s *Server) UpdateGameState(ctx context.Context, req *gamestatev1.UpdateGam
 	return &gamestatev1.UpdateGameStateResponse{}, nil
 }
 
+// UpdateGameStates writes and broadcasts several game states in one call. Every state is
+// attempted and the failures are returned joined.
+func (s *Server) UpdateGameStates(ctx context.Context, req *gamestatev1.UpdateGameStatesRequest) (*gamestatev1.UpdateGameStatesResponse, error) {
+	var errs []error
+	for _, state := range req.GetStates() {
+		if err := s.store.SetGameState(ctx, state); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &gamestatev1.UpdateGameStatesResponse{}, nil
+}
+
 // WatchGameState streams state updates for a game until the client disconnects.
 func (s *Server) WatchGameState(req *gamestatev1.WatchGameStateRequest, stream gamestatev1.GameStateService_WatchGameStateServer) error {
 	ctx := stream.Context()
@@ -38,6 +55,9 @@ func (s *Server) WatchGameState(req *gamestatev1.WatchGameStateRequest, stream g
 		return err
 	}
 
+	metrics.ActiveStreams.Inc()
+	defer metrics.ActiveStreams.Dec()
+
 	for {
 		select {
 		case <-ctx.Done():
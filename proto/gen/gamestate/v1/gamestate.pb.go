@@ -177,6 +177,86 @@ func (*UpdateGameStateResponse) Descriptor() ([]byte, []int) {
 	return file_gamestate_v1_gamestate_proto_rawDescGZIP(), []int{2}
 }
 
+type UpdateGameStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []*GameState           `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameStatesRequest) Reset() {
+	*x = UpdateGameStatesRequest{}
+	mi := &file_gamestate_v1_gamestate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameStatesRequest) ProtoMessage() {}
+
+func (x *UpdateGameStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gamestate_v1_gamestate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameStatesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameStatesRequest) Descriptor() ([]byte, []int) {
+	return file_gamestate_v1_gamestate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateGameStatesRequest) GetStates() []*GameState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type UpdateGameStatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameStatesResponse) Reset() {
+	*x = UpdateGameStatesResponse{}
+	mi := &file_gamestate_v1_gamestate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameStatesResponse) ProtoMessage() {}
+
+func (x *UpdateGameStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gamestate_v1_gamestate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameStatesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameStatesResponse) Descriptor() ([]byte, []int) {
+	return file_gamestate_v1_gamestate_proto_rawDescGZIP(), []int{4}
+}
+
 type WatchGameStateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
@@ -186,7 +266,7 @@ type WatchGameStateRequest struct {
 
 func (x *WatchGameStateRequest) Reset() {
 	*x = WatchGameStateRequest{}
-	mi := &file_gamestate_v1_gamestate_proto_msgTypes[3]
+	mi := &file_gamestate_v1_gamestate_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +278,7 @@ func (x *WatchGameStateRequest) String() string {
 func (*WatchGameStateRequest) ProtoMessage() {}
 
 func (x *WatchGameStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gamestate_v1_gamestate_proto_msgTypes[3]
+	mi := &file_gamestate_v1_gamestate_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +291,7 @@ func (x *WatchGameStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchGameStateRequest.ProtoReflect.Descriptor instead.
 func (*WatchGameStateRequest) Descriptor() ([]byte, []int) {
-	return file_gamestate_v1_gamestate_proto_rawDescGZIP(), []int{3}
+	return file_gamestate_v1_gamestate_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WatchGameStateRequest) GetGameId() string {
@@ -236,11 +316,15 @@ const file_gamestate_v1_gamestate_proto_rawDesc = "" +
 	"\x06minute\x18\x05 \x01(\x05R\x06minute\"G\n" +
 	"\x16UpdateGameStateRequest\x12-\n" +
 	"\x05state\x18\x01 \x01(\v2\x17.gamestate.v1.GameStateR\x05state\"\x19\n" +
-	"\x17UpdateGameStateResponse\"0\n" +
+	"\x17UpdateGameStateResponse\"J\n" +
+	"\x17UpdateGameStatesRequest\x12/\n" +
+	"\x06states\x18\x01 \x03(\v2\x17.gamestate.v1.GameStateR\x06states\"\x1a\n" +
+	"\x18UpdateGameStatesResponse\"0\n" +
 	"\x15WatchGameStateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId2\xc4\x01\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId2\xa7\x02\n" +
 	"\x10GameStateService\x12^\n" +
-	"\x0fUpdateGameState\x12$.gamestate.v1.UpdateGameStateRequest\x1a%.gamestate.v1.UpdateGameStateResponse\x12P\n" +
+	"\x0fUpdateGameState\x12$.gamestate.v1.UpdateGameStateRequest\x1a%.gamestate.v1.UpdateGameStateResponse\x12a\n" +
+	"\x10UpdateGameStates\x12%.gamestate.v1.UpdateGameStatesRequest\x1a&.gamestate.v1.UpdateGameStatesResponse\x12P\n" +
 	"\x0eWatchGameState\x12#.gamestate.v1.WatchGameStateRequest\x1a\x17.gamestate.v1.GameState0\x01BFZDgithub.com/bradley-adams/gainline/proto/gen/gamestate/v1;gamestatev1b\x06proto3"
 
 var (
@@ -255,24 +339,29 @@ func file_gamestate_v1_gamestate_proto_rawDescGZIP() []byte {
 	return file_gamestate_v1_gamestate_proto_rawDescData
 }
 
-var file_gamestate_v1_gamestate_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gamestate_v1_gamestate_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_gamestate_v1_gamestate_proto_goTypes = []any{
-	(*GameState)(nil),               // 0: gamestate.v1.GameState
-	(*UpdateGameStateRequest)(nil),  // 1: gamestate.v1.UpdateGameStateRequest
-	(*UpdateGameStateResponse)(nil), // 2: gamestate.v1.UpdateGameStateResponse
-	(*WatchGameStateRequest)(nil),   // 3: gamestate.v1.WatchGameStateRequest
+	(*GameState)(nil),                // 0: gamestate.v1.GameState
+	(*UpdateGameStateRequest)(nil),   // 1: gamestate.v1.UpdateGameStateRequest
+	(*UpdateGameStateResponse)(nil),  // 2: gamestate.v1.UpdateGameStateResponse
+	(*UpdateGameStatesRequest)(nil),  // 3: gamestate.v1.UpdateGameStatesRequest
+	(*UpdateGameStatesResponse)(nil), // 4: gamestate.v1.UpdateGameStatesResponse
+	(*WatchGameStateRequest)(nil),    // 5: gamestate.v1.WatchGameStateRequest
 }
 var file_gamestate_v1_gamestate_proto_depIdxs = []int32{
 	0, // 0: gamestate.v1.UpdateGameStateRequest.state:type_name -> gamestate.v1.GameState
-	1, // 1: gamestate.v1.GameStateService.UpdateGameState:input_type -> gamestate.v1.UpdateGameStateRequest
-	3, // 2: gamestate.v1.GameStateService.WatchGameState:input_type -> gamestate.v1.WatchGameStateRequest
-	2, // 3: gamestate.v1.GameStateService.UpdateGameState:output_type -> gamestate.v1.UpdateGameStateResponse
-	0, // 4: gamestate.v1.GameStateService.WatchGameState:output_type -> gamestate.v1.GameState
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 1: gamestate.v1.UpdateGameStatesRequest.states:type_name -> gamestate.v1.GameState
+	1, // 2: gamestate.v1.GameStateService.UpdateGameState:input_type -> gamestate.v1.UpdateGameStateRequest
+	3, // 3: gamestate.v1.GameStateService.UpdateGameStates:input_type -> gamestate.v1.UpdateGameStatesRequest
+	5, // 4: gamestate.v1.GameStateService.WatchGameState:input_type -> gamestate.v1.WatchGameStateRequest
+	2, // 5: gamestate.v1.GameStateService.UpdateGameState:output_type -> gamestate.v1.UpdateGameStateResponse
+	4, // 6: gamestate.v1.GameStateService.UpdateGameStates:output_type -> gamestate.v1.UpdateGameStatesResponse
+	0, // 7: gamestate.v1.GameStateService.WatchGameState:output_type -> gamestate.v1.GameState
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_gamestate_v1_gamestate_proto_init() }
@@ -286,7 +375,7 @@ func file_gamestate_v1_gamestate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gamestate_v1_gamestate_proto_rawDesc), len(file_gamestate_v1_gamestate_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GameStateService_UpdateGameState_FullMethodName = "/gamestate.v1.GameStateService/UpdateGameState"
-	GameStateService_WatchGameState_FullMethodName  = "/gamestate.v1.GameStateService/WatchGameState"
+	GameStateService_UpdateGameState_FullMethodName  = "/gamestate.v1.GameStateService/UpdateGameState"
+	GameStateService_UpdateGameStates_FullMethodName = "/gamestate.v1.GameStateService/UpdateGameStates"
+	GameStateService_WatchGameState_FullMethodName   = "/gamestate.v1.GameStateService/WatchGameState"
 )
 
 // GameStateServiceClient is the client API for GameStateService service.
@@ -28,6 +29,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GameStateServiceClient interface {
 	UpdateGameState(ctx context.Context, in *UpdateGameStateRequest, opts ...grpc.CallOption) (*UpdateGameStateResponse, error)
+	UpdateGameStates(ctx context.Context, in *UpdateGameStatesRequest, opts ...grpc.CallOption) (*UpdateGameStatesResponse, error)
 	WatchGameState(ctx context.Context, in *WatchGameStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameState], error)
 }
 
@@ -49,6 +51,16 @@ func (c *gameStateServiceClient) UpdateGameState(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *gameStateServiceClient) UpdateGameStates(ctx context.Context, in *UpdateGameStatesRequest, opts ...grpc.CallOption) (*UpdateGameStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGameStatesResponse)
+	err := c.cc.Invoke(ctx, GameStateService_UpdateGameStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gameStateServiceClient) WatchGameState(ctx context.Context, in *WatchGameStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameState], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &GameStateService_ServiceDesc.Streams[0], GameStateService_WatchGameState_FullMethodName, cOpts...)
@@ -73,6 +85,7 @@ type GameStateService_WatchGameStateClient = grpc.ServerStreamingClient[GameStat
 // for forward compatibility.
 type GameStateServiceServer interface {
 	UpdateGameState(context.Context, *UpdateGameStateRequest) (*UpdateGameStateResponse, error)
+	UpdateGameStates(context.Context, *UpdateGameStatesRequest) (*UpdateGameStatesResponse, error)
 	WatchGameState(*WatchGameStateRequest, grpc.ServerStreamingServer[GameState]) error
 	mustEmbedUnimplementedGameStateServiceServer()
 }
@@ -87,6 +100,9 @@ type UnimplementedGameStateServiceServer struct{}
 func (UnimplementedGameStateServiceServer) UpdateGameState(context.Context, *UpdateGameStateRequest) (*UpdateGameStateResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateGameState not implemented")
 }
+func (UnimplementedGameStateServiceServer) UpdateGameStates(context.Context, *UpdateGameStatesRequest) (*UpdateGameStatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGameStates not implemented")
+}
 func (UnimplementedGameStateServiceServer) WatchGameState(*WatchGameStateRequest, grpc.ServerStreamingServer[GameState]) error {
 	return status.Error(codes.Unimplemented, "method WatchGameState not implemented")
 }
@@ -129,6 +145,24 @@ func _GameStateService_UpdateGameState_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameStateService_UpdateGameStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameStateServiceServer).UpdateGameStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameStateService_UpdateGameStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameStateServiceServer).UpdateGameStates(ctx, req.(*UpdateGameStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GameStateService_WatchGameState_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WatchGameStateRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -151,6 +185,10 @@ var GameStateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGameState",
 			Handler:    _GameStateService_UpdateGameState_Handler,
 		},
+		{
+			MethodName: "UpdateGameStates",
+			Handler:    _GameStateService_UpdateGameStates_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,22 @@
+package prom
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+)
+
+// Serve exposes the default Prometheus registry at /metrics on METRICS_PORT, away from
+// the service's public listener so scrapes are neither authenticated nor counted as
+// requests. It blocks, so run it in its own goroutine.
+func Serve(logger zerolog.Logger) {
+	addr := ":" + viper.GetString("METRICS_PORT")
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	logger.Info().Str("addr", addr).Msg("serving metrics")
+	logger.Fatal().Err(http.ListenAndServe(addr, mux)).Msg("failed to serve metrics")
+}